@@ -0,0 +1,89 @@
+package bot
+
+import (
+	"math/rand"
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// Random folds, calls or raises at random. Raises are sized uniformly between the minimum and the maximum.
+type Random struct {
+	Rand       *rand.Rand
+	FoldChance float64 // Chance of folding when facing a bet
+	RaiseRate  float64 // Chance of betting or raising when allowed
+}
+
+// NewRandom creates a random bot that folds to a third of bets and raises a fifth of the time
+func NewRandom(seed int64) *Random {
+	return &Random{Rand: rand.New(rand.NewSource(seed)), FoldChance: 1.0 / 3, RaiseRate: 0.2}
+}
+
+// Act picks a random legal action
+func (b *Random) Act(observation Observation) game.Action {
+	legal := observation.Legal
+	if legal.CanRaise && b.Rand.Float64() < b.RaiseRate {
+		return raiseTo(observation, legal.MinRaise+b.Rand.Int63n(legal.MaxRaise-legal.MinRaise+1))
+	}
+	if !legal.CanCheck && b.Rand.Float64() < b.FoldChance {
+		return game.Action{Type: game.Fold}
+	}
+	return checkOrCall(legal)
+}
+
+// CallingStation never folds and never raises
+type CallingStation struct{}
+
+// Act checks or calls
+func (CallingStation) Act(observation Observation) game.Action {
+	return checkOrCall(observation.Legal)
+}
+
+// DefaultEquitySimulations is the number of Monte Carlo deals an Equity bot runs per decision
+const DefaultEquitySimulations = 200
+
+// Equity plays by its chance of winning against random hands, estimated with poker.CalculateWinProbability.
+// It bets or raises with at least RaiseEquity, calls when its equity beats the pot odds, and otherwise checks or folds.
+type Equity struct {
+	RaiseEquity float64 // Equity needed to bet or raise (default 0.6)
+	Simulations int     // Monte Carlo deals per decision (default DefaultEquitySimulations)
+}
+
+// Act compares the bot's equity against the pot odds
+func (b Equity) Act(observation Observation) game.Action {
+	raiseEquity := b.RaiseEquity
+	if raiseEquity == 0 {
+		raiseEquity = 0.6
+	}
+	simulations := b.Simulations
+	if simulations <= 0 {
+		simulations = DefaultEquitySimulations
+	}
+
+	players := 0
+	for _, p := range observation.State.Players {
+		if p.InHand && !p.Folded {
+			players++
+		}
+	}
+	win, tie := poker.CalculateWinProbability(observation.HoleCards, observation.State.Board, nil, players, simulations)
+	equity := win + tie/2
+
+	legal := observation.Legal
+	if legal.CanRaise && equity >= raiseEquity {
+		// Bet three quarters of the pot, or raise to three times the current bet
+		amount := observation.State.CurrentBet * 3
+		if observation.State.CurrentBet == 0 {
+			amount = observation.State.Pot * 3 / 4
+		}
+		return raiseTo(observation, amount)
+	}
+	if legal.CanCheck {
+		return game.Action{Type: game.Check}
+	}
+	potOdds := float64(legal.CallAmount) / float64(observation.State.Pot+legal.CallAmount)
+	if equity >= potOdds {
+		return game.Action{Type: game.Call}
+	}
+	return game.Action{Type: game.Fold}
+}
@@ -0,0 +1,69 @@
+// Package bot defines automated players for the game engine and a harness that plays them against each other,
+// in cash games measured in big blinds per 100 hands or in sit-and-go tournaments.
+package bot
+
+import (
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// Observation is what a bot sees when it is its turn: the public table, its own hole cards,
+// the hand so far and the actions it may take
+type Observation struct {
+	Seat      int
+	HoleCards []poker.Card
+	State     game.State
+	Legal     game.Legal
+	History   []game.Event // Events of the hand so far, without other players' hole cards
+}
+
+// Bot chooses an action for the player it controls. The action must be legal under Observation.Legal.
+type Bot interface {
+	Act(observation Observation) game.Action
+}
+
+// Func adapts a function to the Bot interface
+type Func func(observation Observation) game.Action
+
+// Act calls f
+func (f Func) Act(observation Observation) game.Action {
+	return f(observation)
+}
+
+// observe builds the observation of the player to act
+func observe(table *game.Table) (Observation, error) {
+	legal, err := table.LegalActions()
+	if err != nil {
+		return Observation{}, err
+	}
+	var history []game.Event
+	for _, event := range table.Events() {
+		if !event.Private || event.Seat == legal.Seat {
+			history = append(history, event)
+		}
+	}
+	return Observation{
+		Seat:      legal.Seat,
+		HoleCards: table.HoleCards(legal.Seat),
+		State:     table.State(),
+		Legal:     legal,
+		History:   history,
+	}, nil
+}
+
+// checkOrCall returns the passive action: check when possible, otherwise call
+func checkOrCall(legal game.Legal) game.Action {
+	if legal.CanCheck {
+		return game.Action{Type: game.Check}
+	}
+	return game.Action{Type: game.Call}
+}
+
+// raiseTo returns a bet or raise to amount, clamped to the legal range
+func raiseTo(observation Observation, amount int64) game.Action {
+	amount = max(observation.Legal.MinRaise, min(amount, observation.Legal.MaxRaise))
+	if observation.State.CurrentBet == 0 {
+		return game.Action{Type: game.Bet, Amount: amount}
+	}
+	return game.Action{Type: game.Raise, Amount: amount}
+}
@@ -0,0 +1,228 @@
+package bot
+
+import (
+	"math"
+	"testing"
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+var testTable = game.Config{SmallBlind: 1, BigBlind: 2}
+
+func TestMeanInterval(t *testing.T) {
+	interval := meanInterval([]float64{1, 2, 3, 4, 5})
+	// Standard deviation sqrt(2.5), so the half-width is 1.96 * sqrt(2.5 / 5)
+	if math.Abs(interval.Mean-3) > 1e-9 || math.Abs(interval.High-interval.Mean-1.3859) > 1e-4 {
+		t.Errorf("Expected 3 ± 1.3859, got %+v", interval)
+	}
+	if interval := meanInterval([]float64{4}); interval.Low != 4 || interval.High != 4 {
+		t.Errorf("Expected a single sample to have no spread, got %+v", interval)
+	}
+	if interval := meanInterval(nil); interval != (Interval{}) {
+		t.Errorf("Expected an empty interval, got %+v", interval)
+	}
+}
+
+func TestEntrantValidation(t *testing.T) {
+	if _, err := RunCash(CashConfig{Table: testTable}, []Entrant{{Name: "a", Bot: CallingStation{}}}); err == nil {
+		t.Error("Expected error for a single entrant")
+	}
+	if _, err := RunCash(CashConfig{Table: testTable}, []Entrant{{Name: "a", Bot: CallingStation{}}, {Name: "b"}}); err == nil {
+		t.Error("Expected error for an entrant without a bot")
+	}
+	three := []Entrant{{"a", CallingStation{}}, {"b", CallingStation{}}, {"c", CallingStation{}}}
+	config := testTable
+	config.Seats = 2
+	if _, err := RunTournaments(TournamentConfig{Table: config}, three); err == nil {
+		t.Error("Expected error for more entrants than seats")
+	}
+}
+
+func TestCashGameAccounting(t *testing.T) {
+	for _, structure := range []game.BettingStructure{game.NoLimit, game.PotLimit, game.FixedLimit} {
+		config := testTable
+		config.Structure = structure
+		entrants := []Entrant{
+			{"random-1", NewRandom(1)},
+			{"random-2", NewRandom(2)},
+			{"station", CallingStation{}},
+		}
+		result, err := RunCash(CashConfig{Table: config, Hands: 300, Seed: 1}, entrants)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", structure, err)
+		}
+
+		total := int64(0)
+		for _, standing := range result.Standings {
+			total += standing.Won
+			if standing.Hands != 300 {
+				t.Errorf("%s: expected 300 hands for %s, got %d", structure, standing.Name, standing.Hands)
+			}
+			expected := 100 * float64(standing.Won) / 2 / 300
+			if math.Abs(standing.BBPer100.Mean-expected) > 1e-9 {
+				t.Errorf("%s: expected %.2f bb/100 for %s, got %.2f", structure, expected, standing.Name, standing.BBPer100.Mean)
+			}
+			if standing.BBPer100.Low > standing.BBPer100.Mean || standing.BBPer100.High < standing.BBPer100.Mean {
+				t.Errorf("%s: expected the interval to contain the mean, got %+v", structure, standing.BBPer100)
+			}
+		}
+		if total != 0 {
+			t.Errorf("%s: expected winnings to sum to 0, got %d", structure, total)
+		}
+		if result.Standings[0].Won < result.Standings[2].Won {
+			t.Errorf("%s: expected standings sorted by winnings, got %+v", structure, result.Standings)
+		}
+	}
+}
+
+func TestCashGameReplaysWithSeed(t *testing.T) {
+	play := func() CashResult {
+		result, err := RunCash(CashConfig{Table: testTable, Hands: 100, Seed: 5}, []Entrant{
+			{"random", NewRandom(1)},
+			{"station", CallingStation{}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	first, second := play(), play()
+	if first.Standings[0] != second.Standings[0] {
+		t.Errorf("Expected the same result, got %+v and %+v", first.Standings[0], second.Standings[0])
+	}
+}
+
+func TestObservation(t *testing.T) {
+	checked := 0
+	watcher := Func(func(observation Observation) game.Action {
+		if len(observation.HoleCards) != 2 {
+			t.Errorf("Expected 2 hole cards, got %d", len(observation.HoleCards))
+		}
+		if observation.State.ToAct != observation.Seat || observation.Legal.Seat != observation.Seat {
+			t.Errorf("Expected seat %d to act, got %d", observation.Seat, observation.State.ToAct)
+		}
+		for _, event := range observation.History {
+			if event.Private && event.Seat != observation.Seat {
+				t.Errorf("Expected no other player's hole cards, got %+v", event)
+			}
+		}
+		checked++
+		return checkOrCall(observation.Legal)
+	})
+	if _, err := RunCash(CashConfig{Table: testTable, Hands: 20}, []Entrant{{"watcher", watcher}, {"station", CallingStation{}}, {"random", NewRandom(3)}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if checked == 0 {
+		t.Error("Expected the bot to be asked to act")
+	}
+}
+
+func TestCallingStationNeverFoldsOrRaises(t *testing.T) {
+	for _, legal := range []game.Legal{
+		{CanCheck: true, CanRaise: true, MinRaise: 2, MaxRaise: 100},
+		{CallAmount: 50, CanRaise: true, MinRaise: 100, MaxRaise: 200},
+	} {
+		action := CallingStation{}.Act(Observation{Legal: legal})
+		if action.Type != game.Check && action.Type != game.Call {
+			t.Errorf("Expected a check or call, got %s", action.Type)
+		}
+	}
+}
+
+func TestIllegalMoveIsReported(t *testing.T) {
+	cheater := Func(func(observation Observation) game.Action {
+		return game.Action{Type: game.Raise, Amount: observation.Legal.MaxRaise + 1}
+	})
+	if _, err := RunCash(CashConfig{Table: testTable, Hands: 10}, []Entrant{{"cheater", cheater}, {"station", CallingStation{}}}); err == nil {
+		t.Error("Expected error for an illegal raise")
+	}
+}
+
+func TestEquityBot(t *testing.T) {
+	observation := func(hole, board []string, legal game.Legal, currentBet, pot int64) Observation {
+		holeCards, _ := poker.ParseCards(hole)
+		boardCards, _ := poker.ParseCards(board)
+		return Observation{
+			HoleCards: holeCards,
+			Legal:     legal,
+			State: game.State{
+				Board:      boardCards,
+				Pot:        pot,
+				CurrentBet: currentBet,
+				Players:    []game.PlayerState{{Seat: 0, InHand: true}, {Seat: 1, InHand: true}},
+			},
+		}
+	}
+	bot := Equity{Simulations: 500}
+
+	// Quads on the river raise to three times the bet
+	action := bot.Act(observation([]string{"HA", "SA"}, []string{"CA", "DA", "H2", "S7", "D9"},
+		game.Legal{CallAmount: 10, CanRaise: true, MinRaise: 20, MaxRaise: 500}, 10, 30))
+	if action.Type != game.Raise || action.Amount != 30 {
+		t.Errorf("Expected a raise to 30, got %s %d", action.Type, action.Amount)
+	}
+
+	// Bets three quarters of the pot when checked to
+	action = bot.Act(observation([]string{"HA", "SA"}, []string{"CA", "DA", "H2"},
+		game.Legal{CanCheck: true, CanRaise: true, MinRaise: 2, MaxRaise: 500}, 0, 40))
+	if action.Type != game.Bet || action.Amount != 30 {
+		t.Errorf("Expected a bet of 30, got %s %d", action.Type, action.Amount)
+	}
+
+	// Seven high folds to a pot-sized bet on a board it missed
+	action = bot.Act(observation([]string{"H7", "S2"}, []string{"CA", "DK", "HQ", "SJ", "D9"},
+		game.Legal{CallAmount: 100, CanRaise: true, MinRaise: 200, MaxRaise: 500}, 100, 200))
+	if action.Type != game.Fold {
+		t.Errorf("Expected a fold, got %s", action.Type)
+	}
+
+	// A weak hand checks when it can
+	action = bot.Act(observation([]string{"H7", "S2"}, []string{"CA", "DK", "HQ"},
+		game.Legal{CanCheck: true, CanRaise: true, MinRaise: 2, MaxRaise: 500}, 0, 10))
+	if action.Type != game.Check {
+		t.Errorf("Expected a check, got %s", action.Type)
+	}
+}
+
+func TestTournaments(t *testing.T) {
+	entrants := []Entrant{
+		{"random-1", NewRandom(1)},
+		{"random-2", NewRandom(2)},
+		{"station-1", CallingStation{}},
+		{"station-2", CallingStation{}},
+	}
+	result, err := RunTournaments(TournamentConfig{Table: testTable, Tournaments: 20, Seed: 1}, entrants)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	prizes := 0.0
+	places := make([]int, len(entrants))
+	for _, standing := range result.Standings {
+		prizes += standing.Prize.Mean
+		finished := 0
+		for place, count := range standing.Finishes {
+			places[place] += count
+			finished += count
+		}
+		if finished != 20 {
+			t.Errorf("Expected 20 finishes for %s, got %d", standing.Name, finished)
+		}
+		if standing.AverageFinish < 1 || standing.AverageFinish > 4 {
+			t.Errorf("Expected an average finish between 1 and 4 for %s, got %.2f", standing.Name, standing.AverageFinish)
+		}
+	}
+	// Every tournament pays out 100 with exactly one player in each place
+	if math.Abs(prizes-100) > 1e-9 {
+		t.Errorf("Expected prizes to average 100 per tournament, got %.2f", prizes)
+	}
+	for place, count := range places {
+		if count != 20 {
+			t.Errorf("Expected 20 finishes in place %d, got %d", place+1, count)
+		}
+	}
+	if result.Hands < 20 {
+		t.Errorf("Expected at least one hand per tournament, got %d", result.Hands)
+	}
+}
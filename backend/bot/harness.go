@@ -0,0 +1,328 @@
+package bot
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"temperature-converter/game"
+)
+
+// Default match settings
+const (
+	DefaultHands             = 1000
+	DefaultCashStackBB       = 100
+	DefaultTournaments       = 100
+	DefaultTournamentStackBB = 50
+	DefaultHandsPerLevel     = 10
+	MaxTournamentHands       = 10000 // Tournaments still running after this many hands are ranked by stack
+)
+
+// DefaultPayouts are the prizes of a sit-and-go, 1st place first
+var DefaultPayouts = []float64{50, 30, 20}
+
+// Entrant is a named bot taking part in a match
+type Entrant struct {
+	Name string
+	Bot  Bot
+}
+
+// Interval is a sample mean with its 95% confidence interval
+type Interval struct {
+	Mean float64
+	Low  float64
+	High float64
+}
+
+// CashConfig describes a cash game match. Every entrant plays every hand, and stacks are reset
+// before each hand so results are not skewed by busting or deep stacks.
+type CashConfig struct {
+	Table game.Config // Stakes and rules; Seats defaults to the number of entrants
+	Stack int64       // Starting stack for every hand (default DefaultCashStackBB big blinds)
+	Hands int         // Hands to play (default DefaultHands)
+	Seed  int64       // Seed for the shuffles
+}
+
+// CashStanding is an entrant's result in a cash game match
+type CashStanding struct {
+	Name     string
+	Hands    int
+	Won      int64    // Net chips won
+	BBPer100 Interval // Big blinds won per 100 hands
+}
+
+// CashResult holds the standings of a cash game match, best first
+type CashResult struct {
+	Hands     int
+	Standings []CashStanding
+}
+
+// RunCash plays a cash game match between the entrants
+func RunCash(config CashConfig, entrants []Entrant) (CashResult, error) {
+	if err := validateEntrants(entrants); err != nil {
+		return CashResult{}, err
+	}
+	if config.Table.Seats == 0 {
+		config.Table.Seats = len(entrants)
+	}
+	if len(entrants) > config.Table.Seats {
+		return CashResult{}, fmt.Errorf("%d entrants do not fit at a %d-seat table", len(entrants), config.Table.Seats)
+	}
+	if config.Hands <= 0 {
+		config.Hands = DefaultHands
+	}
+	if config.Stack <= 0 {
+		config.Stack = DefaultCashStackBB * config.Table.BigBlind
+	}
+	table, err := game.NewTable(config.Table, config.Seed)
+	if err != nil {
+		return CashResult{}, err
+	}
+	bigBlind := float64(table.Config().BigBlind)
+
+	samples := make([][]float64, len(entrants))
+	won := make([]int64, len(entrants))
+	for hand := 0; hand < config.Hands; hand++ {
+		for seat, entrant := range entrants {
+			if hand > 0 {
+				table.Leave(seat)
+			}
+			if err := table.Sit(seat, entrant.Name, config.Stack); err != nil {
+				return CashResult{}, err
+			}
+		}
+		if err := playHand(table, entrants); err != nil {
+			return CashResult{}, err
+		}
+		for seat, net := range table.Result().Net {
+			samples[seat] = append(samples[seat], float64(net)/bigBlind)
+			won[seat] += net
+		}
+	}
+
+	result := CashResult{Hands: config.Hands}
+	for i, entrant := range entrants {
+		bbPer100 := meanInterval(samples[i])
+		result.Standings = append(result.Standings, CashStanding{
+			Name:  entrant.Name,
+			Hands: len(samples[i]),
+			Won:   won[i],
+			BBPer100: Interval{
+				Mean: 100 * bbPer100.Mean,
+				Low:  100 * bbPer100.Low,
+				High: 100 * bbPer100.High,
+			},
+		})
+	}
+	sort.SliceStable(result.Standings, func(a, b int) bool {
+		return result.Standings[a].Won > result.Standings[b].Won
+	})
+	return result, nil
+}
+
+// TournamentConfig describes a series of sit-and-go tournaments. Every tournament seats the entrants
+// in a random order; blinds and antes double every HandsPerLevel hands until one player has every chip.
+type TournamentConfig struct {
+	Table         game.Config // Starting stakes and rules; Seats defaults to the number of entrants
+	Stack         int64       // Starting stack (default DefaultTournamentStackBB starting big blinds)
+	Payouts       []float64   // Prize for each place, 1st first (default DefaultPayouts)
+	HandsPerLevel int         // Hands between blind increases (default DefaultHandsPerLevel)
+	Tournaments   int         // Tournaments to play (default DefaultTournaments)
+	Seed          int64       // Seed for the shuffles and seating
+}
+
+// TournamentStanding is an entrant's result over a series of tournaments
+type TournamentStanding struct {
+	Name          string
+	Finishes      []int    // Number of finishes in each place, 1st first
+	AverageFinish float64  // Average finishing place (1 is best)
+	Prize         Interval // Prize won per tournament
+}
+
+// TournamentResult holds the standings of a tournament series, best first
+type TournamentResult struct {
+	Tournaments int
+	Hands       int // Hands played over every tournament
+	Standings   []TournamentStanding
+}
+
+// RunTournaments plays a series of sit-and-go tournaments between the entrants
+func RunTournaments(config TournamentConfig, entrants []Entrant) (TournamentResult, error) {
+	if err := validateEntrants(entrants); err != nil {
+		return TournamentResult{}, err
+	}
+	if config.Table.Seats == 0 {
+		config.Table.Seats = len(entrants)
+	}
+	if len(entrants) > config.Table.Seats {
+		return TournamentResult{}, fmt.Errorf("%d entrants do not fit at a %d-seat table", len(entrants), config.Table.Seats)
+	}
+	if config.Stack <= 0 {
+		config.Stack = DefaultTournamentStackBB * config.Table.BigBlind
+	}
+	if len(config.Payouts) == 0 {
+		config.Payouts = DefaultPayouts
+	}
+	if config.HandsPerLevel <= 0 {
+		config.HandsPerLevel = DefaultHandsPerLevel
+	}
+	if config.Tournaments <= 0 {
+		config.Tournaments = DefaultTournaments
+	}
+
+	r := rand.New(rand.NewSource(config.Seed))
+	result := TournamentResult{Tournaments: config.Tournaments}
+	finishes := make([][]int, len(entrants))
+	prizes := make([][]float64, len(entrants))
+	for i := range entrants {
+		finishes[i] = make([]int, len(entrants))
+	}
+
+	for tournament := 0; tournament < config.Tournaments; tournament++ {
+		order := r.Perm(len(entrants))
+		places, hands, err := playTournament(config, entrants, order, r.Int63())
+		if err != nil {
+			return TournamentResult{}, err
+		}
+		result.Hands += hands
+		for i, place := range places {
+			finishes[i][place]++
+			prize := 0.0
+			if place < len(config.Payouts) {
+				prize = config.Payouts[place]
+			}
+			prizes[i] = append(prizes[i], prize)
+		}
+	}
+
+	for i, entrant := range entrants {
+		standing := TournamentStanding{
+			Name:     entrant.Name,
+			Finishes: finishes[i],
+			Prize:    meanInterval(prizes[i]),
+		}
+		for place, count := range finishes[i] {
+			standing.AverageFinish += float64((place+1)*count) / float64(config.Tournaments)
+		}
+		result.Standings = append(result.Standings, standing)
+	}
+	sort.SliceStable(result.Standings, func(a, b int) bool {
+		return result.Standings[a].Prize.Mean > result.Standings[b].Prize.Mean
+	})
+	return result, nil
+}
+
+// playTournament plays one tournament with entrant order[i] in seat i. It returns each entrant's
+// finishing place (0 for 1st) and the number of hands played.
+func playTournament(config TournamentConfig, entrants []Entrant, order []int, seed int64) ([]int, int, error) {
+	table, err := game.NewTable(config.Table, seed)
+	if err != nil {
+		return nil, 0, err
+	}
+	seated := make([]Entrant, len(entrants))
+	for seat, i := range order {
+		seated[seat] = entrants[i]
+		if err := table.Sit(seat, entrants[i].Name, config.Stack); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	places := make([]int, len(entrants))
+	remaining := len(entrants)
+	hands := 0
+	for ; remaining > 1 && hands < MaxTournamentHands; hands++ {
+		if hands > 0 && hands%config.HandsPerLevel == 0 {
+			level := table.Config()
+			if err := table.SetBlinds(2*level.SmallBlind, 2*level.BigBlind, 2*level.Ante); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		before := make([]int64, len(seated))
+		for seat := range seated {
+			before[seat] = table.Stack(seat)
+		}
+		if err := playHand(table, seated); err != nil {
+			return nil, 0, err
+		}
+
+		// Players busting on the same hand finish in order of the stacks they started it with
+		var busted []int
+		for seat := range seated {
+			if before[seat] > 0 && table.Stack(seat) == 0 {
+				busted = append(busted, seat)
+			}
+		}
+		sort.Slice(busted, func(a, b int) bool { return before[busted[a]] < before[busted[b]] })
+		for _, seat := range busted {
+			remaining--
+			places[order[seat]] = remaining
+		}
+	}
+
+	// Rank whoever is left by their stacks
+	var alive []int
+	for seat := range seated {
+		if table.Stack(seat) > 0 {
+			alive = append(alive, seat)
+		}
+	}
+	sort.SliceStable(alive, func(a, b int) bool { return table.Stack(alive[a]) > table.Stack(alive[b]) })
+	for place, seat := range alive {
+		places[order[seat]] = place
+	}
+	return places, hands, nil
+}
+
+// playHand deals a hand and lets the bot in each seat act until it is over
+func playHand(table *game.Table, seated []Entrant) error {
+	if err := table.StartHand(); err != nil {
+		return err
+	}
+	for table.InHand() {
+		observation, err := observe(table)
+		if err != nil {
+			return err
+		}
+		action := seated[observation.Seat].Bot.Act(observation)
+		if err := table.Act(observation.Seat, action); err != nil {
+			return fmt.Errorf("%s made an illegal move: %v", seated[observation.Seat].Name, err)
+		}
+	}
+	return nil
+}
+
+// validateEntrants checks that there are enough entrants and that each has a bot
+func validateEntrants(entrants []Entrant) error {
+	if len(entrants) < 2 {
+		return fmt.Errorf("need at least 2 entrants")
+	}
+	for i, entrant := range entrants {
+		if entrant.Bot == nil {
+			return fmt.Errorf("entrant %d has no bot", i)
+		}
+	}
+	return nil
+}
+
+// meanInterval returns the mean of the samples with a normal-approximation 95% confidence interval
+func meanInterval(samples []float64) Interval {
+	n := float64(len(samples))
+	if n == 0 {
+		return Interval{}
+	}
+	mean := 0.0
+	for _, sample := range samples {
+		mean += sample / n
+	}
+	if n < 2 {
+		return Interval{Mean: mean, Low: mean, High: mean}
+	}
+	variance := 0.0
+	for _, sample := range samples {
+		variance += (sample - mean) * (sample - mean) / (n - 1)
+	}
+	halfWidth := 1.96 * math.Sqrt(variance/n)
+	return Interval{Mean: mean, Low: mean - halfWidth, High: mean + halfWidth}
+}
@@ -0,0 +1,75 @@
+// Command selfplay pits bots against each other in a cash game or a series of sit-and-go tournaments
+// and prints each bot's win rate with a 95% confidence interval.
+//
+//	go run ./bot/selfplay -bots equity,station,random -hands 5000
+//	go run ./bot/selfplay -bots equity,station,random,random -sng 200
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"temperature-converter/bot"
+	"temperature-converter/game"
+)
+
+func main() {
+	bots := flag.String("bots", "equity,station,random", "comma-separated bots: random, station or equity")
+	hands := flag.Int("hands", bot.DefaultHands, "cash game hands to play")
+	sng := flag.Int("sng", 0, "play this many sit-and-go tournaments instead of a cash game")
+	smallBlind := flag.Int64("sb", 1, "small blind")
+	bigBlind := flag.Int64("bb", 2, "big blind")
+	structure := flag.String("structure", "no-limit", "no-limit, pot-limit or fixed-limit")
+	simulations := flag.Int("simulations", bot.DefaultEquitySimulations, "Monte Carlo deals per equity bot decision")
+	seed := flag.Int64("seed", 1, "random seed")
+	flag.Parse()
+
+	bettingStructure, err := game.ParseBettingStructure(*structure)
+	if err != nil {
+		log.Fatal(err)
+	}
+	table := game.Config{SmallBlind: *smallBlind, BigBlind: *bigBlind, Structure: bettingStructure}
+
+	var entrants []bot.Entrant
+	for i, name := range strings.Split(*bots, ",") {
+		entrant := bot.Entrant{Name: fmt.Sprintf("%s-%d", name, i+1)}
+		switch name {
+		case "random":
+			entrant.Bot = bot.NewRandom(*seed + int64(i))
+		case "station":
+			entrant.Bot = bot.CallingStation{}
+		case "equity":
+			entrant.Bot = bot.Equity{Simulations: *simulations}
+		default:
+			log.Fatalf("unknown bot: %q", name)
+		}
+		entrants = append(entrants, entrant)
+	}
+
+	if *sng > 0 {
+		result, err := bot.RunTournaments(bot.TournamentConfig{Table: table, Tournaments: *sng, Seed: *seed}, entrants)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%d tournaments, %d hands\n", result.Tournaments, result.Hands)
+		fmt.Printf("%-12s %10s %22s %s\n", "bot", "avg place", "prize (95% CI)", "finishes")
+		for _, standing := range result.Standings {
+			fmt.Printf("%-12s %10.2f %8.2f [%5.2f, %5.2f] %v\n", standing.Name, standing.AverageFinish,
+				standing.Prize.Mean, standing.Prize.Low, standing.Prize.High, standing.Finishes)
+		}
+		return
+	}
+
+	result, err := bot.RunCash(bot.CashConfig{Table: table, Hands: *hands, Seed: *seed}, entrants)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d hands\n", result.Hands)
+	fmt.Printf("%-12s %10s %28s\n", "bot", "chips", "bb/100 (95% CI)")
+	for _, standing := range result.Standings {
+		fmt.Printf("%-12s %10d %9.1f [%7.1f, %7.1f]\n", standing.Name, standing.Won,
+			standing.BBPer100.Mean, standing.BBPer100.Low, standing.BBPer100.High)
+	}
+}
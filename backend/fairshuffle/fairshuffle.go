@@ -0,0 +1,115 @@
+// Package fairshuffle deals provably fair decks with a commit-reveal protocol. The server picks a secret
+// seed with crypto/rand and publishes its SHA-256 hash before the hand; the deck order is derived from the
+// seed, the players' own client seeds and a nonce; after the hand the seed is revealed, so anyone can check
+// it against the hash and reproduce the deck.
+//
+// The deck starts in poker.GetDeck order and is shuffled with Fisher-Yates, from the last card down. Each
+// swap index comes from a stream of bytes made of the HMAC-SHA256 blocks
+//
+//	HMAC-SHA256(key = server seed, message = "<client seeds joined by ':'>:<nonce>:<block number from 0>")
+//
+// read four bytes at a time as big-endian integers, rejecting values that would bias the index.
+package fairshuffle
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+
+	"temperature-converter/poker"
+)
+
+// SeedSize is the size of a server seed in bytes
+const SeedSize = 32
+
+// ServerSeed is the server's secret for one hand
+type ServerSeed [SeedSize]byte
+
+// NewServerSeed picks a server seed with crypto/rand
+func NewServerSeed() ServerSeed {
+	var seed ServerSeed
+	rand.Read(seed[:])
+	return seed
+}
+
+// ParseServerSeed parses a server seed written in hex
+func ParseServerSeed(text string) (ServerSeed, error) {
+	var seed ServerSeed
+	bytes, err := hex.DecodeString(strings.TrimSpace(text))
+	if err != nil || len(bytes) != SeedSize {
+		return seed, fmt.Errorf("invalid server seed: expected %d hex characters", 2*SeedSize)
+	}
+	copy(seed[:], bytes)
+	return seed, nil
+}
+
+// String returns the seed in hex, the form it is revealed in
+func (s ServerSeed) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// Hash returns the hex SHA-256 hash of the seed, the commitment published before the hand
+func (s ServerSeed) Hash() string {
+	hash := sha256.Sum256(s[:])
+	return hex.EncodeToString(hash[:])
+}
+
+// Deck returns the deck order for a hand
+func Deck(seed ServerSeed, clientSeeds []string, nonce int64) []poker.Card {
+	deck := poker.GetDeck()
+	stream := &byteStream{mac: hmac.New(sha256.New, seed[:]), message: fmt.Sprintf("%s:%d:", strings.Join(clientSeeds, ":"), nonce)}
+	for i := len(deck) - 1; i > 0; i-- {
+		j := stream.intn(i + 1)
+		deck[i], deck[j] = deck[j], deck[i]
+	}
+	return deck
+}
+
+// Verify checks a revealed server seed against the hash published before the hand and returns the
+// deck it dealt
+func Verify(serverSeed, commitment string, clientSeeds []string, nonce int64) ([]poker.Card, error) {
+	seed, err := ParseServerSeed(serverSeed)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(seed.Hash(), strings.TrimSpace(commitment)) {
+		return nil, fmt.Errorf("server seed does not match the hash: it hashes to %s", seed.Hash())
+	}
+	return Deck(seed, clientSeeds, nonce), nil
+}
+
+// byteStream reads the HMAC blocks of a hand one after another
+type byteStream struct {
+	mac     hash.Hash
+	message string
+	block   int
+	buffer  []byte
+}
+
+// uint32 reads the next four bytes as a big-endian integer
+func (s *byteStream) uint32() uint32 {
+	if len(s.buffer) < 4 {
+		s.mac.Reset()
+		fmt.Fprintf(s.mac, "%s%d", s.message, s.block)
+		s.buffer = s.mac.Sum(nil)
+		s.block++
+	}
+	value := binary.BigEndian.Uint32(s.buffer)
+	s.buffer = s.buffer[4:]
+	return value
+}
+
+// intn returns a uniform integer in [0, n), rejecting the top values that do not divide evenly by n
+func (s *byteStream) intn(n int) int {
+	limit := (1 << 32) / uint64(n) * uint64(n)
+	for {
+		if value := uint64(s.uint32()); value < limit {
+			return int(value % uint64(n))
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package fairshuffle
+
+import (
+	"strings"
+	"testing"
+
+	"temperature-converter/poker"
+)
+
+func cardsText(cards []poker.Card) string {
+	texts := make([]string, len(cards))
+	for i, card := range cards {
+		texts[i] = poker.CardToString(card)
+	}
+	return strings.Join(texts, " ")
+}
+
+func TestDeckKnownAnswer(t *testing.T) {
+	// Worked out independently from the algorithm in the package documentation
+	var seed ServerSeed
+	if hash := seed.Hash(); hash != "66687aadf862bd776c8fc18b8e9f8e20089714856ee233b3902a591d0d5f2925" {
+		t.Errorf("Unexpected hash of the zero seed: %s", hash)
+	}
+	deck := Deck(seed, []string{"alice", "bob"}, 7)
+	if got := cardsText(deck[:9]); got != "DK HT C9 H3 H8 H5 SJ DQ CJ" {
+		t.Errorf("Unexpected deck order: %s", got)
+	}
+}
+
+func TestDeck(t *testing.T) {
+	seed := NewServerSeed()
+	deck := Deck(seed, []string{"alice", "bob"}, 1)
+	if len(deck) != 52 || poker.CheckDuplicateCards(deck) != nil {
+		t.Fatalf("Expected a full deck, got %d cards: %s", len(deck), cardsText(deck))
+	}
+	if cardsText(Deck(seed, []string{"alice", "bob"}, 1)) != cardsText(deck) {
+		t.Error("Expected the same inputs to give the same deck")
+	}
+
+	// Every input changes the order
+	for name, other := range map[string][]poker.Card{
+		"server seed": Deck(NewServerSeed(), []string{"alice", "bob"}, 1),
+		"client seed": Deck(seed, []string{"alice", "carol"}, 1),
+		"nonce":       Deck(seed, []string{"alice", "bob"}, 2),
+	} {
+		if cardsText(other) == cardsText(deck) {
+			t.Errorf("Expected a different deck for another %s", name)
+		}
+	}
+	if NewServerSeed() == seed {
+		t.Error("Expected a new server seed each time")
+	}
+}
+
+func TestDeckIsUniform(t *testing.T) {
+	// Over many hands each card lands on the top of the deck about as often as any other
+	const hands = 26000
+	seed := NewServerSeed()
+	counts := make(map[poker.Card]int)
+	for nonce := int64(0); nonce < hands; nonce++ {
+		counts[Deck(seed, nil, nonce)[0]]++
+	}
+	for card, count := range counts {
+		// 500 expected, with a standard deviation of about 22
+		if count < 400 || count > 600 {
+			t.Errorf("Expected %s on top about 500 times, got %d", poker.CardToString(card), count)
+		}
+	}
+	if len(counts) != 52 {
+		t.Errorf("Expected every card on top at some point, got %d cards", len(counts))
+	}
+}
+
+func TestVerify(t *testing.T) {
+	seed := NewServerSeed()
+	clientSeeds := []string{"alice", "bob"}
+
+	deck, err := Verify(seed.String(), seed.Hash(), clientSeeds, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cardsText(deck) != cardsText(Deck(seed, clientSeeds, 3)) {
+		t.Error("Expected the verified deck to be the dealt deck")
+	}
+	if _, err := Verify(strings.ToUpper(seed.String()), strings.ToUpper(seed.Hash()), clientSeeds, 3); err != nil {
+		t.Errorf("Expected upper case hex to verify, got %v", err)
+	}
+
+	testCases := []struct {
+		name       string
+		serverSeed string
+		hash       string
+	}{
+		{"Another seed", NewServerSeed().String(), seed.Hash()},
+		{"Not hex", "zz", seed.Hash()},
+		{"Short seed", seed.String()[:10], seed.Hash()},
+	}
+	for _, tc := range testCases {
+		if _, err := Verify(tc.serverSeed, tc.hash, clientSeeds, 3); err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+	}
+
+	parsed, err := ParseServerSeed(seed.String())
+	if err != nil || parsed != seed {
+		t.Errorf("Expected the seed to parse back, got %v (%v)", parsed, err)
+	}
+}
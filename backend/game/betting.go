@@ -0,0 +1,303 @@
+package game
+
+import (
+	"fmt"
+
+	"temperature-converter/poker"
+)
+
+// LegalActions returns what the player whose turn it is may do
+func (t *Table) LegalActions() (Legal, error) {
+	if !t.inHand || t.toAct < 0 {
+		return Legal{}, fmt.Errorf("no player is to act")
+	}
+	p := t.players[t.toAct]
+	stack := t.seats[p.seat].stack
+	toCall := t.currentBet - p.bet
+
+	legal := Legal{Seat: p.seat, CanCheck: toCall <= 0}
+	if toCall > 0 {
+		legal.CallAmount = min(toCall, stack)
+	}
+
+	// Raising needs chips beyond the call, someone left to respond, and a full raise since
+	// the player last acted (an all-in for less does not reopen the betting)
+	opponentsCanAct := false
+	for _, other := range t.players {
+		if other != p && !other.folded && !other.allIn {
+			opponentsCanAct = true
+		}
+	}
+	reopened := !p.acted || t.currentBet-p.facedBet >= t.lastRaise
+	capped := t.config.Structure == FixedLimit && t.bets >= t.config.MaxBets
+	if stack <= toCall || !opponentsCanAct || !reopened || capped {
+		return legal, nil
+	}
+
+	allIn := p.bet + stack
+	switch t.config.Structure {
+	case NoLimit:
+		legal.MinRaise = t.currentBet + max(t.lastRaise, t.config.BigBlind)
+		legal.MaxRaise = allIn
+	case PotLimit:
+		legal.MinRaise = t.currentBet + max(t.lastRaise, t.config.BigBlind)
+		// A pot-sized raise calls first, then raises by the whole pot
+		legal.MaxRaise = t.currentBet + t.pot() + toCall
+	case FixedLimit:
+		legal.MinRaise = t.currentBet + t.limitBet()
+		legal.MaxRaise = legal.MinRaise
+	}
+	if legal.MaxRaise > allIn {
+		legal.MaxRaise = allIn
+	}
+	if legal.MinRaise > allIn {
+		// Only an all-in for less than a full raise is possible
+		legal.MinRaise = allIn
+	}
+	legal.CanRaise = true
+	return legal, nil
+}
+
+// limitBet returns the fixed-limit bet size: the small bet preflop and on the flop, the big bet later
+func (t *Table) limitBet() int64 {
+	if t.street >= Turn {
+		return 2 * t.config.BigBlind
+	}
+	return t.config.BigBlind
+}
+
+// pot returns every chip committed in the hand
+func (t *Table) pot() int64 {
+	total := int64(0)
+	for _, p := range t.players {
+		total += p.committed
+	}
+	return total
+}
+
+// Act applies the action of the player in seatNumber, whose turn it must be
+func (t *Table) Act(seatNumber int, action Action) error {
+	legal, err := t.LegalActions()
+	if err != nil {
+		return err
+	}
+	if legal.Seat != seatNumber {
+		return fmt.Errorf("it is seat %d's turn, not seat %d's", legal.Seat, seatNumber)
+	}
+	p := t.players[t.toAct]
+
+	text := ""
+	switch action.Type {
+	case Fold:
+		p.folded = true
+		text = fmt.Sprintf("%s folds", t.name(p))
+	case Check:
+		if !legal.CanCheck {
+			return fmt.Errorf("cannot check facing a bet of %d", t.currentBet)
+		}
+		text = fmt.Sprintf("%s checks", t.name(p))
+	case Call:
+		if legal.CanCheck {
+			return fmt.Errorf("nothing to call")
+		}
+		t.commit(p, legal.CallAmount)
+		action.Amount = legal.CallAmount
+		text = fmt.Sprintf("%s calls %d", t.name(p), legal.CallAmount)
+	case Bet, Raise:
+		if action.Type == Bet && t.currentBet > 0 {
+			return fmt.Errorf("cannot bet facing a bet of %d; raise instead", t.currentBet)
+		}
+		if action.Type == Raise && t.currentBet == 0 {
+			return fmt.Errorf("cannot raise without a bet; bet instead")
+		}
+		if !legal.CanRaise {
+			return fmt.Errorf("%s is not allowed", action.Type)
+		}
+		if action.Amount < legal.MinRaise || action.Amount > legal.MaxRaise {
+			return fmt.Errorf("%s must be to between %d and %d", action.Type, legal.MinRaise, legal.MaxRaise)
+		}
+		if increase := action.Amount - t.currentBet; increase >= t.lastRaise {
+			// A full bet or raise sets the minimum for the next raise
+			t.lastRaise = increase
+			t.bets++
+		}
+		t.commit(p, action.Amount-p.bet)
+		t.currentBet = action.Amount
+		text = fmt.Sprintf("%s %ss to %d", t.name(p), action.Type, action.Amount)
+	default:
+		return fmt.Errorf("unknown action: %d", action.Type)
+	}
+	if p.allIn {
+		text += " and is all-in"
+	}
+
+	p.acted = true
+	p.facedBet = t.currentBet
+	if action.Type == Fold || action.Type == Check {
+		action.Amount = 0
+	}
+	t.emit(Event{Type: EventAction, Seat: p.seat, Action: action.Type, Amount: action.Amount, Text: text})
+
+	if next := t.nextToAct(t.toAct); next >= 0 {
+		t.toAct = next
+		return nil
+	}
+	return t.endRound()
+}
+
+// commit moves chips from a player's stack into their bet
+func (t *Table) commit(p *player, amount int64) {
+	amount = t.pay(p, amount)
+	p.bet += amount
+	p.committed += amount
+}
+
+// needsAction reports whether a player still has to act on the street
+func (t *Table) needsAction(p *player) bool {
+	return !p.folded && !p.allIn && (!p.acted || p.bet < t.currentBet)
+}
+
+// nextToAct returns the index of the next player after i who has to act, or -1 when the round is over
+func (t *Table) nextToAct(i int) int {
+	live, canAct := 0, 0
+	for _, p := range t.players {
+		if !p.folded {
+			live++
+			if !p.allIn {
+				canAct++
+			}
+		}
+	}
+	if live < 2 {
+		return -1
+	}
+	for step := 1; step <= len(t.players); step++ {
+		j := (i + step) % len(t.players)
+		p := t.players[j]
+		if !t.needsAction(p) {
+			continue
+		}
+		// A lone player facing only all-ins acts only if they still have a bet to call
+		if canAct == 1 && p.bet >= t.currentBet {
+			continue
+		}
+		return j
+	}
+	return -1
+}
+
+// endRound deals the following streets until someone has to act, or settles the hand
+func (t *Table) endRound() error {
+	t.toAct = -1
+	for {
+		live := 0
+		for _, p := range t.players {
+			if !p.folded {
+				live++
+			}
+		}
+		if live < 2 || t.street == River {
+			return t.settle(live)
+		}
+		t.dealStreet()
+
+		// Action starts left of the button
+		if next := t.nextToAct(t.buttonIdx); next >= 0 {
+			t.toAct = next
+			return nil
+		}
+	}
+}
+
+// dealStreet burns a card, deals the next street and resets the betting
+func (t *Table) dealStreet() {
+	t.street++
+	t.draw()
+	count := 1
+	if t.street == Flop {
+		count = 3
+	}
+	var cards []poker.Card
+	for i := 0; i < count; i++ {
+		cards = append(cards, t.draw())
+	}
+	t.board = append(t.board, cards...)
+
+	t.currentBet = 0
+	t.lastRaise = t.config.BigBlind
+	t.bets = 0
+	for _, p := range t.players {
+		p.bet = 0
+		p.acted = false
+		p.facedBet = 0
+	}
+	t.emit(Event{Type: EventBoard, Seat: -1, Cards: cards, Text: fmt.Sprintf("%s: %s", t.street, cardsText(t.board))})
+}
+
+// settle shows down the remaining hands and awards every pot
+func (t *Table) settle(live int) error {
+	showdownPlayers := make([]poker.ShowdownPlayer, len(t.players))
+	for i, p := range t.players {
+		showdownPlayers[i] = poker.ShowdownPlayer{
+			Name:      t.name(p),
+			HoleCards: p.holeCards,
+			Committed: p.committed,
+			Folded:    p.folded,
+		}
+	}
+	outcome, err := poker.Showdown(t.board, showdownPlayers, t.buttonIdx)
+	if err != nil {
+		return err
+	}
+
+	result := &HandResult{
+		HandNumber:   t.handNumber,
+		Board:        t.board,
+		Payouts:      make(map[int]int64),
+		Net:          make(map[int]int64),
+		Shown:        make(map[int]poker.Hand),
+		Explanations: outcome.Explanations,
+	}
+	if live > 1 {
+		t.street = Showdown
+		for i, p := range t.players {
+			if !p.folded {
+				result.Shown[p.seat] = outcome.Hands[i]
+				t.emit(Event{Type: EventShowdown, Seat: p.seat, Cards: p.holeCards,
+					Text: fmt.Sprintf("%s shows %s (%s)", t.name(p), cardsText(p.holeCards), outcome.Hands[i].Description)})
+			}
+		}
+	}
+	for i, pot := range outcome.Pots {
+		pot.Eligible = t.seatNumbers(pot.Eligible)
+		pot.Winners = t.seatNumbers(pot.Winners)
+		result.Pots = append(result.Pots, pot)
+		share := pot.Amount / int64(len(pot.Winners))
+		for j, winner := range pot.Winners {
+			amount := share
+			if int64(j) < pot.OddChips {
+				amount++
+			}
+			t.emit(Event{Type: EventPotAwarded, Seat: winner, Amount: amount, Text: outcome.Explanations[i]})
+		}
+	}
+	for i, p := range t.players {
+		t.seats[p.seat].stack += outcome.Payouts[i]
+		result.Payouts[p.seat] = outcome.Payouts[i]
+		result.Net[p.seat] = outcome.Payouts[i] - p.committed
+	}
+
+	t.result = result
+	t.inHand = false
+	t.emit(Event{Type: EventHandEnded, Seat: -1, Text: fmt.Sprintf("hand %d is over", t.handNumber)})
+	return nil
+}
+
+// seatNumbers converts player indexes to seat numbers
+func (t *Table) seatNumbers(indexes []int) []int {
+	seats := make([]int, len(indexes))
+	for i, index := range indexes {
+		seats[i] = t.players[index].seat
+	}
+	return seats
+}
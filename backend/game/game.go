@@ -0,0 +1,227 @@
+// Package game runs Texas Hold'em hands at a table: seating, the button, antes and blinds,
+// betting rounds under no-limit, pot-limit or fixed-limit rules, all-ins, side pots and showdown.
+// Shuffles come from a seeded random source, so a table replays identically for the same seed and actions,
+// unless the deck is supplied with StartHandWithDeck.
+package game
+
+import (
+	"fmt"
+
+	"temperature-converter/poker"
+)
+
+// BettingStructure is the rule that limits bet and raise sizes
+type BettingStructure int
+
+const (
+	NoLimit BettingStructure = iota
+	PotLimit
+	FixedLimit
+)
+
+// String returns the structure's name
+func (s BettingStructure) String() string {
+	switch s {
+	case NoLimit:
+		return "no-limit"
+	case PotLimit:
+		return "pot-limit"
+	case FixedLimit:
+		return "fixed-limit"
+	}
+	return fmt.Sprintf("BettingStructure(%d)", int(s))
+}
+
+// ParseBettingStructure parses a structure name such as "no-limit"
+func ParseBettingStructure(name string) (BettingStructure, error) {
+	for structure := NoLimit; structure <= FixedLimit; structure++ {
+		if structure.String() == name {
+			return structure, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown betting structure: %q", name)
+}
+
+// Street is a betting round of a hand
+type Street int
+
+const (
+	Preflop Street = iota
+	Flop
+	Turn
+	River
+	Showdown
+)
+
+// String returns the street's name
+func (s Street) String() string {
+	switch s {
+	case Preflop:
+		return "preflop"
+	case Flop:
+		return "flop"
+	case Turn:
+		return "turn"
+	case River:
+		return "river"
+	case Showdown:
+		return "showdown"
+	}
+	return fmt.Sprintf("Street(%d)", int(s))
+}
+
+// ActionType is a player's decision when it is their turn
+type ActionType int
+
+const (
+	Fold ActionType = iota
+	Check
+	Call
+	Bet
+	Raise
+)
+
+// String returns the action's name
+func (a ActionType) String() string {
+	switch a {
+	case Fold:
+		return "fold"
+	case Check:
+		return "check"
+	case Call:
+		return "call"
+	case Bet:
+		return "bet"
+	case Raise:
+		return "raise"
+	}
+	return fmt.Sprintf("ActionType(%d)", int(a))
+}
+
+// ParseActionType parses an action name such as "fold" or "raise"
+func ParseActionType(name string) (ActionType, error) {
+	for action := Fold; action <= Raise; action++ {
+		if action.String() == name {
+			return action, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown action: %q", name)
+}
+
+// Action is a player's decision. For Bet and Raise, Amount is the player's total bet on the street
+// after the action ("raise to"); it is ignored for the other actions.
+type Action struct {
+	Type   ActionType
+	Amount int64
+}
+
+// Config describes the stakes and rules of a table
+type Config struct {
+	Seats      int   // Number of seats, 2 to 10
+	SmallBlind int64 // Small blind
+	BigBlind   int64 // Big blind; also the minimum bet, and the small bet in fixed-limit
+	Ante       int64 // Ante posted by every player in the hand
+	Structure  BettingStructure
+	MaxBets    int // Fixed-limit cap on bets per street, counting the big blind or opening bet (defaults to 4)
+}
+
+// validate checks the config and fills in defaults
+func (c *Config) validate() error {
+	if c.Seats < 2 || c.Seats > 10 {
+		return fmt.Errorf("table must have between 2 and 10 seats")
+	}
+	if c.BigBlind <= 0 {
+		return fmt.Errorf("big blind must be positive")
+	}
+	if c.SmallBlind < 0 || c.SmallBlind > c.BigBlind {
+		return fmt.Errorf("small blind must be between 0 and the big blind")
+	}
+	if c.Ante < 0 {
+		return fmt.Errorf("ante must not be negative")
+	}
+	if c.Structure < NoLimit || c.Structure > FixedLimit {
+		return fmt.Errorf("unknown betting structure: %d", c.Structure)
+	}
+	if c.MaxBets == 0 {
+		c.MaxBets = 4
+	}
+	if c.MaxBets < 1 {
+		return fmt.Errorf("max bets must be positive")
+	}
+	return nil
+}
+
+// EventType identifies what happened in an Event
+type EventType string
+
+const (
+	EventHandStarted EventType = "hand_started"
+	EventAnte        EventType = "ante"
+	EventSmallBlind  EventType = "small_blind"
+	EventBigBlind    EventType = "big_blind"
+	EventHoleCards   EventType = "hole_cards"
+	EventAction      EventType = "action"
+	EventBoard       EventType = "board"
+	EventShowdown    EventType = "showdown"
+	EventPotAwarded  EventType = "pot_awarded"
+	EventHandEnded   EventType = "hand_ended"
+)
+
+// Event is something that happened during a hand, in order
+type Event struct {
+	Type    EventType
+	Seat    int          // Seat the event is about, or -1
+	Action  ActionType   // The action, for EventAction
+	Amount  int64        // Chips posted, called, bet or raised to, or won
+	Cards   []poker.Card // Hole cards, board cards or the cards shown down
+	Street  Street
+	Private bool   // Only the player in Seat may see the event (their hole cards)
+	Text    string // Human-readable description
+}
+
+// Legal describes the actions available to the player whose turn it is
+type Legal struct {
+	Seat       int
+	CanCheck   bool
+	CallAmount int64 // Chips needed to call, capped at the player's stack (0 when checking is possible)
+	CanRaise   bool  // Bet when CurrentBet is 0, raise otherwise
+	MinRaise   int64 // Smallest total bet after betting or raising (an all-in may be smaller)
+	MaxRaise   int64 // Largest total bet after betting or raising
+}
+
+// PlayerState is the public state of a seat
+type PlayerState struct {
+	Seat      int
+	Name      string
+	Stack     int64 // Chips behind
+	Bet       int64 // Chips put in on the current street
+	Committed int64 // Chips put in during the hand
+	InHand    bool  // Dealt into the current hand
+	Folded    bool
+	AllIn     bool
+}
+
+// State is a public snapshot of the table
+type State struct {
+	HandNumber int
+	Street     Street
+	Button     int // Button seat, or -1 before the first hand
+	Board      []poker.Card
+	Pot        int64 // All chips committed in the hand, including the current street
+	CurrentBet int64 // Largest bet on the current street
+	ToAct      int   // Seat whose turn it is, or -1
+	Legal      *Legal
+	Players    []PlayerState // One entry per occupied seat
+	Config     Config
+}
+
+// HandResult is how a finished hand was settled
+type HandResult struct {
+	HandNumber   int
+	Board        []poker.Card
+	Pots         []poker.PotResult // Eligible and Winners hold seat numbers
+	Payouts      map[int]int64     // Chips won by each seat in the hand
+	Net          map[int]int64     // Chips won minus chips committed by each seat in the hand
+	Shown        map[int]poker.Hand
+	Explanations []string
+}
@@ -0,0 +1,354 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+
+	"temperature-converter/poker"
+)
+
+// seat is a chair at the table
+type seat struct {
+	name     string
+	stack    int64
+	occupied bool
+}
+
+// player is a seat's state during a hand
+type player struct {
+	seat      int
+	holeCards []poker.Card
+	bet       int64 // Chips put in on the current street
+	committed int64 // Chips put in during the hand
+	folded    bool
+	allIn     bool
+	acted     bool  // Has acted on the current street
+	facedBet  int64 // Current bet right after the player last acted
+}
+
+// Table is a poker table that deals one hand at a time. It is not safe for concurrent use.
+type Table struct {
+	config     Config
+	seats      []seat
+	button     int
+	rng        *rand.Rand
+	handNumber int
+
+	// Current or last hand
+	inHand     bool
+	players    []*player // Players dealt in, in seat order
+	buttonIdx  int       // Index of the button in players
+	deck       []poker.Card
+	board      []poker.Card
+	street     Street
+	currentBet int64
+	lastRaise  int64 // Size of the last full bet or raise on the street
+	bets       int   // Bets and raises on the street, counting the big blind
+	toAct      int   // Index into players, or -1
+	events     []Event
+	result     *HandResult
+}
+
+// NewTable creates an empty table. The seed drives every shuffle.
+func NewTable(config Config, seed int64) (*Table, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &Table{
+		config: config,
+		seats:  make([]seat, config.Seats),
+		button: -1,
+		rng:    rand.New(rand.NewSource(seed)),
+		toAct:  -1,
+	}, nil
+}
+
+// Config returns the table's configuration
+func (t *Table) Config() Config {
+	return t.config
+}
+
+// SetBlinds changes the blinds and ante from the next hand, as when a tournament level goes up
+func (t *Table) SetBlinds(smallBlind, bigBlind, ante int64) error {
+	if t.inHand {
+		return fmt.Errorf("cannot change the blinds during a hand")
+	}
+	config := t.config
+	config.SmallBlind, config.BigBlind, config.Ante = smallBlind, bigBlind, ante
+	if err := config.validate(); err != nil {
+		return err
+	}
+	t.config = config
+	return nil
+}
+
+// Sit places a player with a stack in an empty seat. They are dealt in from the next hand.
+func (t *Table) Sit(seatNumber int, name string, stack int64) error {
+	if seatNumber < 0 || seatNumber >= len(t.seats) {
+		return fmt.Errorf("seat must be between 0 and %d", len(t.seats)-1)
+	}
+	if t.seats[seatNumber].occupied {
+		return fmt.Errorf("seat %d is taken", seatNumber)
+	}
+	if stack <= 0 {
+		return fmt.Errorf("stack must be positive")
+	}
+	t.seats[seatNumber] = seat{name: name, stack: stack, occupied: true}
+	return nil
+}
+
+// Leave empties a seat. A player still in a hand must wait for it to finish.
+func (t *Table) Leave(seatNumber int) error {
+	if seatNumber < 0 || seatNumber >= len(t.seats) || !t.seats[seatNumber].occupied {
+		return fmt.Errorf("seat %d is empty", seatNumber)
+	}
+	if p := t.player(seatNumber); t.inHand && p != nil && !p.folded {
+		return fmt.Errorf("seat %d is still in the hand", seatNumber)
+	}
+	t.seats[seatNumber] = seat{}
+	return nil
+}
+
+// Stack returns the chips behind in a seat
+func (t *Table) Stack(seatNumber int) int64 {
+	if seatNumber < 0 || seatNumber >= len(t.seats) {
+		return 0
+	}
+	return t.seats[seatNumber].stack
+}
+
+// HoleCards returns the hole cards dealt to a seat in the current or last hand
+func (t *Table) HoleCards(seatNumber int) []poker.Card {
+	if p := t.player(seatNumber); p != nil {
+		return p.holeCards
+	}
+	return nil
+}
+
+// InHand reports whether a hand is being played
+func (t *Table) InHand() bool {
+	return t.inHand
+}
+
+// Events returns every event of the current or last hand
+func (t *Table) Events() []Event {
+	return t.events
+}
+
+// Result returns the settlement of the last hand, or nil while a hand is being played
+func (t *Table) Result() *HandResult {
+	if t.inHand {
+		return nil
+	}
+	return t.result
+}
+
+// ToAct returns the seat whose turn it is, or -1
+func (t *Table) ToAct() int {
+	if !t.inHand || t.toAct < 0 {
+		return -1
+	}
+	return t.players[t.toAct].seat
+}
+
+// State returns a public snapshot of the table
+func (t *Table) State() State {
+	state := State{
+		HandNumber: t.handNumber,
+		Street:     t.street,
+		Button:     t.button,
+		Board:      append([]poker.Card{}, t.board...),
+		CurrentBet: t.currentBet,
+		ToAct:      t.ToAct(),
+		Config:     t.config,
+	}
+	if legal, err := t.LegalActions(); err == nil {
+		state.Legal = &legal
+	}
+	for number, s := range t.seats {
+		if !s.occupied {
+			continue
+		}
+		ps := PlayerState{Seat: number, Name: s.name, Stack: s.stack}
+		if p := t.player(number); p != nil {
+			ps.Bet = p.bet
+			ps.Committed = p.committed
+			ps.InHand = t.inHand
+			ps.Folded = p.folded
+			ps.AllIn = p.allIn
+			state.Pot += p.committed
+		}
+		state.Players = append(state.Players, ps)
+	}
+	return state
+}
+
+// player returns the hand state of a seat, or nil if it was not dealt in
+func (t *Table) player(seatNumber int) *player {
+	for _, p := range t.players {
+		if p.seat == seatNumber {
+			return p
+		}
+	}
+	return nil
+}
+
+// StartHand moves the button, posts antes and blinds, shuffles and deals hole cards.
+// Every occupied seat with chips is dealt in; at least two are needed.
+func (t *Table) StartHand() error {
+	return t.startHand(nil)
+}
+
+// StartHandWithDeck starts a hand like StartHand, but deals from deck, first card first, instead of
+// shuffling. The deck must hold all 52 cards; it is used for provably fair shuffles made outside the table.
+func (t *Table) StartHandWithDeck(deck []poker.Card) error {
+	if len(deck) != 52 {
+		return fmt.Errorf("expected a deck of 52 cards, got %d", len(deck))
+	}
+	if err := poker.CheckDuplicateCards(deck); err != nil {
+		return err
+	}
+	return t.startHand(deck)
+}
+
+// startHand starts a hand with the given deck, or a shuffled one when deck is nil
+func (t *Table) startHand(deck []poker.Card) error {
+	if t.inHand {
+		return fmt.Errorf("hand %d is still being played", t.handNumber)
+	}
+	var dealt []*player
+	for number, s := range t.seats {
+		if s.occupied && s.stack > 0 {
+			dealt = append(dealt, &player{seat: number})
+		}
+	}
+	if len(dealt) < 2 {
+		return fmt.Errorf("need at least 2 players with chips to start a hand")
+	}
+
+	// The button moves to the next player dealt in
+	t.buttonIdx = 0
+	for i, p := range dealt {
+		if p.seat > t.button {
+			t.buttonIdx = i
+			break
+		}
+	}
+	t.button = dealt[t.buttonIdx].seat
+
+	t.handNumber++
+	t.inHand = true
+	t.players = dealt
+	t.board = nil
+	t.street = Preflop
+	t.currentBet = 0
+	t.lastRaise = t.config.BigBlind
+	t.bets = 0
+	t.toAct = -1
+	t.events = nil
+	t.result = nil
+	if deck != nil {
+		t.deck = append([]poker.Card{}, deck...)
+	} else {
+		t.deck = poker.GetDeck()
+		t.rng.Shuffle(len(t.deck), func(i, j int) { t.deck[i], t.deck[j] = t.deck[j], t.deck[i] })
+	}
+	t.emit(Event{Type: EventHandStarted, Seat: t.button, Text: fmt.Sprintf("hand %d, button on seat %d", t.handNumber, t.button)})
+
+	if t.config.Ante > 0 {
+		for _, p := range t.players {
+			amount := t.pay(p, t.config.Ante)
+			p.committed += amount
+			t.emit(Event{Type: EventAnte, Seat: p.seat, Amount: amount, Text: fmt.Sprintf("%s posts an ante of %d", t.name(p), amount)})
+		}
+	}
+
+	// Heads-up the button posts the small blind
+	smallBlind := t.next(t.buttonIdx)
+	if len(t.players) == 2 {
+		smallBlind = t.buttonIdx
+	}
+	bigBlind := t.next(smallBlind)
+	t.postBlind(t.players[smallBlind], t.config.SmallBlind, EventSmallBlind, "small blind")
+	t.postBlind(t.players[bigBlind], t.config.BigBlind, EventBigBlind, "big blind")
+	t.currentBet = t.config.BigBlind
+	t.bets = 1
+
+	// Deal one card at a time starting with the small blind
+	for round := 0; round < 2; round++ {
+		for i := range t.players {
+			p := t.players[(smallBlind+i)%len(t.players)]
+			p.holeCards = append(p.holeCards, t.draw())
+		}
+	}
+	for _, p := range t.players {
+		t.emit(Event{Type: EventHoleCards, Seat: p.seat, Cards: p.holeCards, Private: true, Text: fmt.Sprintf("%s is dealt %s", t.name(p), cardsText(p.holeCards))})
+	}
+
+	// Action starts left of the big blind
+	t.toAct = t.nextToAct(bigBlind)
+	if t.toAct < 0 {
+		return t.endRound()
+	}
+	return nil
+}
+
+// postBlind puts a blind in front of a player, all-in if they cannot cover it
+func (t *Table) postBlind(p *player, blind int64, eventType EventType, label string) {
+	if blind == 0 {
+		return
+	}
+	amount := t.pay(p, blind)
+	p.bet += amount
+	p.committed += amount
+	t.emit(Event{Type: eventType, Seat: p.seat, Amount: amount, Text: fmt.Sprintf("%s posts the %s of %d", t.name(p), label, amount)})
+}
+
+// pay moves up to amount chips from a player's stack and returns how many moved
+func (t *Table) pay(p *player, amount int64) int64 {
+	s := &t.seats[p.seat]
+	if amount >= s.stack {
+		amount = s.stack
+		p.allIn = true
+	}
+	s.stack -= amount
+	return amount
+}
+
+// draw deals the top card of the deck
+func (t *Table) draw() poker.Card {
+	card := t.deck[0]
+	t.deck = t.deck[1:]
+	return card
+}
+
+// next returns the index of the player after i in seat order
+func (t *Table) next(i int) int {
+	return (i + 1) % len(t.players)
+}
+
+// name returns a player's name, or their seat
+func (t *Table) name(p *player) string {
+	if name := t.seats[p.seat].name; name != "" {
+		return name
+	}
+	return fmt.Sprintf("seat %d", p.seat)
+}
+
+// emit records an event
+func (t *Table) emit(event Event) {
+	event.Street = t.street
+	t.events = append(t.events, event)
+}
+
+// cardsText formats cards as a space-separated list
+func cardsText(cards []poker.Card) string {
+	text := ""
+	for i, card := range cards {
+		if i > 0 {
+			text += " "
+		}
+		text += poker.CardToString(card)
+	}
+	return text
+}
@@ -0,0 +1,718 @@
+package game
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"temperature-converter/poker"
+)
+
+// newTestTable seats one player per stack, in seats 0, 1, 2, ...
+func newTestTable(t *testing.T, config Config, stacks ...int64) *Table {
+	if config.Seats == 0 {
+		config.Seats = 6
+	}
+	table, err := NewTable(config, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for seat, stack := range stacks {
+		if err := table.Sit(seat, "", stack); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	return table
+}
+
+// mustAct applies an action and fails the test if it is rejected
+func mustAct(t *testing.T, table *Table, seat int, actionType ActionType, amount int64) {
+	t.Helper()
+	if err := table.Act(seat, Action{Type: actionType, Amount: amount}); err != nil {
+		t.Fatalf("Unexpected error for seat %d %s %d: %v", seat, actionType, amount, err)
+	}
+}
+
+// mustLegal returns the legal actions and fails the test if there are none
+func mustLegal(t *testing.T, table *Table) Legal {
+	t.Helper()
+	legal, err := table.LegalActions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return legal
+}
+
+// stackCards replaces the hole cards and the rest of the deck so a hand runs out a known way.
+// The board is dealt with burn cards in between, as the table does.
+func stackCards(t *testing.T, table *Table, holeCards map[int][]string, board []string) {
+	t.Helper()
+	var used []poker.Card
+	for seat, cards := range holeCards {
+		parsed, err := poker.ParseCards(cards)
+		if err != nil {
+			t.Fatalf("Failed to parse hole cards: %v", err)
+		}
+		table.player(seat).holeCards = parsed
+		used = append(used, parsed...)
+	}
+	boardCards, err := poker.ParseCards(board)
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	used = append(used, boardCards...)
+	burns := poker.RemoveCards(poker.GetDeck(), used)
+	table.deck = []poker.Card{
+		burns[0], boardCards[0], boardCards[1], boardCards[2],
+		burns[1], boardCards[3],
+		burns[2], boardCards[4],
+	}
+}
+
+func totalChips(table *Table) int64 {
+	total := int64(0)
+	for seat := range table.seats {
+		total += table.Stack(seat)
+	}
+	for _, p := range table.players {
+		if table.inHand {
+			total += p.committed
+		}
+	}
+	return total
+}
+
+func TestConfigValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config Config
+	}{
+		{"one seat", Config{Seats: 1, SmallBlind: 1, BigBlind: 2}},
+		{"eleven seats", Config{Seats: 11, SmallBlind: 1, BigBlind: 2}},
+		{"no big blind", Config{Seats: 6, SmallBlind: 0, BigBlind: 0}},
+		{"small blind above big blind", Config{Seats: 6, SmallBlind: 3, BigBlind: 2}},
+		{"negative ante", Config{Seats: 6, SmallBlind: 1, BigBlind: 2, Ante: -1}},
+		{"unknown structure", Config{Seats: 6, SmallBlind: 1, BigBlind: 2, Structure: 7}},
+		{"negative max bets", Config{Seats: 6, SmallBlind: 1, BigBlind: 2, MaxBets: -1}},
+	}
+	for _, tc := range testCases {
+		if _, err := NewTable(tc.config, 1); err == nil {
+			t.Errorf("Expected error for %s", tc.name)
+		}
+	}
+
+	table, err := NewTable(Config{Seats: 2, SmallBlind: 1, BigBlind: 2, Structure: FixedLimit}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if table.Config().MaxBets != 4 {
+		t.Errorf("Expected default max bets 4, got %d", table.Config().MaxBets)
+	}
+}
+
+func TestSetBlinds(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 1000)
+	if err := table.SetBlinds(20, 10, 0); err == nil {
+		t.Error("Expected error for a small blind above the big blind")
+	}
+	if err := table.SetBlinds(10, 20, 5); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	table.StartHand()
+	if err := table.SetBlinds(20, 40, 5); err == nil {
+		t.Error("Expected error changing the blinds during a hand")
+	}
+	if table.State().Pot != 40 {
+		t.Errorf("Expected blinds of 10 and 20 with antes of 5, got a pot of %d", table.State().Pot)
+	}
+}
+
+func TestSeating(t *testing.T) {
+	table := newTestTable(t, Config{Seats: 3, SmallBlind: 5, BigBlind: 10}, 1000)
+	if err := table.Sit(0, "Bob", 1000); err == nil {
+		t.Error("Expected error sitting in a taken seat")
+	}
+	if err := table.Sit(3, "Bob", 1000); err == nil {
+		t.Error("Expected error sitting in a seat that does not exist")
+	}
+	if err := table.Sit(1, "Bob", 0); err == nil {
+		t.Error("Expected error sitting without chips")
+	}
+	if err := table.StartHand(); err == nil {
+		t.Error("Expected error starting a hand with one player")
+	}
+	if err := table.Leave(2); err == nil {
+		t.Error("Expected error leaving an empty seat")
+	}
+
+	if err := table.Sit(2, "Bob", 500); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := table.StartHand(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := table.StartHand(); err == nil {
+		t.Error("Expected error starting a hand while one is being played")
+	}
+	if err := table.Leave(2); err == nil {
+		t.Error("Expected error leaving while still in the hand")
+	}
+	if table.Result() != nil {
+		t.Error("Expected no result while the hand is being played")
+	}
+}
+
+func TestBlindsAndActionOrder(t *testing.T) {
+	table, _ := NewTable(Config{Seats: 9, SmallBlind: 5, BigBlind: 10}, 1)
+	for _, seat := range []int{1, 4, 7} {
+		table.Sit(seat, "", 1000)
+	}
+
+	if err := table.StartHand(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	state := table.State()
+	if state.Button != 1 {
+		t.Errorf("Expected button on seat 1, got %d", state.Button)
+	}
+	if table.Stack(4) != 995 || table.Stack(7) != 990 {
+		t.Errorf("Expected blinds from seats 4 and 7, got stacks %d and %d", table.Stack(4), table.Stack(7))
+	}
+	if state.Pot != 15 || state.CurrentBet != 10 {
+		t.Errorf("Expected pot 15 facing 10, got %d facing %d", state.Pot, state.CurrentBet)
+	}
+	// Three-handed the button is first to act preflop
+	if state.ToAct != 1 {
+		t.Errorf("Expected seat 1 to act, got %d", state.ToAct)
+	}
+	legal := mustLegal(t, table)
+	if legal.CanCheck || legal.CallAmount != 10 || !legal.CanRaise || legal.MinRaise != 20 || legal.MaxRaise != 1000 {
+		t.Errorf("Unexpected legal actions: %+v", legal)
+	}
+	for _, seat := range []int{1, 4, 7} {
+		if len(table.HoleCards(seat)) != 2 {
+			t.Errorf("Expected 2 hole cards for seat %d, got %d", seat, len(table.HoleCards(seat)))
+		}
+	}
+
+	mustAct(t, table, 1, Call, 0)
+	mustAct(t, table, 4, Call, 0)
+	// The big blind has the option
+	legal = mustLegal(t, table)
+	if legal.Seat != 7 || !legal.CanCheck {
+		t.Errorf("Expected the big blind to be able to check, got %+v", legal)
+	}
+	mustAct(t, table, 7, Check, 0)
+
+	// After the flop the small blind acts first
+	state = table.State()
+	if state.Street != Flop || len(state.Board) != 3 || state.ToAct != 4 || state.CurrentBet != 0 {
+		t.Errorf("Expected the flop with seat 4 to act, got %s with %d cards and seat %d to act", state.Street, len(state.Board), state.ToAct)
+	}
+	legal = mustLegal(t, table)
+	if !legal.CanCheck || legal.MinRaise != 10 {
+		t.Errorf("Unexpected legal actions on the flop: %+v", legal)
+	}
+
+	// Fold around to finish the hand, then the button moves
+	mustAct(t, table, 4, Bet, 10)
+	mustAct(t, table, 7, Fold, 0)
+	mustAct(t, table, 1, Fold, 0)
+	if err := table.StartHand(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if table.State().Button != 4 || table.ToAct() != 4 {
+		t.Errorf("Expected button on seat 4 and to act, got %d and %d", table.State().Button, table.ToAct())
+	}
+}
+
+func TestHeadsUpBlinds(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 1000)
+	if err := table.StartHand(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The button posts the small blind and acts first preflop, last afterwards
+	if table.Stack(0) != 995 || table.Stack(1) != 990 {
+		t.Errorf("Expected the button to post the small blind, got stacks %d and %d", table.Stack(0), table.Stack(1))
+	}
+	if table.ToAct() != 0 {
+		t.Errorf("Expected the button to act first preflop, got seat %d", table.ToAct())
+	}
+	mustAct(t, table, 0, Call, 0)
+	mustAct(t, table, 1, Check, 0)
+	if table.ToAct() != 1 {
+		t.Errorf("Expected the big blind to act first on the flop, got seat %d", table.ToAct())
+	}
+}
+
+func TestNoLimitMinRaise(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+	table.StartHand()
+
+	if err := table.Act(0, Action{Type: Raise, Amount: 15}); err == nil {
+		t.Error("Expected error raising by less than the big blind")
+	}
+	if err := table.Act(0, Action{Type: Bet, Amount: 30}); err == nil {
+		t.Error("Expected error betting facing the big blind")
+	}
+	mustAct(t, table, 0, Raise, 35)
+
+	// The next raise must be at least as big as the last one
+	legal := mustLegal(t, table)
+	if legal.CallAmount != 30 || legal.MinRaise != 60 || legal.MaxRaise != 1000 {
+		t.Errorf("Unexpected legal actions: %+v", legal)
+	}
+	if err := table.Act(1, Action{Type: Raise, Amount: 59}); err == nil {
+		t.Error("Expected error raising by less than the last raise")
+	}
+	if err := table.Act(1, Action{Type: Raise, Amount: 1001}); err == nil {
+		t.Error("Expected error raising more than the stack")
+	}
+	mustAct(t, table, 1, Raise, 100)
+	legal = mustLegal(t, table)
+	if legal.MinRaise != 165 {
+		t.Errorf("Expected min raise to 165, got %d", legal.MinRaise)
+	}
+
+	// Going all-in is always allowed
+	mustAct(t, table, 2, Raise, 1000)
+	legal = mustLegal(t, table)
+	if legal.CanRaise || legal.CallAmount != 965 {
+		t.Errorf("Expected only a call of 965 facing an all-in, got %+v", legal)
+	}
+}
+
+func TestPotLimitMaxRaise(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10, Structure: PotLimit}, 1000, 1000, 1000)
+	table.StartHand()
+
+	// Call 10, then raise the pot of 25: to 35
+	legal := mustLegal(t, table)
+	if legal.MinRaise != 20 || legal.MaxRaise != 35 {
+		t.Errorf("Expected raises between 20 and 35, got %+v", legal)
+	}
+	if err := table.Act(0, Action{Type: Raise, Amount: 36}); err == nil {
+		t.Error("Expected error raising more than the pot")
+	}
+	mustAct(t, table, 0, Raise, 35)
+
+	// Call 30 into 50, then raise 80: to 115
+	legal = mustLegal(t, table)
+	if legal.MaxRaise != 115 {
+		t.Errorf("Expected max raise to 115, got %d", legal.MaxRaise)
+	}
+	mustAct(t, table, 1, Call, 0)
+	mustAct(t, table, 2, Call, 0)
+
+	// On the flop the pot is 105
+	legal = mustLegal(t, table)
+	if legal.MinRaise != 10 || legal.MaxRaise != 105 {
+		t.Errorf("Expected bets between 10 and 105, got %+v", legal)
+	}
+}
+
+func TestFixedLimitSizesAndCap(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10, Structure: FixedLimit}, 1000, 1000, 1000)
+	table.StartHand()
+
+	legal := mustLegal(t, table)
+	if legal.MinRaise != 20 || legal.MaxRaise != 20 {
+		t.Errorf("Expected a raise to exactly 20, got %+v", legal)
+	}
+	if err := table.Act(0, Action{Type: Raise, Amount: 30}); err == nil {
+		t.Error("Expected error raising more than the limit")
+	}
+	mustAct(t, table, 0, Raise, 20)
+	mustAct(t, table, 1, Raise, 30)
+	mustAct(t, table, 2, Raise, 40)
+
+	// Four bets cap the betting
+	legal = mustLegal(t, table)
+	if legal.CanRaise || legal.CallAmount != 20 {
+		t.Errorf("Expected the betting to be capped, got %+v", legal)
+	}
+	mustAct(t, table, 0, Call, 0)
+	mustAct(t, table, 1, Call, 0)
+
+	// Small bets on the flop, big bets on the turn
+	legal = mustLegal(t, table)
+	if legal.MinRaise != 10 || legal.MaxRaise != 10 {
+		t.Errorf("Expected a bet of 10 on the flop, got %+v", legal)
+	}
+	for _, seat := range []int{1, 2, 0} {
+		mustAct(t, table, seat, Check, 0)
+	}
+	legal = mustLegal(t, table)
+	if table.State().Street != Turn || legal.MinRaise != 20 || legal.MaxRaise != 20 {
+		t.Errorf("Expected a bet of 20 on the turn, got %+v", legal)
+	}
+}
+
+func TestIncompleteAllInDoesNotReopen(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 40)
+	table.StartHand()
+
+	mustAct(t, table, 0, Raise, 30)
+	mustAct(t, table, 1, Call, 0)
+
+	// The big blind can only go all-in for less than a full raise
+	legal := mustLegal(t, table)
+	if legal.Seat != 2 || legal.MinRaise != 40 || legal.MaxRaise != 40 {
+		t.Errorf("Expected an all-in raise to 40, got %+v", legal)
+	}
+	mustAct(t, table, 2, Raise, 40)
+
+	// Players who already acted may only call or fold
+	legal = mustLegal(t, table)
+	if legal.Seat != 0 || legal.CanRaise || legal.CallAmount != 10 {
+		t.Errorf("Expected seat 0 to only call 10, got %+v", legal)
+	}
+	mustAct(t, table, 0, Call, 0)
+	legal = mustLegal(t, table)
+	if legal.Seat != 1 || legal.CanRaise {
+		t.Errorf("Expected seat 1 to only call, got %+v", legal)
+	}
+	mustAct(t, table, 1, Call, 0)
+	if table.State().Street != Flop {
+		t.Errorf("Expected the flop, got %s", table.State().Street)
+	}
+}
+
+func TestFullAllInReopens(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 50)
+	table.StartHand()
+
+	mustAct(t, table, 0, Raise, 20)
+	mustAct(t, table, 1, Call, 0)
+	mustAct(t, table, 2, Raise, 50)
+
+	legal := mustLegal(t, table)
+	if !legal.CanRaise || legal.MinRaise != 80 {
+		t.Errorf("Expected a full all-in raise to reopen the betting, got %+v", legal)
+	}
+}
+
+func TestSidePots(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 100, 300, 500)
+	table.StartHand()
+	stackCards(t, table, map[int][]string{
+		0: {"HA", "SA"},
+		1: {"HK", "SK"},
+		2: {"HQ", "SQ"},
+	}, []string{"C2", "D7", "C9", "D4", "H3"})
+
+	mustAct(t, table, 0, Raise, 100)
+	mustAct(t, table, 1, Raise, 300)
+	mustAct(t, table, 2, Call, 0)
+
+	// Nobody can bet any more, so the board runs out
+	result := table.Result()
+	if result == nil {
+		t.Fatal("Expected the hand to be over")
+	}
+	if len(result.Board) != 5 || table.State().Street != Showdown {
+		t.Errorf("Expected a full board at showdown, got %d cards on %s", len(result.Board), table.State().Street)
+	}
+	if len(result.Pots) != 2 || result.Pots[0].Amount != 300 || result.Pots[1].Amount != 400 {
+		t.Fatalf("Expected pots of 300 and 400, got %+v", result.Pots)
+	}
+	if result.Pots[0].Winners[0] != 0 || result.Pots[1].Winners[0] != 1 {
+		t.Errorf("Expected seat 0 to win the main pot and seat 1 the side pot, got %+v", result.Pots)
+	}
+	expectedStacks := []int64{300, 400, 200}
+	for seat, stack := range expectedStacks {
+		if table.Stack(seat) != stack {
+			t.Errorf("Expected stack %d for seat %d, got %d", stack, seat, table.Stack(seat))
+		}
+	}
+	if result.Net[2] != -300 || result.Payouts[1] != 400 {
+		t.Errorf("Unexpected net result: %+v", result.Net)
+	}
+	if len(result.Shown) != 3 {
+		t.Errorf("Expected 3 hands shown, got %d", len(result.Shown))
+	}
+}
+
+func TestSplitPotOddChip(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10, Ante: 1}, 1000, 1000, 1000)
+	table.StartHand()
+	stackCards(t, table, map[int][]string{
+		0: {"H2", "S3"},
+		1: {"HA", "SK"},
+		2: {"DA", "CK"},
+	}, []string{"C2", "D7", "C9", "DQ", "HJ"})
+
+	// 3 antes and three bets of 20 make 63 for the two winners
+	mustAct(t, table, 0, Raise, 20)
+	mustAct(t, table, 1, Call, 0)
+	mustAct(t, table, 2, Call, 0)
+	for street := 0; street < 3; street++ {
+		mustAct(t, table, 1, Check, 0)
+		mustAct(t, table, 2, Check, 0)
+		if street == 0 {
+			mustAct(t, table, 0, Fold, 0)
+		}
+	}
+
+	result := table.Result()
+	if result == nil {
+		t.Fatal("Expected the hand to be over")
+	}
+	if result.Payouts[1] != 32 || result.Payouts[2] != 31 {
+		t.Errorf("Expected the odd chip for seat 1, left of the button, got %+v", result.Payouts)
+	}
+	if result.Pots[0].OddChips != 1 {
+		t.Errorf("Expected 1 odd chip, got %d", result.Pots[0].OddChips)
+	}
+	if _, ok := result.Shown[0]; ok {
+		t.Error("Expected the folded hand not to be shown")
+	}
+}
+
+func TestUncontestedPot(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10, Ante: 2}, 1000, 1000, 1000)
+	table.StartHand()
+
+	if table.State().Pot != 21 {
+		t.Errorf("Expected antes and blinds of 21, got %d", table.State().Pot)
+	}
+	mustAct(t, table, 0, Fold, 0)
+	mustAct(t, table, 1, Fold, 0)
+
+	result := table.Result()
+	if result == nil {
+		t.Fatal("Expected the hand to be over")
+	}
+	if result.Payouts[2] != 21 || result.Net[2] != 9 || result.Net[1] != -7 {
+		t.Errorf("Expected the big blind to win 21, got payouts %+v and net %+v", result.Payouts, result.Net)
+	}
+	if len(result.Shown) != 0 || len(result.Board) != 0 {
+		t.Errorf("Expected no showdown or board, got %d hands and %d cards", len(result.Shown), len(result.Board))
+	}
+	if table.ToAct() != -1 || table.InHand() {
+		t.Error("Expected no hand in progress")
+	}
+
+	events := table.Events()
+	last := events[len(events)-1]
+	if last.Type != EventHandEnded {
+		t.Errorf("Expected the last event to end the hand, got %s", last.Type)
+	}
+	if !strings.Contains(events[len(events)-2].Text, "uncontested") {
+		t.Errorf("Expected an uncontested pot, got %q", events[len(events)-2].Text)
+	}
+}
+
+func TestShortBlindsAllIn(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 3, 8)
+	table.StartHand()
+
+	// Both blinds are all-in, so the button only has to call
+	legal := mustLegal(t, table)
+	if legal.Seat != 0 || legal.CallAmount != 10 || legal.CanRaise {
+		t.Errorf("Expected the button to only call 10, got %+v", legal)
+	}
+	mustAct(t, table, 0, Call, 0)
+	result := table.Result()
+	if result == nil || len(result.Board) != 5 {
+		t.Fatal("Expected the board to run out")
+	}
+	if totalChips(table) != 1011 {
+		t.Errorf("Expected 1011 chips, got %d", totalChips(table))
+	}
+}
+
+func TestEventsAndPrivateCards(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 1000)
+	table.StartHand()
+
+	private := 0
+	for _, event := range table.Events() {
+		if event.Type == EventHoleCards {
+			private++
+			if !event.Private || len(event.Cards) != 2 {
+				t.Errorf("Expected private hole cards, got %+v", event)
+			}
+		}
+	}
+	if private != 2 {
+		t.Errorf("Expected 2 hole card events, got %d", private)
+	}
+
+	mustAct(t, table, 0, Raise, 30)
+	events := table.Events()
+	last := events[len(events)-1]
+	if last.Type != EventAction || last.Action != Raise || last.Amount != 30 || last.Seat != 0 {
+		t.Errorf("Unexpected action event: %+v", last)
+	}
+	if err := table.Act(0, Action{Type: Call}); err == nil {
+		t.Error("Expected error acting out of turn")
+	}
+	if err := table.Act(1, Action{Type: Check}); err == nil {
+		t.Error("Expected error checking facing a raise")
+	}
+	if _, err := ParseActionType("muck"); err == nil {
+		t.Error("Expected error parsing an unknown action")
+	}
+	if action, _ := ParseActionType("raise"); action != Raise {
+		t.Errorf("Expected raise, got %s", action)
+	}
+	if structure, _ := ParseBettingStructure("pot-limit"); structure != PotLimit {
+		t.Errorf("Expected pot-limit, got %s", structure)
+	}
+	if _, err := ParseBettingStructure("spread-limit"); err == nil {
+		t.Error("Expected error parsing an unknown betting structure")
+	}
+}
+
+// playRandomHand plays a hand with random legal actions
+func playRandomHand(t *testing.T, table *Table, r *rand.Rand) {
+	t.Helper()
+	for table.InHand() {
+		legal := mustLegal(t, table)
+		action := Action{Type: Fold}
+		switch choice := r.Intn(10); {
+		case choice < 2 && legal.CanRaise:
+			action.Type = Raise
+			if table.State().CurrentBet == 0 {
+				action.Type = Bet
+			}
+			action.Amount = legal.MinRaise + r.Int63n(legal.MaxRaise-legal.MinRaise+1)
+		case choice < 8 && legal.CanCheck:
+			action.Type = Check
+		case choice < 8:
+			action.Type = Call
+		case legal.CanCheck:
+			action.Type = Check
+		}
+		if err := table.Act(legal.Seat, action); err != nil {
+			t.Fatalf("Unexpected error for %+v with %+v: %v", action, legal, err)
+		}
+	}
+}
+
+func TestRandomPlayConservesChips(t *testing.T) {
+	for _, structure := range []BettingStructure{NoLimit, PotLimit, FixedLimit} {
+		config := Config{Seats: 6, SmallBlind: 5, BigBlind: 10, Ante: 1, Structure: structure}
+		table := newTestTable(t, config, 200, 500, 300, 1000, 150, 400)
+		r := rand.New(rand.NewSource(7))
+		total := int64(2550)
+
+		for hands := 0; hands < 300; hands++ {
+			// Busted players buy back in
+			for seat := range table.seats {
+				if table.Stack(seat) == 0 {
+					table.Leave(seat)
+					table.Sit(seat, "", 300)
+					total += 300
+				}
+			}
+			if err := table.StartHand(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			playRandomHand(t, table, r)
+
+			if chips := totalChips(table); chips != total {
+				t.Fatalf("%s hand %d: expected %d chips, got %d", structure, hands+1, total, chips)
+			}
+			result := table.Result()
+			net := int64(0)
+			for _, amount := range result.Net {
+				net += amount
+			}
+			if net != 0 {
+				t.Fatalf("%s hand %d: expected net results to sum to 0, got %d", structure, hands+1, net)
+			}
+			for seat := range table.seats {
+				if table.Stack(seat) < 0 {
+					t.Fatalf("%s hand %d: negative stack for seat %d", structure, hands+1, seat)
+				}
+			}
+		}
+	}
+}
+
+func TestSameSeedReplays(t *testing.T) {
+	play := func() []Event {
+		table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 1000, 1000)
+		r := rand.New(rand.NewSource(3))
+		var events []Event
+		for hand := 0; hand < 5; hand++ {
+			table.StartHand()
+			playRandomHand(t, table, r)
+			events = append(events, table.Events()...)
+		}
+		return events
+	}
+
+	first, second := play(), play()
+	if len(first) != len(second) {
+		t.Fatalf("Expected the same number of events, got %d and %d", len(first), len(second))
+	}
+	for i := range first {
+		if first[i].Text != second[i].Text {
+			t.Fatalf("Expected event %d to replay, got %q and %q", i, first[i].Text, second[i].Text)
+		}
+	}
+}
+
+func TestStartHandWithDeck(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2}, 100, 100, 100)
+	deck := poker.GetDeck()
+
+	// Cards are dealt one at a time starting with the small blind, seat 1
+	if err := table.StartHandWithDeck(deck); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for seat, cards := range map[int][]poker.Card{1: {deck[0], deck[3]}, 2: {deck[1], deck[4]}, 0: {deck[2], deck[5]}} {
+		if got := table.HoleCards(seat); len(got) != 2 || got[0] != cards[0] || got[1] != cards[1] {
+			t.Errorf("Expected seat %d to get %v, got %v", seat, cards, got)
+		}
+	}
+
+	short := newTestTable(t, Config{SmallBlind: 1, BigBlind: 2}, 100, 100)
+	if err := short.StartHandWithDeck(deck[:51]); err == nil {
+		t.Error("Expected error for a short deck")
+	}
+	if err := short.StartHandWithDeck(append(deck[:51:51], deck[0])); err == nil {
+		t.Error("Expected error for a duplicate card")
+	}
+	if short.InHand() {
+		t.Error("Expected no hand to start with a bad deck")
+	}
+}
+
+func TestBustedPlayersAreSkipped(t *testing.T) {
+	table := newTestTable(t, Config{SmallBlind: 5, BigBlind: 10}, 1000, 5, 1000)
+	table.StartHand()
+	stackCards(t, table, map[int][]string{
+		0: {"HA", "SA"},
+		1: {"H2", "S7"},
+		2: {"HK", "SK"},
+	}, []string{"C3", "D8", "C9", "DJ", "HQ"})
+
+	// Seat 1 posts its whole stack as the small blind and loses
+	mustAct(t, table, 0, Call, 0)
+	mustAct(t, table, 2, Check, 0)
+	for table.InHand() {
+		mustAct(t, table, table.ToAct(), Check, 0)
+	}
+	if table.Stack(1) != 0 {
+		t.Fatalf("Expected seat 1 to bust, got %d", table.Stack(1))
+	}
+
+	if err := table.StartHand(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	state := table.State()
+	if state.Button != 2 {
+		t.Errorf("Expected the button to skip the busted seat, got %d", state.Button)
+	}
+	if table.player(1) != nil {
+		t.Error("Expected the busted seat not to be dealt in")
+	}
+	if err := table.Leave(1); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,426 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "temperature-converter/pb"
+)
+
+// maxHandHistoryUpload limits the size of an uploaded hand history file
+const maxHandHistoryUpload = 64 << 20
+
+// phhContentType is the media type of a PHH file, returned by /poker/hand-history/export?download=true
+const phhContentType = "application/toml"
+
+// REST clients see the proto field names and every field, and may send fields this version does not know
+var (
+	restMarshalOptions   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	restUnmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// streamPaths are the routes of the client streaming RPCs, which the in-process handlers cannot serve
+var streamPaths = map[string]bool{
+	"/poker/batch/evaluate-hands/stream": true,
+	"/poker/batch/compare-hands/stream":  true,
+}
+
+// newGateway serves the REST API generated from the google.api.http rules in poker.proto and
+// temperature.proto. Unary calls go straight to the services; streaming calls go through conn.
+// JSON uses the proto field names.
+func newGateway(ctx context.Context, temperature pb.TemperatureConverterServer, poker pb.PokerEvaluatorServer, conn *grpc.ClientConn) (http.Handler, error) {
+	jsonMarshaler := restMarshaler{&runtime.JSONPb{
+		MarshalOptions:   restMarshalOptions,
+		UnmarshalOptions: restUnmarshalOptions,
+	}}
+	newMux := func() *runtime.ServeMux {
+		return runtime.NewServeMux(
+			runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+			runtime.WithMarshalerOption(phhContentType, phhMarshaler{jsonMarshaler}),
+			runtime.WithForwardResponseOption(phhAttachment),
+			// The services send no metadata meant for REST clients
+			runtime.WithOutgoingHeaderMatcher(func(string) (string, bool) { return "", false }),
+			runtime.WithErrorHandler(writeGatewayError),
+			runtime.WithRoutingErrorHandler(writeRoutingError),
+		)
+	}
+
+	unary := newMux()
+	if err := pb.RegisterTemperatureConverterHandlerServer(ctx, unary, temperature); err != nil {
+		return nil, err
+	}
+	if err := pb.RegisterPokerEvaluatorHandlerServer(ctx, unary, poker); err != nil {
+		return nil, err
+	}
+	streams := newMux()
+	if err := pb.RegisterPokerEvaluatorHandler(ctx, streams, conn); err != nil {
+		return nil, err
+	}
+	return adaptRESTRequest(unary, streams), nil
+}
+
+// writeGatewayError writes a failed call as a plain text message. Errors the services return without
+// a gRPC status code are the caller's fault, so they are bad requests.
+func writeGatewayError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	s := status.Convert(err)
+	code := http.StatusBadRequest
+	if s.Code() != codes.Unknown {
+		code = runtime.HTTPStatusFromCode(s.Code())
+	}
+	http.Error(w, s.Message(), code)
+}
+
+// writeRoutingError writes an unknown path or a wrong method as a plain text 404 or 405
+func writeRoutingError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	http.Error(w, http.StatusText(httpStatus), httpStatus)
+}
+
+// adaptRESTRequest turns the requests the REST API takes besides JSON bodies into ones the gateway
+// routes: hand history files and plain text uploads, PHH downloads, and NDJSON batches. Streaming
+// routes go to streams and the others to unary.
+func adaptRESTRequest(unary, streams http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			switch r.URL.Path {
+			case "/poker/hand-history":
+				if !handHistoryUpload(w, r) {
+					return
+				}
+			case "/poker/hand-history/export":
+				if r.URL.Query().Get("download") == "true" {
+					r.Header.Set("Accept", phhContentType)
+				}
+			case "/poker/batch/evaluate-hands", "/poker/batch/compare-hands":
+				// NDJSON bodies are streamed through StreamEvaluateHands and StreamCompareHands
+				if isNDJSON(r) {
+					r.URL.Path += "/stream"
+					r.URL.RawPath = ""
+				}
+			}
+		}
+		if streamPaths[r.URL.Path] {
+			streams.ServeHTTP(w, r)
+			return
+		}
+		unary.ServeHTTP(w, r)
+	})
+}
+
+// handHistoryUpload rewrites a hand history uploaded as a multipart "file" field or as a plain text
+// body into a JSON ImportHandHistory request, taking ?include_hands=true and ?format=pokerstars or
+// ?format=phh from the query. JSON bodies are passed on as they are. It reports false after writing
+// an error.
+func handHistoryUpload(w http.ResponseWriter, r *http.Request) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, maxHandHistoryUpload)
+	contentType := r.Header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "application/json") {
+		return true
+	}
+
+	var data []byte
+	if strings.HasPrefix(contentType, "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "Missing file", http.StatusBadRequest)
+			return false
+		}
+		defer file.Close()
+		if data, err = io.ReadAll(file); err != nil {
+			http.Error(w, "Invalid file", http.StatusBadRequest)
+			return false
+		}
+	} else {
+		var err error
+		if data, err = io.ReadAll(r.Body); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return false
+		}
+	}
+
+	body, err := protojson.Marshal(&pb.ImportHandHistoryRequest{
+		Text:         string(data),
+		IncludeHands: r.URL.Query().Get("include_hands") == "true",
+		Format:       r.URL.Query().Get("format"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.Header.Set("Content-Type", "application/json")
+	return true
+}
+
+// isNDJSON reports whether a request body is newline-delimited JSON
+func isNDJSON(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-ndjson")
+}
+
+// restMarshaler writes messages as marshalJSON does
+type restMarshaler struct {
+	*runtime.JSONPb
+}
+
+// Marshal writes a message with its 64-bit integers as JSON numbers
+func (m restMarshaler) Marshal(v any) ([]byte, error) {
+	if message, ok := v.(proto.Message); ok {
+		return marshalJSON(message)
+	}
+	return m.JSONPb.Marshal(v)
+}
+
+// marshalJSON writes a message for REST clients. protojson quotes 64-bit integers, which JavaScript numbers
+// cannot always hold; the chip counts, counters and hand numbers here stay far below that, so they are
+// written as numbers.
+func marshalJSON(message proto.Message) ([]byte, error) {
+	data, err := restMarshalOptions.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	numbers := &jsonNumbers{decoder: decoder}
+	numbers.encoder = json.NewEncoder(&numbers.out)
+	numbers.encoder.SetEscapeHTML(false)
+	if err := numbers.message(message.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+	return numbers.out.Bytes(), nil
+}
+
+// jsonNumbers copies protojson output token by token, following the message's fields to find its
+// 64-bit integers
+type jsonNumbers struct {
+	decoder *json.Decoder
+	encoder *json.Encoder
+	out     bytes.Buffer
+}
+
+// message copies a message's object, or null for an unset message
+func (n *jsonNumbers) message(md protoreflect.MessageDescriptor) error {
+	if md.FullName().Parent() == "google.protobuf" {
+		// Well-known types have JSON forms of their own
+		return n.value()
+	}
+	token, err := n.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		// An unset message field
+		return n.write(token)
+	}
+	if token != json.Delim('{') {
+		return fmt.Errorf("unexpected JSON %v, expected {", token)
+	}
+	n.out.WriteByte('{')
+	for i := 0; n.decoder.More(); i++ {
+		name, err := n.key(i)
+		if err != nil {
+			return err
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(name)
+		}
+		switch {
+		case fd == nil:
+			err = n.value()
+		case fd.IsList():
+			err = n.list(fd)
+		case fd.IsMap():
+			err = n.entries(fd.MapValue())
+		default:
+			err = n.field(fd)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return n.delim('}')
+}
+
+// list copies the array of a repeated field
+func (n *jsonNumbers) list(fd protoreflect.FieldDescriptor) error {
+	if err := n.delim('['); err != nil {
+		return err
+	}
+	for i := 0; n.decoder.More(); i++ {
+		if i > 0 {
+			n.out.WriteByte(',')
+		}
+		if err := n.field(fd); err != nil {
+			return err
+		}
+	}
+	return n.delim(']')
+}
+
+// entries copies the object of a map field, whose values are of fd
+func (n *jsonNumbers) entries(fd protoreflect.FieldDescriptor) error {
+	if err := n.delim('{'); err != nil {
+		return err
+	}
+	for i := 0; n.decoder.More(); i++ {
+		if _, err := n.key(i); err != nil {
+			return err
+		}
+		if err := n.field(fd); err != nil {
+			return err
+		}
+	}
+	return n.delim('}')
+}
+
+// field copies one value of a field, unquoting it when the field is a 64-bit integer
+func (n *jsonNumbers) field(fd protoreflect.FieldDescriptor) error {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return n.message(fd.Message())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		token, err := n.decoder.Token()
+		if err != nil {
+			return err
+		}
+		if number, ok := token.(string); ok {
+			token = json.Number(number)
+		}
+		return n.write(token)
+	default:
+		return n.value()
+	}
+}
+
+// value copies a value as it is
+func (n *jsonNumbers) value() error {
+	token, err := n.decoder.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return n.write(token)
+	}
+	n.out.WriteRune(rune(delim))
+	for i := 0; n.decoder.More(); i++ {
+		if delim == '{' {
+			if _, err := n.key(i); err != nil {
+				return err
+			}
+		} else if i > 0 {
+			n.out.WriteByte(',')
+		}
+		if err := n.value(); err != nil {
+			return err
+		}
+	}
+	return n.delim(delim + 2) // '{' + 2 is '}', '[' + 2 is ']'
+}
+
+// key copies the i-th key of an object and returns it
+func (n *jsonNumbers) key(i int) (string, error) {
+	token, err := n.decoder.Token()
+	if err != nil {
+		return "", err
+	}
+	if i > 0 {
+		n.out.WriteByte(',')
+	}
+	if err := n.write(token); err != nil {
+		return "", err
+	}
+	n.out.WriteByte(':')
+	key, _ := token.(string)
+	return key, nil
+}
+
+// delim copies the expected delimiter
+func (n *jsonNumbers) delim(want json.Delim) error {
+	token, err := n.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != want {
+		return fmt.Errorf("unexpected JSON %v, expected %v", token, want)
+	}
+	n.out.WriteRune(rune(want))
+	return nil
+}
+
+// write writes a string, number, boolean or null
+func (n *jsonNumbers) write(token json.Token) error {
+	if number, ok := token.(json.Number); ok {
+		n.out.WriteString(number.String())
+		return nil
+	}
+	if err := n.encoder.Encode(token); err != nil {
+		return err
+	}
+	n.out.Truncate(n.out.Len() - 1) // Encode ends with a newline
+	return nil
+}
+
+// phhMarshaler writes an exported hand history as the PHH file itself, and anything else as JSON
+type phhMarshaler struct {
+	runtime.Marshaler
+}
+
+// ContentType returns the PHH media type for exported hand histories
+func (m phhMarshaler) ContentType(v any) string {
+	if _, ok := v.(*pb.ExportHandHistoryResponse); ok {
+		return phhContentType
+	}
+	return m.Marshaler.ContentType(v)
+}
+
+// Marshal returns the text of exported hand histories
+func (m phhMarshaler) Marshal(v any) ([]byte, error) {
+	if export, ok := v.(*pb.ExportHandHistoryResponse); ok {
+		return []byte(export.Text), nil
+	}
+	return m.Marshaler.Marshal(v)
+}
+
+// phhAttachment names a PHH download: hand.phh for one hand, hands.phhs for several
+func phhAttachment(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	export, ok := resp.(*pb.ExportHandHistoryResponse)
+	if !ok || w.Header().Get("Content-Type") != phhContentType {
+		return nil
+	}
+	filename := "hands.phhs"
+	if export.Exported == 1 {
+		filename = "hand.phh"
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	return nil
+}
+
+// allowCORS lets browsers call the REST API from any origin, answering preflight requests itself
+func allowCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"temperature-converter/fairshuffle"
+	"temperature-converter/handhistory"
+	pb "temperature-converter/pb"
+)
+
+// startGateway serves the REST gateway in front of the temperature and poker services
+func startGateway(t *testing.T) *httptest.Server {
+	t.Helper()
+	temperature, poker := &server{}, newPokerServer(handhistory.NewStore())
+	conn := dialTestServer(t, func(s *grpc.Server) {
+		pb.RegisterTemperatureConverterServer(s, temperature)
+		pb.RegisterPokerEvaluatorServer(s, poker)
+	})
+	gateway, err := newGateway(context.Background(), temperature, poker, conn)
+	if err != nil {
+		t.Fatalf("Failed to create the gateway: %v", err)
+	}
+	httpServer := httptest.NewServer(allowCORS(gateway))
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+// call sends a request and returns the response body, failing unless the status is the expected one
+func call(t *testing.T, method, url, contentType, body string, status int) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response: %v", err)
+	}
+	if resp.StatusCode != status {
+		t.Fatalf("%s %s: expected status %d, got %d: %s", method, url, status, resp.StatusCode, data)
+	}
+	return resp, string(data)
+}
+
+// callJSON sends a request and decodes its JSON response, failing unless it succeeds
+func callJSON(t *testing.T, method, url, body string) map[string]any {
+	t.Helper()
+	_, data := call(t, method, url, "application/json", body, http.StatusOK)
+	var result map[string]any
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("Invalid JSON %s: %v", data, err)
+	}
+	return result
+}
+
+// number returns a JSON field that must be a number, not a string
+func number(t *testing.T, object any, field string) float64 {
+	t.Helper()
+	value, ok := object.(map[string]any)[field].(float64)
+	if !ok {
+		t.Fatalf("Expected %s to be a JSON number, got %#v", field, object.(map[string]any)[field])
+	}
+	return value
+}
+
+func TestMarshalJSON(t *testing.T) {
+	update := &pb.TableUpdate{
+		Type:   "turn",
+		Amount: 1 << 40,
+		Text:   "<seat 1> & friends",
+		State: &pb.TableState{
+			Pot:   75,
+			Seats: []*pb.TableSeat{{Seat: 1, Stack: 925}, {Seat: 2, Stack: -3}},
+		},
+	}
+	data, err := marshalJSON(update)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	text := string(data)
+	for _, want := range []string{`"amount":1099511627776`, `"text":"<seat 1> & friends"`, `"pot":75`, `"stack":-3`, `"legal":null`, `"deadline_unix_ms":0`} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %s in %s", want, text)
+		}
+	}
+
+	// The numbers read back as the same message
+	var back pb.TableUpdate
+	if err := restUnmarshalOptions.Unmarshal(data, &back); err != nil || !proto.Equal(&back, update) {
+		t.Errorf("Expected the update back, got %v (%v)", &back, err)
+	}
+}
+
+func TestGatewayConvert(t *testing.T) {
+	gateway := startGateway(t)
+	result := callJSON(t, http.MethodPost, gateway.URL+"/convert", `{"celsius": 100}`)
+	if number(t, result, "fahrenheit") != 212 {
+		t.Errorf("Expected 212 F, got %v", result)
+	}
+	result = callJSON(t, http.MethodPost, gateway.URL+"/convert-fahrenheit", `{"fahrenheit": 32, "unknown": true}`)
+	if number(t, result, "celsius") != 0 || number(t, result, "fahrenheit") != 32 {
+		t.Errorf("Expected 0 C with the zero value shown, got %v", result)
+	}
+}
+
+func TestGatewayShowdownNumbers(t *testing.T) {
+	gateway := startGateway(t)
+	result := callJSON(t, http.MethodPost, gateway.URL+"/poker/showdown", `{
+		"community_cards": ["C2", "D7", "C9", "DK", "H3"],
+		"button": 0,
+		"players": [
+			{"name": "Alice", "hole_cards": ["HA", "SA"], "committed": 100},
+			{"name": "Bob", "hole_cards": ["HK", "SK"], "committed": 300},
+			{"name": "Carol", "hole_cards": ["HQ", "SQ"], "committed": 300},
+			{"name": "Dave", "committed": 50, "folded": true}
+		]
+	}`)
+
+	players := result["players"].([]any)
+	wantPayouts := []float64{0, 750, 0, 0}
+	wantNets := []float64{-100, 450, -300, -50}
+	for i, player := range players {
+		if payout := number(t, player, "payout"); payout != wantPayouts[i] {
+			t.Errorf("Expected player %d to win %v, got %v", i, wantPayouts[i], payout)
+		}
+		if net := number(t, player, "net"); net != wantNets[i] {
+			t.Errorf("Expected player %d to net %v, got %v", i, wantNets[i], net)
+		}
+	}
+	pots := result["pots"].([]any)
+	if len(pots) != 2 || number(t, pots[0], "amount") != 350 || number(t, pots[1], "amount") != 400 || number(t, pots[0], "odd_chips") != 0 {
+		t.Errorf("Expected pots of 350 and 400, got %v", pots)
+	}
+}
+
+func TestGatewayVerifyShuffle(t *testing.T) {
+	gateway := startGateway(t)
+	seed := fairshuffle.NewServerSeed()
+	result := callJSON(t, http.MethodPost, gateway.URL+"/poker/shuffle/verify",
+		`{"server_seed": "`+seed.String()+`", "server_seed_hash": "`+seed.Hash()+`", "client_seeds": ["alice"], "nonce": 7}`)
+	want := strings.Join(cardsToStrings(fairshuffle.Deck(seed, []string{"alice"}, 7)), ",")
+	if result["valid"] != true || len(result["deck"].([]any)) != 52 {
+		t.Fatalf("Expected a valid shuffle, got %v", result)
+	}
+	deck := make([]string, 52)
+	for i, card := range result["deck"].([]any) {
+		deck[i] = card.(string)
+	}
+	if strings.Join(deck, ",") != want {
+		t.Errorf("Expected the deck dealt by nonce 7")
+	}
+}
+
+func TestGatewayCacheStats(t *testing.T) {
+	gateway := startGateway(t)
+	for i := 0; i < 2; i++ {
+		callJSON(t, http.MethodPost, gateway.URL+"/poker/evaluate-hand", `{"hole_cards": ["HA", "SA"], "community_cards": ["C2", "D7", "C9"]}`)
+	}
+	result := callJSON(t, http.MethodGet, gateway.URL+"/poker/cache/stats", "")
+	evaluation := result["caches"].([]any)[0]
+	if evaluation.(map[string]any)["name"] != "evaluation" {
+		t.Fatalf("Expected the evaluation cache first, got %v", evaluation)
+	}
+	if number(t, evaluation, "hits") != 1 || number(t, evaluation, "misses") != 1 || number(t, evaluation, "ttl_seconds") != 3600 {
+		t.Errorf("Expected 1 hit and 1 miss with a one hour TTL, got %v", evaluation)
+	}
+	for _, field := range []string{"entries", "capacity", "evictions", "expirations", "hit_rate"} {
+		number(t, evaluation, field)
+	}
+}
+
+func TestGatewayErrors(t *testing.T) {
+	gateway := startGateway(t)
+	resp, body := call(t, http.MethodPost, gateway.URL+"/poker/evaluate-hand", "application/json", `{"hole_cards": ["HA"]}`, http.StatusBadRequest)
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain") || body == "" {
+		t.Errorf("Expected a plain text error, got %q (%s)", body, resp.Header.Get("Content-Type"))
+	}
+	call(t, http.MethodPost, gateway.URL+"/poker/evaluate-hand", "application/json", `{not json`, http.StatusBadRequest)
+	call(t, http.MethodGet, gateway.URL+"/poker/showdown", "", "", http.StatusMethodNotAllowed)
+	call(t, http.MethodGet, gateway.URL+"/poker/unknown", "", "", http.StatusNotFound)
+	if _, body := call(t, http.MethodGet, gateway.URL+"/poker/stats/Nobody", "", "", http.StatusNotFound); !strings.Contains(body, "Nobody") {
+		t.Errorf("Expected the missing player in the error, got %q", body)
+	}
+
+	// Preflight requests are answered without reaching the services
+	resp, _ = call(t, http.MethodOptions, gateway.URL+"/poker/showdown", "", "", http.StatusOK)
+	if resp.Header.Get("Access-Control-Allow-Origin") != "*" {
+		t.Errorf("Expected CORS headers, got %v", resp.Header)
+	}
+}
+
+func TestGatewayHandHistory(t *testing.T) {
+	gateway := startGateway(t)
+	data, err := os.ReadFile("handhistory/testdata/pokerstars.txt")
+	if err != nil {
+		t.Fatalf("Failed to read test data: %v", err)
+	}
+
+	// A file upload, with the hands returned
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	file, _ := form.CreateFormFile("file", "hands.txt")
+	file.Write(data)
+	form.Close()
+	_, response := call(t, http.MethodPost, gateway.URL+"/poker/hand-history?include_hands=true", form.FormDataContentType(), body.String(), http.StatusOK)
+	var imported map[string]any
+	json.Unmarshal([]byte(response), &imported)
+	if number(t, imported, "imported") != 3 || len(imported["hands"].([]any)) != 3 || len(imported["errors"].([]any)) != 1 {
+		t.Fatalf("Expected 3 hands imported and 1 error, got %s", response)
+	}
+	hand := imported["hands"].([]any)[0].(map[string]any)
+	if hand["time"] != "2021-03-14T19:02:11-04:00" {
+		t.Errorf("Expected the hand time in ET, got %v", hand["time"])
+	}
+
+	// The same hands as plain text are duplicates
+	_, response = call(t, http.MethodPost, gateway.URL+"/poker/hand-history", "text/plain", string(data), http.StatusOK)
+	json.Unmarshal([]byte(response), &imported)
+	if number(t, imported, "duplicates") != 3 || len(imported["hands"].([]any)) != 0 {
+		t.Errorf("Expected 3 duplicates and no hands, got %s", response)
+	}
+	call(t, http.MethodPost, gateway.URL+"/poker/hand-history", "text/plain", " ", http.StatusBadRequest)
+
+	exported := callJSON(t, http.MethodPost, gateway.URL+"/poker/hand-history/export", `{"hand_ids": ["PokerStars#219876543210", "219876543211"]}`)
+	if number(t, exported, "exported") != 1 || len(exported["missing"].([]any)) != 1 {
+		t.Errorf("Expected 1 hand exported and 1 missing, got %v", exported)
+	}
+	resp, text := call(t, http.MethodPost, gateway.URL+"/poker/hand-history/export?download=true", "application/json", `{"hand_ids": ["PokerStars#219876543210"]}`, http.StatusOK)
+	if resp.Header.Get("Content-Type") != phhContentType || resp.Header.Get("Content-Disposition") != "attachment; filename=hand.phh" {
+		t.Errorf("Expected a hand.phh download, got %v", resp.Header)
+	}
+	if text != exported["text"] {
+		t.Errorf("Expected the download to be the exported text, got %q", text)
+	}
+}
+
+func TestGatewayBatchNDJSON(t *testing.T) {
+	gateway := startGateway(t)
+	lines := `{"hole_cards": ["HA", "SA"], "community_cards": ["C2", "D7", "C9"]}` + "\n" + `{"hole_cards": ["HA"]}` + "\n"
+	_, response := call(t, http.MethodPost, gateway.URL+"/poker/batch/evaluate-hands", "application/x-ndjson", lines, http.StatusOK)
+	var result map[string]any
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		t.Fatalf("Invalid JSON %s: %v", response, err)
+	}
+	if len(result["results"].([]any)) != 2 || number(t, result, "errors") != 1 {
+		t.Errorf("Expected 2 results with 1 error, got %s", response)
+	}
+
+	result = callJSON(t, http.MethodPost, gateway.URL+"/poker/batch/evaluate-hands", `{"hands": [{"hole_cards": ["HA", "SA"]}]}`)
+	if len(result["results"].([]any)) != 1 || number(t, result, "errors") != 0 {
+		t.Errorf("Expected 1 result, got %v", result)
+	}
+}
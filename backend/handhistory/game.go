@@ -0,0 +1,152 @@
+package handhistory
+
+import (
+	"fmt"
+
+	"temperature-converter/game"
+)
+
+// GameSite is the site of hands played on this service's tables
+const GameSite = "TableService"
+
+// FromGame builds the history of a hand played on a game table from its events. players is the table's
+// state before the hand started, for names and starting stacks. Seat numbers in the history count from 1.
+func FromGame(id, table string, config game.Config, players []game.PlayerState, events []game.Event) (Hand, error) {
+	hand := Hand{
+		Site:       GameSite,
+		ID:         id,
+		Game:       gameName(config.Structure),
+		Structure:  config.Structure,
+		SmallBlind: float64(config.SmallBlind),
+		BigBlind:   float64(config.BigBlind),
+		Ante:       float64(config.Ante),
+		Table:      table,
+		MaxSeats:   config.Seats,
+	}
+
+	// Players are dealt in if they got hole cards
+	dealt := make(map[int]bool)
+	for _, event := range events {
+		switch event.Type {
+		case game.EventHandStarted:
+			hand.Button = event.Seat + 1
+		case game.EventHoleCards:
+			dealt[event.Seat] = true
+		}
+	}
+	index := make(map[int]int)
+	var names []string
+	var stacks []float64
+	for _, player := range players {
+		name := player.Name
+		if name == "" {
+			name = fmt.Sprintf("seat %d", player.Seat)
+		}
+		hand.Seats = append(hand.Seats, Seat{Number: player.Seat + 1, Player: name, Stack: float64(player.Stack), SittingOut: !dealt[player.Seat]})
+		if dealt[player.Seat] {
+			index[player.Seat] = len(names)
+			names = append(names, name)
+			stacks = append(stacks, float64(player.Stack))
+		}
+	}
+	if len(names) < 2 {
+		return hand, fmt.Errorf("expected at least 2 players dealt in, got %d", len(names))
+	}
+
+	r := newReplay(&hand, names, stacks)
+	type award struct {
+		player int
+		amount float64
+	}
+	var awards []award
+	for _, event := range events {
+		i, seated := index[event.Seat]
+		if !seated && event.Seat >= 0 && event.Type != game.EventHandStarted {
+			return hand, fmt.Errorf("%s event for seat %d, which was not dealt in", event.Type, event.Seat)
+		}
+		amount := float64(event.Amount)
+		switch event.Type {
+		case game.EventAnte, game.EventSmallBlind, game.EventBigBlind:
+			// A player all-in from the ante has nothing left for a blind
+			if amount == 0 {
+				continue
+			}
+		}
+		switch event.Type {
+		case game.EventAnte:
+			r.post(i, PostAnte, amount)
+		case game.EventSmallBlind:
+			r.post(i, PostSmallBlind, amount)
+		case game.EventBigBlind:
+			r.post(i, PostBigBlind, amount)
+		case game.EventHoleCards:
+			hand.Seats[seatIndex(hand.Seats, event.Seat+1)].HoleCards = event.Cards
+		case game.EventBoard:
+			if err := r.deal(event.Cards); err != nil {
+				return hand, err
+			}
+		case game.EventShowdown:
+			r.show(i, event.Cards)
+		case game.EventPotAwarded:
+			awards = append(awards, award{i, amount})
+		case game.EventAction:
+			switch event.Action {
+			case game.Fold:
+				r.fold(i)
+			case game.Check, game.Call:
+				r.checkOrCall(i)
+			case game.Bet, game.Raise:
+				if err := r.betOrRaise(i, amount); err != nil {
+					return hand, err
+				}
+			}
+		}
+	}
+
+	// The table awards an uncalled bet back as a pot of its own; the history returns it instead
+	r.returnUncalled()
+	for _, action := range hand.Actions {
+		if action.Type != UncalledReturn {
+			continue
+		}
+		returned := action.Amount
+		for j := len(awards) - 1; j >= 0 && returned > 0; j-- {
+			if names[awards[j].player] == action.Player {
+				taken := min(awards[j].amount, returned)
+				awards[j].amount -= taken
+				returned -= taken
+			}
+		}
+	}
+	for _, award := range awards {
+		if award.amount > 0 {
+			r.collect(award.player, award.amount)
+		}
+	}
+
+	for _, seat := range hand.Seats {
+		hand.TotalPot += hand.Invested(seat.Player)
+	}
+	return hand, nil
+}
+
+// gameName names the game the way site histories do, such as "Hold'em No Limit"
+func gameName(structure game.BettingStructure) string {
+	switch structure {
+	case game.PotLimit:
+		return "Hold'em Pot Limit"
+	case game.FixedLimit:
+		return "Hold'em Limit"
+	}
+	return "Hold'em No Limit"
+}
+
+// seatIndex returns the position of a seat number in seats
+func seatIndex(seats []Seat, number int) int {
+	for i, seat := range seats {
+		if seat.Number == number {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,127 @@
+package handhistory
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"temperature-converter/game"
+)
+
+// playRandomHand plays one hand with random legal actions and returns the table's state before it started
+func playRandomHand(t *testing.T, table *game.Table, rng *rand.Rand) []game.PlayerState {
+	t.Helper()
+	before := table.State().Players
+	if err := table.StartHand(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for table.InHand() {
+		legal, err := table.LegalActions()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		action := game.Action{Type: game.Fold}
+		switch n := rng.Intn(10); {
+		case n < 2 && !legal.CanCheck:
+		case n < 7 || !legal.CanRaise:
+			action.Type = game.Call
+			if legal.CanCheck {
+				action.Type = game.Check
+			}
+		default:
+			action.Type = game.Raise
+			if table.State().CurrentBet == 0 {
+				action.Type = game.Bet
+			}
+			action.Amount = legal.MinRaise + rng.Int63n(legal.MaxRaise-legal.MinRaise+1)
+		}
+		if err := table.Act(legal.Seat, action); err != nil {
+			t.Fatalf("Unexpected error for %+v: %v", action, err)
+		}
+	}
+	return before
+}
+
+func TestFromGame(t *testing.T) {
+	for _, structure := range []game.BettingStructure{game.NoLimit, game.PotLimit, game.FixedLimit} {
+		config := game.Config{Seats: 6, SmallBlind: 1, BigBlind: 2, Ante: 1, Structure: structure}
+		table, err := game.NewTable(config, 7)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for seat, name := range []string{"Alice", "Bob", "", "Dave"} {
+			if err := table.Sit(seat*2%6+seat/3, name, int64(40+30*seat)); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+		rng := rand.New(rand.NewSource(int64(structure)))
+
+		for n := 1; n <= 100; n++ {
+			before := playRandomHand(t, table, rng)
+			hand, err := FromGame(fmt.Sprint(n), "main", table.Config(), before, table.Events())
+			if err != nil {
+				t.Fatalf("%s hand %d: unexpected error: %v", structure, n, err)
+			}
+
+			// Chips won and lost match the table's result
+			result := table.Result()
+			for _, seat := range hand.Seats {
+				if net := hand.Net(seat.Player); net != float64(result.Net[seat.Number-1]) {
+					t.Fatalf("%s hand %d: expected %s to net %d, got %v\n%+v", structure, n, seat.Player, result.Net[seat.Number-1], net, hand)
+				}
+			}
+			if len(hand.Board) != len(result.Board) {
+				t.Fatalf("%s hand %d: expected a board of %d cards, got %d", structure, n, len(result.Board), len(hand.Board))
+			}
+
+			// And the hand survives a PHH round trip
+			text, err := WritePHH(hand)
+			if err != nil {
+				t.Fatalf("%s hand %d: unexpected error: %v", structure, n, err)
+			}
+			back := ParsePHH(text)
+			if len(back.Hands) != 1 || !reflect.DeepEqual(back.Hands[0], hand) {
+				t.Fatalf("%s hand %d changed in the round trip (errors: %v):\n%+v\n%+v\n%s", structure, n, back.Errors, hand, back.Hands, text)
+			}
+
+			// Busted players buy back in
+			for _, player := range table.State().Players {
+				if player.Stack == 0 {
+					table.Leave(player.Seat)
+					table.Sit(player.Seat, player.Name, 100)
+				}
+			}
+		}
+	}
+}
+
+func TestFromGameNamesAndSeats(t *testing.T) {
+	table, err := game.NewTable(game.Config{Seats: 6, SmallBlind: 1, BigBlind: 2}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	table.Sit(0, "Alice", 100)
+	table.Sit(2, "", 100)
+	before := table.State().Players
+	table.StartHand()
+	legal, _ := table.LegalActions()
+	table.Act(legal.Seat, game.Action{Type: game.Fold})
+
+	hand, err := FromGame("1", "main", table.Config(), before, table.Events())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Seats count from 1
+	if hand.Site != GameSite || hand.Game != "Hold'em No Limit" || hand.Button != 1 || len(hand.Seats) != 2 {
+		t.Fatalf("Unexpected hand: %+v", hand)
+	}
+	if seat := hand.Seats[1]; seat.Number != 3 || seat.Player != "seat 2" || seat.Stack != 100 || len(seat.HoleCards) != 2 {
+		t.Errorf("Expected the unnamed player in seat 3, got %+v", seat)
+	}
+	// Heads-up the button posts the small blind and folds; the big blind's bet is partly uncalled
+	last := hand.Actions[len(hand.Actions)-1]
+	if last.Type != Collect || last.Player != "seat 2" || last.Amount != 2 {
+		t.Errorf("Expected seat 2 to collect 2, got %+v", hand.Actions)
+	}
+}
@@ -0,0 +1,164 @@
+// Package handhistory reads and writes poker hand histories and keeps imported hands for analysis.
+package handhistory
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// ActionType is what a player did in a hand history
+type ActionType string
+
+const (
+	PostSmallBlind ActionType = "post_small_blind"
+	PostBigBlind   ActionType = "post_big_blind"
+	PostBothBlinds ActionType = "post_both_blinds" // Small and big blind posted together; the small blind is dead
+	PostAnte       ActionType = "post_ante"
+	Fold           ActionType = "fold"
+	Check          ActionType = "check"
+	Call           ActionType = "call"
+	Bet            ActionType = "bet"
+	Raise          ActionType = "raise"
+	UncalledReturn ActionType = "uncalled_return" // An unmatched bet handed back
+	Show           ActionType = "show"
+	Muck           ActionType = "muck"
+	Collect        ActionType = "collect" // Won chips from a pot
+)
+
+// Action is one line of a hand: a post, a betting action, cards shown or chips collected
+type Action struct {
+	Street game.Street
+	Player string
+	Type   ActionType
+	Amount float64 // Chips posted, called, bet, returned or collected; for Raise, the total bet after raising ("raise to")
+	AllIn  bool
+	Cards  []poker.Card // Cards shown or mucked
+}
+
+// Seat is a player seated for a hand
+type Seat struct {
+	Number     int // Seat number as printed in the history
+	Player     string
+	Stack      float64 // Chips at the start of the hand
+	HoleCards  []poker.Card
+	SittingOut bool
+}
+
+// Hand is a parsed hand history
+type Hand struct {
+	Site         string // e.g. "PokerStars"
+	ID           string // Hand number assigned by the site
+	TournamentID string // Tournament number, empty for cash games
+	Game         string // e.g. "Hold'em No Limit"
+	Structure    game.BettingStructure
+	Currency     string // e.g. "USD", empty for tournament or play chips
+	SmallBlind   float64
+	BigBlind     float64
+	Ante         float64
+	Time         time.Time
+	Table        string
+	MaxSeats     int
+	Button       int // Seat number of the button
+	Seats        []Seat
+	Actions      []Action
+	Board        []poker.Card
+	TotalPot     float64
+	Rake         float64
+}
+
+// Seat returns the seat of a player, or nil
+func (h *Hand) Seat(player string) *Seat {
+	for i := range h.Seats {
+		if h.Seats[i].Player == player {
+			return &h.Seats[i]
+		}
+	}
+	return nil
+}
+
+// Invested returns the chips a player put into the pot, after uncalled bets were returned
+func (h *Hand) Invested(player string) float64 {
+	dead := 0.0
+	streets := make(map[game.Street]float64)
+	for _, action := range h.Actions {
+		if action.Player != player {
+			continue
+		}
+		switch action.Type {
+		case PostAnte:
+			dead += action.Amount
+		case PostBothBlinds:
+			live := math.Min(action.Amount, h.BigBlind)
+			streets[action.Street] += live
+			dead += action.Amount - live
+		case PostSmallBlind, PostBigBlind, Call, Bet:
+			streets[action.Street] += action.Amount
+		case Raise:
+			streets[action.Street] = action.Amount
+		case UncalledReturn:
+			dead -= action.Amount
+		}
+	}
+	total := dead
+	for _, amount := range streets {
+		total += amount
+	}
+	return total
+}
+
+// Collected returns the chips a player won
+func (h *Hand) Collected(player string) float64 {
+	total := 0.0
+	for _, action := range h.Actions {
+		if action.Player == player && action.Type == Collect {
+			total += action.Amount
+		}
+	}
+	return total
+}
+
+// Net returns the chips a player won minus the chips they invested
+func (h *Hand) Net(player string) float64 {
+	return h.Collected(player) - h.Invested(player)
+}
+
+// Key identifies a hand across sites by its site and hand number, as in "PokerStars#219876543210"
+func (h *Hand) Key() string {
+	return h.Site + "#" + h.ID
+}
+
+// Hand history formats that can be imported
+const (
+	FormatPokerStars = "pokerstars"
+	FormatPHH        = "phh" // PHH, or PHHS with several hands
+)
+
+// Parse parses hand histories in a format, or in the format detected from the text when format is empty
+func Parse(text, format string) (ParseResult, error) {
+	if format == "" {
+		format = DetectFormat(text)
+	}
+	switch format {
+	case FormatPokerStars:
+		return ParsePokerStars(text), nil
+	case FormatPHH:
+		return ParsePHH(text), nil
+	}
+	return ParseResult{}, fmt.Errorf("unsupported hand history format %q; use %q or %q", format, FormatPokerStars, FormatPHH)
+}
+
+// DetectFormat guesses the format of hand histories: PHH files set a variant, text histories start with a header
+func DetectFormat(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(line, "\ufeff"))
+		if strings.HasPrefix(line, "variant") && strings.Contains(line, "=") {
+			return FormatPHH
+		}
+	}
+	return FormatPokerStars
+}
@@ -0,0 +1,231 @@
+package handhistory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// DefaultLuckSimulations is the number of boards sampled for each pot of a preflop all-in. Later streets
+// are enumerated exactly.
+const DefaultLuckSimulations = 2000
+
+// AllInEV is the expected outcome of a hand whose players got all-in before the river
+type AllInEV struct {
+	Street   game.Street        // Street the last bet was called on
+	Board    []poker.Card       // Board cards out at the all-in
+	Equity   map[string]float64 // Share of the main pot each player still in the hand would win on average
+	Expected map[string]float64 // Chips each player would collect on average, after rake
+}
+
+// AllInEV works out what each player would collect on average if the board were run out from the moment
+// every player still in the hand was all-in, which takes the luck of the run out away. It returns nil when
+// the hand had no all-in before the river, or when the hole cards of a player still in the hand are unknown.
+func (h *Hand) AllInEV(simulations int) (*AllInEV, error) {
+	if simulations <= 0 {
+		simulations = DefaultLuckSimulations
+	}
+	street, ok := h.allInStreet()
+	if !ok || len(h.Board) != 5 {
+		return nil, nil
+	}
+
+	folded := make(map[string]bool)
+	for _, action := range h.Actions {
+		if action.Type == Fold {
+			folded[action.Player] = true
+		}
+	}
+	var players []string
+	var cards [][]poker.Card
+	for _, seat := range h.dealtIn() {
+		if folded[seat.Player] {
+			continue
+		}
+		if len(seat.HoleCards) != 2 {
+			return nil, nil
+		}
+		players = append(players, seat.Player)
+		cards = append(cards, seat.HoleCards)
+	}
+	if len(players) < 2 {
+		return nil, nil
+	}
+	board := h.Board[:map[game.Street]int{game.Preflop: 0, game.Flop: 3, game.Turn: 4}[street]]
+
+	// Equity in each pot among the players eligible for it, reusing it for pots with the same players
+	equities := make(map[string][]float64)
+	equity := func(eligible []int) ([]float64, error) {
+		key := fmt.Sprint(eligible)
+		if shares, ok := equities[key]; ok {
+			return shares, nil
+		}
+		shares := []float64{1}
+		if len(eligible) > 1 {
+			hands := make([][]poker.Card, len(eligible))
+			for i, player := range eligible {
+				hands[i] = cards[player]
+			}
+			var err error
+			if shares, err = poker.CalculateEquity(hands, board, simulations); err != nil {
+				return nil, err
+			}
+		}
+		equities[key] = shares
+		return shares, nil
+	}
+
+	// Whatever was taken out of the pot is taken out of every share of it
+	invested, collected := 0.0, 0.0
+	for _, seat := range h.dealtIn() {
+		invested += h.Invested(seat.Player)
+		collected += h.Collected(seat.Player)
+	}
+	kept := 1.0
+	if invested > 0 {
+		kept = collected / invested
+	}
+
+	ev := &AllInEV{Street: street, Board: board, Equity: make(map[string]float64), Expected: make(map[string]float64)}
+	for _, pot := range h.allInPots(players) {
+		shares, err := equity(pot.Eligible)
+		if err != nil {
+			return nil, fmt.Errorf("hand %s: %w", h.ID, err)
+		}
+		if len(ev.Equity) == 0 {
+			for i, player := range pot.Eligible {
+				ev.Equity[players[player]] = shares[i]
+			}
+		}
+		for i, player := range pot.Eligible {
+			ev.Expected[players[player]] += pot.Amount * shares[i] * kept
+		}
+	}
+	return ev, nil
+}
+
+// allInStreet returns the street of the last betting decision when it was made before the river and
+// a player still in the hand was all-in, so nobody could bet again
+func (h *Hand) allInStreet() (game.Street, bool) {
+	street, allIn := game.River, false
+	folded := make(map[string]bool)
+	for _, action := range h.Actions {
+		switch action.Type {
+		case Fold:
+			folded[action.Player] = true
+			street = action.Street
+		case Check, Call, Bet, Raise:
+			street = action.Street
+		}
+	}
+	for _, action := range h.Actions {
+		if action.AllIn && !folded[action.Player] {
+			allIn = true
+		}
+	}
+	return street, allIn && street < game.River
+}
+
+// allInPots splits the chips invested into a main pot and side pots as poker.SplitPots does. The players who
+// can win each pot are given by their index in players, the players still in the hand.
+func (h *Hand) allInPots(players []string) []poker.SidePot[float64] {
+	index := make(map[string]int, len(players))
+	for i, player := range players {
+		index[player] = i
+	}
+	seats := h.dealtIn()
+	invested := make([]float64, len(seats))
+	folded := make([]bool, len(seats))
+	for i, seat := range seats {
+		invested[i] = roundAmount(h.Invested(seat.Player))
+		_, live := index[seat.Player]
+		folded[i] = !live
+	}
+
+	pots := poker.SplitPots(invested, folded)
+	for _, pot := range pots {
+		for i, seat := range pot.Eligible {
+			pot.Eligible[i] = index[seats[seat].Player]
+		}
+	}
+	return pots
+}
+
+// LuckPoint is one hand of a player's luck series
+type LuckPoint struct {
+	HandID                string
+	Time                  time.Time
+	Net                   float64 // Chips won minus chips invested
+	AdjustedNet           float64 // Chips expected at the all-in minus chips invested; Net when there was no all-in
+	AllIn                 bool    // The player was in an all-in before the river
+	Equity                float64 // Share of the main pot at the all-in
+	Street                game.Street
+	CumulativeNet         float64
+	CumulativeAdjustedNet float64
+}
+
+// LuckSeries compares a player's actual winnings with their all-in adjusted winnings, hand by hand
+type LuckSeries struct {
+	Player      string
+	Points      []LuckPoint
+	Net         float64
+	AdjustedNet float64
+	Luck        float64 // Net minus AdjustedNet: chips won or lost to the run out of all-in hands
+	Hands       int
+	AllInHands  int
+}
+
+// AnalyzeLuck builds the luck series of a player over the hands they were dealt into, in time order, or of
+// every player when player is empty. Series are sorted by player name. simulations is the number of boards
+// sampled per pot of a preflop all-in (DefaultLuckSimulations if 0).
+func AnalyzeLuck(hands []Hand, player string, simulations int) ([]LuckSeries, error) {
+	ordered := append([]Hand{}, hands...)
+	sort.SliceStable(ordered, func(a, b int) bool { return ordered[a].Time.Before(ordered[b].Time) })
+
+	series := make(map[string]*LuckSeries)
+	for i := range ordered {
+		hand := &ordered[i]
+		ev, err := hand.AllInEV(simulations)
+		if err != nil {
+			return nil, err
+		}
+		for _, seat := range hand.dealtIn() {
+			if player != "" && seat.Player != player {
+				continue
+			}
+			s, ok := series[seat.Player]
+			if !ok {
+				s = &LuckSeries{Player: seat.Player}
+				series[seat.Player] = s
+			}
+			point := LuckPoint{HandID: hand.ID, Time: hand.Time, Net: roundAmount(hand.Net(seat.Player))}
+			point.AdjustedNet = point.Net
+			if ev != nil {
+				if equity, live := ev.Equity[seat.Player]; live {
+					point.AllIn = true
+					point.Equity = equity
+					point.Street = ev.Street
+					point.AdjustedNet = roundAmount(ev.Expected[seat.Player] - hand.Invested(seat.Player))
+					s.AllInHands++
+				}
+			}
+			s.Net = roundAmount(s.Net + point.Net)
+			s.AdjustedNet = roundAmount(s.AdjustedNet + point.AdjustedNet)
+			s.Hands++
+			point.CumulativeNet = s.Net
+			point.CumulativeAdjustedNet = s.AdjustedNet
+			s.Points = append(s.Points, point)
+		}
+	}
+
+	var result []LuckSeries
+	for _, s := range series {
+		s.Luck = roundAmount(s.Net - s.AdjustedNet)
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(a, b int) bool { return result[a].Player < result[b].Player })
+	return result, nil
+}
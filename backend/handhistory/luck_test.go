@@ -0,0 +1,174 @@
+package handhistory
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// luckHand reads a three-handed 1/2 hand between Ann (small blind), Ben (big blind) and Cat (button)
+func luckHand(t *testing.T, id, stacks, finishing string, actions ...string) Hand {
+	t.Helper()
+	text := fmt.Sprintf(`variant = "NT"
+antes = [0, 0, 0]
+blinds_or_straddles = [1, 2, 0]
+min_bet = 2
+starting_stacks = [%s]
+actions = ["%s"]
+hand = %s
+players = ["Ann", "Ben", "Cat"]
+finishing_stacks = [%s]
+`, stacks, strings.Join(actions, `", "`), id, finishing)
+	result := ParsePHH(text)
+	if len(result.Errors) > 0 || len(result.Hands) != 1 {
+		t.Fatalf("Hand %s: expected 1 hand, got %d (errors: %v)", id, len(result.Hands), result.Errors)
+	}
+	return result.Hands[0]
+}
+
+func flopAllIn(t *testing.T) Hand {
+	return luckHand(t, "1", "100, 100, 100", "200, 0, 100",
+		"d dh p1 AhAs", "d dh p2 KhKs", "d dh p3 ????", "p3 f", "p1 cc", "p2 cc",
+		"d db 2c7d9c", "p1 cbr 98", "p2 cc", "d db Kd", "d db Ad", "p1 sm AhAs", "p2 sm KhKs")
+}
+
+func mustCards(t *testing.T, cards ...string) []poker.Card {
+	t.Helper()
+	parsed, err := poker.ParseCards(cards)
+	if err != nil {
+		t.Fatalf("Failed to parse cards: %v", err)
+	}
+	return parsed
+}
+
+func TestAllInEV(t *testing.T) {
+	aces, kings, queens := mustCards(t, "HA", "SA"), mustCards(t, "HK", "SK"), mustCards(t, "HQ", "SQ")
+
+	t.Run("Flop", func(t *testing.T) {
+		hand := flopAllIn(t)
+		ev, err := hand.AllInEV(0)
+		if err != nil || ev == nil {
+			t.Fatalf("Expected an all-in, got %v (%v)", ev, err)
+		}
+		if ev.Street != game.Flop || cardsText(ev.Board) != "2c 7d 9c" {
+			t.Errorf("Expected the all-in on the flop, got %s %s", ev.Street, cardsText(ev.Board))
+		}
+		// The aces win 907 of the 990 turn and river cards
+		if math.Abs(ev.Equity["Ann"]-907.0/990) > 1e-9 || math.Abs(ev.Expected["Ann"]-200*907.0/990) > 1e-9 {
+			t.Errorf("Unexpected equity for Ann: %.4f, expected %.2f", ev.Equity["Ann"], ev.Expected["Ann"])
+		}
+		if math.Abs(ev.Expected["Ann"]+ev.Expected["Ben"]-200) > 1e-9 {
+			t.Errorf("Expected the whole pot to be shared, got %v", ev.Expected)
+		}
+		if _, ok := ev.Equity["Cat"]; ok {
+			t.Errorf("Expected no equity for a folded player: %v", ev.Equity)
+		}
+	})
+
+	t.Run("Side pot on the turn", func(t *testing.T) {
+		hand := luckHand(t, "2", "50, 100, 100", "150, 100, 0",
+			"d dh p1 AhAs", "d dh p2 KhKs", "d dh p3 QhQs", "p3 cc", "p1 cc", "p2 cc",
+			"d db 2c7d9c", "p1 cbr 48", "p2 cc", "p3 cc", "d db 3h", "p2 cbr 50", "p3 cc", "d db 5d",
+			"p1 sm AhAs", "p2 sm KhKs", "p3 sm QhQs")
+		ev, err := hand.AllInEV(0)
+		if err != nil || ev == nil {
+			t.Fatalf("Expected an all-in, got %v (%v)", ev, err)
+		}
+		board := mustCards(t, "C2", "D7", "C9", "H3")
+		main, _ := poker.CalculateEquity([][]poker.Card{aces, kings, queens}, board, 0)
+		side, _ := poker.CalculateEquity([][]poker.Card{kings, queens}, board, 0)
+		expected := map[string]float64{"Ann": 150 * main[0], "Ben": 150*main[1] + 100*side[0], "Cat": 150*main[2] + 100*side[1]}
+		for player, chips := range expected {
+			if math.Abs(ev.Expected[player]-chips) > 1e-9 {
+				t.Errorf("Expected %s to collect %.4f, got %.4f", player, chips, ev.Expected[player])
+			}
+			if math.Abs(ev.Equity[player]-main[map[string]int{"Ann": 0, "Ben": 1, "Cat": 2}[player]]) > 1e-9 {
+				t.Errorf("Expected %s's main pot equity, got %.4f", player, ev.Equity[player])
+			}
+		}
+		if ev.Street != game.Turn {
+			t.Errorf("Expected the all-in on the turn, got %s", ev.Street)
+		}
+	})
+
+	t.Run("Preflop", func(t *testing.T) {
+		hand := luckHand(t, "3", "100, 100, 100", "200, 0, 100",
+			"d dh p1 AhAs", "d dh p2 KhKs", "d dh p3 ????", "p3 f", "p1 cbr 100", "p2 cc",
+			"d db 2c7d9c", "d db Kd", "d db Ad", "p1 sm AhAs", "p2 sm KhKs")
+		ev, err := hand.AllInEV(5000)
+		if err != nil || ev == nil {
+			t.Fatalf("Expected an all-in, got %v (%v)", ev, err)
+		}
+		if ev.Street != game.Preflop || len(ev.Board) != 0 || math.Abs(ev.Equity["Ann"]-0.82) > 0.03 {
+			t.Errorf("Expected sampled preflop equity near 0.82, got %.3f on the %s", ev.Equity["Ann"], ev.Street)
+		}
+	})
+
+	noAllIn := []struct {
+		name string
+		hand Hand
+	}{
+		{"Folded", luckHand(t, "4", "100, 100, 100", "102, 98, 100", "p3 f", "p1 cbr 6", "p2 f")},
+		{"River", luckHand(t, "5", "100, 100, 100", "200, 0, 100",
+			"d dh p1 AhAs", "d dh p2 KhKs", "p3 f", "p1 cc", "p2 cc", "d db 2c7d9c", "p1 cc", "p2 cc",
+			"d db Kd", "p1 cc", "p2 cc", "d db Ad", "p1 cbr 98", "p2 cc", "p1 sm AhAs", "p2 sm KhKs")},
+		{"Unknown cards", luckHand(t, "6", "100, 100, 100", "200, 0, 100",
+			"d dh p1 AhAs", "p3 f", "p1 cbr 100", "p2 cc", "d db 2c7d9c", "d db Kd", "d db Ad", "p1 sm AhAs", "p2 sm")},
+	}
+	for _, tc := range noAllIn {
+		if ev, err := tc.hand.AllInEV(0); ev != nil || err != nil {
+			t.Errorf("%s: expected no all-in, got %+v (%v)", tc.name, ev, err)
+		}
+	}
+}
+
+func TestAnalyzeLuck(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	folded := luckHand(t, "4", "100, 100, 100", "102, 98, 100", "p3 f", "p1 cbr 6", "p2 f")
+	folded.Time = start
+	allIn := flopAllIn(t)
+	allIn.Time = start.Add(time.Minute)
+
+	// Hands are analyzed in time order, whatever order they are given in
+	series, err := AnalyzeLuck([]Hand{allIn, folded}, "", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(series) != 3 || series[0].Player != "Ann" || series[1].Player != "Ben" || series[2].Player != "Cat" {
+		t.Fatalf("Expected a series for each player by name, got %+v", series)
+	}
+
+	ann := series[0]
+	expected := roundAmount(200*907.0/990 - 100)
+	if ann.Hands != 2 || ann.AllInHands != 1 || len(ann.Points) != 2 {
+		t.Fatalf("Unexpected series for Ann: %+v", ann)
+	}
+	first, second := ann.Points[0], ann.Points[1]
+	if first.HandID != "4" || first.AllIn || first.Net != 2 || first.AdjustedNet != 2 {
+		t.Errorf("Expected the folded hand first with no adjustment, got %+v", first)
+	}
+	if second.HandID != "1" || !second.AllIn || second.Street != game.Flop || second.Net != 100 || second.AdjustedNet != expected {
+		t.Errorf("Expected the flop all-in second, got %+v", second)
+	}
+	if second.CumulativeNet != 102 || second.CumulativeAdjustedNet != roundAmount(2+expected) {
+		t.Errorf("Unexpected cumulative winnings: %+v", second)
+	}
+	if ann.Net != 102 || ann.Luck != roundAmount(100-expected) {
+		t.Errorf("Unexpected totals for Ann: net %v, luck %v", ann.Net, ann.Luck)
+	}
+
+	// Luck is zero-sum between the players of an all-in, and a folded player has none
+	if math.Abs(ann.Luck+series[1].Luck) > 1e-6 || series[2].Luck != 0 || series[2].AllInHands != 0 {
+		t.Errorf("Unexpected luck: Ann %v, Ben %v, Cat %v", ann.Luck, series[1].Luck, series[2].Luck)
+	}
+
+	one, err := AnalyzeLuck([]Hand{allIn, folded}, "Ben", 0)
+	if err != nil || len(one) != 1 || one[0].Player != "Ben" {
+		t.Errorf("Expected only Ben's series, got %+v (%v)", one, err)
+	}
+}
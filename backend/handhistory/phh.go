@@ -0,0 +1,831 @@
+package handhistory
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// PHH variants for the games hands can be played in
+const (
+	phhNoLimitHoldem    = "NT"
+	phhFixedLimitHoldem = "FT"
+)
+
+// contentIDLength is the number of hex digits of the hash that numbers hands without a hand number
+const contentIDLength = 16
+
+// Fields this package adds to PHH files for details the standard fields do not cover. The specification
+// reserves keys starting with an underscore for such user-defined fields.
+const (
+	phhSmallBlind       = "_small_blind"
+	phhStructure        = "_structure" // "pot-limit"; PHH has no pot-limit hold'em variant, so such hands are written as NT
+	phhButton           = "_button"
+	phhDeadBlinds       = "_dead_blinds" // The part of each player's antes that is a dead small blind
+	phhCollections      = "_collections" // Chips collected from each pot, in order: "p1 147"
+	phhHandID           = "_hand_id"     // Hand numbers that are not integers
+	phhTable            = "_table"       // Table names that are not integers
+	phhTournament       = "_tournament"
+	phhGame             = "_game"
+	phhTotalPot         = "_total_pot"
+	phhRake             = "_rake"
+	phhSittingOutPlayer = "_sitting_out_players"
+	phhSittingOutSeats  = "_sitting_out_seats"
+	phhSittingOutStacks = "_sitting_out_stacks"
+)
+
+// WritePHH writes a hand in the Poker Hand History (PHH) format, a TOML file read by academic poker tools.
+// Players are numbered p1, p2, ... starting left of the button. Posts, uncalled bets and amounts called are
+// implied by the standard fields and worked out again by ParsePHH.
+func WritePHH(hand Hand) (string, error) {
+	w := &tomlWriter{}
+	if err := writePHHHand(w, hand); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// WritePHHS writes hands as a PHHS file: one PHH table per hand, named [1], [2], ...
+func WritePHHS(hands []Hand) (string, error) {
+	w := &tomlWriter{}
+	for i, hand := range hands {
+		w.header(strconv.Itoa(i + 1))
+		if err := writePHHHand(w, hand); err != nil {
+			return "", fmt.Errorf("hand #%s: %v", hand.ID, err)
+		}
+	}
+	return w.String(), nil
+}
+
+// writePHHHand writes the fields of one hand
+func writePHHHand(w *tomlWriter, hand Hand) error {
+	players := hand.dealtIn()
+	if len(players) < 2 {
+		return fmt.Errorf("expected at least 2 players dealt in, got %d", len(players))
+	}
+	index := make(map[string]int)
+	names := make([]string, len(players))
+	seats := make([]int, len(players))
+	stacks := make([]float64, len(players))
+	for i, seat := range players {
+		index[seat.Player] = i
+		names[i], seats[i], stacks[i] = seat.Player, seat.Number, seat.Stack
+	}
+
+	antes := make([]float64, len(players))
+	blinds := make([]float64, len(players))
+	dead := make([]float64, len(players))
+	var collections []string
+	for _, action := range hand.Actions {
+		i, ok := index[action.Player]
+		if !ok {
+			return fmt.Errorf("action by %q, who was not dealt in", action.Player)
+		}
+		switch action.Type {
+		case PostAnte:
+			antes[i] = roundAmount(antes[i] + action.Amount)
+		case PostSmallBlind, PostBigBlind:
+			blinds[i] = roundAmount(blinds[i] + action.Amount)
+		case PostBothBlinds:
+			live := math.Min(action.Amount, hand.BigBlind)
+			blinds[i] = roundAmount(blinds[i] + live)
+			antes[i] = roundAmount(antes[i] + action.Amount - live)
+			dead[i] = roundAmount(dead[i] + action.Amount - live)
+		case Collect:
+			collections = append(collections, fmt.Sprintf("p%d %s", i+1, formatAmount(action.Amount)))
+		}
+	}
+	actions, err := hand.phhActions(index)
+	if err != nil {
+		return err
+	}
+
+	switch hand.Structure {
+	case game.NoLimit, game.PotLimit:
+		w.value("variant", phhNoLimitHoldem)
+	case game.FixedLimit:
+		w.value("variant", phhFixedLimitHoldem)
+	default:
+		return fmt.Errorf("unsupported betting structure: %s", hand.Structure)
+	}
+	w.value("antes", antes)
+	w.value("blinds_or_straddles", blinds)
+	if hand.Structure == game.FixedLimit {
+		w.value("small_bet", hand.BigBlind)
+		w.value("big_bet", 2*hand.BigBlind)
+	} else {
+		w.value("min_bet", hand.BigBlind)
+	}
+	w.value("starting_stacks", stacks)
+	w.list("actions", actions)
+
+	if hand.Site != "" {
+		w.value("venue", hand.Site)
+	}
+	if number, err := strconv.ParseInt(hand.ID, 10, 64); err == nil && strconv.FormatInt(number, 10) == hand.ID {
+		w.value("hand", number)
+	} else if hand.ID != "" {
+		w.value(phhHandID, hand.ID)
+	}
+	if number, err := strconv.ParseInt(hand.Table, 10, 64); err == nil && strconv.FormatInt(number, 10) == hand.Table {
+		w.value("table", number)
+	} else if hand.Table != "" {
+		w.value(phhTable, hand.Table)
+	}
+	if !hand.Time.IsZero() {
+		w.value("year", hand.Time.Year())
+		w.value("month", int(hand.Time.Month()))
+		w.value("day", hand.Time.Day())
+		w.value("time", hand.Time.Format(time.TimeOnly))
+		w.value("time_zone", hand.Time.Location().String())
+	}
+	if hand.MaxSeats > 0 {
+		w.value("seat_count", hand.MaxSeats)
+	}
+	w.value("seats", seats)
+	w.value("players", names)
+	finishing := make([]float64, len(players))
+	winnings := make([]float64, len(players))
+	for i, seat := range players {
+		winnings[i] = roundAmount(hand.Collected(seat.Player))
+		finishing[i] = roundAmount(seat.Stack + hand.Net(seat.Player))
+	}
+	w.value("finishing_stacks", finishing)
+	w.value("winnings", winnings)
+	if hand.Currency != "" {
+		w.value("currency", hand.Currency)
+	}
+
+	w.value(phhSmallBlind, hand.SmallBlind)
+	if hand.Structure == game.PotLimit {
+		w.value(phhStructure, hand.Structure.String())
+	}
+	w.value(phhButton, hand.Button)
+	for _, amount := range dead {
+		if amount > 0 {
+			w.value(phhDeadBlinds, dead)
+			break
+		}
+	}
+	if len(collections) > 0 {
+		w.value(phhCollections, collections)
+	}
+	if hand.TournamentID != "" {
+		w.value(phhTournament, hand.TournamentID)
+	}
+	if hand.Game != "" {
+		w.value(phhGame, hand.Game)
+	}
+	w.value(phhTotalPot, hand.TotalPot)
+	if hand.Rake > 0 {
+		w.value(phhRake, hand.Rake)
+	}
+
+	var sittingOut []Seat
+	for _, seat := range hand.Seats {
+		if seat.SittingOut {
+			sittingOut = append(sittingOut, seat)
+		}
+	}
+	if len(sittingOut) > 0 {
+		names := make([]string, len(sittingOut))
+		seats := make([]int, len(sittingOut))
+		stacks := make([]float64, len(sittingOut))
+		for i, seat := range sittingOut {
+			names[i], seats[i], stacks[i] = seat.Player, seat.Number, seat.Stack
+		}
+		w.value(phhSittingOutPlayer, names)
+		w.value(phhSittingOutSeats, seats)
+		w.value(phhSittingOutStacks, stacks)
+	}
+	return nil
+}
+
+// dealtIn returns the seats dealt into the hand, starting left of the button and ending with it
+func (h *Hand) dealtIn() []Seat {
+	var players []Seat
+	for _, seat := range h.Seats {
+		if !seat.SittingOut {
+			players = append(players, seat)
+		}
+	}
+	// Seats up to the button come after every seat past it
+	distance := func(seat Seat) int {
+		if seat.Number > h.Button {
+			return seat.Number - h.Button
+		}
+		return seat.Number - h.Button + 1<<20
+	}
+	sort.SliceStable(players, func(a, b int) bool { return distance(players[a]) < distance(players[b]) })
+	return players
+}
+
+// phhActions writes the dealing and the players' decisions as PHH actions
+func (h *Hand) phhActions(index map[string]int) ([]string, error) {
+	var actions []string
+	for i, seat := range h.dealtIn() {
+		actions = append(actions, fmt.Sprintf("d dh p%d %s", i+1, phhCards(seat.HoleCards, 2)))
+	}
+
+	// Board cards are dealt before the first action on their street
+	streets := []struct {
+		street game.Street
+		board  int
+	}{{game.Flop, 3}, {game.Turn, 4}, {game.River, 5}}
+	dealt := 0
+	deal := func(street game.Street) {
+		for _, s := range streets {
+			if dealt < s.board && s.board <= len(h.Board) && street >= s.street {
+				actions = append(actions, "d db "+phhCards(h.Board[dealt:s.board], s.board-dealt))
+				dealt = s.board
+			}
+		}
+	}
+
+	for _, action := range h.Actions {
+		player := index[action.Player] + 1
+		switch action.Type {
+		case Fold:
+			deal(action.Street)
+			actions = append(actions, fmt.Sprintf("p%d f", player))
+		case Check, Call:
+			deal(action.Street)
+			actions = append(actions, fmt.Sprintf("p%d cc", player))
+		case Bet, Raise:
+			deal(action.Street)
+			actions = append(actions, fmt.Sprintf("p%d cbr %s", player, formatAmount(action.Amount)))
+		case Show:
+			deal(action.Street)
+			actions = append(actions, fmt.Sprintf("p%d sm %s", player, phhCards(action.Cards, len(action.Cards))))
+		case Muck:
+			deal(action.Street)
+			actions = append(actions, fmt.Sprintf("p%d sm", player))
+		case PostAnte, PostSmallBlind, PostBigBlind, PostBothBlinds, UncalledReturn, Collect:
+			// Implied by the antes, blinds and winnings
+		default:
+			return nil, fmt.Errorf("unsupported action: %s", action.Type)
+		}
+	}
+	deal(game.River)
+	return actions, nil
+}
+
+// ParsePHH parses a PHH file with one hand, or a PHHS file with one hand per table. Hands are read
+// independently, so one invalid hand is reported in the result's errors without affecting the others.
+func ParsePHH(text string) ParseResult {
+	var result ParseResult
+	doc, err := parseTOML(strings.TrimPrefix(text, "\ufeff"))
+	if err != nil {
+		result.Errors = append(result.Errors, ParseError{Line: err.(*tomlError).Line, Err: err})
+		return result
+	}
+
+	tables := doc.Tables
+	if len(doc.Root.Values) > 0 {
+		tables = append([]tomlTable{doc.Root}, tables...)
+	}
+	if len(tables) == 0 {
+		result.Errors = append(result.Errors, ParseError{Line: 1, Err: fmt.Errorf("no PHH hands found")})
+		return result
+	}
+	for index, table := range tables {
+		hand, err := parsePHHHand(table)
+		if err != nil {
+			result.Errors = append(result.Errors, ParseError{Index: index, HandID: hand.ID, Line: table.lineOf(err), Err: err})
+			continue
+		}
+		if hand.ID == "" {
+			// Hands without a number are told apart by their contents, so that they can be stored and exported
+			hand.ID = contentID(hand)
+		}
+		result.Hands = append(result.Hands, hand)
+	}
+	return result
+}
+
+// contentID numbers a hand with a hash of its contents
+func contentID(hand Hand) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%+v", hand)))
+	return hex.EncodeToString(sum[:])[:contentIDLength]
+}
+
+// phhFieldError is an invalid field of a PHH hand
+type phhFieldError struct {
+	Key string
+	Err error
+}
+
+func (e *phhFieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Key, e.Err)
+}
+
+// lineOf returns the line of the field an error is about, or of the table
+func (t tomlTable) lineOf(err error) int {
+	if fieldErr, ok := err.(*phhFieldError); ok {
+		if line, ok := t.Lines[fieldErr.Key]; ok {
+			return line
+		}
+	}
+	return t.Line
+}
+
+// parsePHHHand reads one hand from its table. The hand ID is filled in as soon as it is known, for errors.
+func parsePHHHand(t tomlTable) (Hand, error) {
+	var hand Hand
+	fields := &phhFields{tomlTable: t}
+
+	hand.Site = fields.text("venue")
+	hand.ID = fields.text(phhHandID)
+	if number, ok := t.Values["hand"].(int64); ok {
+		hand.ID = strconv.FormatInt(number, 10)
+	}
+	if _, ok := t.Values["variant"]; !ok {
+		return hand, &phhFieldError{"variant", fmt.Errorf("missing")}
+	}
+	switch variant := fields.text("variant"); variant {
+	case phhNoLimitHoldem:
+		hand.Structure = game.NoLimit
+		if fields.text(phhStructure) == game.PotLimit.String() {
+			hand.Structure = game.PotLimit
+		}
+	case phhFixedLimitHoldem:
+		hand.Structure = game.FixedLimit
+	default:
+		return hand, &phhFieldError{"variant", fmt.Errorf("unsupported variant %q", variant)}
+	}
+
+	stacks, err := fields.amounts("starting_stacks", -1)
+	if err != nil {
+		return hand, err
+	}
+	n := len(stacks)
+	if n < 2 {
+		return hand, &phhFieldError{"starting_stacks", fmt.Errorf("expected at least 2 players, got %d", n)}
+	}
+	antes, err := fields.amounts("antes", n)
+	if err != nil {
+		return hand, err
+	}
+	blinds, err := fields.amounts("blinds_or_straddles", n)
+	if err != nil {
+		return hand, err
+	}
+	dead, err := fields.optionalAmounts(phhDeadBlinds, n)
+	if err != nil {
+		return hand, err
+	}
+	names, err := fields.texts("players", n)
+	if err != nil {
+		return hand, err
+	}
+	seats, err := fields.numbers("seats", n)
+	if err != nil {
+		return hand, err
+	}
+	for i := range stacks {
+		if names[i] == "" {
+			names[i] = fmt.Sprintf("p%d", i+1)
+		}
+		if seats[i] == 0 {
+			seats[i] = i + 1
+		}
+	}
+
+	betKey := "min_bet"
+	if hand.Structure == game.FixedLimit {
+		betKey = "small_bet"
+	}
+	if hand.BigBlind, err = fields.amount(betKey); err != nil {
+		return hand, err
+	}
+	if hand.BigBlind == 0 {
+		for _, blind := range blinds {
+			hand.BigBlind = math.Max(hand.BigBlind, blind)
+		}
+	}
+	if hand.SmallBlind, err = fields.amount(phhSmallBlind); err != nil {
+		return hand, err
+	}
+	if _, ok := t.Values[phhSmallBlind]; !ok {
+		hand.SmallBlind = hand.BigBlind / 2
+	}
+	for i := range antes {
+		hand.Ante = math.Max(hand.Ante, roundAmount(antes[i]-dead[i]))
+	}
+
+	hand.Button = seats[n-1]
+	if button, ok := t.Values[phhButton].(int64); ok {
+		hand.Button = int(button)
+	}
+	hand.TournamentID = fields.text(phhTournament)
+	hand.Game = fields.text(phhGame)
+	hand.Currency = fields.text("currency")
+	hand.Table = fields.text(phhTable)
+	if number, ok := t.Values["table"].(int64); ok {
+		hand.Table = strconv.FormatInt(number, 10)
+	}
+	if count, ok := t.Values["seat_count"].(int64); ok {
+		hand.MaxSeats = int(count)
+	}
+	if hand.Time, err = fields.time(); err != nil {
+		return hand, err
+	}
+	if hand.TotalPot, err = fields.amount(phhTotalPot); err != nil {
+		return hand, err
+	}
+	if hand.Rake, err = fields.amount(phhRake); err != nil {
+		return hand, err
+	}
+	if fields.errs != nil {
+		return hand, fields.errs
+	}
+
+	for i := range stacks {
+		hand.Seats = append(hand.Seats, Seat{Number: seats[i], Player: names[i], Stack: stacks[i]})
+	}
+	outNames, err := fields.texts(phhSittingOutPlayer, -1)
+	if err != nil {
+		return hand, err
+	}
+	outSeats, err := fields.numbers(phhSittingOutSeats, len(outNames))
+	if err != nil {
+		return hand, err
+	}
+	outStacks, err := fields.optionalAmounts(phhSittingOutStacks, len(outNames))
+	if err != nil {
+		return hand, err
+	}
+	for i, name := range outNames {
+		hand.Seats = append(hand.Seats, Seat{Number: outSeats[i], Player: name, Stack: outStacks[i], SittingOut: true})
+	}
+	sort.SliceStable(hand.Seats, func(a, b int) bool { return hand.Seats[a].Number < hand.Seats[b].Number })
+
+	// Antes go in first, then the small blinds and the big blinds, each in seat order
+	r := newReplay(&hand, names, stacks)
+	player := make(map[string]int)
+	for i, name := range names {
+		player[name] = i
+	}
+	posts := []func(i int){
+		func(i int) {
+			if ante := roundAmount(antes[i] - dead[i]); ante > 0 {
+				r.post(i, PostAnte, ante)
+			}
+		},
+		func(i int) {
+			if dead[i] == 0 && blinds[i] > 0 && blinds[i] < hand.BigBlind {
+				r.post(i, PostSmallBlind, blinds[i])
+			}
+		},
+		func(i int) {
+			if dead[i] == 0 && blinds[i] > 0 && blinds[i] >= hand.BigBlind {
+				r.post(i, PostBigBlind, blinds[i])
+			}
+		},
+		func(i int) {
+			if dead[i] > 0 {
+				r.post(i, PostBothBlinds, roundAmount(blinds[i]+dead[i]))
+			}
+		},
+	}
+	for _, post := range posts {
+		for _, seat := range hand.Seats {
+			if i, ok := player[seat.Player]; ok && !seat.SittingOut {
+				post(i)
+			}
+		}
+	}
+
+	actions, err := fields.texts("actions", -1)
+	if err != nil {
+		return hand, err
+	}
+	for _, action := range actions {
+		if err := r.phhAction(action); err != nil {
+			return hand, &phhFieldError{"actions", fmt.Errorf("%q: %v", action, err)}
+		}
+	}
+
+	collections, err := fields.texts(phhCollections, -1)
+	if err != nil {
+		return hand, err
+	}
+	if _, ok := t.Values[phhCollections]; !ok {
+		winnings, err := fields.optionalAmounts("winnings", n)
+		if err != nil {
+			return hand, err
+		}
+		_, hasWinnings := t.Values["winnings"]
+		if _, ok := t.Values["finishing_stacks"]; ok && !hasWinnings {
+			// Without winnings, what a player won is what they finished with beyond what they had left
+			finishing, err := fields.amounts("finishing_stacks", n)
+			if err != nil {
+				return hand, err
+			}
+			r.returnUncalled()
+			for i, amount := range finishing {
+				winnings[i] = roundAmount(amount - stacks[i] + hand.Invested(names[i]))
+			}
+		}
+		for i, amount := range winnings {
+			if amount > 0 {
+				collections = append(collections, fmt.Sprintf("p%d %s", i+1, formatAmount(amount)))
+			}
+		}
+	}
+	for _, collection := range collections {
+		var i int
+		var amount float64
+		if _, err := fmt.Sscanf(collection, "p%d %g", &i, &amount); err != nil || i < 1 || i > n {
+			return hand, &phhFieldError{phhCollections, fmt.Errorf("invalid collection %q", collection)}
+		}
+		r.collect(i-1, amount)
+	}
+	r.returnUncalled()
+
+	if err := hand.checkCards(); err != nil {
+		return hand, err
+	}
+	if hand.TotalPot == 0 {
+		for _, seat := range hand.Seats {
+			hand.TotalPot += hand.Invested(seat.Player)
+		}
+		hand.TotalPot = roundAmount(hand.TotalPot)
+	}
+	return hand, nil
+}
+
+// phhAction applies one PHH action, such as "d dh p1 AhKh", "d db Kd7c2h", "p2 cbr 300" or "p1 sm AhKh"
+func (r *replay) phhAction(text string) error {
+	if comment := strings.Index(text, "#"); comment >= 0 {
+		text = text[:comment]
+	}
+	fields := strings.Fields(text)
+	if len(fields) < 2 {
+		return fmt.Errorf("incomplete action")
+	}
+
+	if fields[0] == "d" {
+		switch {
+		case fields[1] == "dh" && len(fields) == 4:
+			i, err := r.player(fields[2])
+			if err != nil {
+				return err
+			}
+			cards, known, err := parsePHHCards(fields[3])
+			if err != nil {
+				return err
+			}
+			if known {
+				r.hand.Seat(r.names[i]).HoleCards = cards
+			}
+			return nil
+		case fields[1] == "db" && len(fields) == 3:
+			cards, known, err := parsePHHCards(fields[2])
+			if err != nil {
+				return err
+			}
+			if !known {
+				return fmt.Errorf("board cards must be known")
+			}
+			return r.deal(cards)
+		}
+		return fmt.Errorf("unknown dealing action")
+	}
+
+	i, err := r.player(fields[0])
+	if err != nil {
+		return err
+	}
+	switch {
+	case fields[1] == "f" && len(fields) == 2:
+		r.fold(i)
+	case fields[1] == "cc" && len(fields) == 2:
+		r.checkOrCall(i)
+	case fields[1] == "cbr" && len(fields) == 3:
+		to, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil || to <= 0 {
+			return fmt.Errorf("invalid amount %q", fields[2])
+		}
+		return r.betOrRaise(i, to)
+	case fields[1] == "cbr" && len(fields) == 2 && r.hand.Structure == game.FixedLimit:
+		// Fixed-limit bets may leave out the amount: one small bet before the turn, one big bet after
+		size := r.hand.BigBlind
+		if r.street >= game.Turn {
+			size *= 2
+		}
+		return r.betOrRaise(i, roundAmount(r.currentBet+size))
+	case fields[1] == "sm":
+		var cards []poker.Card
+		if len(fields) == 3 && fields[2] != "-" {
+			shown, known, err := parsePHHCards(fields[2])
+			if err != nil {
+				return err
+			}
+			if known {
+				cards = shown
+				r.hand.Seat(r.names[i]).HoleCards = shown
+			}
+		}
+		r.show(i, cards)
+	default:
+		return fmt.Errorf("unknown action")
+	}
+	return nil
+}
+
+// player returns the index of a player written as p1, p2, ...
+func (r *replay) player(text string) (int, error) {
+	number, err := strconv.Atoi(strings.TrimPrefix(text, "p"))
+	if !strings.HasPrefix(text, "p") || err != nil || number < 1 || number > len(r.names) {
+		return 0, fmt.Errorf("unknown player %q", text)
+	}
+	return number - 1, nil
+}
+
+// phhCards writes cards with no spaces, such as "AhKh", using "??" for each unknown card
+func phhCards(cards []poker.Card, count int) string {
+	if len(cards) == 0 {
+		return strings.Repeat("??", count)
+	}
+	return strings.ReplaceAll(cardsText(cards), " ", "")
+}
+
+// parsePHHCards parses cards written with no spaces, such as "AhKh". Unknown cards are written "??";
+// known is false when any card is unknown.
+func parsePHHCards(text string) ([]poker.Card, bool, error) {
+	if len(text)%2 != 0 {
+		return nil, false, fmt.Errorf("invalid cards %q", text)
+	}
+	var cards []poker.Card
+	known := true
+	for i := 0; i < len(text); i += 2 {
+		if text[i:i+2] == "??" {
+			known = false
+			continue
+		}
+		card, err := parseCards(text[i : i+2])
+		if err != nil {
+			return nil, false, err
+		}
+		cards = append(cards, card...)
+	}
+	if !known {
+		return nil, false, nil
+	}
+	return cards, true, nil
+}
+
+// phhFields reads typed values from a hand's table. Type errors are collected in errs by the accessors
+// that do not return one.
+type phhFields struct {
+	tomlTable
+	errs error
+}
+
+// text returns a string value, or "" when it is missing
+func (f *phhFields) text(key string) string {
+	value, ok := f.Values[key]
+	if !ok {
+		return ""
+	}
+	text, ok := value.(string)
+	if !ok && f.errs == nil {
+		f.errs = &phhFieldError{key, fmt.Errorf("expected a string")}
+	}
+	return text
+}
+
+// amount returns a number, or 0 when it is missing
+func (f *phhFields) amount(key string) (float64, error) {
+	value, ok := f.Values[key]
+	if !ok {
+		return 0, nil
+	}
+	amount, ok := tomlNumber(value)
+	if !ok || amount < 0 {
+		return 0, &phhFieldError{key, fmt.Errorf("expected a non-negative number")}
+	}
+	return amount, nil
+}
+
+// amounts returns a required array of numbers with one per player, or any length when n is -1
+func (f *phhFields) amounts(key string, n int) ([]float64, error) {
+	if _, ok := f.Values[key]; !ok {
+		return nil, &phhFieldError{key, fmt.Errorf("missing")}
+	}
+	return f.optionalAmounts(key, n)
+}
+
+// optionalAmounts returns an array of numbers with one per player, or zeroes when it is missing
+func (f *phhFields) optionalAmounts(key string, n int) ([]float64, error) {
+	values, err := f.array(key, n)
+	if err != nil {
+		return nil, err
+	}
+	amounts := make([]float64, max(n, len(values)))
+	for i, value := range values {
+		amount, ok := tomlNumber(value)
+		if !ok || amount < 0 {
+			return nil, &phhFieldError{key, fmt.Errorf("expected non-negative numbers")}
+		}
+		amounts[i] = amount
+	}
+	return amounts, nil
+}
+
+// numbers returns an array of integers with one per player, or zeroes when it is missing
+func (f *phhFields) numbers(key string, n int) ([]int, error) {
+	values, err := f.array(key, n)
+	if err != nil {
+		return nil, err
+	}
+	numbers := make([]int, max(n, len(values)))
+	for i, value := range values {
+		number, ok := value.(int64)
+		if !ok {
+			return nil, &phhFieldError{key, fmt.Errorf("expected integers")}
+		}
+		numbers[i] = int(number)
+	}
+	return numbers, nil
+}
+
+// texts returns an array of strings with one per player, or empty strings when it is missing
+func (f *phhFields) texts(key string, n int) ([]string, error) {
+	values, err := f.array(key, n)
+	if err != nil {
+		return nil, err
+	}
+	texts := make([]string, max(n, len(values)))
+	for i, value := range values {
+		text, ok := value.(string)
+		if !ok {
+			return nil, &phhFieldError{key, fmt.Errorf("expected strings")}
+		}
+		texts[i] = text
+	}
+	return texts, nil
+}
+
+// array returns an array value with n elements, or any number when n is -1
+func (f *phhFields) array(key string, n int) ([]any, error) {
+	value, ok := f.Values[key]
+	if !ok {
+		return nil, nil
+	}
+	values, ok := value.([]any)
+	if !ok {
+		return nil, &phhFieldError{key, fmt.Errorf("expected an array")}
+	}
+	if n >= 0 && len(values) != n {
+		return nil, &phhFieldError{key, fmt.Errorf("expected %d values, got %d", n, len(values))}
+	}
+	return values, nil
+}
+
+// time returns when the hand was played, from the year, month, day, time and time_zone fields
+func (f *phhFields) time() (time.Time, error) {
+	year, hasYear := f.Values["year"].(int64)
+	month, hasMonth := f.Values["month"].(int64)
+	day, hasDay := f.Values["day"].(int64)
+	if !hasYear || !hasMonth || !hasDay {
+		return time.Time{}, nil
+	}
+	location := time.UTC
+	if name := f.text("time_zone"); name != "" {
+		loaded, err := time.LoadLocation(name)
+		if err != nil {
+			return time.Time{}, &phhFieldError{"time_zone", err}
+		}
+		location = loaded
+	}
+	clock := time.Time{}
+	if text := f.text("time"); text != "" {
+		parsed, err := time.Parse(time.TimeOnly, text)
+		if err != nil {
+			return time.Time{}, &phhFieldError{"time", fmt.Errorf("expected HH:MM:SS, got %q", text)}
+		}
+		clock = parsed
+	}
+	return time.Date(int(year), time.Month(month), int(day), clock.Hour(), clock.Minute(), clock.Second(), 0, location), nil
+}
+
+// tomlNumber returns an integer or float value as a float
+func tomlNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
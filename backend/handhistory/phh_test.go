@@ -0,0 +1,233 @@
+package handhistory
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"temperature-converter/game"
+)
+
+func TestPHHRoundTripsPokerStarsHands(t *testing.T) {
+	hands := ParsePokerStars(readTestData(t, "pokerstars.txt")).Hands
+	for _, hand := range hands {
+		text, err := WritePHH(hand)
+		if err != nil {
+			t.Fatalf("Hand #%s: unexpected error: %v", hand.ID, err)
+		}
+		result := ParsePHH(text)
+		if len(result.Errors) > 0 || len(result.Hands) != 1 {
+			t.Fatalf("Hand #%s: expected 1 hand back, got %d (errors: %v)\n%s", hand.ID, len(result.Hands), result.Errors, text)
+		}
+		if !reflect.DeepEqual(result.Hands[0], hand) {
+			t.Errorf("Hand #%s changed in the round trip:\nbefore: %+v\nafter:  %+v\n%s", hand.ID, hand, result.Hands[0], text)
+		}
+
+		// Writing the hand read back gives the same file
+		again, err := WritePHH(result.Hands[0])
+		if err != nil || again != text {
+			t.Errorf("Hand #%s: expected the same PHH when written again, got (%v):\n%s", hand.ID, err, again)
+		}
+	}
+}
+
+func TestWritePHH(t *testing.T) {
+	hand := ParsePokerStars(readTestData(t, "pokerstars.txt")).Hands[0]
+	text, err := WritePHH(hand)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Players start left of the button; posts and uncalled bets are implied
+	for _, line := range []string{
+		`variant = "NT"`,
+		`blinds_or_straddles = [0.5, 1, 0]`,
+		`starting_stacks = [50, 200, 100]`,
+		`players = ["Bob", "Carol", "Alice"]`,
+		`seats = [2, 3, 1]`,
+		`hand = 219876543210`,
+		`  "d dh p3 AhKh",`,
+		`  "p3 cbr 97",`,
+		`  "d db Kd7c2h",`,
+		`  "p1 sm 7d7h",`,
+		`winnings = [147, 0, 100]`,
+		`_sitting_out_players = ["Dave"]`,
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Errorf("Expected %q in:\n%s", line, text)
+		}
+	}
+}
+
+func TestParsePHHFromOtherTools(t *testing.T) {
+	result := ParsePHH(readTestData(t, "pluribus.phh"))
+	if len(result.Errors) > 0 || len(result.Hands) != 1 {
+		t.Fatalf("Expected 1 hand, got %d (errors: %v)", len(result.Hands), result.Errors)
+	}
+	hand := result.Hands[0]
+
+	if hand.ID != "30" || hand.Structure != game.NoLimit || hand.SmallBlind != 50 || hand.BigBlind != 100 {
+		t.Errorf("Unexpected header: %+v", hand)
+	}
+	// Without seats, players sit in order and the last one has the button
+	if len(hand.Seats) != 6 || hand.Seats[4].Player != "Pluribus" || hand.Seats[4].Number != 5 || hand.Button != 6 {
+		t.Errorf("Unexpected seats: %+v, button %d", hand.Seats, hand.Button)
+	}
+	if cardsText(hand.Seat("Pluribus").HoleCards) != "Ts 9s" || hand.Seat("Budd").HoleCards != nil {
+		t.Errorf("Expected only Pluribus's cards to be known: %+v", hand.Seats)
+	}
+
+	// The flop bet was not called, and the winnings come from the finishing stacks
+	var uncalled, collected *Action
+	for i, action := range hand.Actions {
+		switch action.Type {
+		case UncalledReturn:
+			uncalled = &hand.Actions[i]
+		case Collect:
+			collected = &hand.Actions[i]
+		}
+	}
+	if uncalled == nil || uncalled.Player != "Pluribus" || uncalled.Amount != 250 || uncalled.Street != game.Flop {
+		t.Errorf("Expected 250 returned to Pluribus on the flop, got %+v", uncalled)
+	}
+	if collected == nil || collected.Player != "Pluribus" || collected.Amount != 470 {
+		t.Errorf("Expected Pluribus to collect 470, got %+v", collected)
+	}
+	if hand.Net("Pluribus") != 260 || hand.Net("Budd") != -210 || hand.Net("MrBlue") != -50 || hand.TotalPot != 470 {
+		t.Errorf("Unexpected results: Pluribus %v, Budd %v, MrBlue %v, pot %v",
+			hand.Net("Pluribus"), hand.Net("Budd"), hand.Net("MrBlue"), hand.TotalPot)
+	}
+	if budd := hand.Actions[len(hand.Actions)-3]; budd.Type != Fold || budd.Player != "Budd" {
+		t.Errorf("Expected Budd's fold before the uncalled bet, got %+v", budd)
+	}
+
+	// Exporting the hand and reading it again loses nothing
+	text, err := WritePHH(hand)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	again := ParsePHH(text)
+	if len(again.Hands) != 1 || !reflect.DeepEqual(again.Hands[0], hand) {
+		t.Errorf("Hand changed in the round trip (errors: %v):\n%s", again.Errors, text)
+	}
+}
+
+func TestPHHS(t *testing.T) {
+	hands := ParsePokerStars(readTestData(t, "pokerstars.txt")).Hands
+	text, err := WritePHHS(hands)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(text, "[1]\n") || !strings.Contains(text, "\n\n[3]\n") {
+		t.Errorf("Expected one table per hand:\n%s", text)
+	}
+
+	result := ParsePHH(text)
+	if len(result.Errors) > 0 || !reflect.DeepEqual(result.Hands, hands) {
+		t.Errorf("Expected the hands back (errors: %v)", result.Errors)
+	}
+}
+
+func TestParsePHHErrors(t *testing.T) {
+	valid := "variant = \"NT\"\nantes = [0, 0]\nblinds_or_straddles = [1, 2]\nmin_bet = 2\nstarting_stacks = [100, 100]\n"
+	tests := []struct {
+		name  string
+		text  string
+		line  int
+		error string
+	}{
+		{"no hands", "# nothing here\n", 1, "no PHH hands found"},
+		{"syntax", "variant = \"NT\nantes = []\n", 1, "unterminated string"},
+		{"unclosed array", "antes = [0,\n0\n", 3, "unterminated array"},
+		{"duplicate key", "antes = [0]\nantes = [1]\n", 2, "defined twice"},
+		{"missing variant", "antes = [0, 0]\n", 1, "variant: missing"},
+		{"unsupported variant", "variant = \"PO\"\n", 1, "unsupported variant"},
+		{"missing stacks", "variant = \"NT\"\nantes = [0, 0]\n", 1, "starting_stacks: missing"},
+		{"wrong length", "variant = \"NT\"\nstarting_stacks = [100, 100]\nantes = [0]\n", 3, "antes: expected 2 values, got 1"},
+		{"unknown player", valid + "actions = [\"p3 f\"]\n", 6, "unknown player"},
+		{"unknown action", valid + "actions = [\n  \"d dh p1 ????\",\n  \"p1 xx\",\n]\n", 6, "unknown action"},
+		{"bad card", valid + "actions = [\"d dh p1 AhKx\"]\n", 6, "invalid card"},
+		{"small raise", valid + "actions = [\"p1 cbr 2\"]\n", 6, "cannot raise to 2"},
+		{"duplicate cards", valid + "actions = [\"d dh p1 AhKh\", \"d dh p2 AhQh\"]\n", 1, "duplicate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParsePHH(tt.text)
+			if len(result.Hands) != 0 || len(result.Errors) != 1 {
+				t.Fatalf("Expected 1 error and no hands, got %d hands and %v", len(result.Hands), result.Errors)
+			}
+			err := result.Errors[0]
+			if err.Line != tt.line || !strings.Contains(err.Error(), tt.error) {
+				t.Errorf("Expected %q on line %d, got %q on line %d", tt.error, tt.line, err.Error(), err.Line)
+			}
+		})
+	}
+}
+
+func TestParsePHHSKeepsValidHands(t *testing.T) {
+	text := "[a]\nvariant = \"NT\"\nantes = [0, 0]\nblinds_or_straddles = [1, 2]\nmin_bet = 2\nstarting_stacks = [100, 100]\nhand = 7\n" +
+		"actions = [\"p1 f\"]\n\n[b]\nvariant = \"XX\"\nhand = 8\n"
+	result := ParsePHH(text)
+	if len(result.Hands) != 1 || result.Hands[0].ID != "7" {
+		t.Fatalf("Expected hand 7, got %+v", result.Hands)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Index != 1 || result.Errors[0].HandID != "8" || result.Errors[0].Line != 11 {
+		t.Errorf("Expected an error for the second hand's variant on line 11, got %+v", result.Errors)
+	}
+}
+
+func TestParsePHHNumbersHandsByContent(t *testing.T) {
+	hand := "variant = \"NT\"\nantes = [0, 0]\nblinds_or_straddles = [1, 2]\nmin_bet = 2\nstarting_stacks = [100, 100]\n"
+	text := "[1]\n" + hand + "actions = [\"p1 f\"]\n\n[2]\n" + hand + "actions = [\"p1 cc\", \"p2 f\"]\n\n[3]\n" + hand + "actions = [\"p1 f\"]\n"
+	hands := ParsePHH(text).Hands
+	if len(hands) != 3 || hands[0].ID == "" || hands[0].ID == hands[1].ID || hands[0].ID != hands[2].ID {
+		t.Fatalf("Expected different hands to get different numbers and the same hand the same one, got %+v", hands)
+	}
+
+	// Without a site or hand number the hands would all share one key
+	store := NewStore()
+	if added := store.Add(hands...); added != 2 {
+		t.Errorf("Expected 2 new hands and the repeated one skipped, got %d", added)
+	}
+	written, err := WritePHH(hands[1])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if again := ParsePHH(written).Hands; len(again) != 1 || again[0].Key() != hands[1].Key() {
+		t.Errorf("Expected the hand's number to survive export, got %+v", again)
+	}
+}
+
+func TestTOMLStrings(t *testing.T) {
+	for _, text := range []string{"plain", `quote " and \ backslash`, "tab\tnew\nline", "control \x01", "unicode ♠"} {
+		doc, err := parseTOML("key = " + tomlString(text) + "\n")
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", text, err)
+		}
+		if doc.Root.Values["key"] != text {
+			t.Errorf("Expected %q back, got %q", text, doc.Root.Values["key"])
+		}
+	}
+}
+
+func TestParseDetectsFormat(t *testing.T) {
+	pokerStars := readTestData(t, "pokerstars.txt")
+	phh := readTestData(t, "pluribus.phh")
+
+	if format := DetectFormat(pokerStars); format != FormatPokerStars {
+		t.Errorf("Expected %s, got %s", FormatPokerStars, format)
+	}
+	if format := DetectFormat(phh); format != FormatPHH {
+		t.Errorf("Expected %s, got %s", FormatPHH, format)
+	}
+	if result, err := Parse(phh, ""); err != nil || len(result.Hands) != 1 {
+		t.Errorf("Expected the PHH hand, got %d hands (%v)", len(result.Hands), err)
+	}
+	if result, err := Parse(pokerStars, FormatPokerStars); err != nil || len(result.Hands) != 3 {
+		t.Errorf("Expected the PokerStars hands, got %d hands (%v)", len(result.Hands), err)
+	}
+	if _, err := Parse(phh, "xml"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,507 @@
+package handhistory
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // ET times are converted without relying on the system's zoneinfo
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// ParseError describes a hand that could not be parsed
+type ParseError struct {
+	Index  int    // Position of the hand in the input, from 0
+	HandID string // Hand number, when the header could be read
+	Line   int    // Line of the input with the problem, from 1
+	Err    error
+}
+
+// Error formats the error with its position
+func (e ParseError) Error() string {
+	if e.HandID != "" {
+		return fmt.Sprintf("hand #%s (line %d): %v", e.HandID, e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// ParseResult holds the hands that were parsed and an error for every hand that was not
+type ParseResult struct {
+	Hands  []Hand
+	Errors []ParseError
+}
+
+var (
+	easternTime        = mustLoadLocation("America/New_York")
+	pokerStarsHeader   = regexp.MustCompile(`^PokerStars (?:Zoom |Home Game )?(?:Hand|Game) #(\d+):\s*(.*)$`)
+	tournamentPattern  = regexp.MustCompile(`Tournament #(\d+)`)
+	gamePattern        = regexp.MustCompile(`Hold'em (No Limit|Pot Limit|Limit)`)
+	stakesPattern      = regexp.MustCompile(`\(([^/()\s]+)/([^/()\s]+)(?: ([A-Z]{3}))?\)`)
+	timePattern        = regexp.MustCompile(`(\d{4}/\d{2}/\d{2} \d{1,2}:\d{2}:\d{2})(?: ([A-Z]+))?`)
+	tablePattern       = regexp.MustCompile(`^Table '(.+)' (\d+)-max.* Seat #(\d+) is the button`)
+	seatPattern        = regexp.MustCompile(`^Seat (\d+): (.+) \((\S+) in chips[^)]*\)(.*)$`)
+	streetPattern      = regexp.MustCompile(`^\*\*\* ([A-Z ]+) \*\*\*(.*)$`)
+	cardsPattern       = regexp.MustCompile(`\[([^\]]*)\]`)
+	dealtPattern       = regexp.MustCompile(`^Dealt to (.+?)(?: \[([^\]]*)\])?$`)
+	uncalledPattern    = regexp.MustCompile(`^Uncalled bet \((\S+)\) returned to (.+)$`)
+	collectedPattern   = regexp.MustCompile(`^(.+) collected (\S+) from (?:the )?(?:main pot|side pot(?:-\d+)?|pot)$`)
+	totalPotPattern    = regexp.MustCompile(`^Total pot (\S+).*\| Rake (\S+)`)
+	summaryCardPattern = regexp.MustCompile(`^Seat \d+: (.+?) (?:\([^)]*\) )*(?:showed|mucked) \[([^\]]*)\]`)
+	allInSuffix        = " and is all-in"
+)
+
+// mustLoadLocation loads a time zone from the embedded database
+func mustLoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return location
+}
+
+// ParsePokerStars parses PokerStars-style text hand histories. Hands are read independently, so one
+// malformed hand is reported in the result's errors without affecting the others.
+func ParsePokerStars(text string) ParseResult {
+	text = strings.TrimPrefix(text, "\ufeff")
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	var result ParseResult
+	var starts []int
+	for i, line := range lines {
+		if pokerStarsHeader.MatchString(strings.TrimSpace(line)) {
+			starts = append(starts, i)
+		}
+	}
+	if len(starts) == 0 {
+		if strings.TrimSpace(text) != "" {
+			result.Errors = append(result.Errors, ParseError{Line: 1, Err: fmt.Errorf("no PokerStars hand histories found")})
+		}
+		return result
+	}
+
+	for index, start := range starts {
+		end := len(lines)
+		if index+1 < len(starts) {
+			end = starts[index+1]
+		}
+		hand, err := parsePokerStarsHand(lines[start:end], start+1)
+		if err != nil {
+			err.Index = index
+			result.Errors = append(result.Errors, *err)
+			continue
+		}
+		result.Hands = append(result.Hands, hand)
+	}
+	return result
+}
+
+// pokerStarsParser holds the state of one hand being parsed
+type pokerStarsParser struct {
+	hand    Hand
+	street  game.Street
+	summary bool
+	names   []string // Player names, longest first, for matching "name: action" lines
+}
+
+// parsePokerStarsHand parses the lines of one hand, the first of which is line firstLine of the input
+func parsePokerStarsHand(lines []string, firstLine int) (Hand, *ParseError) {
+	p := &pokerStarsParser{}
+	fail := func(i int, err error) (Hand, *ParseError) {
+		return Hand{}, &ParseError{HandID: p.hand.ID, Line: firstLine + i, Err: err}
+	}
+
+	if err := p.parseHeader(strings.TrimSpace(lines[0])); err != nil {
+		return fail(0, err)
+	}
+	for i, line := range lines[1:] {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if err := p.parseLine(line); err != nil {
+			return fail(i+1, err)
+		}
+	}
+	if err := p.check(); err != nil {
+		return fail(len(lines)-1, err)
+	}
+	return p.hand, nil
+}
+
+// parseHeader reads the hand number, game, stakes and time
+func (p *pokerStarsParser) parseHeader(line string) error {
+	match := pokerStarsHeader.FindStringSubmatch(line)
+	p.hand.Site = "PokerStars"
+	p.hand.ID = match[1]
+	rest := match[2]
+
+	if m := tournamentPattern.FindStringSubmatch(rest); m != nil {
+		p.hand.TournamentID = m[1]
+	}
+	m := gamePattern.FindStringSubmatch(rest)
+	if m == nil {
+		return fmt.Errorf("unsupported game: %s", rest)
+	}
+	p.hand.Game = m[0]
+	switch m[1] {
+	case "No Limit":
+		p.hand.Structure = game.NoLimit
+	case "Pot Limit":
+		p.hand.Structure = game.PotLimit
+	case "Limit":
+		p.hand.Structure = game.FixedLimit
+	}
+
+	m = stakesPattern.FindStringSubmatch(rest)
+	if m == nil {
+		return fmt.Errorf("missing stakes")
+	}
+	low, err := parseAmount(m[1])
+	if err != nil {
+		return err
+	}
+	high, err := parseAmount(m[2])
+	if err != nil {
+		return err
+	}
+	p.hand.SmallBlind, p.hand.BigBlind = low, high
+	if p.hand.Structure == game.FixedLimit {
+		// Limit stakes are the small and big bets; the blinds are half of each
+		p.hand.SmallBlind, p.hand.BigBlind = low/2, low
+	}
+	if p.hand.TournamentID == "" {
+		p.hand.Currency = m[3]
+	}
+
+	p.hand.Time = pokerStarsTime(rest)
+	return nil
+}
+
+// pokerStarsTime reads when a hand was played. Headers give the time in ET, sometimes after the local
+// time in brackets, as in "2021/03/14 19:02:11 CET [2021/03/14 13:02:11 ET]"; without an ET time the
+// first one is taken as UTC.
+func pokerStarsTime(header string) time.Time {
+	matches := timePattern.FindAllStringSubmatch(header, -1)
+	if len(matches) == 0 {
+		return time.Time{}
+	}
+	text, location := matches[0][1], time.UTC
+	for _, m := range matches {
+		if m[2] == "ET" {
+			text, location = m[1], easternTime
+			break
+		}
+	}
+	t, err := time.ParseInLocation("2006/01/02 15:04:05", text, location)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// parseLine reads one line after the header
+func (p *pokerStarsParser) parseLine(line string) error {
+	if m := streetPattern.FindStringSubmatch(line); m != nil {
+		return p.parseStreet(m[1], m[2])
+	}
+	if p.summary {
+		return p.parseSummary(line)
+	}
+
+	if m := tablePattern.FindStringSubmatch(line); m != nil {
+		p.hand.Table = m[1]
+		p.hand.MaxSeats, _ = strconv.Atoi(m[2])
+		p.hand.Button, _ = strconv.Atoi(m[3])
+		return nil
+	}
+	if m := seatPattern.FindStringSubmatch(line); m != nil && p.street == game.Preflop && len(p.hand.Actions) == 0 {
+		number, _ := strconv.Atoi(m[1])
+		stack, err := parseAmount(m[3])
+		if err != nil {
+			return err
+		}
+		p.hand.Seats = append(p.hand.Seats, Seat{
+			Number:     number,
+			Player:     m[2],
+			Stack:      stack,
+			SittingOut: strings.Contains(m[4], "sitting out"),
+		})
+		p.names = append(p.names, m[2])
+		sort.Slice(p.names, func(a, b int) bool { return len(p.names[a]) > len(p.names[b]) })
+		return nil
+	}
+	if m := dealtPattern.FindStringSubmatch(line); m != nil {
+		seat := p.hand.Seat(m[1])
+		if seat == nil {
+			return fmt.Errorf("cards dealt to unknown player %q", m[1])
+		}
+		if m[2] != "" {
+			cards, err := parseCards(m[2])
+			if err != nil {
+				return err
+			}
+			seat.HoleCards = cards
+		}
+		return nil
+	}
+	if m := uncalledPattern.FindStringSubmatch(line); m != nil {
+		return p.addAmount(m[2], UncalledReturn, m[1], false)
+	}
+	if m := collectedPattern.FindStringSubmatch(line); m != nil {
+		return p.addAmount(m[1], Collect, m[2], false)
+	}
+
+	for _, name := range p.names {
+		if rest, ok := strings.CutPrefix(line, name+": "); ok {
+			return p.parseAction(name, rest)
+		}
+	}
+	// Chat, connection and seating messages do not affect the hand
+	return nil
+}
+
+// parseStreet starts a new section of the hand, dealing any board cards it shows
+func (p *pokerStarsParser) parseStreet(name, rest string) error {
+	var street game.Street
+	cards := 0
+	switch name {
+	case "HOLE CARDS":
+		street = game.Preflop
+	case "FLOP":
+		street, cards = game.Flop, 3
+	case "TURN":
+		street, cards = game.Turn, 1
+	case "RIVER":
+		street, cards = game.River, 1
+	case "SHOW DOWN":
+		street = game.Showdown
+	case "SUMMARY":
+		p.summary = true
+		return nil
+	default:
+		return fmt.Errorf("unsupported section: %s", name)
+	}
+	p.street = street
+	if cards == 0 {
+		return nil
+	}
+
+	// The last bracket holds the new cards: "*** TURN *** [2h 7c Td] [Js]"
+	groups := cardsPattern.FindAllStringSubmatch(rest, -1)
+	if len(groups) == 0 {
+		return fmt.Errorf("missing %s cards", street)
+	}
+	dealt, err := parseCards(groups[len(groups)-1][1])
+	if err != nil {
+		return err
+	}
+	if len(dealt) != cards {
+		return fmt.Errorf("expected %d %s cards, got %d", cards, street, len(dealt))
+	}
+	p.hand.Board = append(p.hand.Board, dealt...)
+	return nil
+}
+
+// parseAction reads what a player did from the text after "name: "
+func (p *pokerStarsParser) parseAction(player, text string) error {
+	allIn := strings.HasSuffix(text, allInSuffix)
+	text = strings.TrimSuffix(text, allInSuffix)
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return fmt.Errorf("missing action for %s", player)
+	}
+
+	switch {
+	case fields[0] == "folds":
+		p.add(Action{Street: p.street, Player: player, Type: Fold})
+		if m := cardsPattern.FindStringSubmatch(text); m != nil {
+			return p.reveal(player, m[1])
+		}
+		return nil
+	case text == "checks":
+		p.add(Action{Street: p.street, Player: player, Type: Check})
+		return nil
+	case fields[0] == "calls" && len(fields) == 2:
+		return p.addAmount(player, Call, fields[1], allIn)
+	case fields[0] == "bets" && len(fields) == 2:
+		return p.addAmount(player, Bet, fields[1], allIn)
+	case fields[0] == "raises" && len(fields) == 4 && fields[2] == "to":
+		return p.addAmount(player, Raise, fields[3], allIn)
+	case strings.HasPrefix(text, "posts small blind "):
+		return p.addAmount(player, PostSmallBlind, fields[3], allIn)
+	case strings.HasPrefix(text, "posts big blind "):
+		return p.addAmount(player, PostBigBlind, fields[3], allIn)
+	case strings.HasPrefix(text, "posts the ante "):
+		if err := p.addAmount(player, PostAnte, fields[3], allIn); err != nil {
+			return err
+		}
+		p.hand.Ante = math.Max(p.hand.Ante, p.hand.Actions[len(p.hand.Actions)-1].Amount)
+		return nil
+	case strings.HasPrefix(text, "posts small & big blinds "):
+		return p.addAmount(player, PostBothBlinds, fields[5], allIn)
+	case fields[0] == "shows":
+		m := cardsPattern.FindStringSubmatch(text)
+		if m == nil {
+			return fmt.Errorf("missing cards shown by %s", player)
+		}
+		if err := p.reveal(player, m[1]); err != nil {
+			return err
+		}
+		p.add(Action{Street: p.street, Player: player, Type: Show, Cards: p.hand.Seat(player).HoleCards})
+		return nil
+	case text == "mucks hand":
+		p.add(Action{Street: p.street, Player: player, Type: Muck})
+		return nil
+	case text == "doesn't show hand":
+		// Said by a winner who was not called, after the pot is collected; there is no showdown to muck at
+		return nil
+	case text == "sits out" || text == "is sitting out" || strings.HasPrefix(text, "is disconnected") || strings.HasPrefix(text, "is connected"):
+		return nil
+	}
+	return fmt.Errorf("unknown action for %s: %s", player, text)
+}
+
+// parseSummary reads the summary section: the total pot and rake, the board and cards shown or mucked
+func (p *pokerStarsParser) parseSummary(line string) error {
+	if m := totalPotPattern.FindStringSubmatch(line); m != nil {
+		total, err := parseAmount(m[1])
+		if err != nil {
+			return err
+		}
+		rake, err := parseAmount(m[2])
+		if err != nil {
+			return err
+		}
+		p.hand.TotalPot, p.hand.Rake = total, rake
+		return nil
+	}
+	if rest, ok := strings.CutPrefix(line, "Board "); ok {
+		m := cardsPattern.FindStringSubmatch(rest)
+		if m == nil {
+			return fmt.Errorf("invalid board: %s", rest)
+		}
+		board, err := parseCards(m[1])
+		if err != nil {
+			return err
+		}
+		if cardsText(board) != cardsText(p.hand.Board) {
+			return fmt.Errorf("summary board %s does not match the streets dealt (%s)", cardsText(board), cardsText(p.hand.Board))
+		}
+		return nil
+	}
+	if m := summaryCardPattern.FindStringSubmatch(line); m != nil && p.hand.Seat(m[1]) != nil {
+		return p.reveal(m[1], m[2])
+	}
+	return nil
+}
+
+// reveal records a player's hole cards from text such as "Ah Kd"
+func (p *pokerStarsParser) reveal(player, text string) error {
+	cards, err := parseCards(text)
+	if err != nil {
+		return err
+	}
+	if len(cards) != 2 {
+		return fmt.Errorf("expected 2 hole cards for %s, got %d", player, len(cards))
+	}
+	p.hand.Seat(player).HoleCards = cards
+	return nil
+}
+
+// add appends an action
+func (p *pokerStarsParser) add(action Action) {
+	p.hand.Actions = append(p.hand.Actions, action)
+}
+
+// addAmount appends an action with a chip amount
+func (p *pokerStarsParser) addAmount(player string, actionType ActionType, amount string, allIn bool) error {
+	if p.hand.Seat(player) == nil {
+		return fmt.Errorf("unknown player %q", player)
+	}
+	value, err := parseAmount(amount)
+	if err != nil {
+		return err
+	}
+	p.add(Action{Street: p.street, Player: player, Type: actionType, Amount: value, AllIn: allIn})
+	return nil
+}
+
+// check makes sure the hand is complete and its chips add up
+func (p *pokerStarsParser) check() error {
+	if len(p.hand.Seats) < 2 {
+		return fmt.Errorf("expected at least 2 seated players, got %d", len(p.hand.Seats))
+	}
+	if !p.summary {
+		return fmt.Errorf("hand is incomplete: missing summary")
+	}
+	if err := p.hand.checkCards(); err != nil {
+		return err
+	}
+
+	invested := 0.0
+	for _, seat := range p.hand.Seats {
+		invested += p.hand.Invested(seat.Player)
+	}
+	if p.hand.TotalPot > 0 && math.Abs(invested-p.hand.TotalPot) > 0.005 {
+		return fmt.Errorf("players put in %s but the total pot is %s", formatAmount(invested), formatAmount(p.hand.TotalPot))
+	}
+	if p.hand.TotalPot == 0 {
+		p.hand.TotalPot = invested
+	}
+	return nil
+}
+
+// checkCards makes sure no card appears twice among the hole cards and board
+func (h *Hand) checkCards() error {
+	cards := append([]poker.Card{}, h.Board...)
+	for _, seat := range h.Seats {
+		cards = append(cards, seat.HoleCards...)
+	}
+	return poker.CheckDuplicateCards(cards)
+}
+
+// parseAmount parses a chip or money amount such as "1,500", "$0.25" or "€2"
+func parseAmount(text string) (float64, error) {
+	cleaned := strings.NewReplacer("$", "", "€", "", "£", "", ",", "").Replace(text)
+	value, err := strconv.ParseFloat(cleaned, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid amount: %s", text)
+	}
+	return value, nil
+}
+
+// formatAmount prints an amount without trailing zeroes
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+// parseCards parses space-separated cards written rank first, such as "Ah Kd"
+func parseCards(text string) ([]poker.Card, error) {
+	var cards []poker.Card
+	for _, field := range strings.Fields(text) {
+		if len(field) != 2 {
+			return nil, fmt.Errorf("invalid card: %s", field)
+		}
+		card, err := poker.ParseCard(string([]byte{field[1], field[0]}))
+		if err != nil {
+			return nil, fmt.Errorf("invalid card: %s", field)
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// cardsText writes cards rank first, such as "Ah Kd"
+func cardsText(cards []poker.Card) string {
+	texts := make([]string, len(cards))
+	for i, card := range cards {
+		text := poker.CardToString(card)
+		texts[i] = text[1:] + strings.ToLower(text[:1])
+	}
+	return strings.Join(texts, " ")
+}
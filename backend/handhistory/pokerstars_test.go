@@ -0,0 +1,169 @@
+package handhistory
+
+import (
+	"math"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"temperature-converter/game"
+)
+
+func readTestData(t *testing.T, name string) string {
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("Failed to read test data: %v", err)
+	}
+	return string(data)
+}
+
+func TestParsePokerStars(t *testing.T) {
+	result := ParsePokerStars(readTestData(t, "pokerstars.txt"))
+
+	if len(result.Hands) != 3 {
+		t.Fatalf("Expected 3 hands, got %d (errors: %v)", len(result.Hands), result.Errors)
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %v", result.Errors)
+	}
+	parseErr := result.Errors[0]
+	if parseErr.Index != 2 || parseErr.HandID != "219876543212" || parseErr.Line != 77 || !strings.Contains(parseErr.Error(), "invalid card: Ax") {
+		t.Errorf("Unexpected error: %+v (%v)", parseErr, parseErr)
+	}
+
+	hand := result.Hands[0]
+	if hand.ID != "219876543210" || hand.Site != "PokerStars" || hand.Game != "Hold'em No Limit" || hand.Structure != game.NoLimit {
+		t.Errorf("Unexpected header: %+v", hand)
+	}
+	if hand.SmallBlind != 0.5 || hand.BigBlind != 1 || hand.Currency != "USD" || hand.TournamentID != "" {
+		t.Errorf("Unexpected stakes: %v/%v %s", hand.SmallBlind, hand.BigBlind, hand.Currency)
+	}
+	if hand.Time.Format(time.RFC3339) != "2021-03-14T19:02:11-04:00" || hand.Time.Location().String() != "America/New_York" {
+		t.Errorf("Unexpected time: %v", hand.Time)
+	}
+	if hand.Table != "Alcyone" || hand.MaxSeats != 6 || hand.Button != 1 {
+		t.Errorf("Unexpected table: %s %d-max button %d", hand.Table, hand.MaxSeats, hand.Button)
+	}
+	if len(hand.Seats) != 4 || !hand.Seats[3].SittingOut || hand.Seats[1].Stack != 50 {
+		t.Errorf("Unexpected seats: %+v", hand.Seats)
+	}
+	if cardsText(hand.Board) != "Kd 7c 2h 5s 9d" {
+		t.Errorf("Unexpected board: %s", cardsText(hand.Board))
+	}
+	expectedCards := map[string]string{"Alice": "Ah Kh", "Bob": "7d 7h", "Carol": "Qs Qc", "Dave": ""}
+	for player, cards := range expectedCards {
+		if got := cardsText(hand.Seat(player).HoleCards); got != cards {
+			t.Errorf("Expected %s to hold %q, got %q", player, cards, got)
+		}
+	}
+	if hand.TotalPot != 250 || hand.Rake != 3 {
+		t.Errorf("Expected a pot of 250 with 3 rake, got %v and %v", hand.TotalPot, hand.Rake)
+	}
+
+	raise := hand.Actions[7]
+	if raise.Street != game.Flop || raise.Player != "Alice" || raise.Type != Raise || raise.Amount != 97 || !raise.AllIn {
+		t.Errorf("Unexpected all-in raise: %+v", raise)
+	}
+	expectedNet := map[string]float64{"Alice": 0, "Bob": 97, "Carol": -100, "Dave": 0}
+	for player, net := range expectedNet {
+		if got := hand.Net(player); math.Abs(got-net) > 1e-9 {
+			t.Errorf("Expected %s to net %v, got %v", player, net, got)
+		}
+	}
+}
+
+func TestParsePokerStarsTournament(t *testing.T) {
+	result := ParsePokerStars(readTestData(t, "pokerstars.txt"))
+	hand := result.Hands[1]
+
+	if hand.TournamentID != "3012345678" || hand.Currency != "" || hand.SmallBlind != 25 || hand.BigBlind != 50 || hand.Ante != 5 {
+		t.Errorf("Unexpected tournament header: %+v", hand)
+	}
+	if hand.Seat("Frank").Stack != 2340 {
+		t.Errorf("Expected a stack of 2340, got %v", hand.Seat("Frank").Stack)
+	}
+	// The uncalled flop bet goes back, so Frank invested 130 and won 290
+	if hand.Invested("Frank") != 130 || hand.Net("Frank") != 160 {
+		t.Errorf("Expected Frank to invest 130 and net 160, got %v and %v", hand.Invested("Frank"), hand.Net("Frank"))
+	}
+	if hand.Net("Gina") != -30 || hand.Net("Erin") != -130 {
+		t.Errorf("Expected Gina to lose 30 and Erin 130, got %v and %v", hand.Net("Gina"), hand.Net("Erin"))
+	}
+	last := hand.Actions[len(hand.Actions)-1]
+	if last.Type != Collect || last.Player != "Frank" || last.Street != game.Flop {
+		t.Errorf("Expected Frank to collect the pot on the flop without showing, got %+v", last)
+	}
+}
+
+func TestParsePokerStarsFixedLimit(t *testing.T) {
+	result := ParsePokerStars(readTestData(t, "pokerstars.txt"))
+	hand := result.Hands[2]
+
+	if hand.Structure != game.FixedLimit || hand.SmallBlind != 1 || hand.BigBlind != 2 {
+		t.Errorf("Expected fixed-limit blinds of 1 and 2, got %s %v/%v", hand.Structure, hand.SmallBlind, hand.BigBlind)
+	}
+	// Mucked cards come from the summary
+	if cardsText(hand.Seat("Hank").HoleCards) != "As 5s" {
+		t.Errorf("Expected Hank's mucked cards, got %q", cardsText(hand.Seat("Hank").HoleCards))
+	}
+	if hand.Net("Ivy") != 9.5 || hand.Net("Hank") != -10 {
+		t.Errorf("Expected Ivy to net 9.5 and Hank -10, got %v and %v", hand.Net("Ivy"), hand.Net("Hank"))
+	}
+}
+
+func TestParsePokerStarsErrors(t *testing.T) {
+	header := "PokerStars Hand #1:  Hold'em No Limit ($1/$2 USD) - 2021/01/01 12:00:00 ET\n" +
+		"Table 'T' 6-max Seat #1 is the button\nSeat 1: A ($200 in chips)\nSeat 2: B ($200 in chips)\n" +
+		"A: posts small blind $1\nB: posts big blind $2\n*** HOLE CARDS ***\n"
+	summary := "*** SUMMARY ***\nTotal pot $4 | Rake $0\n"
+
+	testCases := []struct {
+		name  string
+		text  string
+		error string
+	}{
+		{"not a hand history", "hello", "no PokerStars hand histories found"},
+		{"unsupported game", "PokerStars Hand #1:  Omaha Pot Limit ($1/$2 USD) - 2021/01/01 12:00:00 ET\n", "unsupported game"},
+		{"unknown action", header + "A: dances\n" + summary, "unknown action for A: dances"},
+		{"missing summary", header + "A: folds\n", "missing summary"},
+		{"pot mismatch", header + "A: calls $1\nB: checks\n*** FLOP *** [2c 3d 4h]\n" + "*** SUMMARY ***\nTotal pot $5 | Rake $0\n", "total pot is 5"},
+		{"board mismatch", header + "A: calls $1\nB: checks\n*** FLOP *** [2c 3d 4h]\n" + summary + "Board [2c 3d 5h]\n", "does not match"},
+		{"duplicate card", header + "Dealt to A [2c 2c]\nA: calls $1\nB: checks\n" + summary, "duplicate"},
+		{"run it twice", header + "*** FIRST FLOP *** [2c 3d 4h]\n" + summary, "unsupported section"},
+	}
+	for _, tc := range testCases {
+		result := ParsePokerStars(tc.text)
+		if len(result.Errors) != 1 || !strings.Contains(result.Errors[0].Error(), tc.error) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.name, tc.error, result.Errors)
+		}
+	}
+
+	if result := ParsePokerStars("  \n"); len(result.Hands) != 0 || len(result.Errors) != 0 {
+		t.Errorf("Expected nothing from blank input, got %+v", result)
+	}
+	crlf := strings.ReplaceAll(header+"A: folds\nUncalled bet ($1) returned to B\nB collected $2 from pot\n*** SUMMARY ***\nTotal pot $2 | Rake $0\n", "\n", "\r\n")
+	if result := ParsePokerStars("\ufeff" + crlf); len(result.Hands) != 1 {
+		t.Errorf("Expected a hand with Windows line endings, got %v", result.Errors)
+	}
+}
+
+func TestPokerStarsTime(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"Hold'em No Limit ($1/$2 USD) - 2021/01/01 12:00:00 ET", "2021-01-01T17:00:00Z"},
+		{"Hold'em No Limit ($1/$2 USD) - 2021/07/01 12:00:00 ET", "2021-07-01T16:00:00Z"},
+		{"Hold'em No Limit (€1/€2 EUR) - 2021/07/01 18:00:00 CET [2021/07/01 12:00:00 ET]", "2021-07-01T16:00:00Z"},
+		{"Hold'em No Limit ($1/$2 USD) - 2021/07/01 12:00:00", "2021-07-01T12:00:00Z"},
+	}
+	for _, tt := range tests {
+		if got := pokerStarsTime(tt.header).UTC().Format(time.RFC3339); got != tt.want {
+			t.Errorf("pokerStarsTime(%q) = %s, want %s", tt.header, got, tt.want)
+		}
+	}
+	if !pokerStarsTime("Hold'em No Limit ($1/$2 USD)").IsZero() {
+		t.Errorf("Expected no time without a date")
+	}
+}
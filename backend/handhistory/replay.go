@@ -0,0 +1,186 @@
+package handhistory
+
+import (
+	"fmt"
+	"math"
+
+	"temperature-converter/game"
+	"temperature-converter/poker"
+)
+
+// replay rebuilds the actions of a hand from formats that only record decisions, such as PHH or the game
+// engine's events. It works out the amounts called, whether a bet is a raise, who went all-in and which
+// bets were uncalled, the way a site's text history shows them.
+type replay struct {
+	hand       *Hand
+	names      []string  // Players dealt in, by index
+	stacks     []float64 // Chips behind
+	bets       []float64 // Chips put in on the current street
+	folded     []bool
+	currentBet float64
+	street     game.Street
+}
+
+// newReplay starts a hand between players with the given stacks
+func newReplay(hand *Hand, names []string, stacks []float64) *replay {
+	return &replay{
+		hand:   hand,
+		names:  names,
+		stacks: append([]float64{}, stacks...),
+		bets:   make([]float64, len(names)),
+		folded: make([]bool, len(names)),
+	}
+}
+
+// pay moves chips from a player's stack, capped at what they have, and returns how many moved
+func (r *replay) pay(player int, amount float64) (float64, bool) {
+	if amount >= r.stacks[player]-amountEpsilon {
+		amount = r.stacks[player]
+	}
+	r.stacks[player] = roundAmount(r.stacks[player] - amount)
+	return amount, r.stacks[player] == 0
+}
+
+// post puts an ante or blind in; the live part of a blind counts toward the player's bet on the street
+func (r *replay) post(player int, actionType ActionType, amount float64) {
+	paid, allIn := r.pay(player, amount)
+	switch actionType {
+	case PostSmallBlind, PostBigBlind:
+		r.bets[player] = roundAmount(r.bets[player] + paid)
+	case PostBothBlinds:
+		r.bets[player] = roundAmount(r.bets[player] + math.Min(paid, r.hand.BigBlind))
+	}
+	r.currentBet = math.Max(r.currentBet, r.bets[player])
+	r.add(Action{Player: r.names[player], Type: actionType, Amount: paid, AllIn: allIn})
+}
+
+// fold folds a player's hand; a bet left uncalled is returned straight away
+func (r *replay) fold(player int) {
+	r.folded[player] = true
+	r.add(Action{Player: r.names[player], Type: Fold})
+	if r.live() == 1 {
+		r.returnUncalled()
+	}
+}
+
+// checkOrCall checks, or calls as much of the current bet as the player can
+func (r *replay) checkOrCall(player int) {
+	owed := roundAmount(r.currentBet - r.bets[player])
+	if owed <= 0 {
+		r.add(Action{Player: r.names[player], Type: Check})
+		return
+	}
+	paid, allIn := r.pay(player, owed)
+	r.bets[player] = roundAmount(r.bets[player] + paid)
+	r.add(Action{Player: r.names[player], Type: Call, Amount: paid, AllIn: allIn})
+}
+
+// betOrRaise makes the player's total bet on the street to; it is a bet when nobody has bet yet
+func (r *replay) betOrRaise(player int, to float64) error {
+	if to <= r.currentBet && to < roundAmount(r.bets[player]+r.stacks[player]) {
+		return fmt.Errorf("%s cannot raise to %s facing a bet of %s", r.names[player], formatAmount(to), formatAmount(r.currentBet))
+	}
+	if to > roundAmount(r.bets[player]+r.stacks[player])+amountEpsilon {
+		return fmt.Errorf("%s cannot bet %s with %s behind", r.names[player], formatAmount(to), formatAmount(r.stacks[player]))
+	}
+	actionType := Raise
+	if r.currentBet == 0 {
+		actionType = Bet
+	}
+	paid, allIn := r.pay(player, roundAmount(to-r.bets[player]))
+	r.bets[player] = roundAmount(r.bets[player] + paid)
+	r.currentBet = math.Max(r.currentBet, r.bets[player])
+	r.add(Action{Player: r.names[player], Type: actionType, Amount: r.bets[player], AllIn: allIn})
+	return nil
+}
+
+// deal ends the betting round and deals board cards, which starts the next street
+func (r *replay) deal(cards []poker.Card) error {
+	r.returnUncalled()
+	board := len(r.hand.Board) + len(cards)
+	switch {
+	case len(r.hand.Board) == 0 && board == 3:
+		r.street = game.Flop
+	case len(r.hand.Board) == 3 && board == 4:
+		r.street = game.Turn
+	case len(r.hand.Board) == 4 && board == 5:
+		r.street = game.River
+	default:
+		return fmt.Errorf("cannot deal %d board cards after %d", len(cards), len(r.hand.Board))
+	}
+	r.hand.Board = append(r.hand.Board, cards...)
+	for i := range r.bets {
+		r.bets[i] = 0
+	}
+	r.currentBet = 0
+	return nil
+}
+
+// show reveals a player's cards, or mucks them when cards is empty. Cards shown while two or more
+// players are left are the showdown.
+func (r *replay) show(player int, cards []poker.Card) {
+	r.returnUncalled()
+	if r.live() > 1 {
+		r.street = game.Showdown
+	}
+	if len(cards) == 0 {
+		r.add(Action{Player: r.names[player], Type: Muck})
+		return
+	}
+	r.add(Action{Player: r.names[player], Type: Show, Cards: cards})
+}
+
+// collect awards chips from a pot
+func (r *replay) collect(player int, amount float64) {
+	r.returnUncalled()
+	r.add(Action{Player: r.names[player], Type: Collect, Amount: amount})
+}
+
+// returnUncalled hands back the part of the largest bet on the street that nobody matched
+func (r *replay) returnUncalled() {
+	top, second := -1, 0.0
+	for i, bet := range r.bets {
+		switch {
+		case top < 0 || bet > r.bets[top]:
+			if top >= 0 {
+				second = r.bets[top]
+			}
+			top = i
+		case bet > second:
+			second = bet
+		}
+	}
+	if top < 0 || r.bets[top] <= second {
+		return
+	}
+	uncalled := roundAmount(r.bets[top] - second)
+	r.bets[top] = second
+	r.stacks[top] = roundAmount(r.stacks[top] + uncalled)
+	r.currentBet = second
+	r.add(Action{Player: r.names[top], Type: UncalledReturn, Amount: uncalled})
+}
+
+// live counts the players who have not folded
+func (r *replay) live() int {
+	live := 0
+	for _, folded := range r.folded {
+		if !folded {
+			live++
+		}
+	}
+	return live
+}
+
+// add appends an action on the current street
+func (r *replay) add(action Action) {
+	action.Street = r.street
+	r.hand.Actions = append(r.hand.Actions, action)
+}
+
+// amountEpsilon absorbs floating point error in chip arithmetic
+const amountEpsilon = 1e-9
+
+// roundAmount removes floating point error from chip arithmetic, keeping up to 6 decimals
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*1e6) / 1e6
+}
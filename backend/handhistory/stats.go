@@ -0,0 +1,363 @@
+package handhistory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"temperature-converter/game"
+)
+
+// Positions at the table, named the way solvers do: the last three seats before the button are the
+// cutoff, hijack and lojack, and the seats before them count from under the gun
+const (
+	PositionButton     = "BTN"
+	PositionSmallBlind = "SB"
+	PositionBigBlind   = "BB"
+	PositionCutoff     = "CO"
+	PositionHijack     = "HJ"
+	PositionLojack     = "LJ"
+	PositionUnderGun   = "UTG" // Followed by UTG+1, UTG+2, ... at full tables
+)
+
+// Positions returns the position of each player dealt into the hand. Heads-up, the button posts the
+// small blind and is named the button.
+func (h *Hand) Positions() map[string]string {
+	players := h.dealtIn()
+	positions := make(map[string]string)
+	if len(players) < 2 {
+		return positions
+	}
+	if len(players) == 2 {
+		positions[players[0].Player] = PositionBigBlind
+		positions[players[1].Player] = PositionButton
+		return positions
+	}
+
+	positions[players[0].Player] = PositionSmallBlind
+	positions[players[1].Player] = PositionBigBlind
+	positions[players[len(players)-1].Player] = PositionButton
+	middle := players[2 : len(players)-1]
+	late := []string{PositionLojack, PositionHijack, PositionCutoff}
+	early := len(middle) - len(late)
+	for i, seat := range middle {
+		switch {
+		case i >= early:
+			positions[seat.Player] = late[i-early]
+		case i == 0:
+			positions[seat.Player] = PositionUnderGun
+		default:
+			positions[seat.Player] = fmt.Sprintf("%s+%d", PositionUnderGun, i)
+		}
+	}
+	return positions
+}
+
+// positionOrder sorts positions in the order they act preflop, or returns -1 for an unknown position
+func positionOrder(position string) int {
+	switch position {
+	case PositionUnderGun:
+		return 0
+	case PositionLojack:
+		return 10
+	case PositionHijack:
+		return 11
+	case PositionCutoff:
+		return 12
+	case PositionButton:
+		return 13
+	case PositionSmallBlind:
+		return 14
+	case PositionBigBlind:
+		return 15
+	}
+	if rest, ok := strings.CutPrefix(position, PositionUnderGun+"+"); ok {
+		if n, err := strconv.Atoi(rest); err == nil && n >= 1 && n <= 6 {
+			return n
+		}
+	}
+	return -1
+}
+
+// Ratio counts how often a player did something out of the times they could have
+type Ratio struct {
+	Count         int
+	Opportunities int
+}
+
+// Percent returns the count as a percentage of the opportunities, or 0 without any
+func (r Ratio) Percent() float64 {
+	if r.Opportunities == 0 {
+		return 0
+	}
+	return 100 * float64(r.Count) / float64(r.Opportunities)
+}
+
+func (r *Ratio) add(other Ratio) {
+	r.Count += other.Count
+	r.Opportunities += other.Opportunities
+}
+
+// PlayerStats are the standard tracking statistics of a player over a set of hands
+type PlayerStats struct {
+	Player         string
+	Position       string // Empty for the stats over every position
+	Hands          int
+	VPIP           Ratio   // Put chips in the pot voluntarily preflop, of hands with a preflop decision
+	PFR            Ratio   // Raised preflop, of hands with a preflop decision
+	ThreeBet       Ratio   // Re-raised a single preflop raise, of the times facing one
+	FoldToThreeBet Ratio   // Folded after raising first preflop and being re-raised
+	CBet           Ratio   // Bet the flop as the last preflop raiser, of the times checked to or first to act
+	WTSD           Ratio   // Went to showdown, of hands that saw the flop
+	WonAtShowdown  Ratio   // Won chips at showdown (W$SD), of showdowns
+	Aggressive     int     // Bets and raises after the flop
+	Calls          int     // Calls after the flop
+	Net            float64 // Chips won
+	NetBigBlinds   float64 // Big blinds won
+	Positions      []PlayerStats
+}
+
+// AggressionFactor returns postflop bets and raises per call; with no calls, it is the number of bets and raises
+func (s PlayerStats) AggressionFactor() float64 {
+	if s.Calls == 0 {
+		return float64(s.Aggressive)
+	}
+	return float64(s.Aggressive) / float64(s.Calls)
+}
+
+// BigBlindsPer100 returns the big blinds won per 100 hands
+func (s PlayerStats) BigBlindsPer100() float64 {
+	if s.Hands == 0 {
+		return 0
+	}
+	return 100 * s.NetBigBlinds / float64(s.Hands)
+}
+
+func (s *PlayerStats) add(other PlayerStats) {
+	s.Hands += other.Hands
+	s.VPIP.add(other.VPIP)
+	s.PFR.add(other.PFR)
+	s.ThreeBet.add(other.ThreeBet)
+	s.FoldToThreeBet.add(other.FoldToThreeBet)
+	s.CBet.add(other.CBet)
+	s.WTSD.add(other.WTSD)
+	s.WonAtShowdown.add(other.WonAtShowdown)
+	s.Aggressive += other.Aggressive
+	s.Calls += other.Calls
+	s.Net = roundAmount(s.Net + other.Net)
+	s.NetBigBlinds = roundAmount(s.NetBigBlinds + other.NetBigBlinds)
+}
+
+// StatsFilter selects the hands statistics are computed over. Zero fields match every hand.
+type StatsFilter struct {
+	Player     string    // Only this player
+	From       time.Time // Hands started at or after this time
+	To         time.Time // Hands started before this time
+	SmallBlind float64   // Stakes, matched when BigBlind is set
+	BigBlind   float64
+	Position   string // Only hands played from this position, such as "BTN" or "UTG+1"
+}
+
+// matches reports whether a hand is within the filter's time range and stakes
+func (f StatsFilter) matches(hand *Hand) bool {
+	if !f.From.IsZero() && (hand.Time.IsZero() || hand.Time.Before(f.From)) {
+		return false
+	}
+	if !f.To.IsZero() && (hand.Time.IsZero() || !hand.Time.Before(f.To)) {
+		return false
+	}
+	if f.BigBlind > 0 && (hand.BigBlind != f.BigBlind || hand.SmallBlind != f.SmallBlind) {
+		return false
+	}
+	return true
+}
+
+// ParseStakes parses stakes written as small blind/big blind, such as "0.50/1" or "$25/$50"
+func ParseStakes(text string) (smallBlind, bigBlind float64, err error) {
+	parts := strings.Split(text, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid stakes %q, expected small blind/big blind", text)
+	}
+	var blinds [2]float64
+	for i, part := range parts {
+		part = strings.TrimLeft(strings.TrimSpace(part), "$€£")
+		blind, err := strconv.ParseFloat(part, 64)
+		if err != nil || blind < 0 {
+			return 0, 0, fmt.Errorf("invalid stakes %q, expected small blind/big blind", text)
+		}
+		blinds[i] = blind
+	}
+	if blinds[1] == 0 {
+		return 0, 0, fmt.Errorf("invalid stakes %q: the big blind must be positive", text)
+	}
+	return blinds[0], blinds[1], nil
+}
+
+// ComputeStats computes the statistics of every player dealt into the hands the filter selects, sorted by
+// player name. Each player's stats are broken down by position, in the order positions act preflop.
+func ComputeStats(hands []Hand, filter StatsFilter) ([]PlayerStats, error) {
+	if filter.Position != "" {
+		filter.Position = strings.ToUpper(filter.Position)
+		if positionOrder(filter.Position) < 0 {
+			return nil, fmt.Errorf("unknown position %q", filter.Position)
+		}
+	}
+
+	players := make(map[string]*PlayerStats)
+	positions := make(map[string]map[string]*PlayerStats)
+	for i := range hands {
+		hand := &hands[i]
+		if !filter.matches(hand) {
+			continue
+		}
+		for player, position := range hand.Positions() {
+			if filter.Player != "" && player != filter.Player || filter.Position != "" && position != filter.Position {
+				continue
+			}
+			stats := hand.playerStats(player)
+			if players[player] == nil {
+				players[player] = &PlayerStats{Player: player}
+				positions[player] = make(map[string]*PlayerStats)
+			}
+			players[player].add(stats)
+			if positions[player][position] == nil {
+				positions[player][position] = &PlayerStats{Player: player, Position: position}
+			}
+			positions[player][position].add(stats)
+		}
+	}
+
+	var result []PlayerStats
+	for player, stats := range players {
+		for _, position := range positions[player] {
+			stats.Positions = append(stats.Positions, *position)
+		}
+		sort.Slice(stats.Positions, func(a, b int) bool {
+			return positionOrder(stats.Positions[a].Position) < positionOrder(stats.Positions[b].Position)
+		})
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(a, b int) bool { return result[a].Player < result[b].Player })
+	return result, nil
+}
+
+// playerStats computes one player's statistics in this hand
+func (h *Hand) playerStats(player string) PlayerStats {
+	stats := PlayerStats{Player: player, Hands: 1, Net: roundAmount(h.Net(player))}
+	if h.BigBlind > 0 {
+		stats.NetBigBlinds = roundAmount(stats.Net / h.BigBlind)
+	}
+
+	// Preflop: raises counts the bets and raises so far, not the blinds
+	raises, lastRaiser := 0, ""
+	opened, decided, facedThreeBet, facedRaise := false, false, false, false
+	folded := make(map[string]bool)
+	for _, action := range h.Actions {
+		if action.Street != game.Preflop {
+			continue
+		}
+		switch action.Type {
+		case Fold, Check, Call, Bet, Raise:
+		default:
+			continue
+		}
+		aggressive := action.Type == Bet || action.Type == Raise
+		if action.Player == player {
+			if !decided {
+				decided = true
+				stats.VPIP.Opportunities, stats.PFR.Opportunities = 1, 1
+			}
+			if action.Type == Call || aggressive {
+				stats.VPIP.Count = 1
+			}
+			if aggressive {
+				stats.PFR.Count = 1
+			}
+			if raises == 1 && !facedRaise {
+				facedRaise = true
+				stats.ThreeBet.Opportunities = 1
+				if aggressive {
+					stats.ThreeBet.Count = 1
+				}
+			}
+			if opened && raises == 2 && !facedThreeBet {
+				facedThreeBet = true
+				stats.FoldToThreeBet.Opportunities = 1
+				if action.Type == Fold {
+					stats.FoldToThreeBet.Count = 1
+				}
+			}
+			if aggressive && raises == 0 {
+				opened = true
+			}
+		}
+		if action.Type == Fold {
+			folded[action.Player] = true
+		}
+		if aggressive {
+			raises++
+			lastRaiser = action.Player
+		}
+	}
+
+	sawFlop := len(h.Board) >= 3 && !folded[player]
+	if !sawFlop {
+		return stats
+	}
+
+	betOnFlop, flopDecided := false, false
+	for _, action := range h.Actions {
+		if action.Street < game.Flop || action.Street > game.River {
+			continue
+		}
+		if action.Type == Fold {
+			folded[action.Player] = true
+		}
+		if action.Player == player {
+			switch action.Type {
+			case Bet, Raise:
+				stats.Aggressive++
+			case Call:
+				stats.Calls++
+			}
+		}
+		if action.Street != game.Flop {
+			continue
+		}
+		switch action.Type {
+		case Fold, Check, Call, Bet, Raise:
+		default:
+			continue
+		}
+		if action.Player == player && lastRaiser == player && !flopDecided && !betOnFlop {
+			stats.CBet.Opportunities = 1
+			if action.Type == Bet {
+				stats.CBet.Count = 1
+			}
+		}
+		if action.Player == player {
+			flopDecided = true
+		}
+		if action.Type == Bet || action.Type == Raise {
+			betOnFlop = true
+		}
+	}
+
+	live := 0
+	for _, seat := range h.dealtIn() {
+		if !folded[seat.Player] {
+			live++
+		}
+	}
+	stats.WTSD.Opportunities = 1
+	if !folded[player] && live > 1 {
+		stats.WTSD.Count = 1
+		stats.WonAtShowdown.Opportunities = 1
+		if h.Collected(player) > 0 {
+			stats.WonAtShowdown.Count = 1
+		}
+	}
+	return stats
+}
@@ -0,0 +1,181 @@
+package handhistory
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPositions(t *testing.T) {
+	testCases := []struct {
+		players   int
+		positions []string // From seat 1, the button being the last seat
+	}{
+		{2, []string{"BB", "BTN"}},
+		{3, []string{"SB", "BB", "BTN"}},
+		{4, []string{"SB", "BB", "CO", "BTN"}},
+		{6, []string{"SB", "BB", "LJ", "HJ", "CO", "BTN"}},
+		{9, []string{"SB", "BB", "UTG", "UTG+1", "UTG+2", "LJ", "HJ", "CO", "BTN"}},
+	}
+	for _, tc := range testCases {
+		hand := Hand{Button: tc.players}
+		for i := 1; i <= tc.players; i++ {
+			hand.Seats = append(hand.Seats, Seat{Number: i, Player: string(rune('A' + i - 1))})
+		}
+		// A player sitting out has no position
+		hand.Seats = append(hand.Seats, Seat{Number: tc.players + 1, Player: "Out", SittingOut: true})
+
+		positions := hand.Positions()
+		if len(positions) != tc.players {
+			t.Errorf("%d players: expected a position each, got %v", tc.players, positions)
+		}
+		for i, position := range tc.positions {
+			if got := positions[string(rune('A'+i))]; got != position {
+				t.Errorf("%d players: expected seat %d in %s, got %s", tc.players, i+1, position, got)
+			}
+			if positionOrder(position) < 0 {
+				t.Errorf("Expected %s to be a known position", position)
+			}
+		}
+	}
+}
+
+// statsHands are three 1/2 hands between Ann (SB), Ben (BB) and Cat (BTN), a minute apart
+func statsHands(t *testing.T) []Hand {
+	threeBet := luckHand(t, "10", "100, 100, 100", "108, 98, 94",
+		"p3 cbr 6", "p1 cbr 18", "p2 f", "p3 f")
+	limped := flopAllIn(t)
+	cBet := luckHand(t, "11", "100, 100, 100", "99, 94, 107",
+		"p3 cbr 6", "p1 f", "p2 cc", "d db 2c7d9c", "p2 cc", "p3 cbr 8", "p2 f")
+
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	hands := []Hand{threeBet, limped, cBet}
+	for i := range hands {
+		hands[i].Time = start.Add(time.Duration(i) * time.Minute)
+	}
+	return hands
+}
+
+func TestComputeStats(t *testing.T) {
+	stats, err := ComputeStats(statsHands(t), StatsFilter{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(stats) != 3 || stats[0].Player != "Ann" || stats[1].Player != "Ben" || stats[2].Player != "Cat" {
+		t.Fatalf("Expected stats for Ann, Ben and Cat, got %+v", stats)
+	}
+	ann, ben, cat := stats[0], stats[1], stats[2]
+
+	testCases := []struct {
+		name     string
+		got      Ratio
+		expected Ratio
+	}{
+		// Ann 3-bets, limps and folds to a raise
+		{"Ann VPIP", ann.VPIP, Ratio{2, 3}},
+		{"Ann PFR", ann.PFR, Ratio{1, 3}},
+		{"Ann 3-bet", ann.ThreeBet, Ratio{1, 2}},
+		{"Ann WTSD", ann.WTSD, Ratio{1, 1}},
+		{"Ann W$SD", ann.WonAtShowdown, Ratio{1, 1}},
+		// Ben faces a 3-bet without raising, checks his option and calls a raise
+		{"Ben VPIP", ben.VPIP, Ratio{1, 3}},
+		{"Ben 3-bet", ben.ThreeBet, Ratio{0, 1}},
+		{"Ben WTSD", ben.WTSD, Ratio{1, 2}},
+		{"Ben W$SD", ben.WonAtShowdown, Ratio{0, 1}},
+		// Cat opens twice, folds to the 3-bet and c-bets the other
+		{"Cat PFR", cat.PFR, Ratio{2, 3}},
+		{"Cat fold to 3-bet", cat.FoldToThreeBet, Ratio{1, 1}},
+		{"Cat c-bet", cat.CBet, Ratio{1, 1}},
+		{"Cat WTSD", cat.WTSD, Ratio{0, 1}},
+	}
+	for _, tc := range testCases {
+		if tc.got != tc.expected {
+			t.Errorf("%s: expected %+v, got %+v", tc.name, tc.expected, tc.got)
+		}
+	}
+
+	// Ann bets the flop all-in and Ben calls it; Cat's c-bet is his only postflop action
+	if ann.Aggressive != 1 || ann.Calls != 0 || ann.AggressionFactor() != 1 {
+		t.Errorf("Unexpected aggression for Ann: %d/%d", ann.Aggressive, ann.Calls)
+	}
+	if ben.Aggressive != 0 || ben.Calls != 1 || ben.AggressionFactor() != 0 {
+		t.Errorf("Unexpected aggression for Ben: %d/%d", ben.Aggressive, ben.Calls)
+	}
+	if ann.Net != 107 || ann.NetBigBlinds != 53.5 || math.Abs(ann.BigBlindsPer100()-53.5*100/3) > 1e-9 {
+		t.Errorf("Unexpected winnings for Ann: %v chips, %v bb/100", ann.Net, ann.BigBlindsPer100())
+	}
+	if cat.Net != 1 || math.Abs(cat.BigBlindsPer100()-0.5*100/3) > 1e-9 {
+		t.Errorf("Unexpected winnings for Cat: %v chips, %v bb/100", cat.Net, cat.BigBlindsPer100())
+	}
+
+	// Everyone kept their position, so each has a single breakdown equal to the total
+	for _, player := range stats {
+		if len(player.Positions) != 1 {
+			t.Fatalf("Expected one position for %s, got %+v", player.Player, player.Positions)
+		}
+		position := player.Positions[0]
+		player.Positions, position.Position = nil, ""
+		if !reflect.DeepEqual(position, player) {
+			t.Errorf("Expected %s's position stats to equal the total:\n%+v\n%+v", player.Player, position, player)
+		}
+	}
+}
+
+func TestComputeStatsFilters(t *testing.T) {
+	hands := statsHands(t)
+	start := hands[0].Time
+	hands[2].SmallBlind, hands[2].BigBlind = 2, 4
+
+	testCases := []struct {
+		name   string
+		filter StatsFilter
+		hands  map[string]int
+	}{
+		{"Player", StatsFilter{Player: "Ben"}, map[string]int{"Ben": 3}},
+		{"From", StatsFilter{From: start.Add(time.Minute)}, map[string]int{"Ann": 2, "Ben": 2, "Cat": 2}},
+		{"To", StatsFilter{To: start.Add(time.Minute)}, map[string]int{"Ann": 1, "Ben": 1, "Cat": 1}},
+		{"Stakes", StatsFilter{SmallBlind: 2, BigBlind: 4}, map[string]int{"Ann": 1, "Ben": 1, "Cat": 1}},
+		{"Position", StatsFilter{Position: "btn"}, map[string]int{"Cat": 3}},
+		{"Nothing", StatsFilter{Position: "UTG"}, map[string]int{}},
+	}
+	for _, tc := range testCases {
+		stats, err := ComputeStats(hands, tc.filter)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		got := make(map[string]int)
+		for _, player := range stats {
+			got[player.Player] = player.Hands
+		}
+		if !reflect.DeepEqual(got, tc.hands) {
+			t.Errorf("%s: expected hands %v, got %v", tc.name, tc.hands, got)
+		}
+	}
+
+	if _, err := ComputeStats(hands, StatsFilter{Position: "MP"}); err == nil {
+		t.Error("Expected error for an unknown position")
+	}
+}
+
+func TestParseStakes(t *testing.T) {
+	testCases := []struct {
+		text       string
+		smallBlind float64
+		bigBlind   float64
+		valid      bool
+	}{
+		{"0.50/1", 0.5, 1, true},
+		{"$25/$50", 25, 50, true},
+		{" 1 / 2 ", 1, 2, true},
+		{"1/0", 0, 0, false},
+		{"2", 0, 0, false},
+		{"a/b", 0, 0, false},
+	}
+	for _, tc := range testCases {
+		smallBlind, bigBlind, err := ParseStakes(tc.text)
+		if (err == nil) != tc.valid || smallBlind != tc.smallBlind || bigBlind != tc.bigBlind {
+			t.Errorf("ParseStakes(%q) = %v, %v, %v", tc.text, smallBlind, bigBlind, err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package handhistory
+
+import "sync"
+
+// Store keeps imported hands in memory. It is safe for concurrent use.
+type Store struct {
+	mu    sync.RWMutex
+	hands []Hand
+	keys  map[string]bool
+}
+
+// NewStore creates an empty store
+func NewStore() *Store {
+	return &Store{keys: make(map[string]bool)}
+}
+
+// Add stores hands that are not stored yet, identified by their keys, and returns how many were new
+func (s *Store) Add(hands ...Hand) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	added := 0
+	for _, hand := range hands {
+		key := hand.Key()
+		if s.keys[key] {
+			continue
+		}
+		s.keys[key] = true
+		s.hands = append(s.hands, hand)
+		added++
+	}
+	return added
+}
+
+// Hands returns every stored hand in the order it was imported
+func (s *Store) Hands() []Hand {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]Hand{}, s.hands...)
+}
+
+// Find returns the stored hands with the given keys, in the order asked for, and the keys of hands that are
+// not stored
+func (s *Store) Find(keys ...string) (hands []Hand, missing []string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, key := range keys {
+		found := false
+		for _, hand := range s.hands {
+			if hand.Key() == key {
+				hands = append(hands, hand)
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, key)
+		}
+	}
+	return hands, missing
+}
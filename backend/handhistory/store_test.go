@@ -0,0 +1,32 @@
+package handhistory
+
+import "testing"
+
+func TestStore(t *testing.T) {
+	store := NewStore()
+	hands := ParsePokerStars(readTestData(t, "pokerstars.txt")).Hands
+
+	if added := store.Add(hands...); added != 3 {
+		t.Errorf("Expected 3 new hands, got %d", added)
+	}
+	if added := store.Add(hands[0]); added != 0 {
+		t.Errorf("Expected the duplicate to be skipped, got %d new", added)
+	}
+	stored := store.Hands()
+	if len(stored) != 3 || stored[0].ID != hands[0].ID {
+		t.Errorf("Expected the 3 hands in import order, got %d", len(stored))
+	}
+}
+
+func TestStoreFind(t *testing.T) {
+	store := NewStore()
+	store.Add(ParsePokerStars(readTestData(t, "pokerstars.txt")).Hands...)
+
+	hands, missing := store.Find("PokerStars#219876543213", "PokerStars#1", "PokerStars#219876543210", "219876543211")
+	if len(hands) != 2 || hands[0].ID != "219876543213" || hands[1].ID != "219876543210" {
+		t.Errorf("Expected the 2 stored hands in the order asked for, got %d", len(hands))
+	}
+	if len(missing) != 2 || missing[0] != "PokerStars#1" || missing[1] != "219876543211" {
+		t.Errorf("Expected hand 1 and the hand number without its site to be missing, got %v", missing)
+	}
+}
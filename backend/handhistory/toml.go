@@ -0,0 +1,389 @@
+package handhistory
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// tomlTable is a table of TOML key/value pairs. Values are string, int64, float64, bool or []any.
+type tomlTable struct {
+	Name   string         // Table header, empty for the root table
+	Line   int            // Line of the table header, or 1 for the root table
+	Values map[string]any // Values by key
+	Lines  map[string]int // Line each key was defined on
+}
+
+// tomlDocument is a parsed TOML document: the root table followed by the tables defined with [headers], in order
+type tomlDocument struct {
+	Root   tomlTable
+	Tables []tomlTable
+}
+
+// parseTOML parses the subset of TOML used by hand history files: comments, bare and quoted keys, single-level
+// [table] headers, and strings, integers, floats, booleans and (multi-line) arrays of them
+func parseTOML(text string) (tomlDocument, error) {
+	p := &tomlParser{text: strings.ReplaceAll(text, "\r\n", "\n"), line: 1}
+	doc := tomlDocument{Root: newTOMLTable("", 1)}
+	current := &doc.Root
+	names := make(map[string]bool)
+
+	for {
+		p.skipBlank()
+		if p.done() {
+			return doc, nil
+		}
+		if p.peek() == '[' {
+			line := p.line
+			p.pos++
+			name, err := p.parseKey()
+			if err != nil {
+				return doc, err
+			}
+			p.skipSpaces()
+			if p.peek() != ']' {
+				return doc, p.errorf("expected ] after table name")
+			}
+			p.pos++
+			if names[name] {
+				return doc, p.errorf("table [%s] is defined twice", name)
+			}
+			names[name] = true
+			doc.Tables = append(doc.Tables, newTOMLTable(name, line))
+			current = &doc.Tables[len(doc.Tables)-1]
+		} else {
+			line := p.line
+			key, err := p.parseKey()
+			if err != nil {
+				return doc, err
+			}
+			p.skipSpaces()
+			if p.peek() != '=' {
+				return doc, p.errorf("expected = after key %q", key)
+			}
+			p.pos++
+			p.skipSpaces()
+			value, err := p.parseValue()
+			if err != nil {
+				return doc, err
+			}
+			if _, ok := current.Values[key]; ok {
+				return doc, p.errorf("key %q is defined twice", key)
+			}
+			current.Values[key] = value
+			current.Lines[key] = line
+		}
+		if err := p.endLine(); err != nil {
+			return doc, err
+		}
+	}
+}
+
+// newTOMLTable creates an empty table
+func newTOMLTable(name string, line int) tomlTable {
+	return tomlTable{Name: name, Line: line, Values: make(map[string]any), Lines: make(map[string]int)}
+}
+
+// tomlParser reads TOML text one character at a time, tracking the line for errors
+type tomlParser struct {
+	text string
+	pos  int
+	line int
+}
+
+func (p *tomlParser) done() bool {
+	return p.pos >= len(p.text)
+}
+
+func (p *tomlParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+func (p *tomlParser) errorf(format string, args ...any) error {
+	return &tomlError{Line: p.line, Message: fmt.Sprintf(format, args...)}
+}
+
+// tomlError is a syntax error with the line it is on
+type tomlError struct {
+	Line    int
+	Message string
+}
+
+func (e *tomlError) Error() string {
+	return "invalid TOML: " + e.Message
+}
+
+// skipSpaces skips spaces and tabs
+func (p *tomlParser) skipSpaces() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.pos++
+	}
+}
+
+// skipComment skips a comment up to the end of the line
+func (p *tomlParser) skipComment() {
+	if p.peek() != '#' {
+		return
+	}
+	for !p.done() && p.peek() != '\n' {
+		p.pos++
+	}
+}
+
+// skipBlank skips whitespace, newlines and comments
+func (p *tomlParser) skipBlank() {
+	for {
+		p.skipSpaces()
+		p.skipComment()
+		if p.peek() != '\n' {
+			return
+		}
+		p.pos++
+		p.line++
+	}
+}
+
+// endLine makes sure nothing but a comment follows a key/value pair or table header
+func (p *tomlParser) endLine() error {
+	p.skipSpaces()
+	p.skipComment()
+	if !p.done() && p.peek() != '\n' {
+		return p.errorf("unexpected %q", p.peek())
+	}
+	return nil
+}
+
+// parseKey reads a bare key or a quoted key
+func (p *tomlParser) parseKey() (string, error) {
+	p.skipSpaces()
+	if p.peek() == '"' || p.peek() == '\'' {
+		return p.parseString()
+	}
+	start := p.pos
+	for !p.done() {
+		c := p.peek()
+		if !(c == '_' || c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			break
+		}
+		p.pos++
+	}
+	if start == p.pos {
+		return "", p.errorf("expected a key")
+	}
+	return p.text[start:p.pos], nil
+}
+
+// parseValue reads a string, number, boolean or array
+func (p *tomlParser) parseValue() (any, error) {
+	switch c := p.peek(); {
+	case c == '"' || c == '\'':
+		return p.parseString()
+	case c == '[':
+		return p.parseArray()
+	case c == 't' && strings.HasPrefix(p.text[p.pos:], "true"):
+		p.pos += len("true")
+		return true, nil
+	case c == 'f' && strings.HasPrefix(p.text[p.pos:], "false"):
+		p.pos += len("false")
+		return false, nil
+	}
+
+	start := p.pos
+	for !p.done() && strings.IndexByte("+-0123456789._eE", p.peek()) >= 0 {
+		p.pos++
+	}
+	token := strings.ReplaceAll(p.text[start:p.pos], "_", "")
+	if token == "" {
+		return nil, p.errorf("expected a value")
+	}
+	if value, err := strconv.ParseInt(token, 10, 64); err == nil {
+		return value, nil
+	}
+	value, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return nil, p.errorf("invalid number %q", token)
+	}
+	return value, nil
+}
+
+// parseArray reads an array, which may span lines and contain comments and a trailing comma
+func (p *tomlParser) parseArray() ([]any, error) {
+	p.pos++
+	values := []any{}
+	for {
+		p.skipBlank()
+		if p.done() {
+			return nil, p.errorf("unterminated array")
+		}
+		if p.peek() == ']' {
+			p.pos++
+			return values, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		p.skipBlank()
+		if p.done() {
+			return nil, p.errorf("unterminated array")
+		}
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+		default:
+			return nil, p.errorf("expected , or ] in array")
+		}
+	}
+}
+
+// parseString reads a basic "string" with escapes or a literal 'string'
+func (p *tomlParser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.done() || p.peek() == '\n' {
+			return "", p.errorf("unterminated string")
+		}
+		c := p.peek()
+		p.pos++
+		if c == quote {
+			return b.String(), nil
+		}
+		if c != '\\' || quote == '\'' {
+			b.WriteByte(c)
+			continue
+		}
+
+		escape := p.peek()
+		p.pos++
+		switch escape {
+		case '"', '\\':
+			b.WriteByte(escape)
+		case 'b':
+			b.WriteByte('\b')
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'f':
+			b.WriteByte('\f')
+		case 'r':
+			b.WriteByte('\r')
+		case 'u', 'U':
+			size := 4
+			if escape == 'U' {
+				size = 8
+			}
+			if p.pos+size > len(p.text) {
+				return "", p.errorf("invalid unicode escape")
+			}
+			code, err := strconv.ParseUint(p.text[p.pos:p.pos+size], 16, 32)
+			if err != nil {
+				return "", p.errorf("invalid unicode escape")
+			}
+			b.WriteRune(rune(code))
+			p.pos += size
+		default:
+			return "", p.errorf("invalid escape \\%c", escape)
+		}
+	}
+}
+
+// tomlWriter writes key/value pairs in the order they are added
+type tomlWriter struct {
+	b strings.Builder
+}
+
+// header starts a [table]
+func (w *tomlWriter) header(name string) {
+	if w.b.Len() > 0 {
+		w.b.WriteString("\n")
+	}
+	fmt.Fprintf(&w.b, "[%s]\n", name)
+}
+
+// value writes key = value on one line
+func (w *tomlWriter) value(key string, value any) {
+	fmt.Fprintf(&w.b, "%s = %s\n", key, tomlValue(value))
+}
+
+// list writes an array with one element per line, which keeps long action lists readable
+func (w *tomlWriter) list(key string, values []string) {
+	fmt.Fprintf(&w.b, "%s = [\n", key)
+	for _, value := range values {
+		fmt.Fprintf(&w.b, "  %s,\n", tomlValue(value))
+	}
+	w.b.WriteString("]\n")
+}
+
+func (w *tomlWriter) String() string {
+	return w.b.String()
+}
+
+// tomlValue formats a value. Whole amounts are written as integers, the way hand histories usually show chips.
+func tomlValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return tomlString(v)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []float64:
+		texts := make([]string, len(v))
+		for i, amount := range v {
+			texts[i] = tomlValue(amount)
+		}
+		return "[" + strings.Join(texts, ", ") + "]"
+	case []int:
+		texts := make([]string, len(v))
+		for i, number := range v {
+			texts[i] = strconv.Itoa(number)
+		}
+		return "[" + strings.Join(texts, ", ") + "]"
+	case []string:
+		texts := make([]string, len(v))
+		for i, text := range v {
+			texts[i] = tomlString(text)
+		}
+		return "[" + strings.Join(texts, ", ") + "]"
+	}
+	panic(fmt.Sprintf("unsupported TOML value %T", value))
+}
+
+// tomlString quotes a basic string, escaping quotes, backslashes and control characters
+func tomlString(text string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range text {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,291 @@
+package icm
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"temperature-converter/poker"
+)
+
+// DefaultSimulations is the number of deals simulated for an all-in scenario
+const DefaultSimulations = 10000
+
+// AllInPlayer is a player who is all-in against the hero, with known cards or a range
+type AllInPlayer struct {
+	Player int          // Index into the stacks
+	Cards  []poker.Card // Known hole cards, or empty to deal from Range
+	Range  [][]poker.Card
+}
+
+// AllInScenario describes the hero deciding whether to call (or shove into) one or more all-in players.
+// Stacks are the stacks at the start of the hand; Posted holds the chips each player has already put in
+// (blinds, antes), which stay in the pot if they fold. Every all-in player and the hero, if calling,
+// commit their whole stack.
+type AllInScenario struct {
+	Stacks         []float64
+	Payouts        []float64
+	Posted         []float64
+	Hero           int
+	HeroCards      []poker.Card
+	Opponents      []AllInPlayer
+	CommunityCards []poker.Card
+	Simulations    int
+	Iterations     int // Monte Carlo ICM iterations for fields larger than MaxExactPlayers
+}
+
+// AllInResult compares the hero's prize equity after calling and after folding
+type AllInResult struct {
+	HeroEquity   float64   // Hero's expected share of the pot when calling
+	CallEquities []float64 // Every player's prize equity if the hero calls
+	FoldEquities []float64 // Every player's prize equity if the hero folds
+	CallEV       float64   // Hero's prize equity if calling
+	FoldEV       float64   // Hero's prize equity if folding
+	Call         bool      // Calling has the higher prize equity
+}
+
+// EvaluateAllIn simulates the all-in with the poker evaluator and converts every outcome
+// to prize equity with the Independent Chip Model
+func EvaluateAllIn(scenario AllInScenario) (AllInResult, error) {
+	if err := validateScenario(scenario); err != nil {
+		return AllInResult{}, err
+	}
+	simulations := scenario.Simulations
+	if simulations <= 0 {
+		simulations = DefaultSimulations
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	cache := make(map[string][]float64)
+	equityOf := func(stacks []float64) ([]float64, error) {
+		key := fmt.Sprint(stacks)
+		if equities, ok := cache[key]; ok {
+			return equities, nil
+		}
+		equities, _, err := Equity(stacks, scenario.Payouts, scenario.Iterations)
+		if err != nil {
+			return nil, err
+		}
+		cache[key] = equities
+		return equities, nil
+	}
+
+	n := len(scenario.Stacks)
+	result := AllInResult{
+		CallEquities: make([]float64, n),
+		FoldEquities: make([]float64, n),
+	}
+
+	callers := []AllInPlayer{{Player: scenario.Hero, Cards: scenario.HeroCards}}
+	callers = append(callers, scenario.Opponents...)
+	foldSimulations := simulations
+	if len(scenario.Opponents) == 1 {
+		// A lone all-in player takes the pot uncontested when the hero folds
+		foldSimulations = 1
+	}
+
+	for _, outcome := range []struct {
+		players     []AllInPlayer
+		equities    []float64
+		simulations int
+	}{
+		{callers, result.CallEquities, simulations},
+		{scenario.Opponents, result.FoldEquities, foldSimulations},
+	} {
+		for sim := 0; sim < outcome.simulations; sim++ {
+			stacks, heroWon, err := playOut(scenario, outcome.players, r)
+			if err != nil {
+				return AllInResult{}, err
+			}
+			if outcome.players[0].Player == scenario.Hero {
+				result.HeroEquity += heroWon / float64(simulations)
+			}
+			equities, err := equityOf(stacks)
+			if err != nil {
+				return AllInResult{}, err
+			}
+			for i, equity := range equities {
+				outcome.equities[i] += equity / float64(outcome.simulations)
+			}
+		}
+	}
+
+	result.HeroEquity /= potSize(scenario, callers)
+	result.CallEV = result.CallEquities[scenario.Hero]
+	result.FoldEV = result.FoldEquities[scenario.Hero]
+	result.Call = result.CallEV > result.FoldEV
+	return result, nil
+}
+
+// validateScenario checks the scenario's indexes, cards and amounts
+func validateScenario(scenario AllInScenario) error {
+	if err := validate(scenario.Stacks, scenario.Payouts); err != nil {
+		return err
+	}
+	n := len(scenario.Stacks)
+	if len(scenario.Posted) != 0 && len(scenario.Posted) != n {
+		return fmt.Errorf("posted amounts must match the number of stacks")
+	}
+	for i, posted := range scenario.Posted {
+		if posted < 0 || posted > scenario.Stacks[i] {
+			return fmt.Errorf("invalid posted amount for player %d: %v", i+1, posted)
+		}
+	}
+	if scenario.Hero < 0 || scenario.Hero >= n {
+		return fmt.Errorf("hero must be a player index between 0 and %d", n-1)
+	}
+	if len(scenario.HeroCards) != 2 {
+		return fmt.Errorf("hero must have exactly 2 hole cards")
+	}
+	if len(scenario.Opponents) == 0 {
+		return fmt.Errorf("must provide at least 1 all-in opponent")
+	}
+	if len(scenario.CommunityCards) != 0 && len(scenario.CommunityCards) != 3 &&
+		len(scenario.CommunityCards) != 4 && len(scenario.CommunityCards) != 5 {
+		return fmt.Errorf("must provide 0, 3, 4, or 5 community cards")
+	}
+
+	knownCards := append([]poker.Card{}, scenario.HeroCards...)
+	knownCards = append(knownCards, scenario.CommunityCards...)
+	seen := map[int]bool{scenario.Hero: true}
+	for _, opponent := range scenario.Opponents {
+		if opponent.Player < 0 || opponent.Player >= n {
+			return fmt.Errorf("opponent must be a player index between 0 and %d", n-1)
+		}
+		if seen[opponent.Player] {
+			return fmt.Errorf("player %d appears more than once", opponent.Player)
+		}
+		seen[opponent.Player] = true
+		if scenario.Stacks[opponent.Player] == 0 {
+			return fmt.Errorf("player %d has no chips to go all-in with", opponent.Player)
+		}
+		switch {
+		case len(opponent.Cards) == 2:
+			knownCards = append(knownCards, opponent.Cards...)
+		case len(opponent.Cards) != 0:
+			return fmt.Errorf("player %d must have exactly 2 hole cards", opponent.Player)
+		case len(opponent.Range) == 0:
+			return fmt.Errorf("player %d needs hole cards or a range", opponent.Player)
+		}
+	}
+	return poker.CheckDuplicateCards(knownCards)
+}
+
+// playOut deals one runout between the all-in players and returns the stacks afterwards,
+// along with the chips the first player won
+func playOut(scenario AllInScenario, players []AllInPlayer, r *rand.Rand) ([]float64, float64, error) {
+	n := len(scenario.Stacks)
+	contributions := make([]float64, n)
+	folded := make([]bool, n)
+	for i := range folded {
+		folded[i] = true
+	}
+	for _, player := range players {
+		folded[player.Player] = false
+	}
+	for i := range contributions {
+		if !folded[i] {
+			contributions[i] = scenario.Stacks[i]
+		} else if len(scenario.Posted) > 0 {
+			contributions[i] = scenario.Posted[i]
+		}
+	}
+
+	stacks := make([]float64, n)
+	for i := range stacks {
+		stacks[i] = scenario.Stacks[i] - contributions[i]
+	}
+
+	// Rank the players' hands, unless one player is left and takes the pot
+	ranks := make(map[int]int, len(players))
+	if len(players) > 1 {
+		hands, err := dealHands(scenario, players, r)
+		if err != nil {
+			return nil, 0, err
+		}
+		for i, player := range players {
+			ranks[player.Player] = hands[i].Rank
+		}
+	} else {
+		ranks[players[0].Player] = 1
+	}
+
+	first := players[0].Player
+	firstWon := 0.0
+	for _, pot := range poker.SplitPots(contributions, folded) {
+		best := 0
+		var winners []int
+		for _, player := range pot.Eligible {
+			switch {
+			case best == 0 || ranks[player] < best:
+				best = ranks[player]
+				winners = []int{player}
+			case ranks[player] == best:
+				winners = append(winners, player)
+			}
+		}
+		share := pot.Amount / float64(len(winners))
+		for _, winner := range winners {
+			stacks[winner] += share
+			if winner == first {
+				firstWon += share
+			}
+		}
+	}
+	return stacks, firstWon, nil
+}
+
+// dealHands deals range-based hole cards and completes the board, then evaluates every player's hand
+func dealHands(scenario AllInScenario, players []AllInPlayer, r *rand.Rand) ([]poker.Hand, error) {
+	used := append([]poker.Card{}, scenario.CommunityCards...)
+	for _, player := range players {
+		used = append(used, player.Cards...)
+	}
+	// The hero's cards are dead even when the hero folds
+	if players[0].Player != scenario.Hero {
+		used = append(used, scenario.HeroCards...)
+	}
+
+	holeCards := make([][]poker.Card, len(players))
+	for i, player := range players {
+		if len(player.Cards) == 2 {
+			holeCards[i] = player.Cards
+			continue
+		}
+		live := poker.FilterCombos(player.Range, used)
+		if len(live) == 0 {
+			return nil, fmt.Errorf("range of player %d is blocked by the other cards", player.Player)
+		}
+		holeCards[i] = live[r.Intn(len(live))]
+		used = append(used, holeCards[i]...)
+	}
+
+	deck := poker.RemoveCards(poker.GetDeck(), used)
+	board := append([]poker.Card{}, scenario.CommunityCards...)
+	for _, k := range r.Perm(len(deck))[:5-len(board)] {
+		board = append(board, deck[k])
+	}
+
+	hands := make([]poker.Hand, len(players))
+	for i := range players {
+		hands[i] = poker.EvaluateBestHand(holeCards[i], board)
+	}
+	return hands, nil
+}
+
+// potSize returns the chips in play when the given players are all-in
+func potSize(scenario AllInScenario, players []AllInPlayer) float64 {
+	inHand := make(map[int]bool, len(players))
+	for _, player := range players {
+		inHand[player.Player] = true
+	}
+	size := 0.0
+	for i, stack := range scenario.Stacks {
+		if inHand[i] {
+			size += stack
+		} else if len(scenario.Posted) > 0 {
+			size += scenario.Posted[i]
+		}
+	}
+	return size
+}
@@ -0,0 +1,168 @@
+// Package icm implements the Independent Chip Model (Malmuth-Harville) for tournament prize equity
+package icm
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// MaxExactPlayers is the largest field solved exactly; larger fields use Monte Carlo
+const MaxExactPlayers = 10
+
+// DefaultIterations is the number of Monte Carlo finishing orders sampled for large fields
+const DefaultIterations = 100000
+
+// Equity returns each player's prize equity for the given stacks and payouts (1st place first).
+// Fields of up to MaxExactPlayers are solved exactly; larger fields sample iterations finishing orders.
+// The second result reports whether the exact model was used.
+func Equity(stacks []float64, payouts []float64, iterations int) ([]float64, bool, error) {
+	if err := validate(stacks, payouts); err != nil {
+		return nil, false, err
+	}
+	if len(stacks) <= MaxExactPlayers {
+		return ExactEquity(stacks, payouts), true, nil
+	}
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return MonteCarloEquity(stacks, payouts, iterations, r), false, nil
+}
+
+// validate checks that there are stacks and payouts and that every value is usable
+func validate(stacks []float64, payouts []float64) error {
+	if len(stacks) < 2 {
+		return fmt.Errorf("must provide at least 2 stacks")
+	}
+	if len(payouts) == 0 {
+		return fmt.Errorf("must provide at least 1 payout")
+	}
+	for i, stack := range stacks {
+		if stack < 0 || math.IsNaN(stack) || math.IsInf(stack, 0) {
+			return fmt.Errorf("invalid stack for player %d: %v", i+1, stack)
+		}
+	}
+	for i, payout := range payouts {
+		if payout < 0 || math.IsNaN(payout) || math.IsInf(payout, 0) {
+			return fmt.Errorf("invalid payout for place %d: %v", i+1, payout)
+		}
+	}
+	if total(stacks) == 0 {
+		return fmt.Errorf("at least one stack must be positive")
+	}
+	return nil
+}
+
+// ExactEquity computes Malmuth-Harville prize equity: each player finishes in the highest remaining
+// place with probability proportional to their stack among the players still unplaced.
+// Players with an empty stack finish behind everyone else and share the remaining places evenly.
+func ExactEquity(stacks []float64, payouts []float64) []float64 {
+	n := len(stacks)
+	equities := make([]float64, n)
+
+	// Busted players take the last places, so only live players compete for the payouts
+	var live, busted []int
+	for i, stack := range stacks {
+		if stack > 0 {
+			live = append(live, i)
+		} else {
+			busted = append(busted, i)
+		}
+	}
+	places := padPayouts(payouts, n)
+	for _, player := range busted {
+		for _, payout := range places[len(live):] {
+			equities[player] += payout / float64(len(busted))
+		}
+	}
+
+	// memo[mask] holds the equity of the live players in mask for the places after those already taken
+	memo := make(map[uint32][]float64)
+	var solve func(mask uint32) []float64
+	solve = func(mask uint32) []float64 {
+		if result, ok := memo[mask]; ok {
+			return result
+		}
+		result := make([]float64, len(live))
+		place := len(live) - bits.OnesCount32(mask)
+		if place >= len(payouts) || mask == 0 {
+			memo[mask] = result
+			return result
+		}
+
+		remaining := 0.0
+		for i := range live {
+			if mask&(1<<i) != 0 {
+				remaining += stacks[live[i]]
+			}
+		}
+		for i := range live {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			probability := stacks[live[i]] / remaining
+			result[i] += probability * places[place]
+			for j, equity := range solve(mask &^ (1 << i)) {
+				result[j] += probability * equity
+			}
+		}
+		memo[mask] = result
+		return result
+	}
+
+	for i, equity := range solve(1<<len(live) - 1) {
+		equities[live[i]] += equity
+	}
+	return equities
+}
+
+// MonteCarloEquity estimates Malmuth-Harville prize equity by sampling finishing orders.
+// Sorting players by an exponential variate with rate equal to their stack draws an order
+// with exactly the Malmuth-Harville probabilities.
+func MonteCarloEquity(stacks []float64, payouts []float64, iterations int, r *rand.Rand) []float64 {
+	n := len(stacks)
+	places := padPayouts(payouts, n)
+	equities := make([]float64, n)
+	order := make([]int, n)
+	keys := make([]float64, n)
+
+	for it := 0; it < iterations; it++ {
+		for i, stack := range stacks {
+			order[i] = i
+			if stack > 0 {
+				keys[i] = r.ExpFloat64() / stack
+			} else {
+				keys[i] = math.Inf(1)
+			}
+		}
+		sort.Slice(order, func(a, b int) bool { return keys[order[a]] < keys[order[b]] })
+		for place, player := range order {
+			equities[player] += places[place]
+		}
+	}
+
+	for i := range equities {
+		equities[i] /= float64(iterations)
+	}
+	return equities
+}
+
+// padPayouts returns payouts extended with zeroes (or truncated) to n places
+func padPayouts(payouts []float64, n int) []float64 {
+	places := make([]float64, n)
+	copy(places, payouts)
+	return places
+}
+
+// total sums values
+func total(values []float64) float64 {
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	return sum
+}
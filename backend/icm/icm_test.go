@@ -0,0 +1,189 @@
+package icm
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"temperature-converter/poker"
+)
+
+func TestExactEquity(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stacks   []float64
+		payouts  []float64
+		expected []float64
+	}{
+		{
+			name:     "Winner takes all",
+			stacks:   []float64{600, 300, 100},
+			payouts:  []float64{100},
+			expected: []float64{60, 30, 10},
+		},
+		{
+			name:     "Equal stacks",
+			stacks:   []float64{100, 100, 100, 100},
+			payouts:  []float64{50, 30, 20},
+			expected: []float64{25, 25, 25, 25},
+		},
+		{
+			name:     "Three players",
+			stacks:   []float64{50, 30, 20},
+			payouts:  []float64{50, 30, 20},
+			expected: []float64{38.3929, 32.75, 28.8571},
+		},
+		{
+			name:     "Busted player takes last place",
+			stacks:   []float64{50, 50, 0},
+			payouts:  []float64{50, 30, 20},
+			expected: []float64{40, 40, 20},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			equities := ExactEquity(tc.stacks, tc.payouts)
+			for i, expected := range tc.expected {
+				if math.Abs(equities[i]-expected) > 0.001 {
+					t.Errorf("Expected equity %.4f for player %d, got %.4f", expected, i+1, equities[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMonteCarloEquity(t *testing.T) {
+	stacks := []float64{4000, 2500, 1500, 1000, 600, 400}
+	payouts := []float64{50, 30, 20}
+	exact := ExactEquity(stacks, payouts)
+	estimate := MonteCarloEquity(stacks, payouts, 200000, rand.New(rand.NewSource(1)))
+
+	for i := range stacks {
+		if math.Abs(exact[i]-estimate[i]) > 0.5 {
+			t.Errorf("Expected equity close to %.3f for player %d, got %.3f", exact[i], i+1, estimate[i])
+		}
+	}
+}
+
+func TestEquity(t *testing.T) {
+	stacks := make([]float64, 12)
+	for i := range stacks {
+		stacks[i] = float64(1000 * (i + 1))
+	}
+	payouts := []float64{50, 30, 20}
+
+	equities, exact, err := Equity(stacks, payouts, 10000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if exact {
+		t.Errorf("Expected Monte Carlo for %d players", len(stacks))
+	}
+	sum := 0.0
+	for _, equity := range equities {
+		sum += equity
+	}
+	if math.Abs(sum-100) > 1e-6 {
+		t.Errorf("Expected equities to sum to 100, got %.6f", sum)
+	}
+
+	invalid := []struct {
+		name    string
+		stacks  []float64
+		payouts []float64
+	}{
+		{"One stack", []float64{100}, []float64{1}},
+		{"No payouts", []float64{100, 100}, nil},
+		{"Negative stack", []float64{100, -1}, []float64{1}},
+		{"No chips", []float64{0, 0}, []float64{1}},
+	}
+	for _, tc := range invalid {
+		if _, _, err := Equity(tc.stacks, tc.payouts, 0); err == nil {
+			t.Errorf("Expected error for %s", tc.name)
+		}
+	}
+}
+
+func TestEvaluateAllIn(t *testing.T) {
+	mustParse := func(cards ...string) []poker.Card {
+		parsed, err := poker.ParseCards(cards)
+		if err != nil {
+			t.Fatalf("Failed to parse cards: %v", err)
+		}
+		return parsed
+	}
+
+	// Bubble of a 3-paid, 4-player sit and go: the hero covers the shover but a call risks busting
+	scenario := AllInScenario{
+		Stacks:      []float64{3000, 3000, 2000, 2000},
+		Payouts:     []float64{50, 30, 20},
+		Posted:      []float64{0, 0, 100, 200},
+		Hero:        3,
+		HeroCards:   mustParse("HK", "DQ"),
+		Opponents:   []AllInPlayer{{Player: 0, Cards: mustParse("SA", "CJ")}},
+		Simulations: 5000,
+	}
+	result, err := EvaluateAllIn(scenario)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.HeroEquity < 0.3 || result.HeroEquity > 0.5 {
+		t.Errorf("Expected hero to win about 40%% of the pot, got %.3f", result.HeroEquity)
+	}
+	if result.Call {
+		t.Errorf("Expected fold on the bubble, got call EV %.3f vs fold EV %.3f", result.CallEV, result.FoldEV)
+	}
+
+	callSum, foldSum := 0.0, 0.0
+	for i := range result.CallEquities {
+		callSum += result.CallEquities[i]
+		foldSum += result.FoldEquities[i]
+	}
+	if math.Abs(callSum-100) > 1e-6 || math.Abs(foldSum-100) > 1e-6 {
+		t.Errorf("Expected equities to sum to 100, got %.6f and %.6f", callSum, foldSum)
+	}
+
+	// Aces against a wide range are a clear call
+	scenario.HeroCards = mustParse("HA", "DA")
+	scenario.Opponents = []AllInPlayer{{Player: 0, Range: mustRange(t, "22+,A2+,K9+,QT+")}}
+	result, err = EvaluateAllIn(scenario)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Call {
+		t.Errorf("Expected call with aces, got call EV %.3f vs fold EV %.3f", result.CallEV, result.FoldEV)
+	}
+
+	scenario.Opponents = []AllInPlayer{{Player: 3, Cards: mustParse("SA", "CJ")}}
+	if _, err := EvaluateAllIn(scenario); err == nil {
+		t.Errorf("Expected error when the hero is also an opponent")
+	}
+}
+
+func TestPlayOutKeepsFoldedChips(t *testing.T) {
+	// The hero posts the big blind and folds to a shorter all-in: the chips above the call stay in the pot
+	scenario := AllInScenario{
+		Stacks: []float64{1000, 50, 1000},
+		Posted: []float64{0, 50, 100},
+		Hero:   2,
+	}
+	stacks, won, err := playOut(scenario, []AllInPlayer{{Player: 1}}, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if stacks[0]+stacks[1]+stacks[2] != 2050 {
+		t.Errorf("Expected the stacks to keep all 2050 chips, got %v", stacks)
+	}
+	if won != 150 || stacks[1] != 150 || stacks[2] != 900 {
+		t.Errorf("Expected the all-in player to win 150 and the hero to keep 900, got %v", stacks)
+	}
+}
+
+func mustRange(t *testing.T, rangeStr string) [][]poker.Card {
+	combos, err := poker.ParseRange(rangeStr)
+	if err != nil {
+		t.Fatalf("Failed to parse range: %v", err)
+	}
+	return combos
+}
@@ -2,19 +2,25 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
-	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 
+	"temperature-converter/handhistory"
 	pb "temperature-converter/pb"
 )
 
+// maxMessageSize allows large hand history uploads through gRPC
+const maxMessageSize = 64 << 20
+
+// inProcessBufferSize is the buffer of the in-memory connection that carries streaming calls to the gRPC server
+const inProcessBufferSize = 1 << 20
+
 // server implements the TemperatureConverter service
 type server struct {
 	pb.UnimplementedTemperatureConverterServer
@@ -42,101 +48,19 @@ func (s *server) ConvertFahrenheitToCelsius(ctx context.Context, req *pb.Fahrenh
 	}, nil
 }
 
-// REST handlers that call gRPC service
-type ConversionRequest struct {
-	Celsius    float64 `json:"celsius"`
-	Fahrenheit float64 `json:"fahrenheit"`
-}
-
-type ConversionResponse struct {
-	Celsius    float64 `json:"celsius"`
-	Fahrenheit float64 `json:"fahrenheit"`
-}
-
-func celsiusToFahrenheitHandler(grpcClient pb.TemperatureConverterClient) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
-
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		var req ConversionRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
-			return
-		}
-
-		// Call gRPC service
-		grpcReq := &pb.CelsiusRequest{Celsius: req.Celsius}
-		resp, err := grpcClient.ConvertCelsiusToFahrenheit(context.Background(), grpcReq)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		response := ConversionResponse{
-			Celsius:    resp.Celsius,
-			Fahrenheit: resp.Fahrenheit,
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(response)
-	}
-}
-
-func fahrenheitToCelsiusHandler(grpcClient pb.TemperatureConverterClient) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
-
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		var req ConversionRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
-			return
-		}
-
-		// Call gRPC service
-		grpcReq := &pb.FahrenheitRequest{Fahrenheit: req.Fahrenheit}
-		resp, err := grpcClient.ConvertFahrenheitToCelsius(context.Background(), grpcReq)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		response := ConversionResponse{
-			Celsius:    resp.Celsius,
-			Fahrenheit: resp.Fahrenheit,
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(response)
-	}
-}
-
 func main() {
 	grpcPort := ":8081"
 	httpPort := ":8080"
 
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize))
+	temperature := &server{}
+	pb.RegisterTemperatureConverterServer(grpcServer, temperature)
+	// Imported hands and hands played at the tables share one store
+	hands := handhistory.NewStore()
+	poker := newPokerServer(hands)
+	pb.RegisterPokerEvaluatorServer(grpcServer, poker)
+	pb.RegisterTableServiceServer(grpcServer, newTableServer(hands))
+
 	// Start gRPC server in a goroutine
 	go func() {
 		lis, err := net.Listen("tcp", grpcPort)
@@ -144,10 +68,6 @@ func main() {
 			log.Fatalf("Failed to listen: %v", err)
 		}
 
-		grpcServer := grpc.NewServer()
-		pb.RegisterTemperatureConverterServer(grpcServer, &server{})
-		pb.RegisterPokerEvaluatorServer(grpcServer, &pokerServer{})
-
 		fmt.Printf("gRPC server starting on port %s\n", grpcPort)
 		fmt.Println("gRPC endpoints:")
 		fmt.Println("  TemperatureConverter:")
@@ -157,43 +77,75 @@ func main() {
 		fmt.Println("    EvaluateHand")
 		fmt.Println("    CompareHands")
 		fmt.Println("    CalculateWinProbability")
+		fmt.Println("    StreamWinProbability (server streaming)")
+		fmt.Println("    AnalyzeBoardTexture")
+		fmt.Println("    AnalyzeNuts")
+		fmt.Println("    CalculateHandPotential")
+		fmt.Println("    EvaluateCallDecision")
+		fmt.Println("    CalculateICM")
+		fmt.Println("    SolvePushFold")
+		fmt.Println("    CalculateEquityBreakdown")
+		fmt.Println("    Showdown")
+		fmt.Println("    ImportHandHistory")
+		fmt.Println("    ExportHandHistory")
+		fmt.Println("    AnalyzeLuck")
+		fmt.Println("    GetPlayerStats")
+		fmt.Println("    VerifyShuffle")
+		fmt.Println("    GetCacheStats")
+		fmt.Println("    BatchEvaluateHands")
+		fmt.Println("    BatchCompareHands")
+		fmt.Println("    StreamEvaluateHands (client streaming)")
+		fmt.Println("    StreamCompareHands (client streaming)")
+		fmt.Println("  TableService:")
+		fmt.Println("    PlayHand (bidirectional streaming)")
 
 		if err := grpcServer.Serve(lis); err != nil {
 			log.Fatalf("Failed to serve gRPC: %v", err)
 		}
 	}()
 
-	// Wait for gRPC server to be ready
-	time.Sleep(1 * time.Second)
+	// Streaming calls from the REST gateway and the streaming handlers reach the services in memory rather than over TCP
+	inProcess := bufconn.Listen(inProcessBufferSize)
+	go func() {
+		if err := grpcServer.Serve(inProcess); err != nil {
+			log.Fatalf("Failed to serve gRPC in-process: %v", err)
+		}
+	}()
 
-	// Connect to gRPC server
-	conn, err := grpc.NewClient("localhost"+grpcPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient("passthrough:///in-process",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return inProcess.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)),
+	)
 	if err != nil {
 		log.Fatalf("Failed to connect to gRPC server: %v", err)
 	}
 	defer conn.Close()
 
-	tempGrpcClient := pb.NewTemperatureConverterClient(conn)
-	pokerGrpcClient := pb.NewPokerEvaluatorClient(conn)
+	gateway, err := newGateway(context.Background(), temperature, poker, conn)
+	if err != nil {
+		log.Fatalf("Failed to register REST gateway: %v", err)
+	}
+
+	mux := http.NewServeMux()
 
 	// Health check endpoint
-	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
 
-	// Set up REST API that calls gRPC
-	// Temperature converter endpoints
-	http.HandleFunc("/convert", celsiusToFahrenheitHandler(tempGrpcClient))
-	http.HandleFunc("/convert-fahrenheit", fahrenheitToCelsiusHandler(tempGrpcClient))
-	
-	// Poker endpoints
-	http.HandleFunc("/poker/evaluate-hand", evaluateHandHandler(pokerGrpcClient))
-	http.HandleFunc("/poker/compare-hands", compareHandsHandler(pokerGrpcClient))
-	http.HandleFunc("/poker/calculate-probability", calculateProbabilityHandler(pokerGrpcClient))
+	// Streams the gateway cannot serve: Server-Sent Events and multiplayer tables over WebSocket
+	mux.HandleFunc("/poker/stream-probability", streamProbabilityHandler(pb.NewPokerEvaluatorClient(conn)))
+	mux.Handle("/poker/table", tableWebSocketHandler(pb.NewTableServiceClient(conn)))
+
+	// Every other endpoint is generated from the google.api.http rules in the protos
+	mux.Handle("/", gateway)
 
 	fmt.Printf("REST API (gRPC gateway) starting on port %s\n", httpPort)
-	fmt.Println("REST endpoints (calling gRPC internally):")
+	fmt.Println("REST endpoints (calling gRPC in-process):")
 	fmt.Println("  Temperature Converter:")
 	fmt.Println("    POST http://localhost:8080/convert")
 	fmt.Println("    POST http://localhost:8080/convert-fahrenheit")
@@ -201,8 +153,30 @@ func main() {
 	fmt.Println("    POST http://localhost:8080/poker/evaluate-hand")
 	fmt.Println("    POST http://localhost:8080/poker/compare-hands")
 	fmt.Println("    POST http://localhost:8080/poker/calculate-probability")
-
-	if err := http.ListenAndServe(httpPort, nil); err != nil {
+	fmt.Println("    GET|POST http://localhost:8080/poker/stream-probability (Server-Sent Events)")
+	fmt.Println("    POST http://localhost:8080/poker/board-texture")
+	fmt.Println("    POST http://localhost:8080/poker/nut-analysis")
+	fmt.Println("    POST http://localhost:8080/poker/hand-potential")
+	fmt.Println("    POST http://localhost:8080/poker/call-decision")
+	fmt.Println("    POST http://localhost:8080/poker/icm")
+	fmt.Println("    POST http://localhost:8080/poker/push-fold")
+	fmt.Println("    POST http://localhost:8080/poker/equity-breakdown")
+	fmt.Println("    POST http://localhost:8080/poker/showdown")
+	fmt.Println("    POST http://localhost:8080/poker/hand-history")
+	fmt.Println("    POST http://localhost:8080/poker/hand-history/export")
+	fmt.Println("    POST http://localhost:8080/poker/hand-history/luck")
+	fmt.Println("    GET  http://localhost:8080/poker/stats")
+	fmt.Println("    GET  http://localhost:8080/poker/stats/{player}")
+	fmt.Println("    POST http://localhost:8080/poker/shuffle/verify")
+	fmt.Println("    GET  http://localhost:8080/poker/cache/stats")
+	fmt.Println("    POST http://localhost:8080/poker/batch/evaluate-hands")
+	fmt.Println("    POST http://localhost:8080/poker/batch/compare-hands")
+	fmt.Println("    POST http://localhost:8080/poker/batch/evaluate-hands/stream (NDJSON)")
+	fmt.Println("    POST http://localhost:8080/poker/batch/compare-hands/stream (NDJSON)")
+	fmt.Println("  Table Service:")
+	fmt.Println("    WS   ws://localhost:8080/poker/table (JSON commands and updates)")
+
+	if err := http.ListenAndServe(httpPort, allowCORS(mux)); err != nil {
 		log.Fatalf("Failed to serve HTTP: %v", err)
 	}
 }
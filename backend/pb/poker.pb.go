@@ -7,6 +7,7 @@
 package pb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,7 +26,8 @@ const (
 type EvaluateHandRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	HoleCards      []string               `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // 2 cards (e.g., ["HA", "S7"])
-	CommunityCards []string               `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // 5 cards (e.g., ["CT", "DK", "H5", "S2", "C9"])
+	CommunityCards []string               `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // 0, 3, 4, or 5 cards (e.g., ["CT", "DK", "H5", "S2", "C9"])
+	NoCache        bool                   `protobuf:"varint,3,opt,name=no_cache,json=noCache,proto3" json:"no_cache,omitempty"`                     // Evaluate afresh, without reading or storing a cached result
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -74,12 +76,22 @@ func (x *EvaluateHandRequest) GetCommunityCards() []string {
 	return nil
 }
 
+func (x *EvaluateHandRequest) GetNoCache() bool {
+	if x != nil {
+		return x.NoCache
+	}
+	return false
+}
+
 // Response with hand evaluation
 type EvaluateHandResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BestHand      string                 `protobuf:"bytes,1,opt,name=best_hand,json=bestHand,proto3" json:"best_hand,omitempty"`                  // Hand type (e.g., "Three of a Kind", "Flush", "Royal Flush")
-	HandValue     int32                  `protobuf:"varint,2,opt,name=hand_value,json=handValue,proto3" json:"hand_value,omitempty"`              // Numeric value for comparison (higher is better)
+	BestHand      string                 `protobuf:"bytes,1,opt,name=best_hand,json=bestHand,proto3" json:"best_hand,omitempty"`                  // Hand type (e.g., "Three of a Kind", "Flush", "Royal Flush"), or preflop category (e.g., "Pocket Pair", "Suited Connectors")
+	HandValue     int32                  `protobuf:"varint,2,opt,name=hand_value,json=handValue,proto3" json:"hand_value,omitempty"`              // Legacy score (higher is better); sparse, prefer hand_rank
 	BestFiveCards []string               `protobuf:"bytes,3,rep,name=best_five_cards,json=bestFiveCards,proto3" json:"best_five_cards,omitempty"` // The 5 cards that make the best hand
+	Draws         []*Draw                `protobuf:"bytes,4,rep,name=draws,proto3" json:"draws,omitempty"`                                        // Drawing hands, when 3 or 4 community cards are given
+	StartingHand  string                 `protobuf:"bytes,5,opt,name=starting_hand,json=startingHand,proto3" json:"starting_hand,omitempty"`      // Preflop hand class (e.g., "AKs", "TT", "72o"), when no community cards are given
+	HandRank      int32                  `protobuf:"varint,6,opt,name=hand_rank,json=handRank,proto3" json:"hand_rank,omitempty"`                 // Equivalence class of the best five cards, from 1 (royal flush) to 7462 (7-5-4-3-2 offsuit); lower is stronger
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -135,6 +147,96 @@ func (x *EvaluateHandResponse) GetBestFiveCards() []string {
 	return nil
 }
 
+func (x *EvaluateHandResponse) GetDraws() []*Draw {
+	if x != nil {
+		return x.Draws
+	}
+	return nil
+}
+
+func (x *EvaluateHandResponse) GetStartingHand() string {
+	if x != nil {
+		return x.StartingHand
+	}
+	return ""
+}
+
+func (x *EvaluateHandResponse) GetHandRank() int32 {
+	if x != nil {
+		return x.HandRank
+	}
+	return 0
+}
+
+// A drawing hand and the cards that complete it
+type Draw struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`               // "flush draw", "open-ended straight draw", "gutshot", "double gutshot", "backdoor flush draw", "backdoor straight draw" or "overcards"
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // Human-readable description (e.g., "nut flush draw")
+	Nut           bool                   `protobuf:"varint,3,opt,name=nut,proto3" json:"nut,omitempty"`                // Flush draw holding the highest card of the suit not on the board
+	Outs          []string               `protobuf:"bytes,4,rep,name=outs,proto3" json:"outs,omitempty"`               // Cards that complete the draw (for backdoor draws, the cards that turn it into a draw)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Draw) Reset() {
+	*x = Draw{}
+	mi := &file_poker_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Draw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draw) ProtoMessage() {}
+
+func (x *Draw) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draw.ProtoReflect.Descriptor instead.
+func (*Draw) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Draw) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Draw) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Draw) GetNut() bool {
+	if x != nil {
+		return x.Nut
+	}
+	return false
+}
+
+func (x *Draw) GetOuts() []string {
+	if x != nil {
+		return x.Outs
+	}
+	return nil
+}
+
 // Request to compare two hands
 type CompareHandsRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
@@ -148,7 +250,7 @@ type CompareHandsRequest struct {
 
 func (x *CompareHandsRequest) Reset() {
 	*x = CompareHandsRequest{}
-	mi := &file_poker_proto_msgTypes[2]
+	mi := &file_poker_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -160,7 +262,7 @@ func (x *CompareHandsRequest) String() string {
 func (*CompareHandsRequest) ProtoMessage() {}
 
 func (x *CompareHandsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[2]
+	mi := &file_poker_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,7 +275,7 @@ func (x *CompareHandsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompareHandsRequest.ProtoReflect.Descriptor instead.
 func (*CompareHandsRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{2}
+	return file_poker_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CompareHandsRequest) GetPlayer1HoleCards() []string {
@@ -216,7 +318,7 @@ type CompareHandsResponse struct {
 
 func (x *CompareHandsResponse) Reset() {
 	*x = CompareHandsResponse{}
-	mi := &file_poker_proto_msgTypes[3]
+	mi := &file_poker_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +330,7 @@ func (x *CompareHandsResponse) String() string {
 func (*CompareHandsResponse) ProtoMessage() {}
 
 func (x *CompareHandsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[3]
+	mi := &file_poker_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +343,7 @@ func (x *CompareHandsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompareHandsResponse.ProtoReflect.Descriptor instead.
 func (*CompareHandsResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{3}
+	return file_poker_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CompareHandsResponse) GetPlayer1Hand() *EvaluateHandResponse {
@@ -272,13 +374,15 @@ type ProbabilityRequest struct {
 	CommunityCards []string               `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // 0, 3, 4, or 5 community cards
 	NumPlayers     int32                  `protobuf:"varint,3,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`             // Number of players (including the one with hole_cards)
 	NumSimulations int32                  `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Number of Monte Carlo simulations
+	DeadCards      []string               `protobuf:"bytes,5,rep,name=dead_cards,json=deadCards,proto3" json:"dead_cards,omitempty"`                 // Cards known to be out of play (burned, folded, exposed)
+	NoCache        bool                   `protobuf:"varint,6,opt,name=no_cache,json=noCache,proto3" json:"no_cache,omitempty"`                      // Simulate afresh, without reading or storing cached samples
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProbabilityRequest) Reset() {
 	*x = ProbabilityRequest{}
-	mi := &file_poker_proto_msgTypes[4]
+	mi := &file_poker_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -290,7 +394,7 @@ func (x *ProbabilityRequest) String() string {
 func (*ProbabilityRequest) ProtoMessage() {}
 
 func (x *ProbabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[4]
+	mi := &file_poker_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +407,7 @@ func (x *ProbabilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbabilityRequest.ProtoReflect.Descriptor instead.
 func (*ProbabilityRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{4}
+	return file_poker_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ProbabilityRequest) GetHoleCards() []string {
@@ -334,18 +438,34 @@ func (x *ProbabilityRequest) GetNumSimulations() int32 {
 	return 0
 }
 
+func (x *ProbabilityRequest) GetDeadCards() []string {
+	if x != nil {
+		return x.DeadCards
+	}
+	return nil
+}
+
+func (x *ProbabilityRequest) GetNoCache() bool {
+	if x != nil {
+		return x.NoCache
+	}
+	return false
+}
+
 // Response with probability
 type ProbabilityResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	WinProbability float64                `protobuf:"fixed64,1,opt,name=win_probability,json=winProbability,proto3" json:"win_probability,omitempty"` // Probability of winning (0.0 to 1.0)
 	TieProbability float64                `protobuf:"fixed64,2,opt,name=tie_probability,json=tieProbability,proto3" json:"tie_probability,omitempty"` // Probability of tying (0.0 to 1.0)
+	Simulations    int32                  `protobuf:"varint,3,opt,name=simulations,proto3" json:"simulations,omitempty"`                              // Simulations behind the estimate; more than asked for when a cached result had more
+	Cached         bool                   `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`                                        // Some or all of the simulations came from the cache
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProbabilityResponse) Reset() {
 	*x = ProbabilityResponse{}
-	mi := &file_poker_proto_msgTypes[5]
+	mi := &file_poker_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +477,7 @@ func (x *ProbabilityResponse) String() string {
 func (*ProbabilityResponse) ProtoMessage() {}
 
 func (x *ProbabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[5]
+	mi := &file_poker_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +490,7 @@ func (x *ProbabilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbabilityResponse.ProtoReflect.Descriptor instead.
 func (*ProbabilityResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{5}
+	return file_poker_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ProbabilityResponse) GetWinProbability() float64 {
@@ -387,95 +507,5934 @@ func (x *ProbabilityResponse) GetTieProbability() float64 {
 	return 0
 }
 
-var File_poker_proto protoreflect.FileDescriptor
+func (x *ProbabilityResponse) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
 
-const file_poker_proto_rawDesc = "" +
-	"\n" +
-	"\vpoker.proto\x12\x05poker\"]\n" +
-	"\x13EvaluateHandRequest\x12\x1d\n" +
-	"\n" +
-	"hole_cards\x18\x01 \x03(\tR\tholeCards\x12'\n" +
-	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\"z\n" +
-	"\x14EvaluateHandResponse\x12\x1b\n" +
-	"\tbest_hand\x18\x01 \x01(\tR\bbestHand\x12\x1d\n" +
-	"\n" +
-	"hand_value\x18\x02 \x01(\x05R\thandValue\x12&\n" +
-	"\x0fbest_five_cards\x18\x03 \x03(\tR\rbestFiveCards\"\xe1\x01\n" +
-	"\x13CompareHandsRequest\x12,\n" +
-	"\x12player1_hole_cards\x18\x01 \x03(\tR\x10player1HoleCards\x126\n" +
-	"\x17player1_community_cards\x18\x02 \x03(\tR\x15player1CommunityCards\x12,\n" +
-	"\x12player2_hole_cards\x18\x03 \x03(\tR\x10player2HoleCards\x126\n" +
-	"\x17player2_community_cards\x18\x04 \x03(\tR\x15player2CommunityCards\"\xae\x01\n" +
-	"\x14CompareHandsResponse\x12>\n" +
-	"\fplayer1_hand\x18\x01 \x01(\v2\x1b.poker.EvaluateHandResponseR\vplayer1Hand\x12>\n" +
-	"\fplayer2_hand\x18\x02 \x01(\v2\x1b.poker.EvaluateHandResponseR\vplayer2Hand\x12\x16\n" +
-	"\x06winner\x18\x03 \x01(\x05R\x06winner\"\xa6\x01\n" +
-	"\x12ProbabilityRequest\x12\x1d\n" +
-	"\n" +
-	"hole_cards\x18\x01 \x03(\tR\tholeCards\x12'\n" +
-	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\x12\x1f\n" +
-	"\vnum_players\x18\x03 \x01(\x05R\n" +
-	"numPlayers\x12'\n" +
-	"\x0fnum_simulations\x18\x04 \x01(\x05R\x0enumSimulations\"g\n" +
-	"\x13ProbabilityResponse\x12'\n" +
-	"\x0fwin_probability\x18\x01 \x01(\x01R\x0ewinProbability\x12'\n" +
-	"\x0ftie_probability\x18\x02 \x01(\x01R\x0etieProbability2\xf4\x01\n" +
-	"\x0ePokerEvaluator\x12G\n" +
-	"\fEvaluateHand\x12\x1a.poker.EvaluateHandRequest\x1a\x1b.poker.EvaluateHandResponse\x12G\n" +
-	"\fCompareHands\x12\x1a.poker.CompareHandsRequest\x1a\x1b.poker.CompareHandsResponse\x12P\n" +
-	"\x17CalculateWinProbability\x12\x19.poker.ProbabilityRequest\x1a\x1a.poker.ProbabilityResponseB\x06Z\x04./pbb\x06proto3"
+func (x *ProbabilityResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
 
-var (
-	file_poker_proto_rawDescOnce sync.Once
-	file_poker_proto_rawDescData []byte
-)
+// Request for a streamed probability calculation
+type StreamProbabilityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HoleCards      []string               `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                 // 2 hole cards
+	CommunityCards []string               `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // 0, 3, 4, or 5 community cards
+	NumPlayers     int32                  `protobuf:"varint,3,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`             // Number of players (including the one with hole_cards)
+	NumSimulations int32                  `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Total number of Monte Carlo simulations
+	UpdateInterval int32                  `protobuf:"varint,5,opt,name=update_interval,json=updateInterval,proto3" json:"update_interval,omitempty"` // Simulations between interim updates (defaults to 1000)
+	DeadCards      []string               `protobuf:"bytes,6,rep,name=dead_cards,json=deadCards,proto3" json:"dead_cards,omitempty"`                 // Cards known to be out of play (burned, folded, exposed)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-func file_poker_proto_rawDescGZIP() []byte {
-	file_poker_proto_rawDescOnce.Do(func() {
-		file_poker_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_poker_proto_rawDesc), len(file_poker_proto_rawDesc)))
-	})
-	return file_poker_proto_rawDescData
+func (x *StreamProbabilityRequest) Reset() {
+	*x = StreamProbabilityRequest{}
+	mi := &file_poker_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var file_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_poker_proto_goTypes = []any{
-	(*EvaluateHandRequest)(nil),  // 0: poker.EvaluateHandRequest
-	(*EvaluateHandResponse)(nil), // 1: poker.EvaluateHandResponse
-	(*CompareHandsRequest)(nil),  // 2: poker.CompareHandsRequest
-	(*CompareHandsResponse)(nil), // 3: poker.CompareHandsResponse
-	(*ProbabilityRequest)(nil),   // 4: poker.ProbabilityRequest
-	(*ProbabilityResponse)(nil),  // 5: poker.ProbabilityResponse
+func (x *StreamProbabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_poker_proto_depIdxs = []int32{
-	1, // 0: poker.CompareHandsResponse.player1_hand:type_name -> poker.EvaluateHandResponse
-	1, // 1: poker.CompareHandsResponse.player2_hand:type_name -> poker.EvaluateHandResponse
-	0, // 2: poker.PokerEvaluator.EvaluateHand:input_type -> poker.EvaluateHandRequest
-	2, // 3: poker.PokerEvaluator.CompareHands:input_type -> poker.CompareHandsRequest
-	4, // 4: poker.PokerEvaluator.CalculateWinProbability:input_type -> poker.ProbabilityRequest
-	1, // 5: poker.PokerEvaluator.EvaluateHand:output_type -> poker.EvaluateHandResponse
-	3, // 6: poker.PokerEvaluator.CompareHands:output_type -> poker.CompareHandsResponse
-	5, // 7: poker.PokerEvaluator.CalculateWinProbability:output_type -> poker.ProbabilityResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (*StreamProbabilityRequest) ProtoMessage() {}
+
+func (x *StreamProbabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_poker_proto_init() }
-func file_poker_proto_init() {
-	if File_poker_proto != nil {
-		return
+// Deprecated: Use StreamProbabilityRequest.ProtoReflect.Descriptor instead.
+func (*StreamProbabilityRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamProbabilityRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *StreamProbabilityRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *StreamProbabilityRequest) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *StreamProbabilityRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+func (x *StreamProbabilityRequest) GetUpdateInterval() int32 {
+	if x != nil {
+		return x.UpdateInterval
+	}
+	return 0
+}
+
+func (x *StreamProbabilityRequest) GetDeadCards() []string {
+	if x != nil {
+		return x.DeadCards
+	}
+	return nil
+}
+
+// Interim or final estimate from a streamed probability calculation
+type ProbabilityUpdate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Iterations     int32                  `protobuf:"varint,1,opt,name=iterations,proto3" json:"iterations,omitempty"`                                // Simulations run so far
+	WinProbability float64                `protobuf:"fixed64,2,opt,name=win_probability,json=winProbability,proto3" json:"win_probability,omitempty"` // Probability of winning (0.0 to 1.0)
+	TieProbability float64                `protobuf:"fixed64,3,opt,name=tie_probability,json=tieProbability,proto3" json:"tie_probability,omitempty"` // Probability of tying (0.0 to 1.0)
+	Equity         float64                `protobuf:"fixed64,4,opt,name=equity,proto3" json:"equity,omitempty"`                                       // Expected share of the pot, with split pots divided between the winners
+	WinCiLow       float64                `protobuf:"fixed64,5,opt,name=win_ci_low,json=winCiLow,proto3" json:"win_ci_low,omitempty"`                 // 95% confidence interval for win_probability
+	WinCiHigh      float64                `protobuf:"fixed64,6,opt,name=win_ci_high,json=winCiHigh,proto3" json:"win_ci_high,omitempty"`
+	EquityCiLow    float64                `protobuf:"fixed64,7,opt,name=equity_ci_low,json=equityCiLow,proto3" json:"equity_ci_low,omitempty"` // 95% confidence interval for equity
+	EquityCiHigh   float64                `protobuf:"fixed64,8,opt,name=equity_ci_high,json=equityCiHigh,proto3" json:"equity_ci_high,omitempty"`
+	Final          bool                   `protobuf:"varint,9,opt,name=final,proto3" json:"final,omitempty"` // True for the last message of the stream
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProbabilityUpdate) Reset() {
+	*x = ProbabilityUpdate{}
+	mi := &file_poker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbabilityUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbabilityUpdate) ProtoMessage() {}
+
+func (x *ProbabilityUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbabilityUpdate.ProtoReflect.Descriptor instead.
+func (*ProbabilityUpdate) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProbabilityUpdate) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetWinProbability() float64 {
+	if x != nil {
+		return x.WinProbability
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetTieProbability() float64 {
+	if x != nil {
+		return x.TieProbability
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetWinCiLow() float64 {
+	if x != nil {
+		return x.WinCiLow
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetWinCiHigh() float64 {
+	if x != nil {
+		return x.WinCiHigh
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetEquityCiLow() float64 {
+	if x != nil {
+		return x.EquityCiLow
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetEquityCiHigh() float64 {
+	if x != nil {
+		return x.EquityCiHigh
+	}
+	return 0
+}
+
+func (x *ProbabilityUpdate) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+// Request to classify a board
+type BoardTextureRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CommunityCards []string               `protobuf:"bytes,1,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // 3, 4, or 5 community cards
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BoardTextureRequest) Reset() {
+	*x = BoardTextureRequest{}
+	mi := &file_poker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardTextureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardTextureRequest) ProtoMessage() {}
+
+func (x *BoardTextureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardTextureRequest.ProtoReflect.Descriptor instead.
+func (*BoardTextureRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BoardTextureRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+// Board texture classification
+type BoardTextureResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Pairing              string                 `protobuf:"bytes,1,opt,name=pairing,proto3" json:"pairing,omitempty"`                                                          // "unpaired", "paired", "two pair", "trips", "full house" or "quads"
+	SuitPattern          string                 `protobuf:"bytes,2,opt,name=suit_pattern,json=suitPattern,proto3" json:"suit_pattern,omitempty"`                               // "rainbow", "two-tone", "monotone", "three-flush" or "four-flush"
+	Connectedness        string                 `protobuf:"bytes,3,opt,name=connectedness,proto3" json:"connectedness,omitempty"`                                              // "connected", "semi-connected" or "disconnected"
+	MaxSuitCount         int32                  `protobuf:"varint,4,opt,name=max_suit_count,json=maxSuitCount,proto3" json:"max_suit_count,omitempty"`                         // Number of cards of the most common suit
+	ConnectedRanks       int32                  `protobuf:"varint,5,opt,name=connected_ranks,json=connectedRanks,proto3" json:"connected_ranks,omitempty"`                     // Most distinct board ranks that fit inside one straight
+	StraightPossible     bool                   `protobuf:"varint,6,opt,name=straight_possible,json=straightPossible,proto3" json:"straight_possible,omitempty"`               // Some holding makes a straight
+	FlushPossible        bool                   `protobuf:"varint,7,opt,name=flush_possible,json=flushPossible,proto3" json:"flush_possible,omitempty"`                        // Some holding makes a flush
+	StraightDrawPossible bool                   `protobuf:"varint,8,opt,name=straight_draw_possible,json=straightDrawPossible,proto3" json:"straight_draw_possible,omitempty"` // A straight draw is possible with cards still to come
+	FlushDrawPossible    bool                   `protobuf:"varint,9,opt,name=flush_draw_possible,json=flushDrawPossible,proto3" json:"flush_draw_possible,omitempty"`          // A flush draw is possible with cards still to come
+	HighCard             string                 `protobuf:"bytes,10,opt,name=high_card,json=highCard,proto3" json:"high_card,omitempty"`                                       // Highest rank on the board (e.g., "A")
+	HighCardClass        string                 `protobuf:"bytes,11,opt,name=high_card_class,json=highCardClass,proto3" json:"high_card_class,omitempty"`                      // "ace-high", "broadway", "middle" or "low"
+	Wetness              int32                  `protobuf:"varint,12,opt,name=wetness,proto3" json:"wetness,omitempty"`                                                        // 0 (dry) to 100 (wet)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BoardTextureResponse) Reset() {
+	*x = BoardTextureResponse{}
+	mi := &file_poker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardTextureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardTextureResponse) ProtoMessage() {}
+
+func (x *BoardTextureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardTextureResponse.ProtoReflect.Descriptor instead.
+func (*BoardTextureResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BoardTextureResponse) GetPairing() string {
+	if x != nil {
+		return x.Pairing
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetSuitPattern() string {
+	if x != nil {
+		return x.SuitPattern
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetConnectedness() string {
+	if x != nil {
+		return x.Connectedness
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetMaxSuitCount() int32 {
+	if x != nil {
+		return x.MaxSuitCount
+	}
+	return 0
+}
+
+func (x *BoardTextureResponse) GetConnectedRanks() int32 {
+	if x != nil {
+		return x.ConnectedRanks
+	}
+	return 0
+}
+
+func (x *BoardTextureResponse) GetStraightPossible() bool {
+	if x != nil {
+		return x.StraightPossible
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetFlushPossible() bool {
+	if x != nil {
+		return x.FlushPossible
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetStraightDrawPossible() bool {
+	if x != nil {
+		return x.StraightDrawPossible
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetFlushDrawPossible() bool {
+	if x != nil {
+		return x.FlushDrawPossible
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetHighCard() string {
+	if x != nil {
+		return x.HighCard
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetHighCardClass() string {
+	if x != nil {
+		return x.HighCardClass
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetWetness() int32 {
+	if x != nil {
+		return x.Wetness
+	}
+	return 0
+}
+
+// Request to rank a hand against every possible opponent holding
+type NutAnalysisRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HoleCards      []string               `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // 2 cards
+	CommunityCards []string               `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // 3, 4 or 5 cards: the nuts on the flop, turn or river
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NutAnalysisRequest) Reset() {
+	*x = NutAnalysisRequest{}
+	mi := &file_poker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutAnalysisRequest) ProtoMessage() {}
+
+func (x *NutAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*NutAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NutAnalysisRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *NutAnalysisRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+// Two hole cards
+type Holding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []string               `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holding) Reset() {
+	*x = Holding{}
+	mi := &file_poker_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holding) ProtoMessage() {}
+
+func (x *Holding) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holding.ProtoReflect.Descriptor instead.
+func (*Holding) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Holding) GetCards() []string {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// The nuts on a board and the hero's relative rank
+type NutAnalysisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NutHand       *EvaluateHandResponse  `protobuf:"bytes,1,opt,name=nut_hand,json=nutHand,proto3" json:"nut_hand,omitempty"`              // Best hand any holding makes on the board
+	NutHoldings   []*Holding             `protobuf:"bytes,2,rep,name=nut_holdings,json=nutHoldings,proto3" json:"nut_holdings,omitempty"`  // Holdings that make the nuts
+	HeroHand      *EvaluateHandResponse  `protobuf:"bytes,3,opt,name=hero_hand,json=heroHand,proto3" json:"hero_hand,omitempty"`           // Hero's best hand
+	HeroRank      int32                  `protobuf:"varint,4,opt,name=hero_rank,json=heroRank,proto3" json:"hero_rank,omitempty"`          // 1 for the nuts, 2 for the second nuts, and so on
+	BeatenBy      int32                  `protobuf:"varint,5,opt,name=beaten_by,json=beatenBy,proto3" json:"beaten_by,omitempty"`          // Opponent holdings that beat the hero
+	Ties          int32                  `protobuf:"varint,6,opt,name=ties,proto3" json:"ties,omitempty"`                                  // Opponent holdings that tie the hero
+	Beats         int32                  `protobuf:"varint,7,opt,name=beats,proto3" json:"beats,omitempty"`                                // Opponent holdings the hero beats
+	TotalCombos   int32                  `protobuf:"varint,8,opt,name=total_combos,json=totalCombos,proto3" json:"total_combos,omitempty"` // Opponent holdings consistent with the known cards
+	Summary       string                 `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`                             // e.g., "3rd nuts; beaten by 14 combos, ties 3, beats 973"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NutAnalysisResponse) Reset() {
+	*x = NutAnalysisResponse{}
+	mi := &file_poker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutAnalysisResponse) ProtoMessage() {}
+
+func (x *NutAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*NutAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NutAnalysisResponse) GetNutHand() *EvaluateHandResponse {
+	if x != nil {
+		return x.NutHand
+	}
+	return nil
+}
+
+func (x *NutAnalysisResponse) GetNutHoldings() []*Holding {
+	if x != nil {
+		return x.NutHoldings
+	}
+	return nil
+}
+
+func (x *NutAnalysisResponse) GetHeroHand() *EvaluateHandResponse {
+	if x != nil {
+		return x.HeroHand
+	}
+	return nil
+}
+
+func (x *NutAnalysisResponse) GetHeroRank() int32 {
+	if x != nil {
+		return x.HeroRank
+	}
+	return 0
+}
+
+func (x *NutAnalysisResponse) GetBeatenBy() int32 {
+	if x != nil {
+		return x.BeatenBy
+	}
+	return 0
+}
+
+func (x *NutAnalysisResponse) GetTies() int32 {
+	if x != nil {
+		return x.Ties
+	}
+	return 0
+}
+
+func (x *NutAnalysisResponse) GetBeats() int32 {
+	if x != nil {
+		return x.Beats
+	}
+	return 0
+}
+
+func (x *NutAnalysisResponse) GetTotalCombos() int32 {
+	if x != nil {
+		return x.TotalCombos
+	}
+	return 0
+}
+
+func (x *NutAnalysisResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+// Request for hand strength and potential metrics
+type HandPotentialRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HoleCards      []string               `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // 2 cards
+	CommunityCards []string               `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // 3, 4, or 5 cards
+	OpponentRange  string                 `protobuf:"bytes,3,opt,name=opponent_range,json=opponentRange,proto3" json:"opponent_range,omitempty"`    // Opponent range (e.g., "TT+, AKs, KQo"); empty for any two cards
+	NumOpponents   int32                  `protobuf:"varint,4,opt,name=num_opponents,json=numOpponents,proto3" json:"num_opponents,omitempty"`      // Number of opponents for HSn and EHS (defaults to 1)
+	NumSamples     int32                  `protobuf:"varint,5,opt,name=num_samples,json=numSamples,proto3" json:"num_samples,omitempty"`            // Random holding and runout samples; 0 enumerates everything (slow on the flop)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HandPotentialRequest) Reset() {
+	*x = HandPotentialRequest{}
+	mi := &file_poker_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandPotentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandPotentialRequest) ProtoMessage() {}
+
+func (x *HandPotentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandPotentialRequest.ProtoReflect.Descriptor instead.
+func (*HandPotentialRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HandPotentialRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *HandPotentialRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *HandPotentialRequest) GetOpponentRange() string {
+	if x != nil {
+		return x.OpponentRange
+	}
+	return ""
+}
+
+func (x *HandPotentialRequest) GetNumOpponents() int32 {
+	if x != nil {
+		return x.NumOpponents
+	}
+	return 0
+}
+
+func (x *HandPotentialRequest) GetNumSamples() int32 {
+	if x != nil {
+		return x.NumSamples
+	}
+	return 0
+}
+
+// Hand strength and potential metrics
+type HandPotentialResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	HandStrength          float64                `protobuf:"fixed64,1,opt,name=hand_strength,json=handStrength,proto3" json:"hand_strength,omitempty"`                              // HS: share of opponent holdings the hero is ahead of now (ties count half)
+	HandStrengthN         float64                `protobuf:"fixed64,2,opt,name=hand_strength_n,json=handStrengthN,proto3" json:"hand_strength_n,omitempty"`                         // HS raised to the number of opponents
+	PositivePotential     float64                `protobuf:"fixed64,3,opt,name=positive_potential,json=positivePotential,proto3" json:"positive_potential,omitempty"`               // PPot: chance of pulling ahead by the river when behind now
+	NegativePotential     float64                `protobuf:"fixed64,4,opt,name=negative_potential,json=negativePotential,proto3" json:"negative_potential,omitempty"`               // NPot: chance of falling behind by the river when ahead now
+	EffectiveHandStrength float64                `protobuf:"fixed64,5,opt,name=effective_hand_strength,json=effectiveHandStrength,proto3" json:"effective_hand_strength,omitempty"` // EHS = HSn * (1 - NPot) + (1 - HSn) * PPot
+	Samples               int32                  `protobuf:"varint,6,opt,name=samples,proto3" json:"samples,omitempty"`                                                             // Holdings (or holding and runout pairs) evaluated
+	Exact                 bool                   `protobuf:"varint,7,opt,name=exact,proto3" json:"exact,omitempty"`                                                                 // True if every holding and runout was enumerated
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HandPotentialResponse) Reset() {
+	*x = HandPotentialResponse{}
+	mi := &file_poker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandPotentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandPotentialResponse) ProtoMessage() {}
+
+func (x *HandPotentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandPotentialResponse.ProtoReflect.Descriptor instead.
+func (*HandPotentialResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HandPotentialResponse) GetHandStrength() float64 {
+	if x != nil {
+		return x.HandStrength
+	}
+	return 0
+}
+
+func (x *HandPotentialResponse) GetHandStrengthN() float64 {
+	if x != nil {
+		return x.HandStrengthN
+	}
+	return 0
+}
+
+func (x *HandPotentialResponse) GetPositivePotential() float64 {
+	if x != nil {
+		return x.PositivePotential
+	}
+	return 0
+}
+
+func (x *HandPotentialResponse) GetNegativePotential() float64 {
+	if x != nil {
+		return x.NegativePotential
+	}
+	return 0
+}
+
+func (x *HandPotentialResponse) GetEffectiveHandStrength() float64 {
+	if x != nil {
+		return x.EffectiveHandStrength
+	}
+	return 0
+}
+
+func (x *HandPotentialResponse) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *HandPotentialResponse) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+// Request to evaluate calling a bet
+type CallDecisionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PotSize        float64                `protobuf:"fixed64,1,opt,name=pot_size,json=potSize,proto3" json:"pot_size,omitempty"`                      // Pot before calling, including the bet to call
+	BetToCall      float64                `protobuf:"fixed64,2,opt,name=bet_to_call,json=betToCall,proto3" json:"bet_to_call,omitempty"`              // Amount the hero has to call
+	EffectiveStack float64                `protobuf:"fixed64,3,opt,name=effective_stack,json=effectiveStack,proto3" json:"effective_stack,omitempty"` // Hero's effective stack before calling
+	Equity         *float64               `protobuf:"fixed64,4,opt,name=equity,proto3,oneof" json:"equity,omitempty"`                                 // Precomputed equity (0.0 to 1.0); calculated from the cards when unset
+	HoleCards      []string               `protobuf:"bytes,5,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                  // 2 hole cards, when equity is unset
+	CommunityCards []string               `protobuf:"bytes,6,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`   // 0, 3, 4, or 5 community cards
+	DeadCards      []string               `protobuf:"bytes,7,rep,name=dead_cards,json=deadCards,proto3" json:"dead_cards,omitempty"`                  // Cards known to be out of play
+	NumPlayers     int32                  `protobuf:"varint,8,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`              // Players in the hand, including the hero (defaults to 2)
+	NumSimulations int32                  `protobuf:"varint,9,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`  // Monte Carlo simulations (defaults to 10000)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CallDecisionRequest) Reset() {
+	*x = CallDecisionRequest{}
+	mi := &file_poker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallDecisionRequest) ProtoMessage() {}
+
+func (x *CallDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallDecisionRequest.ProtoReflect.Descriptor instead.
+func (*CallDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CallDecisionRequest) GetPotSize() float64 {
+	if x != nil {
+		return x.PotSize
+	}
+	return 0
+}
+
+func (x *CallDecisionRequest) GetBetToCall() float64 {
+	if x != nil {
+		return x.BetToCall
+	}
+	return 0
+}
+
+func (x *CallDecisionRequest) GetEffectiveStack() float64 {
+	if x != nil {
+		return x.EffectiveStack
+	}
+	return 0
+}
+
+func (x *CallDecisionRequest) GetEquity() float64 {
+	if x != nil && x.Equity != nil {
+		return *x.Equity
+	}
+	return 0
+}
+
+func (x *CallDecisionRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *CallDecisionRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *CallDecisionRequest) GetDeadCards() []string {
+	if x != nil {
+		return x.DeadCards
+	}
+	return nil
+}
+
+func (x *CallDecisionRequest) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *CallDecisionRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+// Pot odds, EV and recommendation for a call
+type CallDecisionResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PotOdds              float64                `protobuf:"fixed64,1,opt,name=pot_odds,json=potOdds,proto3" json:"pot_odds,omitempty"`                                         // Pot to call ratio (e.g., 3.0 for 3:1)
+	RequiredEquity       float64                `protobuf:"fixed64,2,opt,name=required_equity,json=requiredEquity,proto3" json:"required_equity,omitempty"`                    // Equity needed for a break-even call
+	Equity               float64                `protobuf:"fixed64,3,opt,name=equity,proto3" json:"equity,omitempty"`                                                          // Equity used for the decision
+	CallAmount           float64                `protobuf:"fixed64,4,opt,name=call_amount,json=callAmount,proto3" json:"call_amount,omitempty"`                                // Chips put in by calling, capped at the effective stack
+	CallEv               float64                `protobuf:"fixed64,5,opt,name=call_ev,json=callEv,proto3" json:"call_ev,omitempty"`                                            // Expected chips won or lost by calling, relative to folding
+	ImpliedOddsNeeded    float64                `protobuf:"fixed64,6,opt,name=implied_odds_needed,json=impliedOddsNeeded,proto3" json:"implied_odds_needed,omitempty"`         // Extra chips to win on later streets to break even (0 if already profitable)
+	ImpliedOddsAvailable bool                   `protobuf:"varint,7,opt,name=implied_odds_available,json=impliedOddsAvailable,proto3" json:"implied_odds_available,omitempty"` // The stack behind after calling covers implied_odds_needed
+	AllIn                bool                   `protobuf:"varint,8,opt,name=all_in,json=allIn,proto3" json:"all_in,omitempty"`                                                // Calling puts the hero all-in
+	Recommendation       string                 `protobuf:"bytes,9,opt,name=recommendation,proto3" json:"recommendation,omitempty"`                                            // "call" or "fold"
+	Reason               string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`                                                           // Explanation of the recommendation
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CallDecisionResponse) Reset() {
+	*x = CallDecisionResponse{}
+	mi := &file_poker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallDecisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallDecisionResponse) ProtoMessage() {}
+
+func (x *CallDecisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallDecisionResponse.ProtoReflect.Descriptor instead.
+func (*CallDecisionResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CallDecisionResponse) GetPotOdds() float64 {
+	if x != nil {
+		return x.PotOdds
+	}
+	return 0
+}
+
+func (x *CallDecisionResponse) GetRequiredEquity() float64 {
+	if x != nil {
+		return x.RequiredEquity
+	}
+	return 0
+}
+
+func (x *CallDecisionResponse) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *CallDecisionResponse) GetCallAmount() float64 {
+	if x != nil {
+		return x.CallAmount
+	}
+	return 0
+}
+
+func (x *CallDecisionResponse) GetCallEv() float64 {
+	if x != nil {
+		return x.CallEv
+	}
+	return 0
+}
+
+func (x *CallDecisionResponse) GetImpliedOddsNeeded() float64 {
+	if x != nil {
+		return x.ImpliedOddsNeeded
+	}
+	return 0
+}
+
+func (x *CallDecisionResponse) GetImpliedOddsAvailable() bool {
+	if x != nil {
+		return x.ImpliedOddsAvailable
+	}
+	return false
+}
+
+func (x *CallDecisionResponse) GetAllIn() bool {
+	if x != nil {
+		return x.AllIn
+	}
+	return false
+}
+
+func (x *CallDecisionResponse) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *CallDecisionResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Request for ICM prize equity
+type ICMRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Stacks               []float64              `protobuf:"fixed64,1,rep,packed,name=stacks,proto3" json:"stacks,omitempty"`                                                   // Chip stacks of every player still in the tournament
+	Payouts              []float64              `protobuf:"fixed64,2,rep,packed,name=payouts,proto3" json:"payouts,omitempty"`                                                 // Prize for each paid place, 1st place first
+	MonteCarloIterations int32                  `protobuf:"varint,3,opt,name=monte_carlo_iterations,json=monteCarloIterations,proto3" json:"monte_carlo_iterations,omitempty"` // Finishing orders sampled for more than 10 players (defaults to 100000)
+	AllIn                *AllInScenario         `protobuf:"bytes,4,opt,name=all_in,json=allIn,proto3" json:"all_in,omitempty"`                                                 // Optional all-in decision to evaluate
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ICMRequest) Reset() {
+	*x = ICMRequest{}
+	mi := &file_poker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ICMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICMRequest) ProtoMessage() {}
+
+func (x *ICMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICMRequest.ProtoReflect.Descriptor instead.
+func (*ICMRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ICMRequest) GetStacks() []float64 {
+	if x != nil {
+		return x.Stacks
+	}
+	return nil
+}
+
+func (x *ICMRequest) GetPayouts() []float64 {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ICMRequest) GetMonteCarloIterations() int32 {
+	if x != nil {
+		return x.MonteCarloIterations
+	}
+	return 0
+}
+
+func (x *ICMRequest) GetAllIn() *AllInScenario {
+	if x != nil {
+		return x.AllIn
+	}
+	return nil
+}
+
+// An all-in the hero can call or fold
+type AllInScenario struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hero           int32                  `protobuf:"varint,1,opt,name=hero,proto3" json:"hero,omitempty"`                                           // Index of the hero in stacks
+	HeroCards      []string               `protobuf:"bytes,2,rep,name=hero_cards,json=heroCards,proto3" json:"hero_cards,omitempty"`                 // Hero's 2 hole cards
+	Opponents      []*AllInPlayer         `protobuf:"bytes,3,rep,name=opponents,proto3" json:"opponents,omitempty"`                                  // Players all-in against the hero
+	Posted         []float64              `protobuf:"fixed64,4,rep,packed,name=posted,proto3" json:"posted,omitempty"`                               // Chips each player has already put in (blinds, antes); empty for none
+	CommunityCards []string               `protobuf:"bytes,5,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // 0, 3, 4, or 5 community cards
+	NumSimulations int32                  `protobuf:"varint,6,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Deals simulated (defaults to 10000)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AllInScenario) Reset() {
+	*x = AllInScenario{}
+	mi := &file_poker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllInScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllInScenario) ProtoMessage() {}
+
+func (x *AllInScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllInScenario.ProtoReflect.Descriptor instead.
+func (*AllInScenario) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AllInScenario) GetHero() int32 {
+	if x != nil {
+		return x.Hero
+	}
+	return 0
+}
+
+func (x *AllInScenario) GetHeroCards() []string {
+	if x != nil {
+		return x.HeroCards
+	}
+	return nil
+}
+
+func (x *AllInScenario) GetOpponents() []*AllInPlayer {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *AllInScenario) GetPosted() []float64 {
+	if x != nil {
+		return x.Posted
+	}
+	return nil
+}
+
+func (x *AllInScenario) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *AllInScenario) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+// A player all-in against the hero
+type AllInPlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`                       // Index of the player in stacks
+	HoleCards     []string               `protobuf:"bytes,2,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"` // Known hole cards
+	Range         string                 `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`                          // Hand range (e.g., "22+,A2s+,KTo+") when hole cards are unknown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllInPlayer) Reset() {
+	*x = AllInPlayer{}
+	mi := &file_poker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllInPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllInPlayer) ProtoMessage() {}
+
+func (x *AllInPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllInPlayer.ProtoReflect.Descriptor instead.
+func (*AllInPlayer) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AllInPlayer) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *AllInPlayer) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *AllInPlayer) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+// ICM prize equity for every player
+type ICMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Equities      []float64              `protobuf:"fixed64,1,rep,packed,name=equities,proto3" json:"equities,omitempty"` // Prize equity of each player, in stack order
+	Exact         bool                   `protobuf:"varint,2,opt,name=exact,proto3" json:"exact,omitempty"`               // False if the equities were estimated by Monte Carlo
+	AllIn         *AllInResult           `protobuf:"bytes,3,opt,name=all_in,json=allIn,proto3" json:"all_in,omitempty"`   // Call and fold comparison, when all_in was requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ICMResponse) Reset() {
+	*x = ICMResponse{}
+	mi := &file_poker_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ICMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICMResponse) ProtoMessage() {}
+
+func (x *ICMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICMResponse.ProtoReflect.Descriptor instead.
+func (*ICMResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ICMResponse) GetEquities() []float64 {
+	if x != nil {
+		return x.Equities
+	}
+	return nil
+}
+
+func (x *ICMResponse) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *ICMResponse) GetAllIn() *AllInResult {
+	if x != nil {
+		return x.AllIn
+	}
+	return nil
+}
+
+// Prize equity of calling versus folding an all-in
+type AllInResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HeroEquity     float64                `protobuf:"fixed64,1,opt,name=hero_equity,json=heroEquity,proto3" json:"hero_equity,omitempty"`              // Hero's expected share of the pot when calling
+	CallEv         float64                `protobuf:"fixed64,2,opt,name=call_ev,json=callEv,proto3" json:"call_ev,omitempty"`                          // Hero's prize equity if calling
+	FoldEv         float64                `protobuf:"fixed64,3,opt,name=fold_ev,json=foldEv,proto3" json:"fold_ev,omitempty"`                          // Hero's prize equity if folding
+	CallEquities   []float64              `protobuf:"fixed64,4,rep,packed,name=call_equities,json=callEquities,proto3" json:"call_equities,omitempty"` // Every player's prize equity if the hero calls
+	FoldEquities   []float64              `protobuf:"fixed64,5,rep,packed,name=fold_equities,json=foldEquities,proto3" json:"fold_equities,omitempty"` // Every player's prize equity if the hero folds
+	Recommendation string                 `protobuf:"bytes,6,opt,name=recommendation,proto3" json:"recommendation,omitempty"`                          // "call" or "fold"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AllInResult) Reset() {
+	*x = AllInResult{}
+	mi := &file_poker_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllInResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllInResult) ProtoMessage() {}
+
+func (x *AllInResult) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllInResult.ProtoReflect.Descriptor instead.
+func (*AllInResult) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AllInResult) GetHeroEquity() float64 {
+	if x != nil {
+		return x.HeroEquity
+	}
+	return 0
+}
+
+func (x *AllInResult) GetCallEv() float64 {
+	if x != nil {
+		return x.CallEv
+	}
+	return 0
+}
+
+func (x *AllInResult) GetFoldEv() float64 {
+	if x != nil {
+		return x.FoldEv
+	}
+	return 0
+}
+
+func (x *AllInResult) GetCallEquities() []float64 {
+	if x != nil {
+		return x.CallEquities
+	}
+	return nil
+}
+
+func (x *AllInResult) GetFoldEquities() []float64 {
+	if x != nil {
+		return x.FoldEquities
+	}
+	return nil
+}
+
+func (x *AllInResult) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+// Request to solve heads-up push/fold
+type PushFoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stack         float64                `protobuf:"fixed64,1,opt,name=stack,proto3" json:"stack,omitempty"`                             // Effective stack in big blinds, before blinds and antes
+	SmallBlind    float64                `protobuf:"fixed64,2,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"` // Small blind in big blinds (defaults to 0.5)
+	Ante          float64                `protobuf:"fixed64,3,opt,name=ante,proto3" json:"ante,omitempty"`                               // Ante per player in big blinds
+	Iterations    int32                  `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`                    // Fictitious play iterations (defaults to 2000)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushFoldRequest) Reset() {
+	*x = PushFoldRequest{}
+	mi := &file_poker_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushFoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFoldRequest) ProtoMessage() {}
+
+func (x *PushFoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFoldRequest.ProtoReflect.Descriptor instead.
+func (*PushFoldRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PushFoldRequest) GetStack() float64 {
+	if x != nil {
+		return x.Stack
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetSmallBlind() float64 {
+	if x != nil {
+		return x.SmallBlind
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetAnte() float64 {
+	if x != nil {
+		return x.Ante
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+// A row of a 13x13 starting hand chart
+type ChartRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*ChartCell           `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"` // 13 cells, Aces first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChartRow) Reset() {
+	*x = ChartRow{}
+	mi := &file_poker_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChartRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartRow) ProtoMessage() {}
+
+func (x *ChartRow) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartRow.ProtoReflect.Descriptor instead.
+func (*ChartRow) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChartRow) GetCells() []*ChartCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+// A starting hand class in a chart
+type ChartCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hand          string                 `protobuf:"bytes,1,opt,name=hand,proto3" json:"hand,omitempty"`             // Hand class (e.g., "AA", "AKs", "AKo")
+	Frequency     float64                `protobuf:"fixed64,2,opt,name=frequency,proto3" json:"frequency,omitempty"` // How often the hand takes the action (0.0 to 1.0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChartCell) Reset() {
+	*x = ChartCell{}
+	mi := &file_poker_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChartCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartCell) ProtoMessage() {}
+
+func (x *ChartCell) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartCell.ProtoReflect.Descriptor instead.
+func (*ChartCell) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChartCell) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *ChartCell) GetFrequency() float64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+// Nash push/fold strategies for both players
+type PushFoldResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PushChart      []*ChartRow            `protobuf:"bytes,1,rep,name=push_chart,json=pushChart,proto3" json:"push_chart,omitempty"`              // Small blind's all-in frequencies; suited hands above the diagonal
+	CallChart      []*ChartRow            `protobuf:"bytes,2,rep,name=call_chart,json=callChart,proto3" json:"call_chart,omitempty"`              // Big blind's calling frequencies against the all-in
+	PushPercent    float64                `protobuf:"fixed64,3,opt,name=push_percent,json=pushPercent,proto3" json:"push_percent,omitempty"`      // Share of starting hands pushed
+	CallPercent    float64                `protobuf:"fixed64,4,opt,name=call_percent,json=callPercent,proto3" json:"call_percent,omitempty"`      // Share of starting hands called
+	SmallBlindEv   float64                `protobuf:"fixed64,5,opt,name=small_blind_ev,json=smallBlindEv,proto3" json:"small_blind_ev,omitempty"` // Small blind's expected result in big blinds
+	Exploitability float64                `protobuf:"fixed64,6,opt,name=exploitability,proto3" json:"exploitability,omitempty"`                   // Big blinds per hand a best response gains against the solution
+	Iterations     int32                  `protobuf:"varint,7,opt,name=iterations,proto3" json:"iterations,omitempty"`                            // Fictitious play iterations run
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PushFoldResponse) Reset() {
+	*x = PushFoldResponse{}
+	mi := &file_poker_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushFoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFoldResponse) ProtoMessage() {}
+
+func (x *PushFoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFoldResponse.ProtoReflect.Descriptor instead.
+func (*PushFoldResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PushFoldResponse) GetPushChart() []*ChartRow {
+	if x != nil {
+		return x.PushChart
+	}
+	return nil
+}
+
+func (x *PushFoldResponse) GetCallChart() []*ChartRow {
+	if x != nil {
+		return x.CallChart
+	}
+	return nil
+}
+
+func (x *PushFoldResponse) GetPushPercent() float64 {
+	if x != nil {
+		return x.PushPercent
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetCallPercent() float64 {
+	if x != nil {
+		return x.CallPercent
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetSmallBlindEv() float64 {
+	if x != nil {
+		return x.SmallBlindEv
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetExploitability() float64 {
+	if x != nil {
+		return x.Exploitability
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+// Request for a street-by-street equity breakdown
+type EquityBreakdownRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Players        []*Holding             `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`                                      // 2 hole cards for each of 2 to 10 players
+	CommunityCards []string               `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // 0, 3, 4, or 5 community cards
+	NumSimulations int32                  `protobuf:"varint,3,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Boards sampled for preflop equity (defaults to 20000)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EquityBreakdownRequest) Reset() {
+	*x = EquityBreakdownRequest{}
+	mi := &file_poker_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquityBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquityBreakdownRequest) ProtoMessage() {}
+
+func (x *EquityBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquityBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*EquityBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EquityBreakdownRequest) GetPlayers() []*Holding {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *EquityBreakdownRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *EquityBreakdownRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+// Equities once a street has been dealt
+type StreetEquity struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Street           string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`                                                      // "preflop", "flop", "turn" or "river"
+	Board            []string               `protobuf:"bytes,2,rep,name=board,proto3" json:"board,omitempty"`                                                        // Community cards dealt by this street
+	Equities         []float64              `protobuf:"fixed64,3,rep,packed,name=equities,proto3" json:"equities,omitempty"`                                         // Each player's expected share of the pot
+	WinProbabilities []float64              `protobuf:"fixed64,4,rep,packed,name=win_probabilities,json=winProbabilities,proto3" json:"win_probabilities,omitempty"` // Each player's probability of winning outright
+	Hands            []string               `protobuf:"bytes,5,rep,name=hands,proto3" json:"hands,omitempty"`                                                        // Each player's current made hand (empty preflop)
+	Leaders          []int32                `protobuf:"varint,6,rep,packed,name=leaders,proto3" json:"leaders,omitempty"`                                            // Players holding the best current hand (empty preflop)
+	Exact            bool                   `protobuf:"varint,7,opt,name=exact,proto3" json:"exact,omitempty"`                                                       // True if every runout was enumerated
+	Runouts          int32                  `protobuf:"varint,8,opt,name=runouts,proto3" json:"runouts,omitempty"`                                                   // Runouts evaluated
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StreetEquity) Reset() {
+	*x = StreetEquity{}
+	mi := &file_poker_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreetEquity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreetEquity) ProtoMessage() {}
+
+func (x *StreetEquity) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreetEquity.ProtoReflect.Descriptor instead.
+func (*StreetEquity) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StreetEquity) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *StreetEquity) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetEquities() []float64 {
+	if x != nil {
+		return x.Equities
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetWinProbabilities() []float64 {
+	if x != nil {
+		return x.WinProbabilities
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetHands() []string {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetLeaders() []int32 {
+	if x != nil {
+		return x.Leaders
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *StreetEquity) GetRunouts() int32 {
+	if x != nil {
+		return x.Runouts
+	}
+	return 0
+}
+
+// Outcome of one possible next card
+type RunoutCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          string                 `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`                         // The next card
+	Equities      []float64              `protobuf:"fixed64,2,rep,packed,name=equities,proto3" json:"equities,omitempty"`        // Each player's equity after the card
+	Leaders       []int32                `protobuf:"varint,3,rep,packed,name=leaders,proto3" json:"leaders,omitempty"`           // Players holding the best hand after the card; on the river, the winners
+	BestHand      string                 `protobuf:"bytes,4,opt,name=best_hand,json=bestHand,proto3" json:"best_hand,omitempty"` // The leaders' hand
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunoutCard) Reset() {
+	*x = RunoutCard{}
+	mi := &file_poker_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunoutCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunoutCard) ProtoMessage() {}
+
+func (x *RunoutCard) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunoutCard.ProtoReflect.Descriptor instead.
+func (*RunoutCard) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RunoutCard) GetCard() string {
+	if x != nil {
+		return x.Card
+	}
+	return ""
+}
+
+func (x *RunoutCard) GetEquities() []float64 {
+	if x != nil {
+		return x.Equities
+	}
+	return nil
+}
+
+func (x *RunoutCard) GetLeaders() []int32 {
+	if x != nil {
+		return x.Leaders
+	}
+	return nil
+}
+
+func (x *RunoutCard) GetBestHand() string {
+	if x != nil {
+		return x.BestHand
+	}
+	return ""
+}
+
+// Street-by-street equity and next-card table
+type EquityBreakdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streets       []*StreetEquity        `protobuf:"bytes,1,rep,name=streets,proto3" json:"streets,omitempty"`                         // Preflop up to the last known street
+	NextStreet    string                 `protobuf:"bytes,2,opt,name=next_street,json=nextStreet,proto3" json:"next_street,omitempty"` // "turn" or "river" for the runout table, empty otherwise
+	Runouts       []*RunoutCard          `protobuf:"bytes,3,rep,name=runouts,proto3" json:"runouts,omitempty"`                         // Every possible next card on the flop or turn
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquityBreakdownResponse) Reset() {
+	*x = EquityBreakdownResponse{}
+	mi := &file_poker_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquityBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquityBreakdownResponse) ProtoMessage() {}
+
+func (x *EquityBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquityBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*EquityBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EquityBreakdownResponse) GetStreets() []*StreetEquity {
+	if x != nil {
+		return x.Streets
+	}
+	return nil
+}
+
+func (x *EquityBreakdownResponse) GetNextStreet() string {
+	if x != nil {
+		return x.NextStreet
+	}
+	return ""
+}
+
+func (x *EquityBreakdownResponse) GetRunouts() []*RunoutCard {
+	if x != nil {
+		return x.Runouts
+	}
+	return nil
+}
+
+// Request to settle a showdown
+type ShowdownRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CommunityCards []string               `protobuf:"bytes,1,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // 5 community cards (optional if only one player has not folded)
+	Players        []*ShowdownPlayer      `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`                                     // Players in seat order
+	Button         int32                  `protobuf:"varint,3,opt,name=button,proto3" json:"button,omitempty"`                                      // Index of the button; odd chips go to winners starting left of it
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShowdownRequest) Reset() {
+	*x = ShowdownRequest{}
+	mi := &file_poker_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownRequest) ProtoMessage() {}
+
+func (x *ShowdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownRequest.ProtoReflect.Descriptor instead.
+func (*ShowdownRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ShowdownRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *ShowdownRequest) GetPlayers() []*ShowdownPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ShowdownRequest) GetButton() int32 {
+	if x != nil {
+		return x.Button
+	}
+	return 0
+}
+
+// A player at showdown
+type ShowdownPlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // Name used in explanations (defaults to "player <index>")
+	HoleCards     []string               `protobuf:"bytes,2,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"` // 2 hole cards (optional for folded players)
+	Committed     int64                  `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`                 // Chips put into the pot during the hand
+	Folded        bool                   `protobuf:"varint,4,opt,name=folded,proto3" json:"folded,omitempty"`                       // True if the player folded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowdownPlayer) Reset() {
+	*x = ShowdownPlayer{}
+	mi := &file_poker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowdownPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownPlayer) ProtoMessage() {}
+
+func (x *ShowdownPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownPlayer.ProtoReflect.Descriptor instead.
+func (*ShowdownPlayer) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ShowdownPlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShowdownPlayer) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *ShowdownPlayer) GetCommitted() int64 {
+	if x != nil {
+		return x.Committed
+	}
+	return 0
+}
+
+func (x *ShowdownPlayer) GetFolded() bool {
+	if x != nil {
+		return x.Folded
+	}
+	return false
+}
+
+// Result for one player
+type ShowdownPlayerResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`      // Player name
+	Hand          *EvaluateHandResponse  `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`      // Best hand (empty for folded players)
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`     // 1 for the best hand, 0 for folded players
+	Payout        int64                  `protobuf:"varint,4,opt,name=payout,proto3" json:"payout,omitempty"` // Chips won
+	Net           int64                  `protobuf:"varint,5,opt,name=net,proto3" json:"net,omitempty"`       // Payout minus the chips committed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowdownPlayerResult) Reset() {
+	*x = ShowdownPlayerResult{}
+	mi := &file_poker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowdownPlayerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownPlayerResult) ProtoMessage() {}
+
+func (x *ShowdownPlayerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownPlayerResult.ProtoReflect.Descriptor instead.
+func (*ShowdownPlayerResult) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ShowdownPlayerResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShowdownPlayerResult) GetHand() *EvaluateHandResponse {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *ShowdownPlayerResult) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ShowdownPlayerResult) GetPayout() int64 {
+	if x != nil {
+		return x.Payout
+	}
+	return 0
+}
+
+func (x *ShowdownPlayerResult) GetNet() int64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+// How a main or side pot was awarded
+type Pot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // "main pot", "side pot 1", ...
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                             // Chips in the pot
+	Eligible      []int32                `protobuf:"varint,3,rep,packed,name=eligible,proto3" json:"eligible,omitempty"`                  // Players who could win the pot
+	Winners       []int32                `protobuf:"varint,4,rep,packed,name=winners,proto3" json:"winners,omitempty"`                    // Players who won or split the pot
+	WinningHand   string                 `protobuf:"bytes,5,opt,name=winning_hand,json=winningHand,proto3" json:"winning_hand,omitempty"` // Winning hand, empty when uncontested
+	OddChips      int64                  `protobuf:"varint,6,opt,name=odd_chips,json=oddChips,proto3" json:"odd_chips,omitempty"`         // Chips left over from an uneven split
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pot) Reset() {
+	*x = Pot{}
+	mi := &file_poker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pot) ProtoMessage() {}
+
+func (x *Pot) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pot.ProtoReflect.Descriptor instead.
+func (*Pot) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Pot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pot) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Pot) GetEligible() []int32 {
+	if x != nil {
+		return x.Eligible
+	}
+	return nil
+}
+
+func (x *Pot) GetWinners() []int32 {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *Pot) GetWinningHand() string {
+	if x != nil {
+		return x.WinningHand
+	}
+	return ""
+}
+
+func (x *Pot) GetOddChips() int64 {
+	if x != nil {
+		return x.OddChips
+	}
+	return 0
+}
+
+// Rankings, pots and payouts of a showdown
+type ShowdownResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Players       []*ShowdownPlayerResult `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`           // Results in seat order
+	Pots          []*Pot                  `protobuf:"bytes,2,rep,name=pots,proto3" json:"pots,omitempty"`                 // Main pot first, then side pots
+	Explanations  []string                `protobuf:"bytes,3,rep,name=explanations,proto3" json:"explanations,omitempty"` // One sentence per pot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowdownResponse) Reset() {
+	*x = ShowdownResponse{}
+	mi := &file_poker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownResponse) ProtoMessage() {}
+
+func (x *ShowdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownResponse.ProtoReflect.Descriptor instead.
+func (*ShowdownResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ShowdownResponse) GetPlayers() []*ShowdownPlayerResult {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ShowdownResponse) GetPots() []*Pot {
+	if x != nil {
+		return x.Pots
+	}
+	return nil
+}
+
+func (x *ShowdownResponse) GetExplanations() []string {
+	if x != nil {
+		return x.Explanations
+	}
+	return nil
+}
+
+// Request to import hand histories
+type ImportHandHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`                                      // One or more hand histories
+	IncludeHands  bool                   `protobuf:"varint,2,opt,name=include_hands,json=includeHands,proto3" json:"include_hands,omitempty"` // Return the parsed hands as well as the counts
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                                  // "pokerstars" or "phh" (PHH or PHHS); detected from the text when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHandHistoryRequest) Reset() {
+	*x = ImportHandHistoryRequest{}
+	mi := &file_poker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHandHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHandHistoryRequest) ProtoMessage() {}
+
+func (x *ImportHandHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHandHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ImportHandHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ImportHandHistoryRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ImportHandHistoryRequest) GetIncludeHands() bool {
+	if x != nil {
+		return x.IncludeHands
+	}
+	return false
+}
+
+func (x *ImportHandHistoryRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Result of a hand history import
+type ImportHandHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parsed        int32                  `protobuf:"varint,1,opt,name=parsed,proto3" json:"parsed,omitempty"`         // Hands parsed
+	Imported      int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`     // Parsed hands that were not imported before
+	Duplicates    int32                  `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"` // Parsed hands that were already imported
+	Errors        []*HandParseError      `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`          // One entry per hand that could not be parsed
+	Hands         []*HandHistory         `protobuf:"bytes,5,rep,name=hands,proto3" json:"hands,omitempty"`            // Parsed hands, when include_hands is set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHandHistoryResponse) Reset() {
+	*x = ImportHandHistoryResponse{}
+	mi := &file_poker_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHandHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHandHistoryResponse) ProtoMessage() {}
+
+func (x *ImportHandHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHandHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ImportHandHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImportHandHistoryResponse) GetParsed() int32 {
+	if x != nil {
+		return x.Parsed
+	}
+	return 0
+}
+
+func (x *ImportHandHistoryResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportHandHistoryResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportHandHistoryResponse) GetErrors() []*HandParseError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportHandHistoryResponse) GetHands() []*HandHistory {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+// Request to export stored hands
+type ExportHandHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HandIds       []string               `protobuf:"bytes,1,rep,name=hand_ids,json=handIds,proto3" json:"hand_ids,omitempty"` // Keys of the hands to export, as in "PokerStars#219876543210"; every stored hand when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportHandHistoryRequest) Reset() {
+	*x = ExportHandHistoryRequest{}
+	mi := &file_poker_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportHandHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportHandHistoryRequest) ProtoMessage() {}
+
+func (x *ExportHandHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportHandHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportHandHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExportHandHistoryRequest) GetHandIds() []string {
+	if x != nil {
+		return x.HandIds
+	}
+	return nil
+}
+
+// Stored hands in the PHH format
+type ExportHandHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`          // A PHH file for one hand, or a PHHS file with one [n] table per hand
+	Exported      int32                  `protobuf:"varint,2,opt,name=exported,proto3" json:"exported,omitempty"` // Hands written
+	Missing       []string               `protobuf:"bytes,3,rep,name=missing,proto3" json:"missing,omitempty"`    // Requested hand keys that are not stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportHandHistoryResponse) Reset() {
+	*x = ExportHandHistoryResponse{}
+	mi := &file_poker_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportHandHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportHandHistoryResponse) ProtoMessage() {}
+
+func (x *ExportHandHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportHandHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ExportHandHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExportHandHistoryResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ExportHandHistoryResponse) GetExported() int32 {
+	if x != nil {
+		return x.Exported
+	}
+	return 0
+}
+
+func (x *ExportHandHistoryResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+// Request for all-in adjusted winnings
+type AnalyzeLuckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`                  // Player to analyze; every player when empty
+	HandIds       []string               `protobuf:"bytes,2,rep,name=hand_ids,json=handIds,proto3" json:"hand_ids,omitempty"` // Keys of the hands to analyze; every stored hand when empty
+	Simulations   int32                  `protobuf:"varint,3,opt,name=simulations,proto3" json:"simulations,omitempty"`       // Boards sampled per pot of a preflop all-in (default 2000); later streets are exact
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeLuckRequest) Reset() {
+	*x = AnalyzeLuckRequest{}
+	mi := &file_poker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeLuckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeLuckRequest) ProtoMessage() {}
+
+func (x *AnalyzeLuckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeLuckRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeLuckRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AnalyzeLuckRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *AnalyzeLuckRequest) GetHandIds() []string {
+	if x != nil {
+		return x.HandIds
+	}
+	return nil
+}
+
+func (x *AnalyzeLuckRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+// Luck series of the players
+type AnalyzeLuckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*LuckSeries          `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"` // One series per player, by name
+	Missing       []string               `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"` // Requested hand IDs that are not stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeLuckResponse) Reset() {
+	*x = AnalyzeLuckResponse{}
+	mi := &file_poker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeLuckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeLuckResponse) ProtoMessage() {}
+
+func (x *AnalyzeLuckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeLuckResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeLuckResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AnalyzeLuckResponse) GetPlayers() []*LuckSeries {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *AnalyzeLuckResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+// A player's actual and all-in adjusted winnings over their hands, in time order
+type LuckSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`                                // Player name
+	Points        []*LuckPoint           `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`                                // One point per hand the player was dealt into
+	Net           float64                `protobuf:"fixed64,3,opt,name=net,proto3" json:"net,omitempty"`                                    // Chips won minus chips invested over every hand
+	AdjustedNet   float64                `protobuf:"fixed64,4,opt,name=adjusted_net,json=adjustedNet,proto3" json:"adjusted_net,omitempty"` // Total winnings with all-in hands scored by equity
+	Luck          float64                `protobuf:"fixed64,5,opt,name=luck,proto3" json:"luck,omitempty"`                                  // Net minus adjusted net
+	Hands         int32                  `protobuf:"varint,6,opt,name=hands,proto3" json:"hands,omitempty"`                                 // Hands played
+	AllInHands    int32                  `protobuf:"varint,7,opt,name=all_in_hands,json=allInHands,proto3" json:"all_in_hands,omitempty"`   // Hands the player was all-in or called an all-in before the river
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LuckSeries) Reset() {
+	*x = LuckSeries{}
+	mi := &file_poker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LuckSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LuckSeries) ProtoMessage() {}
+
+func (x *LuckSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LuckSeries.ProtoReflect.Descriptor instead.
+func (*LuckSeries) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LuckSeries) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *LuckSeries) GetPoints() []*LuckPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *LuckSeries) GetNet() float64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *LuckSeries) GetAdjustedNet() float64 {
+	if x != nil {
+		return x.AdjustedNet
+	}
+	return 0
+}
+
+func (x *LuckSeries) GetLuck() float64 {
+	if x != nil {
+		return x.Luck
+	}
+	return 0
+}
+
+func (x *LuckSeries) GetHands() int32 {
+	if x != nil {
+		return x.Hands
+	}
+	return 0
+}
+
+func (x *LuckSeries) GetAllInHands() int32 {
+	if x != nil {
+		return x.AllInHands
+	}
+	return 0
+}
+
+// One hand of a luck series
+type LuckPoint struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	HandId                string                 `protobuf:"bytes,1,opt,name=hand_id,json=handId,proto3" json:"hand_id,omitempty"`                                                  // Hand number
+	Time                  string                 `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`                                                                    // Start of the hand (RFC 3339), empty when unknown
+	Net                   float64                `protobuf:"fixed64,3,opt,name=net,proto3" json:"net,omitempty"`                                                                    // Chips won minus chips invested
+	AdjustedNet           float64                `protobuf:"fixed64,4,opt,name=adjusted_net,json=adjustedNet,proto3" json:"adjusted_net,omitempty"`                                 // Chips expected at the all-in minus chips invested; net when there was no all-in
+	AllIn                 bool                   `protobuf:"varint,5,opt,name=all_in,json=allIn,proto3" json:"all_in,omitempty"`                                                    // The player was in an all-in before the river
+	Equity                float64                `protobuf:"fixed64,6,opt,name=equity,proto3" json:"equity,omitempty"`                                                              // Share of the main pot at the all-in
+	Street                string                 `protobuf:"bytes,7,opt,name=street,proto3" json:"street,omitempty"`                                                                // Street of the all-in: "preflop", "flop" or "turn"
+	CumulativeNet         float64                `protobuf:"fixed64,8,opt,name=cumulative_net,json=cumulativeNet,proto3" json:"cumulative_net,omitempty"`                           // Net over this hand and every earlier one
+	CumulativeAdjustedNet float64                `protobuf:"fixed64,9,opt,name=cumulative_adjusted_net,json=cumulativeAdjustedNet,proto3" json:"cumulative_adjusted_net,omitempty"` // Adjusted net over this hand and every earlier one
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LuckPoint) Reset() {
+	*x = LuckPoint{}
+	mi := &file_poker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LuckPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LuckPoint) ProtoMessage() {}
+
+func (x *LuckPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LuckPoint.ProtoReflect.Descriptor instead.
+func (*LuckPoint) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LuckPoint) GetHandId() string {
+	if x != nil {
+		return x.HandId
+	}
+	return ""
+}
+
+func (x *LuckPoint) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *LuckPoint) GetNet() float64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *LuckPoint) GetAdjustedNet() float64 {
+	if x != nil {
+		return x.AdjustedNet
+	}
+	return 0
+}
+
+func (x *LuckPoint) GetAllIn() bool {
+	if x != nil {
+		return x.AllIn
+	}
+	return false
+}
+
+func (x *LuckPoint) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *LuckPoint) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *LuckPoint) GetCumulativeNet() float64 {
+	if x != nil {
+		return x.CumulativeNet
+	}
+	return 0
+}
+
+func (x *LuckPoint) GetCumulativeAdjustedNet() float64 {
+	if x != nil {
+		return x.CumulativeAdjustedNet
+	}
+	return 0
+}
+
+// Filters for player statistics; empty fields match every hand
+type PlayerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`     // Only this player
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`         // Hands started at or after this time (RFC 3339, or a date such as "2024-03-01")
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`             // Hands started before this time, or on or before this date
+	Stakes        string                 `protobuf:"bytes,4,opt,name=stakes,proto3" json:"stakes,omitempty"`     // Small blind/big blind, such as "0.50/1"
+	Position      string                 `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"` // Only hands played from this position: "UTG", "UTG+1", ..., "LJ", "HJ", "CO", "BTN", "SB" or "BB"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerStatsRequest) Reset() {
+	*x = PlayerStatsRequest{}
+	mi := &file_poker_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStatsRequest) ProtoMessage() {}
+
+func (x *PlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*PlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PlayerStatsRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *PlayerStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PlayerStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PlayerStatsRequest) GetStakes() string {
+	if x != nil {
+		return x.Stakes
+	}
+	return ""
+}
+
+func (x *PlayerStatsRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+// Statistics of the players in the selected hands
+type PlayerStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*PlayerStats         `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"` // One entry per player, by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerStatsResponse) Reset() {
+	*x = PlayerStatsResponse{}
+	mi := &file_poker_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStatsResponse) ProtoMessage() {}
+
+func (x *PlayerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStatsResponse.ProtoReflect.Descriptor instead.
+func (*PlayerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PlayerStatsResponse) GetPlayers() []*PlayerStats {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// How often a player did something out of the times they could have
+type StatRatio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`                 // Times the player did it
+	Opportunities int32                  `protobuf:"varint,2,opt,name=opportunities,proto3" json:"opportunities,omitempty"` // Times the player could have
+	Percent       float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`            // Count as a percentage of the opportunities
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatRatio) Reset() {
+	*x = StatRatio{}
+	mi := &file_poker_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatRatio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatRatio) ProtoMessage() {}
+
+func (x *StatRatio) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatRatio.ProtoReflect.Descriptor instead.
+func (*StatRatio) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StatRatio) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatRatio) GetOpportunities() int32 {
+	if x != nil {
+		return x.Opportunities
+	}
+	return 0
+}
+
+func (x *StatRatio) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// A player's statistics over every position, or one position
+type PlayerStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Player            string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`                                                  // Player name
+	Position          string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`                                              // Position, empty for the statistics over every position
+	Hands             int32                  `protobuf:"varint,3,opt,name=hands,proto3" json:"hands,omitempty"`                                                   // Hands dealt in
+	Vpip              *StatRatio             `protobuf:"bytes,4,opt,name=vpip,proto3" json:"vpip,omitempty"`                                                      // Put chips in the pot voluntarily preflop
+	Pfr               *StatRatio             `protobuf:"bytes,5,opt,name=pfr,proto3" json:"pfr,omitempty"`                                                        // Raised preflop
+	ThreeBet          *StatRatio             `protobuf:"bytes,6,opt,name=three_bet,json=threeBet,proto3" json:"three_bet,omitempty"`                              // Re-raised a single preflop raise
+	FoldToThreeBet    *StatRatio             `protobuf:"bytes,7,opt,name=fold_to_three_bet,json=foldToThreeBet,proto3" json:"fold_to_three_bet,omitempty"`        // Folded to a re-raise after raising first
+	Cbet              *StatRatio             `protobuf:"bytes,8,opt,name=cbet,proto3" json:"cbet,omitempty"`                                                      // Bet the flop as the last preflop raiser
+	Wtsd              *StatRatio             `protobuf:"bytes,9,opt,name=wtsd,proto3" json:"wtsd,omitempty"`                                                      // Went to showdown after seeing the flop
+	Wsd               *StatRatio             `protobuf:"bytes,10,opt,name=wsd,proto3" json:"wsd,omitempty"`                                                       // Won chips at showdown
+	AggressionFactor  float64                `protobuf:"fixed64,11,opt,name=aggression_factor,json=aggressionFactor,proto3" json:"aggression_factor,omitempty"`   // Postflop bets and raises per call
+	AggressiveActions int32                  `protobuf:"varint,12,opt,name=aggressive_actions,json=aggressiveActions,proto3" json:"aggressive_actions,omitempty"` // Postflop bets and raises
+	Calls             int32                  `protobuf:"varint,13,opt,name=calls,proto3" json:"calls,omitempty"`                                                  // Postflop calls
+	Net               float64                `protobuf:"fixed64,14,opt,name=net,proto3" json:"net,omitempty"`                                                     // Chips won
+	BbPer_100         float64                `protobuf:"fixed64,15,opt,name=bb_per_100,json=bbPer100,proto3" json:"bb_per_100,omitempty"`                         // Big blinds won per 100 hands
+	Positions         []*PlayerStats         `protobuf:"bytes,16,rep,name=positions,proto3" json:"positions,omitempty"`                                           // Statistics per position, in preflop order
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	mi := &file_poker_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PlayerStats) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetHands() int32 {
+	if x != nil {
+		return x.Hands
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetVpip() *StatRatio {
+	if x != nil {
+		return x.Vpip
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetPfr() *StatRatio {
+	if x != nil {
+		return x.Pfr
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetThreeBet() *StatRatio {
+	if x != nil {
+		return x.ThreeBet
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetFoldToThreeBet() *StatRatio {
+	if x != nil {
+		return x.FoldToThreeBet
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetCbet() *StatRatio {
+	if x != nil {
+		return x.Cbet
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetWtsd() *StatRatio {
+	if x != nil {
+		return x.Wtsd
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetWsd() *StatRatio {
+	if x != nil {
+		return x.Wsd
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetAggressionFactor() float64 {
+	if x != nil {
+		return x.AggressionFactor
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAggressiveActions() int32 {
+	if x != nil {
+		return x.AggressiveActions
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetCalls() int32 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetNet() float64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBbPer_100() float64 {
+	if x != nil {
+		return x.BbPer_100
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetPositions() []*PlayerStats {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+// A provably fair shuffle to check after the hand
+type VerifyShuffleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServerSeed     string                 `protobuf:"bytes,1,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"`               // Seed revealed after the hand (hex)
+	ServerSeedHash string                 `protobuf:"bytes,2,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"` // SHA-256 hash of the seed published before the hand (hex)
+	ClientSeeds    []string               `protobuf:"bytes,3,rep,name=client_seeds,json=clientSeeds,proto3" json:"client_seeds,omitempty"`            // Client seeds of the players dealt in, in seat order
+	Nonce          int64                  `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`                                          // Hand number
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifyShuffleRequest) Reset() {
+	*x = VerifyShuffleRequest{}
+	mi := &file_poker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyShuffleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyShuffleRequest) ProtoMessage() {}
+
+func (x *VerifyShuffleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyShuffleRequest.ProtoReflect.Descriptor instead.
+func (*VerifyShuffleRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VerifyShuffleRequest) GetServerSeed() string {
+	if x != nil {
+		return x.ServerSeed
+	}
+	return ""
+}
+
+func (x *VerifyShuffleRequest) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *VerifyShuffleRequest) GetClientSeeds() []string {
+	if x != nil {
+		return x.ClientSeeds
+	}
+	return nil
+}
+
+func (x *VerifyShuffleRequest) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+// Whether the shuffle checks out, and the deck it dealt
+type VerifyShuffleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"` // The seed matches the hash
+	Deck          []string               `protobuf:"bytes,2,rep,name=deck,proto3" json:"deck,omitempty"`    // Deck order, first card dealt first, when valid
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`  // Why the shuffle does not check out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyShuffleResponse) Reset() {
+	*x = VerifyShuffleResponse{}
+	mi := &file_poker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyShuffleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyShuffleResponse) ProtoMessage() {}
+
+func (x *VerifyShuffleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyShuffleResponse.ProtoReflect.Descriptor instead.
+func (*VerifyShuffleResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VerifyShuffleResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyShuffleResponse) GetDeck() []string {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+func (x *VerifyShuffleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Request for the result cache statistics
+type CacheStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheStatsRequest) Reset() {
+	*x = CacheStatsRequest{}
+	mi := &file_poker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStatsRequest) ProtoMessage() {}
+
+func (x *CacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*CacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{50}
+}
+
+// Statistics of every result cache
+type CacheStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caches        []*CacheStats          `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheStatsResponse) Reset() {
+	*x = CacheStatsResponse{}
+	mi := &file_poker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStatsResponse) ProtoMessage() {}
+
+func (x *CacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*CacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CacheStatsResponse) GetCaches() []*CacheStats {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+// Size and use of one result cache
+type CacheStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                // "evaluation" or "probability"
+	Entries       int32                  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`                         // Results held now
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`                       // Most results held at once; the least recently used is dropped first
+	TtlSeconds    int64                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // How long a result is served after it is stored
+	Hits          uint64                 `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses        uint64                 `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions     uint64                 `protobuf:"varint,7,opt,name=evictions,proto3" json:"evictions,omitempty"`             // Results dropped to make room
+	Expirations   uint64                 `protobuf:"varint,8,opt,name=expirations,proto3" json:"expirations,omitempty"`         // Results dropped because they outlived the TTL
+	HitRate       float64                `protobuf:"fixed64,9,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"` // Share of lookups that were hits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	mi := &file_poker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CacheStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheStats) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *CacheStats) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CacheStats) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CacheStats) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheStats) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheStats) GetEvictions() uint64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+func (x *CacheStats) GetExpirations() uint64 {
+	if x != nil {
+		return x.Expirations
+	}
+	return 0
+}
+
+func (x *CacheStats) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+// Request to evaluate many hands
+type BatchEvaluateHandsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hands         []*EvaluateHandRequest `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"` // Up to 10000 hands; stream larger batches
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchEvaluateHandsRequest) Reset() {
+	*x = BatchEvaluateHandsRequest{}
+	mi := &file_poker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchEvaluateHandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEvaluateHandsRequest) ProtoMessage() {}
+
+func (x *BatchEvaluateHandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEvaluateHandsRequest.ProtoReflect.Descriptor instead.
+func (*BatchEvaluateHandsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BatchEvaluateHandsRequest) GetHands() []*EvaluateHandRequest {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+// Results of a batch of evaluations, in the order of the hands
+type BatchEvaluateHandsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*EvaluateHandResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Errors        int32                  `protobuf:"varint,2,opt,name=errors,proto3" json:"errors,omitempty"` // Number of hands that could not be evaluated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchEvaluateHandsResponse) Reset() {
+	*x = BatchEvaluateHandsResponse{}
+	mi := &file_poker_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchEvaluateHandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEvaluateHandsResponse) ProtoMessage() {}
+
+func (x *BatchEvaluateHandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEvaluateHandsResponse.ProtoReflect.Descriptor instead.
+func (*BatchEvaluateHandsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchEvaluateHandsResponse) GetResults() []*EvaluateHandResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchEvaluateHandsResponse) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+// Evaluation of one hand of a batch
+type EvaluateHandResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position of the hand in the batch, from 0
+	Hand          *EvaluateHandResponse  `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`    // Set when the hand was evaluated
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`  // Why the hand could not be evaluated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateHandResult) Reset() {
+	*x = EvaluateHandResult{}
+	mi := &file_poker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateHandResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateHandResult) ProtoMessage() {}
+
+func (x *EvaluateHandResult) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateHandResult.ProtoReflect.Descriptor instead.
+func (*EvaluateHandResult) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EvaluateHandResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EvaluateHandResult) GetHand() *EvaluateHandResponse {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *EvaluateHandResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Request to compare many pairs of hands
+type BatchCompareHandsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comparisons   []*CompareHandsRequest `protobuf:"bytes,1,rep,name=comparisons,proto3" json:"comparisons,omitempty"` // Up to 10000 pairs; stream larger batches
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCompareHandsRequest) Reset() {
+	*x = BatchCompareHandsRequest{}
+	mi := &file_poker_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCompareHandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCompareHandsRequest) ProtoMessage() {}
+
+func (x *BatchCompareHandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCompareHandsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCompareHandsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BatchCompareHandsRequest) GetComparisons() []*CompareHandsRequest {
+	if x != nil {
+		return x.Comparisons
+	}
+	return nil
+}
+
+// Results of a batch of comparisons, in the order of the pairs
+type BatchCompareHandsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*CompareHandsResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Errors        int32                  `protobuf:"varint,2,opt,name=errors,proto3" json:"errors,omitempty"` // Number of pairs that could not be compared
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCompareHandsResponse) Reset() {
+	*x = BatchCompareHandsResponse{}
+	mi := &file_poker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCompareHandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCompareHandsResponse) ProtoMessage() {}
+
+func (x *BatchCompareHandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCompareHandsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCompareHandsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BatchCompareHandsResponse) GetResults() []*CompareHandsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchCompareHandsResponse) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+// Comparison of one pair of hands of a batch
+type CompareHandsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`          // Position of the pair in the batch, from 0
+	Comparison    *CompareHandsResponse  `protobuf:"bytes,2,opt,name=comparison,proto3" json:"comparison,omitempty"` // Set when the hands were compared
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`           // Why the hands could not be compared
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareHandsResult) Reset() {
+	*x = CompareHandsResult{}
+	mi := &file_poker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareHandsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareHandsResult) ProtoMessage() {}
+
+func (x *CompareHandsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareHandsResult.ProtoReflect.Descriptor instead.
+func (*CompareHandsResult) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CompareHandsResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CompareHandsResult) GetComparison() *CompareHandsResponse {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *CompareHandsResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Why a hand could not be parsed
+type HandParseError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                // Position of the hand in the text, from 0
+	HandId        string                 `protobuf:"bytes,2,opt,name=hand_id,json=handId,proto3" json:"hand_id,omitempty"` // Hand number, when the header could be read
+	Line          int32                  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`                  // Line of the text with the problem, from 1
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                 // What went wrong
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandParseError) Reset() {
+	*x = HandParseError{}
+	mi := &file_poker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandParseError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandParseError) ProtoMessage() {}
+
+func (x *HandParseError) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandParseError.ProtoReflect.Descriptor instead.
+func (*HandParseError) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *HandParseError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *HandParseError) GetHandId() string {
+	if x != nil {
+		return x.HandId
+	}
+	return ""
+}
+
+func (x *HandParseError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *HandParseError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A parsed hand
+type HandHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Site          string                 `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`                                     // e.g. "PokerStars"
+	HandId        string                 `protobuf:"bytes,2,opt,name=hand_id,json=handId,proto3" json:"hand_id,omitempty"`                   // Hand number assigned by the site
+	TournamentId  string                 `protobuf:"bytes,3,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"` // Tournament number, empty for cash games
+	Game          string                 `protobuf:"bytes,4,opt,name=game,proto3" json:"game,omitempty"`                                     // e.g. "Hold'em No Limit"
+	Structure     string                 `protobuf:"bytes,5,opt,name=structure,proto3" json:"structure,omitempty"`                           // "no-limit", "pot-limit" or "fixed-limit"
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                             // e.g. "USD", empty for tournament chips
+	SmallBlind    float64                `protobuf:"fixed64,7,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"`     // Small blind
+	BigBlind      float64                `protobuf:"fixed64,8,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`           // Big blind
+	Ante          float64                `protobuf:"fixed64,9,opt,name=ante,proto3" json:"ante,omitempty"`                                   // Ante
+	Time          string                 `protobuf:"bytes,10,opt,name=time,proto3" json:"time,omitempty"`                                    // Start of the hand (RFC 3339, in the site's time zone)
+	Table         string                 `protobuf:"bytes,11,opt,name=table,proto3" json:"table,omitempty"`                                  // Table name
+	MaxSeats      int32                  `protobuf:"varint,12,opt,name=max_seats,json=maxSeats,proto3" json:"max_seats,omitempty"`           // Seats at the table
+	Button        int32                  `protobuf:"varint,13,opt,name=button,proto3" json:"button,omitempty"`                               // Seat number of the button
+	Seats         []*HandHistorySeat     `protobuf:"bytes,14,rep,name=seats,proto3" json:"seats,omitempty"`                                  // Players in seat order
+	Actions       []*HandHistoryAction   `protobuf:"bytes,15,rep,name=actions,proto3" json:"actions,omitempty"`                              // Everything that happened, in order
+	Board         []string               `protobuf:"bytes,16,rep,name=board,proto3" json:"board,omitempty"`                                  // Community cards
+	TotalPot      float64                `protobuf:"fixed64,17,opt,name=total_pot,json=totalPot,proto3" json:"total_pot,omitempty"`          // Chips in the pot, including rake
+	Rake          float64                `protobuf:"fixed64,18,opt,name=rake,proto3" json:"rake,omitempty"`                                  // Rake taken
+	Key           string                 `protobuf:"bytes,19,opt,name=key,proto3" json:"key,omitempty"`                                      // Site and hand number, as in "PokerStars#219876543210", to export or analyze the hand
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandHistory) Reset() {
+	*x = HandHistory{}
+	mi := &file_poker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandHistory) ProtoMessage() {}
+
+func (x *HandHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandHistory.ProtoReflect.Descriptor instead.
+func (*HandHistory) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *HandHistory) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *HandHistory) GetHandId() string {
+	if x != nil {
+		return x.HandId
+	}
+	return ""
+}
+
+func (x *HandHistory) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *HandHistory) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *HandHistory) GetStructure() string {
+	if x != nil {
+		return x.Structure
+	}
+	return ""
+}
+
+func (x *HandHistory) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *HandHistory) GetSmallBlind() float64 {
+	if x != nil {
+		return x.SmallBlind
+	}
+	return 0
+}
+
+func (x *HandHistory) GetBigBlind() float64 {
+	if x != nil {
+		return x.BigBlind
+	}
+	return 0
+}
+
+func (x *HandHistory) GetAnte() float64 {
+	if x != nil {
+		return x.Ante
+	}
+	return 0
+}
+
+func (x *HandHistory) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *HandHistory) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *HandHistory) GetMaxSeats() int32 {
+	if x != nil {
+		return x.MaxSeats
+	}
+	return 0
+}
+
+func (x *HandHistory) GetButton() int32 {
+	if x != nil {
+		return x.Button
+	}
+	return 0
+}
+
+func (x *HandHistory) GetSeats() []*HandHistorySeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *HandHistory) GetActions() []*HandHistoryAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *HandHistory) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *HandHistory) GetTotalPot() float64 {
+	if x != nil {
+		return x.TotalPot
+	}
+	return 0
+}
+
+func (x *HandHistory) GetRake() float64 {
+	if x != nil {
+		return x.Rake
+	}
+	return 0
+}
+
+func (x *HandHistory) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// A player in a hand history
+type HandHistorySeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seat          int32                  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`                               // Seat number
+	Player        string                 `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`                            // Player name
+	Stack         float64                `protobuf:"fixed64,3,opt,name=stack,proto3" json:"stack,omitempty"`                            // Chips at the start of the hand
+	HoleCards     []string               `protobuf:"bytes,4,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`     // Hole cards, when known
+	SittingOut    bool                   `protobuf:"varint,5,opt,name=sitting_out,json=sittingOut,proto3" json:"sitting_out,omitempty"` // Not dealt in
+	Net           float64                `protobuf:"fixed64,6,opt,name=net,proto3" json:"net,omitempty"`                                // Chips won minus chips invested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandHistorySeat) Reset() {
+	*x = HandHistorySeat{}
+	mi := &file_poker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandHistorySeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandHistorySeat) ProtoMessage() {}
+
+func (x *HandHistorySeat) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandHistorySeat.ProtoReflect.Descriptor instead.
+func (*HandHistorySeat) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *HandHistorySeat) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *HandHistorySeat) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *HandHistorySeat) GetStack() float64 {
+	if x != nil {
+		return x.Stack
+	}
+	return 0
+}
+
+func (x *HandHistorySeat) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *HandHistorySeat) GetSittingOut() bool {
+	if x != nil {
+		return x.SittingOut
+	}
+	return false
+}
+
+func (x *HandHistorySeat) GetNet() float64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+// One action in a hand history
+type HandHistoryAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`             // "preflop", "flop", "turn", "river" or "showdown"
+	Player        string                 `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`             // Player name
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                 // e.g. "post_small_blind", "fold", "call", "raise", "uncalled_return", "show", "collect"
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`           // Chips posted, called, bet, returned or collected; the total bet for a raise
+	AllIn         bool                   `protobuf:"varint,5,opt,name=all_in,json=allIn,proto3" json:"all_in,omitempty"` // The action put the player all-in
+	Cards         []string               `protobuf:"bytes,6,rep,name=cards,proto3" json:"cards,omitempty"`               // Cards shown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandHistoryAction) Reset() {
+	*x = HandHistoryAction{}
+	mi := &file_poker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandHistoryAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandHistoryAction) ProtoMessage() {}
+
+func (x *HandHistoryAction) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandHistoryAction.ProtoReflect.Descriptor instead.
+func (*HandHistoryAction) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *HandHistoryAction) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *HandHistoryAction) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *HandHistoryAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HandHistoryAction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *HandHistoryAction) GetAllIn() bool {
+	if x != nil {
+		return x.AllIn
+	}
+	return false
+}
+
+func (x *HandHistoryAction) GetCards() []string {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// A command from a player at a table: exactly one of the fields is set
+type TableCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Command:
+	//
+	//	*TableCommand_Join
+	//	*TableCommand_Action
+	//	*TableCommand_Leave
+	//	*TableCommand_ClientSeed
+	Command       isTableCommand_Command `protobuf_oneof:"command"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableCommand) Reset() {
+	*x = TableCommand{}
+	mi := &file_poker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableCommand) ProtoMessage() {}
+
+func (x *TableCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableCommand.ProtoReflect.Descriptor instead.
+func (*TableCommand) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TableCommand) GetCommand() isTableCommand_Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *TableCommand) GetJoin() *JoinTable {
+	if x != nil {
+		if x, ok := x.Command.(*TableCommand_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *TableCommand) GetAction() *TableAction {
+	if x != nil {
+		if x, ok := x.Command.(*TableCommand_Action); ok {
+			return x.Action
+		}
+	}
+	return nil
+}
+
+func (x *TableCommand) GetLeave() *LeaveTable {
+	if x != nil {
+		if x, ok := x.Command.(*TableCommand_Leave); ok {
+			return x.Leave
+		}
+	}
+	return nil
+}
+
+func (x *TableCommand) GetClientSeed() *SetClientSeed {
+	if x != nil {
+		if x, ok := x.Command.(*TableCommand_ClientSeed); ok {
+			return x.ClientSeed
+		}
+	}
+	return nil
+}
+
+type isTableCommand_Command interface {
+	isTableCommand_Command()
+}
+
+type TableCommand_Join struct {
+	Join *JoinTable `protobuf:"bytes,1,opt,name=join,proto3,oneof"` // Must be the first command
+}
+
+type TableCommand_Action struct {
+	Action *TableAction `protobuf:"bytes,2,opt,name=action,proto3,oneof"` // Act when it is the player's turn
+}
+
+type TableCommand_Leave struct {
+	Leave *LeaveTable `protobuf:"bytes,3,opt,name=leave,proto3,oneof"` // Leave the table (folding any hand in progress)
+}
+
+type TableCommand_ClientSeed struct {
+	ClientSeed *SetClientSeed `protobuf:"bytes,4,opt,name=client_seed,json=clientSeed,proto3,oneof"` // Change the player's client seed from the next hand
+}
+
+func (*TableCommand_Join) isTableCommand_Command() {}
+
+func (*TableCommand_Action) isTableCommand_Command() {}
+
+func (*TableCommand_Leave) isTableCommand_Command() {}
+
+func (*TableCommand_ClientSeed) isTableCommand_Command() {}
+
+// Request to take a seat
+type JoinTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`          // Table to join (default "main"); created with config if it does not exist
+	Seat          *int32                 `protobuf:"varint,2,opt,name=seat,proto3,oneof" json:"seat,omitempty"`                        // Seat to take (default the first empty seat)
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                               // Player name shown to the table
+	BuyIn         int64                  `protobuf:"varint,4,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`               // Starting stack (default 100 big blinds)
+	Config        *TableConfig           `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`                           // Settings for a new table, ignored when the table exists
+	ClientSeed    string                 `protobuf:"bytes,6,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"` // The player's contribution to every shuffle, up to 256 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTable) Reset() {
+	*x = JoinTable{}
+	mi := &file_poker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTable) ProtoMessage() {}
+
+func (x *JoinTable) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTable.ProtoReflect.Descriptor instead.
+func (*JoinTable) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *JoinTable) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *JoinTable) GetSeat() int32 {
+	if x != nil && x.Seat != nil {
+		return *x.Seat
+	}
+	return 0
+}
+
+func (x *JoinTable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JoinTable) GetBuyIn() int64 {
+	if x != nil {
+		return x.BuyIn
+	}
+	return 0
+}
+
+func (x *JoinTable) GetConfig() *TableConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *JoinTable) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+// Stakes and rules of a table
+type TableConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seats         int32                  `protobuf:"varint,1,opt,name=seats,proto3" json:"seats,omitempty"`                                // 2 to 10 seats (default 6)
+	SmallBlind    int64                  `protobuf:"varint,2,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"`    // Small blind (default 1)
+	BigBlind      int64                  `protobuf:"varint,3,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`          // Big blind (default 2)
+	Ante          int64                  `protobuf:"varint,4,opt,name=ante,proto3" json:"ante,omitempty"`                                  // Ante posted by every player
+	Structure     string                 `protobuf:"bytes,5,opt,name=structure,proto3" json:"structure,omitempty"`                         // "no-limit" (default), "pot-limit" or "fixed-limit"
+	TurnSeconds   int32                  `protobuf:"varint,6,opt,name=turn_seconds,json=turnSeconds,proto3" json:"turn_seconds,omitempty"` // Time to act (default 30)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableConfig) Reset() {
+	*x = TableConfig{}
+	mi := &file_poker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableConfig) ProtoMessage() {}
+
+func (x *TableConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableConfig.ProtoReflect.Descriptor instead.
+func (*TableConfig) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TableConfig) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *TableConfig) GetSmallBlind() int64 {
+	if x != nil {
+		return x.SmallBlind
+	}
+	return 0
+}
+
+func (x *TableConfig) GetBigBlind() int64 {
+	if x != nil {
+		return x.BigBlind
+	}
+	return 0
+}
+
+func (x *TableConfig) GetAnte() int64 {
+	if x != nil {
+		return x.Ante
+	}
+	return 0
+}
+
+func (x *TableConfig) GetStructure() string {
+	if x != nil {
+		return x.Structure
+	}
+	return ""
+}
+
+func (x *TableConfig) GetTurnSeconds() int32 {
+	if x != nil {
+		return x.TurnSeconds
+	}
+	return 0
+}
+
+// A player's decision
+type TableAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`      // "fold", "check", "call", "bet" or "raise"
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Total bet on the street after a bet or raise ("raise to")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableAction) Reset() {
+	*x = TableAction{}
+	mi := &file_poker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableAction) ProtoMessage() {}
+
+func (x *TableAction) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableAction.ProtoReflect.Descriptor instead.
+func (*TableAction) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TableAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TableAction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Request to leave the table
+type LeaveTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveTable) Reset() {
+	*x = LeaveTable{}
+	mi := &file_poker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTable) ProtoMessage() {}
+
+func (x *LeaveTable) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTable.ProtoReflect.Descriptor instead.
+func (*LeaveTable) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{67}
+}
+
+// Request to change the player's client seed
+type SetClientSeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seed          string                 `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"` // New client seed, up to 256 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClientSeed) Reset() {
+	*x = SetClientSeed{}
+	mi := &file_poker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClientSeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClientSeed) ProtoMessage() {}
+
+func (x *SetClientSeed) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClientSeed.ProtoReflect.Descriptor instead.
+func (*SetClientSeed) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetClientSeed) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+// Something that happened at the table, sent to one player
+type TableUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // A game event ("hand_started", "ante", "small_blind", "big_blind", "hole_cards", "action", "board",
+	// "showdown", "pot_awarded", "hand_ended"), or "joined", "turn", "timeout", "left", "client_seed",
+	// "shuffle", "seed_revealed" or "error"
+	Seat           int32         `protobuf:"varint,2,opt,name=seat,proto3" json:"seat,omitempty"`                                             // Seat the update is about, or -1
+	Action         string        `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                                          // The action, for "action" and "timeout"
+	Amount         int64         `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                         // Chips posted, called, bet or raised to, or won
+	Cards          []string      `protobuf:"bytes,5,rep,name=cards,proto3" json:"cards,omitempty"`                                            // Hole cards, new board cards or cards shown down
+	Text           string        `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`                                              // Human-readable description
+	State          *TableState   `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`                                            // The table as the player sees it when the update is sent
+	Legal          *LegalActions `protobuf:"bytes,8,opt,name=legal,proto3" json:"legal,omitempty"`                                            // Actions available, for "turn"
+	DeadlineUnixMs int64         `protobuf:"varint,9,opt,name=deadline_unix_ms,json=deadlineUnixMs,proto3" json:"deadline_unix_ms,omitempty"` // When the turn times out, for "turn"
+	Error          string        `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                                           // Why a command was rejected, for "error"
+	Fairness       *HandFairness `protobuf:"bytes,11,opt,name=fairness,proto3" json:"fairness,omitempty"`                                     // The shuffle, for "shuffle" and "seed_revealed"; the next hash for "joined"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TableUpdate) Reset() {
+	*x = TableUpdate{}
+	mi := &file_poker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableUpdate) ProtoMessage() {}
+
+func (x *TableUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableUpdate.ProtoReflect.Descriptor instead.
+func (*TableUpdate) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TableUpdate) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TableUpdate) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *TableUpdate) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TableUpdate) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TableUpdate) GetCards() []string {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *TableUpdate) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TableUpdate) GetState() *TableState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *TableUpdate) GetLegal() *LegalActions {
+	if x != nil {
+		return x.Legal
+	}
+	return nil
+}
+
+func (x *TableUpdate) GetDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.DeadlineUnixMs
+	}
+	return 0
+}
+
+func (x *TableUpdate) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TableUpdate) GetFairness() *HandFairness {
+	if x != nil {
+		return x.Fairness
+	}
+	return nil
+}
+
+// The commit-reveal record of a hand's shuffle
+type HandFairness struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HandNumber         int32                  `protobuf:"varint,1,opt,name=hand_number,json=handNumber,proto3" json:"hand_number,omitempty"`                            // Hand the shuffle dealt
+	ServerSeedHash     string                 `protobuf:"bytes,2,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"`               // SHA-256 hash of the hand's server seed, published as the next hash before it
+	ServerSeed         string                 `protobuf:"bytes,3,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"`                             // The server seed, once revealed after the hand
+	ClientSeeds        []string               `protobuf:"bytes,4,rep,name=client_seeds,json=clientSeeds,proto3" json:"client_seeds,omitempty"`                          // Client seeds of the players dealt in, in seat order
+	Nonce              int64                  `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`                                                        // Hand number mixed into the shuffle
+	NextServerSeedHash string                 `protobuf:"bytes,6,opt,name=next_server_seed_hash,json=nextServerSeedHash,proto3" json:"next_server_seed_hash,omitempty"` // Hash committing to the next hand's server seed
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HandFairness) Reset() {
+	*x = HandFairness{}
+	mi := &file_poker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandFairness) ProtoMessage() {}
+
+func (x *HandFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandFairness.ProtoReflect.Descriptor instead.
+func (*HandFairness) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *HandFairness) GetHandNumber() int32 {
+	if x != nil {
+		return x.HandNumber
+	}
+	return 0
+}
+
+func (x *HandFairness) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *HandFairness) GetServerSeed() string {
+	if x != nil {
+		return x.ServerSeed
+	}
+	return ""
+}
+
+func (x *HandFairness) GetClientSeeds() []string {
+	if x != nil {
+		return x.ClientSeeds
+	}
+	return nil
+}
+
+func (x *HandFairness) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *HandFairness) GetNextServerSeedHash() string {
+	if x != nil {
+		return x.NextServerSeedHash
+	}
+	return ""
+}
+
+// The table as seen by one player
+type TableState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`           // Table name
+	HandNumber    int32                  `protobuf:"varint,2,opt,name=hand_number,json=handNumber,proto3" json:"hand_number,omitempty"` // Current or last hand, 0 before the first
+	Street        string                 `protobuf:"bytes,3,opt,name=street,proto3" json:"street,omitempty"`                            // "preflop", "flop", "turn", "river" or "showdown"
+	Button        int32                  `protobuf:"varint,4,opt,name=button,proto3" json:"button,omitempty"`                           // Button seat, or -1 before the first hand
+	Board         []string               `protobuf:"bytes,5,rep,name=board,proto3" json:"board,omitempty"`                              // Community cards
+	Pot           int64                  `protobuf:"varint,6,opt,name=pot,proto3" json:"pot,omitempty"`                                 // All chips committed in the hand
+	CurrentBet    int64                  `protobuf:"varint,7,opt,name=current_bet,json=currentBet,proto3" json:"current_bet,omitempty"` // Largest bet on the current street
+	ToAct         int32                  `protobuf:"varint,8,opt,name=to_act,json=toAct,proto3" json:"to_act,omitempty"`                // Seat whose turn it is, or -1
+	Seats         []*TableSeat           `protobuf:"bytes,9,rep,name=seats,proto3" json:"seats,omitempty"`                              // Occupied seats
+	YourSeat      int32                  `protobuf:"varint,10,opt,name=your_seat,json=yourSeat,proto3" json:"your_seat,omitempty"`      // The player's seat
+	YourCards     []string               `protobuf:"bytes,11,rep,name=your_cards,json=yourCards,proto3" json:"your_cards,omitempty"`    // The player's hole cards in the current or last hand
+	InHand        bool                   `protobuf:"varint,12,opt,name=in_hand,json=inHand,proto3" json:"in_hand,omitempty"`            // True while a hand is being played
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableState) Reset() {
+	*x = TableState{}
+	mi := &file_poker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableState) ProtoMessage() {}
+
+func (x *TableState) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableState.ProtoReflect.Descriptor instead.
+func (*TableState) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TableState) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *TableState) GetHandNumber() int32 {
+	if x != nil {
+		return x.HandNumber
+	}
+	return 0
+}
+
+func (x *TableState) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *TableState) GetButton() int32 {
+	if x != nil {
+		return x.Button
+	}
+	return 0
+}
+
+func (x *TableState) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *TableState) GetPot() int64 {
+	if x != nil {
+		return x.Pot
+	}
+	return 0
+}
+
+func (x *TableState) GetCurrentBet() int64 {
+	if x != nil {
+		return x.CurrentBet
+	}
+	return 0
+}
+
+func (x *TableState) GetToAct() int32 {
+	if x != nil {
+		return x.ToAct
+	}
+	return 0
+}
+
+func (x *TableState) GetSeats() []*TableSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *TableState) GetYourSeat() int32 {
+	if x != nil {
+		return x.YourSeat
+	}
+	return 0
+}
+
+func (x *TableState) GetYourCards() []string {
+	if x != nil {
+		return x.YourCards
+	}
+	return nil
+}
+
+func (x *TableState) GetInHand() bool {
+	if x != nil {
+		return x.InHand
+	}
+	return false
+}
+
+// Public state of an occupied seat
+type TableSeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seat          int32                  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`                   // Seat number
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // Player name
+	Stack         int64                  `protobuf:"varint,3,opt,name=stack,proto3" json:"stack,omitempty"`                 // Chips behind
+	Bet           int64                  `protobuf:"varint,4,opt,name=bet,proto3" json:"bet,omitempty"`                     // Chips put in on the current street
+	Committed     int64                  `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"`         // Chips put in during the hand
+	InHand        bool                   `protobuf:"varint,6,opt,name=in_hand,json=inHand,proto3" json:"in_hand,omitempty"` // Dealt into the current hand
+	Folded        bool                   `protobuf:"varint,7,opt,name=folded,proto3" json:"folded,omitempty"`               // Folded the current hand
+	AllIn         bool                   `protobuf:"varint,8,opt,name=all_in,json=allIn,proto3" json:"all_in,omitempty"`    // All-in in the current hand
+	Connected     bool                   `protobuf:"varint,9,opt,name=connected,proto3" json:"connected,omitempty"`         // The player's stream is open
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableSeat) Reset() {
+	*x = TableSeat{}
+	mi := &file_poker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSeat) ProtoMessage() {}
+
+func (x *TableSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSeat.ProtoReflect.Descriptor instead.
+func (*TableSeat) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TableSeat) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *TableSeat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableSeat) GetStack() int64 {
+	if x != nil {
+		return x.Stack
+	}
+	return 0
+}
+
+func (x *TableSeat) GetBet() int64 {
+	if x != nil {
+		return x.Bet
+	}
+	return 0
+}
+
+func (x *TableSeat) GetCommitted() int64 {
+	if x != nil {
+		return x.Committed
+	}
+	return 0
+}
+
+func (x *TableSeat) GetInHand() bool {
+	if x != nil {
+		return x.InHand
+	}
+	return false
+}
+
+func (x *TableSeat) GetFolded() bool {
+	if x != nil {
+		return x.Folded
+	}
+	return false
+}
+
+func (x *TableSeat) GetAllIn() bool {
+	if x != nil {
+		return x.AllIn
+	}
+	return false
+}
+
+func (x *TableSeat) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+// Actions available to the player whose turn it is
+type LegalActions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanCheck      bool                   `protobuf:"varint,1,opt,name=can_check,json=canCheck,proto3" json:"can_check,omitempty"`       // Checking is allowed
+	CallAmount    int64                  `protobuf:"varint,2,opt,name=call_amount,json=callAmount,proto3" json:"call_amount,omitempty"` // Chips needed to call (0 when checking is allowed)
+	CanRaise      bool                   `protobuf:"varint,3,opt,name=can_raise,json=canRaise,proto3" json:"can_raise,omitempty"`       // Betting (no bet yet) or raising is allowed
+	MinRaise      int64                  `protobuf:"varint,4,opt,name=min_raise,json=minRaise,proto3" json:"min_raise,omitempty"`       // Smallest total bet after betting or raising (an all-in may be smaller)
+	MaxRaise      int64                  `protobuf:"varint,5,opt,name=max_raise,json=maxRaise,proto3" json:"max_raise,omitempty"`       // Largest total bet after betting or raising
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegalActions) Reset() {
+	*x = LegalActions{}
+	mi := &file_poker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegalActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalActions) ProtoMessage() {}
+
+func (x *LegalActions) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalActions.ProtoReflect.Descriptor instead.
+func (*LegalActions) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *LegalActions) GetCanCheck() bool {
+	if x != nil {
+		return x.CanCheck
+	}
+	return false
+}
+
+func (x *LegalActions) GetCallAmount() int64 {
+	if x != nil {
+		return x.CallAmount
+	}
+	return 0
+}
+
+func (x *LegalActions) GetCanRaise() bool {
+	if x != nil {
+		return x.CanRaise
+	}
+	return false
+}
+
+func (x *LegalActions) GetMinRaise() int64 {
+	if x != nil {
+		return x.MinRaise
+	}
+	return 0
+}
+
+func (x *LegalActions) GetMaxRaise() int64 {
+	if x != nil {
+		return x.MaxRaise
+	}
+	return 0
+}
+
+var File_poker_proto protoreflect.FileDescriptor
+
+const file_poker_proto_rawDesc = "" +
+	"\n" +
+	"\vpoker.proto\x12\x05poker\x1a\x1cgoogle/api/annotations.proto\"x\n" +
+	"\x13EvaluateHandRequest\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x01 \x03(\tR\tholeCards\x12'\n" +
+	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\x12\x19\n" +
+	"\bno_cache\x18\x03 \x01(\bR\anoCache\"\xdf\x01\n" +
+	"\x14EvaluateHandResponse\x12\x1b\n" +
+	"\tbest_hand\x18\x01 \x01(\tR\bbestHand\x12\x1d\n" +
+	"\n" +
+	"hand_value\x18\x02 \x01(\x05R\thandValue\x12&\n" +
+	"\x0fbest_five_cards\x18\x03 \x03(\tR\rbestFiveCards\x12!\n" +
+	"\x05draws\x18\x04 \x03(\v2\v.poker.DrawR\x05draws\x12#\n" +
+	"\rstarting_hand\x18\x05 \x01(\tR\fstartingHand\x12\x1b\n" +
+	"\thand_rank\x18\x06 \x01(\x05R\bhandRank\"b\n" +
+	"\x04Draw\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03nut\x18\x03 \x01(\bR\x03nut\x12\x12\n" +
+	"\x04outs\x18\x04 \x03(\tR\x04outs\"\xe1\x01\n" +
+	"\x13CompareHandsRequest\x12,\n" +
+	"\x12player1_hole_cards\x18\x01 \x03(\tR\x10player1HoleCards\x126\n" +
+	"\x17player1_community_cards\x18\x02 \x03(\tR\x15player1CommunityCards\x12,\n" +
+	"\x12player2_hole_cards\x18\x03 \x03(\tR\x10player2HoleCards\x126\n" +
+	"\x17player2_community_cards\x18\x04 \x03(\tR\x15player2CommunityCards\"\xae\x01\n" +
+	"\x14CompareHandsResponse\x12>\n" +
+	"\fplayer1_hand\x18\x01 \x01(\v2\x1b.poker.EvaluateHandResponseR\vplayer1Hand\x12>\n" +
+	"\fplayer2_hand\x18\x02 \x01(\v2\x1b.poker.EvaluateHandResponseR\vplayer2Hand\x12\x16\n" +
+	"\x06winner\x18\x03 \x01(\x05R\x06winner\"\xe0\x01\n" +
+	"\x12ProbabilityRequest\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x01 \x03(\tR\tholeCards\x12'\n" +
+	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\x12\x1f\n" +
+	"\vnum_players\x18\x03 \x01(\x05R\n" +
+	"numPlayers\x12'\n" +
+	"\x0fnum_simulations\x18\x04 \x01(\x05R\x0enumSimulations\x12\x1d\n" +
+	"\n" +
+	"dead_cards\x18\x05 \x03(\tR\tdeadCards\x12\x19\n" +
+	"\bno_cache\x18\x06 \x01(\bR\anoCache\"\xa1\x01\n" +
+	"\x13ProbabilityResponse\x12'\n" +
+	"\x0fwin_probability\x18\x01 \x01(\x01R\x0ewinProbability\x12'\n" +
+	"\x0ftie_probability\x18\x02 \x01(\x01R\x0etieProbability\x12 \n" +
+	"\vsimulations\x18\x03 \x01(\x05R\vsimulations\x12\x16\n" +
+	"\x06cached\x18\x04 \x01(\bR\x06cached\"\xf4\x01\n" +
+	"\x18StreamProbabilityRequest\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x01 \x03(\tR\tholeCards\x12'\n" +
+	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\x12\x1f\n" +
+	"\vnum_players\x18\x03 \x01(\x05R\n" +
+	"numPlayers\x12'\n" +
+	"\x0fnum_simulations\x18\x04 \x01(\x05R\x0enumSimulations\x12'\n" +
+	"\x0fupdate_interval\x18\x05 \x01(\x05R\x0eupdateInterval\x12\x1d\n" +
+	"\n" +
+	"dead_cards\x18\x06 \x03(\tR\tdeadCards\"\xbb\x02\n" +
+	"\x11ProbabilityUpdate\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x01 \x01(\x05R\n" +
+	"iterations\x12'\n" +
+	"\x0fwin_probability\x18\x02 \x01(\x01R\x0ewinProbability\x12'\n" +
+	"\x0ftie_probability\x18\x03 \x01(\x01R\x0etieProbability\x12\x16\n" +
+	"\x06equity\x18\x04 \x01(\x01R\x06equity\x12\x1c\n" +
+	"\n" +
+	"win_ci_low\x18\x05 \x01(\x01R\bwinCiLow\x12\x1e\n" +
+	"\vwin_ci_high\x18\x06 \x01(\x01R\twinCiHigh\x12\"\n" +
+	"\requity_ci_low\x18\a \x01(\x01R\vequityCiLow\x12$\n" +
+	"\x0eequity_ci_high\x18\b \x01(\x01R\fequityCiHigh\x12\x14\n" +
+	"\x05final\x18\t \x01(\bR\x05final\">\n" +
+	"\x13BoardTextureRequest\x12'\n" +
+	"\x0fcommunity_cards\x18\x01 \x03(\tR\x0ecommunityCards\"\xe1\x03\n" +
+	"\x14BoardTextureResponse\x12\x18\n" +
+	"\apairing\x18\x01 \x01(\tR\apairing\x12!\n" +
+	"\fsuit_pattern\x18\x02 \x01(\tR\vsuitPattern\x12$\n" +
+	"\rconnectedness\x18\x03 \x01(\tR\rconnectedness\x12$\n" +
+	"\x0emax_suit_count\x18\x04 \x01(\x05R\fmaxSuitCount\x12'\n" +
+	"\x0fconnected_ranks\x18\x05 \x01(\x05R\x0econnectedRanks\x12+\n" +
+	"\x11straight_possible\x18\x06 \x01(\bR\x10straightPossible\x12%\n" +
+	"\x0eflush_possible\x18\a \x01(\bR\rflushPossible\x124\n" +
+	"\x16straight_draw_possible\x18\b \x01(\bR\x14straightDrawPossible\x12.\n" +
+	"\x13flush_draw_possible\x18\t \x01(\bR\x11flushDrawPossible\x12\x1b\n" +
+	"\thigh_card\x18\n" +
+	" \x01(\tR\bhighCard\x12&\n" +
+	"\x0fhigh_card_class\x18\v \x01(\tR\rhighCardClass\x12\x18\n" +
+	"\awetness\x18\f \x01(\x05R\awetness\"\\\n" +
+	"\x12NutAnalysisRequest\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x01 \x03(\tR\tholeCards\x12'\n" +
+	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\"\x1f\n" +
+	"\aHolding\x12\x14\n" +
+	"\x05cards\x18\x01 \x03(\tR\x05cards\"\xdb\x02\n" +
+	"\x13NutAnalysisResponse\x126\n" +
+	"\bnut_hand\x18\x01 \x01(\v2\x1b.poker.EvaluateHandResponseR\anutHand\x121\n" +
+	"\fnut_holdings\x18\x02 \x03(\v2\x0e.poker.HoldingR\vnutHoldings\x128\n" +
+	"\thero_hand\x18\x03 \x01(\v2\x1b.poker.EvaluateHandResponseR\bheroHand\x12\x1b\n" +
+	"\thero_rank\x18\x04 \x01(\x05R\bheroRank\x12\x1b\n" +
+	"\tbeaten_by\x18\x05 \x01(\x05R\bbeatenBy\x12\x12\n" +
+	"\x04ties\x18\x06 \x01(\x05R\x04ties\x12\x14\n" +
+	"\x05beats\x18\a \x01(\x05R\x05beats\x12!\n" +
+	"\ftotal_combos\x18\b \x01(\x05R\vtotalCombos\x12\x18\n" +
+	"\asummary\x18\t \x01(\tR\asummary\"\xcb\x01\n" +
+	"\x14HandPotentialRequest\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x01 \x03(\tR\tholeCards\x12'\n" +
+	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\x12%\n" +
+	"\x0eopponent_range\x18\x03 \x01(\tR\ropponentRange\x12#\n" +
+	"\rnum_opponents\x18\x04 \x01(\x05R\fnumOpponents\x12\x1f\n" +
+	"\vnum_samples\x18\x05 \x01(\x05R\n" +
+	"numSamples\"\xaa\x02\n" +
+	"\x15HandPotentialResponse\x12#\n" +
+	"\rhand_strength\x18\x01 \x01(\x01R\fhandStrength\x12&\n" +
+	"\x0fhand_strength_n\x18\x02 \x01(\x01R\rhandStrengthN\x12-\n" +
+	"\x12positive_potential\x18\x03 \x01(\x01R\x11positivePotential\x12-\n" +
+	"\x12negative_potential\x18\x04 \x01(\x01R\x11negativePotential\x126\n" +
+	"\x17effective_hand_strength\x18\x05 \x01(\x01R\x15effectiveHandStrength\x12\x18\n" +
+	"\asamples\x18\x06 \x01(\x05R\asamples\x12\x14\n" +
+	"\x05exact\x18\a \x01(\bR\x05exact\"\xd2\x02\n" +
+	"\x13CallDecisionRequest\x12\x19\n" +
+	"\bpot_size\x18\x01 \x01(\x01R\apotSize\x12\x1e\n" +
+	"\vbet_to_call\x18\x02 \x01(\x01R\tbetToCall\x12'\n" +
+	"\x0feffective_stack\x18\x03 \x01(\x01R\x0eeffectiveStack\x12\x1b\n" +
+	"\x06equity\x18\x04 \x01(\x01H\x00R\x06equity\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x05 \x03(\tR\tholeCards\x12'\n" +
+	"\x0fcommunity_cards\x18\x06 \x03(\tR\x0ecommunityCards\x12\x1d\n" +
+	"\n" +
+	"dead_cards\x18\a \x03(\tR\tdeadCards\x12\x1f\n" +
+	"\vnum_players\x18\b \x01(\x05R\n" +
+	"numPlayers\x12'\n" +
+	"\x0fnum_simulations\x18\t \x01(\x05R\x0enumSimulationsB\t\n" +
+	"\a_equity\"\xe9\x02\n" +
+	"\x14CallDecisionResponse\x12\x19\n" +
+	"\bpot_odds\x18\x01 \x01(\x01R\apotOdds\x12'\n" +
+	"\x0frequired_equity\x18\x02 \x01(\x01R\x0erequiredEquity\x12\x16\n" +
+	"\x06equity\x18\x03 \x01(\x01R\x06equity\x12\x1f\n" +
+	"\vcall_amount\x18\x04 \x01(\x01R\n" +
+	"callAmount\x12\x17\n" +
+	"\acall_ev\x18\x05 \x01(\x01R\x06callEv\x12.\n" +
+	"\x13implied_odds_needed\x18\x06 \x01(\x01R\x11impliedOddsNeeded\x124\n" +
+	"\x16implied_odds_available\x18\a \x01(\bR\x14impliedOddsAvailable\x12\x15\n" +
+	"\x06all_in\x18\b \x01(\bR\x05allIn\x12&\n" +
+	"\x0erecommendation\x18\t \x01(\tR\x0erecommendation\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\"\xa1\x01\n" +
+	"\n" +
+	"ICMRequest\x12\x16\n" +
+	"\x06stacks\x18\x01 \x03(\x01R\x06stacks\x12\x18\n" +
+	"\apayouts\x18\x02 \x03(\x01R\apayouts\x124\n" +
+	"\x16monte_carlo_iterations\x18\x03 \x01(\x05R\x14monteCarloIterations\x12+\n" +
+	"\x06all_in\x18\x04 \x01(\v2\x14.poker.AllInScenarioR\x05allIn\"\xde\x01\n" +
+	"\rAllInScenario\x12\x12\n" +
+	"\x04hero\x18\x01 \x01(\x05R\x04hero\x12\x1d\n" +
+	"\n" +
+	"hero_cards\x18\x02 \x03(\tR\theroCards\x120\n" +
+	"\topponents\x18\x03 \x03(\v2\x12.poker.AllInPlayerR\topponents\x12\x16\n" +
+	"\x06posted\x18\x04 \x03(\x01R\x06posted\x12'\n" +
+	"\x0fcommunity_cards\x18\x05 \x03(\tR\x0ecommunityCards\x12'\n" +
+	"\x0fnum_simulations\x18\x06 \x01(\x05R\x0enumSimulations\"Z\n" +
+	"\vAllInPlayer\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\x05R\x06player\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x02 \x03(\tR\tholeCards\x12\x14\n" +
+	"\x05range\x18\x03 \x01(\tR\x05range\"j\n" +
+	"\vICMResponse\x12\x1a\n" +
+	"\bequities\x18\x01 \x03(\x01R\bequities\x12\x14\n" +
+	"\x05exact\x18\x02 \x01(\bR\x05exact\x12)\n" +
+	"\x06all_in\x18\x03 \x01(\v2\x12.poker.AllInResultR\x05allIn\"\xd2\x01\n" +
+	"\vAllInResult\x12\x1f\n" +
+	"\vhero_equity\x18\x01 \x01(\x01R\n" +
+	"heroEquity\x12\x17\n" +
+	"\acall_ev\x18\x02 \x01(\x01R\x06callEv\x12\x17\n" +
+	"\afold_ev\x18\x03 \x01(\x01R\x06foldEv\x12#\n" +
+	"\rcall_equities\x18\x04 \x03(\x01R\fcallEquities\x12#\n" +
+	"\rfold_equities\x18\x05 \x03(\x01R\ffoldEquities\x12&\n" +
+	"\x0erecommendation\x18\x06 \x01(\tR\x0erecommendation\"|\n" +
+	"\x0fPushFoldRequest\x12\x14\n" +
+	"\x05stack\x18\x01 \x01(\x01R\x05stack\x12\x1f\n" +
+	"\vsmall_blind\x18\x02 \x01(\x01R\n" +
+	"smallBlind\x12\x12\n" +
+	"\x04ante\x18\x03 \x01(\x01R\x04ante\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x04 \x01(\x05R\n" +
+	"iterations\"2\n" +
+	"\bChartRow\x12&\n" +
+	"\x05cells\x18\x01 \x03(\v2\x10.poker.ChartCellR\x05cells\"=\n" +
+	"\tChartCell\x12\x12\n" +
+	"\x04hand\x18\x01 \x01(\tR\x04hand\x12\x1c\n" +
+	"\tfrequency\x18\x02 \x01(\x01R\tfrequency\"\xa6\x02\n" +
+	"\x10PushFoldResponse\x12.\n" +
+	"\n" +
+	"push_chart\x18\x01 \x03(\v2\x0f.poker.ChartRowR\tpushChart\x12.\n" +
+	"\n" +
+	"call_chart\x18\x02 \x03(\v2\x0f.poker.ChartRowR\tcallChart\x12!\n" +
+	"\fpush_percent\x18\x03 \x01(\x01R\vpushPercent\x12!\n" +
+	"\fcall_percent\x18\x04 \x01(\x01R\vcallPercent\x12$\n" +
+	"\x0esmall_blind_ev\x18\x05 \x01(\x01R\fsmallBlindEv\x12&\n" +
+	"\x0eexploitability\x18\x06 \x01(\x01R\x0eexploitability\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\a \x01(\x05R\n" +
+	"iterations\"\x94\x01\n" +
+	"\x16EquityBreakdownRequest\x12(\n" +
+	"\aplayers\x18\x01 \x03(\v2\x0e.poker.HoldingR\aplayers\x12'\n" +
+	"\x0fcommunity_cards\x18\x02 \x03(\tR\x0ecommunityCards\x12'\n" +
+	"\x0fnum_simulations\x18\x03 \x01(\x05R\x0enumSimulations\"\xe5\x01\n" +
+	"\fStreetEquity\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x14\n" +
+	"\x05board\x18\x02 \x03(\tR\x05board\x12\x1a\n" +
+	"\bequities\x18\x03 \x03(\x01R\bequities\x12+\n" +
+	"\x11win_probabilities\x18\x04 \x03(\x01R\x10winProbabilities\x12\x14\n" +
+	"\x05hands\x18\x05 \x03(\tR\x05hands\x12\x18\n" +
+	"\aleaders\x18\x06 \x03(\x05R\aleaders\x12\x14\n" +
+	"\x05exact\x18\a \x01(\bR\x05exact\x12\x18\n" +
+	"\arunouts\x18\b \x01(\x05R\arunouts\"s\n" +
+	"\n" +
+	"RunoutCard\x12\x12\n" +
+	"\x04card\x18\x01 \x01(\tR\x04card\x12\x1a\n" +
+	"\bequities\x18\x02 \x03(\x01R\bequities\x12\x18\n" +
+	"\aleaders\x18\x03 \x03(\x05R\aleaders\x12\x1b\n" +
+	"\tbest_hand\x18\x04 \x01(\tR\bbestHand\"\x96\x01\n" +
+	"\x17EquityBreakdownResponse\x12-\n" +
+	"\astreets\x18\x01 \x03(\v2\x13.poker.StreetEquityR\astreets\x12\x1f\n" +
+	"\vnext_street\x18\x02 \x01(\tR\n" +
+	"nextStreet\x12+\n" +
+	"\arunouts\x18\x03 \x03(\v2\x11.poker.RunoutCardR\arunouts\"\x83\x01\n" +
+	"\x0fShowdownRequest\x12'\n" +
+	"\x0fcommunity_cards\x18\x01 \x03(\tR\x0ecommunityCards\x12/\n" +
+	"\aplayers\x18\x02 \x03(\v2\x15.poker.ShowdownPlayerR\aplayers\x12\x16\n" +
+	"\x06button\x18\x03 \x01(\x05R\x06button\"y\n" +
+	"\x0eShowdownPlayer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x02 \x03(\tR\tholeCards\x12\x1c\n" +
+	"\tcommitted\x18\x03 \x01(\x03R\tcommitted\x12\x16\n" +
+	"\x06folded\x18\x04 \x01(\bR\x06folded\"\x99\x01\n" +
+	"\x14ShowdownPlayerResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x04hand\x18\x02 \x01(\v2\x1b.poker.EvaluateHandResponseR\x04hand\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x16\n" +
+	"\x06payout\x18\x04 \x01(\x03R\x06payout\x12\x10\n" +
+	"\x03net\x18\x05 \x01(\x03R\x03net\"\xa7\x01\n" +
+	"\x03Pot\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\beligible\x18\x03 \x03(\x05R\beligible\x12\x18\n" +
+	"\awinners\x18\x04 \x03(\x05R\awinners\x12!\n" +
+	"\fwinning_hand\x18\x05 \x01(\tR\vwinningHand\x12\x1b\n" +
+	"\todd_chips\x18\x06 \x01(\x03R\boddChips\"\x8d\x01\n" +
+	"\x10ShowdownResponse\x125\n" +
+	"\aplayers\x18\x01 \x03(\v2\x1b.poker.ShowdownPlayerResultR\aplayers\x12\x1e\n" +
+	"\x04pots\x18\x02 \x03(\v2\n" +
+	".poker.PotR\x04pots\x12\"\n" +
+	"\fexplanations\x18\x03 \x03(\tR\fexplanations\"k\n" +
+	"\x18ImportHandHistoryRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12#\n" +
+	"\rinclude_hands\x18\x02 \x01(\bR\fincludeHands\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"\xc8\x01\n" +
+	"\x19ImportHandHistoryResponse\x12\x16\n" +
+	"\x06parsed\x18\x01 \x01(\x05R\x06parsed\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\x12-\n" +
+	"\x06errors\x18\x04 \x03(\v2\x15.poker.HandParseErrorR\x06errors\x12(\n" +
+	"\x05hands\x18\x05 \x03(\v2\x12.poker.HandHistoryR\x05hands\"5\n" +
+	"\x18ExportHandHistoryRequest\x12\x19\n" +
+	"\bhand_ids\x18\x01 \x03(\tR\ahandIds\"e\n" +
+	"\x19ExportHandHistoryResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1a\n" +
+	"\bexported\x18\x02 \x01(\x05R\bexported\x12\x18\n" +
+	"\amissing\x18\x03 \x03(\tR\amissing\"i\n" +
+	"\x12AnalyzeLuckRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x19\n" +
+	"\bhand_ids\x18\x02 \x03(\tR\ahandIds\x12 \n" +
+	"\vsimulations\x18\x03 \x01(\x05R\vsimulations\"\\\n" +
+	"\x13AnalyzeLuckResponse\x12+\n" +
+	"\aplayers\x18\x01 \x03(\v2\x11.poker.LuckSeriesR\aplayers\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing\"\xcf\x01\n" +
+	"\n" +
+	"LuckSeries\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12(\n" +
+	"\x06points\x18\x02 \x03(\v2\x10.poker.LuckPointR\x06points\x12\x10\n" +
+	"\x03net\x18\x03 \x01(\x01R\x03net\x12!\n" +
+	"\fadjusted_net\x18\x04 \x01(\x01R\vadjustedNet\x12\x12\n" +
+	"\x04luck\x18\x05 \x01(\x01R\x04luck\x12\x14\n" +
+	"\x05hands\x18\x06 \x01(\x05R\x05hands\x12 \n" +
+	"\fall_in_hands\x18\a \x01(\x05R\n" +
+	"allInHands\"\x93\x02\n" +
+	"\tLuckPoint\x12\x17\n" +
+	"\ahand_id\x18\x01 \x01(\tR\x06handId\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\tR\x04time\x12\x10\n" +
+	"\x03net\x18\x03 \x01(\x01R\x03net\x12!\n" +
+	"\fadjusted_net\x18\x04 \x01(\x01R\vadjustedNet\x12\x15\n" +
+	"\x06all_in\x18\x05 \x01(\bR\x05allIn\x12\x16\n" +
+	"\x06equity\x18\x06 \x01(\x01R\x06equity\x12\x16\n" +
+	"\x06street\x18\a \x01(\tR\x06street\x12%\n" +
+	"\x0ecumulative_net\x18\b \x01(\x01R\rcumulativeNet\x126\n" +
+	"\x17cumulative_adjusted_net\x18\t \x01(\x01R\x15cumulativeAdjustedNet\"\x84\x01\n" +
+	"\x12PlayerStatsRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x16\n" +
+	"\x06stakes\x18\x04 \x01(\tR\x06stakes\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\tR\bposition\"C\n" +
+	"\x13PlayerStatsResponse\x12,\n" +
+	"\aplayers\x18\x01 \x03(\v2\x12.poker.PlayerStatsR\aplayers\"a\n" +
+	"\tStatRatio\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12$\n" +
+	"\ropportunities\x18\x02 \x01(\x05R\ropportunities\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\"\xd1\x04\n" +
+	"\vPlayerStats\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\tR\bposition\x12\x14\n" +
+	"\x05hands\x18\x03 \x01(\x05R\x05hands\x12$\n" +
+	"\x04vpip\x18\x04 \x01(\v2\x10.poker.StatRatioR\x04vpip\x12\"\n" +
+	"\x03pfr\x18\x05 \x01(\v2\x10.poker.StatRatioR\x03pfr\x12-\n" +
+	"\tthree_bet\x18\x06 \x01(\v2\x10.poker.StatRatioR\bthreeBet\x12;\n" +
+	"\x11fold_to_three_bet\x18\a \x01(\v2\x10.poker.StatRatioR\x0efoldToThreeBet\x12$\n" +
+	"\x04cbet\x18\b \x01(\v2\x10.poker.StatRatioR\x04cbet\x12$\n" +
+	"\x04wtsd\x18\t \x01(\v2\x10.poker.StatRatioR\x04wtsd\x12\"\n" +
+	"\x03wsd\x18\n" +
+	" \x01(\v2\x10.poker.StatRatioR\x03wsd\x12+\n" +
+	"\x11aggression_factor\x18\v \x01(\x01R\x10aggressionFactor\x12-\n" +
+	"\x12aggressive_actions\x18\f \x01(\x05R\x11aggressiveActions\x12\x14\n" +
+	"\x05calls\x18\r \x01(\x05R\x05calls\x12\x10\n" +
+	"\x03net\x18\x0e \x01(\x01R\x03net\x12\x1c\n" +
+	"\n" +
+	"bb_per_100\x18\x0f \x01(\x01R\bbbPer100\x120\n" +
+	"\tpositions\x18\x10 \x03(\v2\x12.poker.PlayerStatsR\tpositions\"\x9a\x01\n" +
+	"\x14VerifyShuffleRequest\x12\x1f\n" +
+	"\vserver_seed\x18\x01 \x01(\tR\n" +
+	"serverSeed\x12(\n" +
+	"\x10server_seed_hash\x18\x02 \x01(\tR\x0eserverSeedHash\x12!\n" +
+	"\fclient_seeds\x18\x03 \x03(\tR\vclientSeeds\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\x03R\x05nonce\"W\n" +
+	"\x15VerifyShuffleResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x12\n" +
+	"\x04deck\x18\x02 \x03(\tR\x04deck\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x13\n" +
+	"\x11CacheStatsRequest\"?\n" +
+	"\x12CacheStatsResponse\x12)\n" +
+	"\x06caches\x18\x01 \x03(\v2\x11.poker.CacheStatsR\x06caches\"\xfe\x01\n" +
+	"\n" +
+	"CacheStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\x05R\aentries\x12\x1a\n" +
+	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\x12\x12\n" +
+	"\x04hits\x18\x05 \x01(\x04R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x06 \x01(\x04R\x06misses\x12\x1c\n" +
+	"\tevictions\x18\a \x01(\x04R\tevictions\x12 \n" +
+	"\vexpirations\x18\b \x01(\x04R\vexpirations\x12\x19\n" +
+	"\bhit_rate\x18\t \x01(\x01R\ahitRate\"M\n" +
+	"\x19BatchEvaluateHandsRequest\x120\n" +
+	"\x05hands\x18\x01 \x03(\v2\x1a.poker.EvaluateHandRequestR\x05hands\"i\n" +
+	"\x1aBatchEvaluateHandsResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.poker.EvaluateHandResultR\aresults\x12\x16\n" +
+	"\x06errors\x18\x02 \x01(\x05R\x06errors\"q\n" +
+	"\x12EvaluateHandResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12/\n" +
+	"\x04hand\x18\x02 \x01(\v2\x1b.poker.EvaluateHandResponseR\x04hand\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"X\n" +
+	"\x18BatchCompareHandsRequest\x12<\n" +
+	"\vcomparisons\x18\x01 \x03(\v2\x1a.poker.CompareHandsRequestR\vcomparisons\"h\n" +
+	"\x19BatchCompareHandsResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.poker.CompareHandsResultR\aresults\x12\x16\n" +
+	"\x06errors\x18\x02 \x01(\x05R\x06errors\"}\n" +
+	"\x12CompareHandsResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12;\n" +
+	"\n" +
+	"comparison\x18\x02 \x01(\v2\x1b.poker.CompareHandsResponseR\n" +
+	"comparison\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"i\n" +
+	"\x0eHandParseError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x17\n" +
+	"\ahand_id\x18\x02 \x01(\tR\x06handId\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x99\x04\n" +
+	"\vHandHistory\x12\x12\n" +
+	"\x04site\x18\x01 \x01(\tR\x04site\x12\x17\n" +
+	"\ahand_id\x18\x02 \x01(\tR\x06handId\x12#\n" +
+	"\rtournament_id\x18\x03 \x01(\tR\ftournamentId\x12\x12\n" +
+	"\x04game\x18\x04 \x01(\tR\x04game\x12\x1c\n" +
+	"\tstructure\x18\x05 \x01(\tR\tstructure\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vsmall_blind\x18\a \x01(\x01R\n" +
+	"smallBlind\x12\x1b\n" +
+	"\tbig_blind\x18\b \x01(\x01R\bbigBlind\x12\x12\n" +
+	"\x04ante\x18\t \x01(\x01R\x04ante\x12\x12\n" +
+	"\x04time\x18\n" +
+	" \x01(\tR\x04time\x12\x14\n" +
+	"\x05table\x18\v \x01(\tR\x05table\x12\x1b\n" +
+	"\tmax_seats\x18\f \x01(\x05R\bmaxSeats\x12\x16\n" +
+	"\x06button\x18\r \x01(\x05R\x06button\x12,\n" +
+	"\x05seats\x18\x0e \x03(\v2\x16.poker.HandHistorySeatR\x05seats\x122\n" +
+	"\aactions\x18\x0f \x03(\v2\x18.poker.HandHistoryActionR\aactions\x12\x14\n" +
+	"\x05board\x18\x10 \x03(\tR\x05board\x12\x1b\n" +
+	"\ttotal_pot\x18\x11 \x01(\x01R\btotalPot\x12\x12\n" +
+	"\x04rake\x18\x12 \x01(\x01R\x04rake\x12\x10\n" +
+	"\x03key\x18\x13 \x01(\tR\x03key\"\xa5\x01\n" +
+	"\x0fHandHistorySeat\x12\x12\n" +
+	"\x04seat\x18\x01 \x01(\x05R\x04seat\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\x12\x14\n" +
+	"\x05stack\x18\x03 \x01(\x01R\x05stack\x12\x1d\n" +
+	"\n" +
+	"hole_cards\x18\x04 \x03(\tR\tholeCards\x12\x1f\n" +
+	"\vsitting_out\x18\x05 \x01(\bR\n" +
+	"sittingOut\x12\x10\n" +
+	"\x03net\x18\x06 \x01(\x01R\x03net\"\x9c\x01\n" +
+	"\x11HandHistoryAction\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x15\n" +
+	"\x06all_in\x18\x05 \x01(\bR\x05allIn\x12\x14\n" +
+	"\x05cards\x18\x06 \x03(\tR\x05cards\"\xd3\x01\n" +
+	"\fTableCommand\x12&\n" +
+	"\x04join\x18\x01 \x01(\v2\x10.poker.JoinTableH\x00R\x04join\x12,\n" +
+	"\x06action\x18\x02 \x01(\v2\x12.poker.TableActionH\x00R\x06action\x12)\n" +
+	"\x05leave\x18\x03 \x01(\v2\x11.poker.LeaveTableH\x00R\x05leave\x127\n" +
+	"\vclient_seed\x18\x04 \x01(\v2\x14.poker.SetClientSeedH\x00R\n" +
+	"clientSeedB\t\n" +
+	"\acommand\"\xc0\x01\n" +
+	"\tJoinTable\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x17\n" +
+	"\x04seat\x18\x02 \x01(\x05H\x00R\x04seat\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x15\n" +
+	"\x06buy_in\x18\x04 \x01(\x03R\x05buyIn\x12*\n" +
+	"\x06config\x18\x05 \x01(\v2\x12.poker.TableConfigR\x06config\x12\x1f\n" +
+	"\vclient_seed\x18\x06 \x01(\tR\n" +
+	"clientSeedB\a\n" +
+	"\x05_seat\"\xb6\x01\n" +
+	"\vTableConfig\x12\x14\n" +
+	"\x05seats\x18\x01 \x01(\x05R\x05seats\x12\x1f\n" +
+	"\vsmall_blind\x18\x02 \x01(\x03R\n" +
+	"smallBlind\x12\x1b\n" +
+	"\tbig_blind\x18\x03 \x01(\x03R\bbigBlind\x12\x12\n" +
+	"\x04ante\x18\x04 \x01(\x03R\x04ante\x12\x1c\n" +
+	"\tstructure\x18\x05 \x01(\tR\tstructure\x12!\n" +
+	"\fturn_seconds\x18\x06 \x01(\x05R\vturnSeconds\"9\n" +
+	"\vTableAction\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\f\n" +
+	"\n" +
+	"LeaveTable\"#\n" +
+	"\rSetClientSeed\x12\x12\n" +
+	"\x04seed\x18\x01 \x01(\tR\x04seed\"\xd4\x02\n" +
+	"\vTableUpdate\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04seat\x18\x02 \x01(\x05R\x04seat\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x14\n" +
+	"\x05cards\x18\x05 \x03(\tR\x05cards\x12\x12\n" +
+	"\x04text\x18\x06 \x01(\tR\x04text\x12'\n" +
+	"\x05state\x18\a \x01(\v2\x11.poker.TableStateR\x05state\x12)\n" +
+	"\x05legal\x18\b \x01(\v2\x13.poker.LegalActionsR\x05legal\x12(\n" +
+	"\x10deadline_unix_ms\x18\t \x01(\x03R\x0edeadlineUnixMs\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12/\n" +
+	"\bfairness\x18\v \x01(\v2\x13.poker.HandFairnessR\bfairness\"\xe6\x01\n" +
+	"\fHandFairness\x12\x1f\n" +
+	"\vhand_number\x18\x01 \x01(\x05R\n" +
+	"handNumber\x12(\n" +
+	"\x10server_seed_hash\x18\x02 \x01(\tR\x0eserverSeedHash\x12\x1f\n" +
+	"\vserver_seed\x18\x03 \x01(\tR\n" +
+	"serverSeed\x12!\n" +
+	"\fclient_seeds\x18\x04 \x03(\tR\vclientSeeds\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\x03R\x05nonce\x121\n" +
+	"\x15next_server_seed_hash\x18\x06 \x01(\tR\x12nextServerSeedHash\"\xd5\x02\n" +
+	"\n" +
+	"TableState\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1f\n" +
+	"\vhand_number\x18\x02 \x01(\x05R\n" +
+	"handNumber\x12\x16\n" +
+	"\x06street\x18\x03 \x01(\tR\x06street\x12\x16\n" +
+	"\x06button\x18\x04 \x01(\x05R\x06button\x12\x14\n" +
+	"\x05board\x18\x05 \x03(\tR\x05board\x12\x10\n" +
+	"\x03pot\x18\x06 \x01(\x03R\x03pot\x12\x1f\n" +
+	"\vcurrent_bet\x18\a \x01(\x03R\n" +
+	"currentBet\x12\x15\n" +
+	"\x06to_act\x18\b \x01(\x05R\x05toAct\x12&\n" +
+	"\x05seats\x18\t \x03(\v2\x10.poker.TableSeatR\x05seats\x12\x1b\n" +
+	"\tyour_seat\x18\n" +
+	" \x01(\x05R\byourSeat\x12\x1d\n" +
+	"\n" +
+	"your_cards\x18\v \x03(\tR\tyourCards\x12\x17\n" +
+	"\ain_hand\x18\f \x01(\bR\x06inHand\"\xdf\x01\n" +
+	"\tTableSeat\x12\x12\n" +
+	"\x04seat\x18\x01 \x01(\x05R\x04seat\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05stack\x18\x03 \x01(\x03R\x05stack\x12\x10\n" +
+	"\x03bet\x18\x04 \x01(\x03R\x03bet\x12\x1c\n" +
+	"\tcommitted\x18\x05 \x01(\x03R\tcommitted\x12\x17\n" +
+	"\ain_hand\x18\x06 \x01(\bR\x06inHand\x12\x16\n" +
+	"\x06folded\x18\a \x01(\bR\x06folded\x12\x15\n" +
+	"\x06all_in\x18\b \x01(\bR\x05allIn\x12\x1c\n" +
+	"\tconnected\x18\t \x01(\bR\tconnected\"\xa3\x01\n" +
+	"\fLegalActions\x12\x1b\n" +
+	"\tcan_check\x18\x01 \x01(\bR\bcanCheck\x12\x1f\n" +
+	"\vcall_amount\x18\x02 \x01(\x03R\n" +
+	"callAmount\x12\x1b\n" +
+	"\tcan_raise\x18\x03 \x01(\bR\bcanRaise\x12\x1b\n" +
+	"\tmin_raise\x18\x04 \x01(\x03R\bminRaise\x12\x1b\n" +
+	"\tmax_raise\x18\x05 \x01(\x03R\bmaxRaise2\xb3\x13\n" +
+	"\x0ePokerEvaluator\x12h\n" +
+	"\fEvaluateHand\x12\x1a.poker.EvaluateHandRequest\x1a\x1b.poker.EvaluateHandResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/poker/evaluate-hand\x12h\n" +
+	"\fCompareHands\x12\x1a.poker.CompareHandsRequest\x1a\x1b.poker.CompareHandsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/poker/compare-hands\x12y\n" +
+	"\x17CalculateWinProbability\x12\x19.poker.ProbabilityRequest\x1a\x1a.poker.ProbabilityResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/poker/calculate-probability\x12S\n" +
+	"\x14StreamWinProbability\x12\x1f.poker.StreamProbabilityRequest\x1a\x18.poker.ProbabilityUpdate0\x01\x12o\n" +
+	"\x13AnalyzeBoardTexture\x12\x1a.poker.BoardTextureRequest\x1a\x1b.poker.BoardTextureResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/poker/board-texture\x12d\n" +
+	"\vAnalyzeNuts\x12\x19.poker.NutAnalysisRequest\x1a\x1a.poker.NutAnalysisResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/poker/nut-analysis\x12u\n" +
+	"\x16CalculateHandPotential\x12\x1b.poker.HandPotentialRequest\x1a\x1c.poker.HandPotentialResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/poker/hand-potential\x12p\n" +
+	"\x14EvaluateCallDecision\x12\x1a.poker.CallDecisionRequest\x1a\x1b.poker.CallDecisionResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/poker/call-decision\x12L\n" +
+	"\fCalculateICM\x12\x11.poker.ICMRequest\x1a\x12.poker.ICMResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/poker/icm\x12]\n" +
+	"\rSolvePushFold\x12\x16.poker.PushFoldRequest\x1a\x17.poker.PushFoldResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/poker/push-fold\x12}\n" +
+	"\x18CalculateEquityBreakdown\x12\x1d.poker.EquityBreakdownRequest\x1a\x1e.poker.EquityBreakdownResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/poker/equity-breakdown\x12W\n" +
+	"\bShowdown\x12\x16.poker.ShowdownRequest\x1a\x17.poker.ShowdownResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/poker/showdown\x12v\n" +
+	"\x11ImportHandHistory\x12\x1f.poker.ImportHandHistoryRequest\x1a .poker.ImportHandHistoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/poker/hand-history\x12}\n" +
+	"\x11ExportHandHistory\x12\x1f.poker.ExportHandHistoryRequest\x1a .poker.ExportHandHistoryResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/poker/hand-history/export\x12i\n" +
+	"\vAnalyzeLuck\x12\x19.poker.AnalyzeLuckRequest\x1a\x1a.poker.AnalyzeLuckResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/poker/hand-history/luck\x12v\n" +
+	"\x0eGetPlayerStats\x12\x19.poker.PlayerStatsRequest\x1a\x1a.poker.PlayerStatsResponse\"-\x82\xd3\xe4\x93\x02'Z\x17\x12\x15/poker/stats/{player}\x12\f/poker/stats\x12l\n" +
+	"\rVerifyShuffle\x12\x1b.poker.VerifyShuffleRequest\x1a\x1c.poker.VerifyShuffleResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/poker/shuffle/verify\x12`\n" +
+	"\rGetCacheStats\x12\x18.poker.CacheStatsRequest\x1a\x19.poker.CacheStatsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/poker/cache/stats\x12\x81\x01\n" +
+	"\x12BatchEvaluateHands\x12 .poker.BatchEvaluateHandsRequest\x1a!.poker.BatchEvaluateHandsResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/poker/batch/evaluate-hands\x12}\n" +
+	"\x11BatchCompareHands\x12\x1f.poker.BatchCompareHandsRequest\x1a .poker.BatchCompareHandsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/poker/batch/compare-hands\x12\x85\x01\n" +
+	"\x13StreamEvaluateHands\x12\x1a.poker.EvaluateHandRequest\x1a!.poker.BatchEvaluateHandsResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/poker/batch/evaluate-hands/stream(\x01\x12\x82\x01\n" +
+	"\x12StreamCompareHands\x12\x1a.poker.CompareHandsRequest\x1a .poker.BatchCompareHandsResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/poker/batch/compare-hands/stream(\x012G\n" +
+	"\fTableService\x127\n" +
+	"\bPlayHand\x12\x13.poker.TableCommand\x1a\x12.poker.TableUpdate(\x010\x01B\x06Z\x04./pbb\x06proto3"
+
+var (
+	file_poker_proto_rawDescOnce sync.Once
+	file_poker_proto_rawDescData []byte
+)
+
+func file_poker_proto_rawDescGZIP() []byte {
+	file_poker_proto_rawDescOnce.Do(func() {
+		file_poker_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_poker_proto_rawDesc), len(file_poker_proto_rawDesc)))
+	})
+	return file_poker_proto_rawDescData
+}
+
+var file_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_poker_proto_goTypes = []any{
+	(*EvaluateHandRequest)(nil),        // 0: poker.EvaluateHandRequest
+	(*EvaluateHandResponse)(nil),       // 1: poker.EvaluateHandResponse
+	(*Draw)(nil),                       // 2: poker.Draw
+	(*CompareHandsRequest)(nil),        // 3: poker.CompareHandsRequest
+	(*CompareHandsResponse)(nil),       // 4: poker.CompareHandsResponse
+	(*ProbabilityRequest)(nil),         // 5: poker.ProbabilityRequest
+	(*ProbabilityResponse)(nil),        // 6: poker.ProbabilityResponse
+	(*StreamProbabilityRequest)(nil),   // 7: poker.StreamProbabilityRequest
+	(*ProbabilityUpdate)(nil),          // 8: poker.ProbabilityUpdate
+	(*BoardTextureRequest)(nil),        // 9: poker.BoardTextureRequest
+	(*BoardTextureResponse)(nil),       // 10: poker.BoardTextureResponse
+	(*NutAnalysisRequest)(nil),         // 11: poker.NutAnalysisRequest
+	(*Holding)(nil),                    // 12: poker.Holding
+	(*NutAnalysisResponse)(nil),        // 13: poker.NutAnalysisResponse
+	(*HandPotentialRequest)(nil),       // 14: poker.HandPotentialRequest
+	(*HandPotentialResponse)(nil),      // 15: poker.HandPotentialResponse
+	(*CallDecisionRequest)(nil),        // 16: poker.CallDecisionRequest
+	(*CallDecisionResponse)(nil),       // 17: poker.CallDecisionResponse
+	(*ICMRequest)(nil),                 // 18: poker.ICMRequest
+	(*AllInScenario)(nil),              // 19: poker.AllInScenario
+	(*AllInPlayer)(nil),                // 20: poker.AllInPlayer
+	(*ICMResponse)(nil),                // 21: poker.ICMResponse
+	(*AllInResult)(nil),                // 22: poker.AllInResult
+	(*PushFoldRequest)(nil),            // 23: poker.PushFoldRequest
+	(*ChartRow)(nil),                   // 24: poker.ChartRow
+	(*ChartCell)(nil),                  // 25: poker.ChartCell
+	(*PushFoldResponse)(nil),           // 26: poker.PushFoldResponse
+	(*EquityBreakdownRequest)(nil),     // 27: poker.EquityBreakdownRequest
+	(*StreetEquity)(nil),               // 28: poker.StreetEquity
+	(*RunoutCard)(nil),                 // 29: poker.RunoutCard
+	(*EquityBreakdownResponse)(nil),    // 30: poker.EquityBreakdownResponse
+	(*ShowdownRequest)(nil),            // 31: poker.ShowdownRequest
+	(*ShowdownPlayer)(nil),             // 32: poker.ShowdownPlayer
+	(*ShowdownPlayerResult)(nil),       // 33: poker.ShowdownPlayerResult
+	(*Pot)(nil),                        // 34: poker.Pot
+	(*ShowdownResponse)(nil),           // 35: poker.ShowdownResponse
+	(*ImportHandHistoryRequest)(nil),   // 36: poker.ImportHandHistoryRequest
+	(*ImportHandHistoryResponse)(nil),  // 37: poker.ImportHandHistoryResponse
+	(*ExportHandHistoryRequest)(nil),   // 38: poker.ExportHandHistoryRequest
+	(*ExportHandHistoryResponse)(nil),  // 39: poker.ExportHandHistoryResponse
+	(*AnalyzeLuckRequest)(nil),         // 40: poker.AnalyzeLuckRequest
+	(*AnalyzeLuckResponse)(nil),        // 41: poker.AnalyzeLuckResponse
+	(*LuckSeries)(nil),                 // 42: poker.LuckSeries
+	(*LuckPoint)(nil),                  // 43: poker.LuckPoint
+	(*PlayerStatsRequest)(nil),         // 44: poker.PlayerStatsRequest
+	(*PlayerStatsResponse)(nil),        // 45: poker.PlayerStatsResponse
+	(*StatRatio)(nil),                  // 46: poker.StatRatio
+	(*PlayerStats)(nil),                // 47: poker.PlayerStats
+	(*VerifyShuffleRequest)(nil),       // 48: poker.VerifyShuffleRequest
+	(*VerifyShuffleResponse)(nil),      // 49: poker.VerifyShuffleResponse
+	(*CacheStatsRequest)(nil),          // 50: poker.CacheStatsRequest
+	(*CacheStatsResponse)(nil),         // 51: poker.CacheStatsResponse
+	(*CacheStats)(nil),                 // 52: poker.CacheStats
+	(*BatchEvaluateHandsRequest)(nil),  // 53: poker.BatchEvaluateHandsRequest
+	(*BatchEvaluateHandsResponse)(nil), // 54: poker.BatchEvaluateHandsResponse
+	(*EvaluateHandResult)(nil),         // 55: poker.EvaluateHandResult
+	(*BatchCompareHandsRequest)(nil),   // 56: poker.BatchCompareHandsRequest
+	(*BatchCompareHandsResponse)(nil),  // 57: poker.BatchCompareHandsResponse
+	(*CompareHandsResult)(nil),         // 58: poker.CompareHandsResult
+	(*HandParseError)(nil),             // 59: poker.HandParseError
+	(*HandHistory)(nil),                // 60: poker.HandHistory
+	(*HandHistorySeat)(nil),            // 61: poker.HandHistorySeat
+	(*HandHistoryAction)(nil),          // 62: poker.HandHistoryAction
+	(*TableCommand)(nil),               // 63: poker.TableCommand
+	(*JoinTable)(nil),                  // 64: poker.JoinTable
+	(*TableConfig)(nil),                // 65: poker.TableConfig
+	(*TableAction)(nil),                // 66: poker.TableAction
+	(*LeaveTable)(nil),                 // 67: poker.LeaveTable
+	(*SetClientSeed)(nil),              // 68: poker.SetClientSeed
+	(*TableUpdate)(nil),                // 69: poker.TableUpdate
+	(*HandFairness)(nil),               // 70: poker.HandFairness
+	(*TableState)(nil),                 // 71: poker.TableState
+	(*TableSeat)(nil),                  // 72: poker.TableSeat
+	(*LegalActions)(nil),               // 73: poker.LegalActions
+}
+var file_poker_proto_depIdxs = []int32{
+	2,  // 0: poker.EvaluateHandResponse.draws:type_name -> poker.Draw
+	1,  // 1: poker.CompareHandsResponse.player1_hand:type_name -> poker.EvaluateHandResponse
+	1,  // 2: poker.CompareHandsResponse.player2_hand:type_name -> poker.EvaluateHandResponse
+	1,  // 3: poker.NutAnalysisResponse.nut_hand:type_name -> poker.EvaluateHandResponse
+	12, // 4: poker.NutAnalysisResponse.nut_holdings:type_name -> poker.Holding
+	1,  // 5: poker.NutAnalysisResponse.hero_hand:type_name -> poker.EvaluateHandResponse
+	19, // 6: poker.ICMRequest.all_in:type_name -> poker.AllInScenario
+	20, // 7: poker.AllInScenario.opponents:type_name -> poker.AllInPlayer
+	22, // 8: poker.ICMResponse.all_in:type_name -> poker.AllInResult
+	25, // 9: poker.ChartRow.cells:type_name -> poker.ChartCell
+	24, // 10: poker.PushFoldResponse.push_chart:type_name -> poker.ChartRow
+	24, // 11: poker.PushFoldResponse.call_chart:type_name -> poker.ChartRow
+	12, // 12: poker.EquityBreakdownRequest.players:type_name -> poker.Holding
+	28, // 13: poker.EquityBreakdownResponse.streets:type_name -> poker.StreetEquity
+	29, // 14: poker.EquityBreakdownResponse.runouts:type_name -> poker.RunoutCard
+	32, // 15: poker.ShowdownRequest.players:type_name -> poker.ShowdownPlayer
+	1,  // 16: poker.ShowdownPlayerResult.hand:type_name -> poker.EvaluateHandResponse
+	33, // 17: poker.ShowdownResponse.players:type_name -> poker.ShowdownPlayerResult
+	34, // 18: poker.ShowdownResponse.pots:type_name -> poker.Pot
+	59, // 19: poker.ImportHandHistoryResponse.errors:type_name -> poker.HandParseError
+	60, // 20: poker.ImportHandHistoryResponse.hands:type_name -> poker.HandHistory
+	42, // 21: poker.AnalyzeLuckResponse.players:type_name -> poker.LuckSeries
+	43, // 22: poker.LuckSeries.points:type_name -> poker.LuckPoint
+	47, // 23: poker.PlayerStatsResponse.players:type_name -> poker.PlayerStats
+	46, // 24: poker.PlayerStats.vpip:type_name -> poker.StatRatio
+	46, // 25: poker.PlayerStats.pfr:type_name -> poker.StatRatio
+	46, // 26: poker.PlayerStats.three_bet:type_name -> poker.StatRatio
+	46, // 27: poker.PlayerStats.fold_to_three_bet:type_name -> poker.StatRatio
+	46, // 28: poker.PlayerStats.cbet:type_name -> poker.StatRatio
+	46, // 29: poker.PlayerStats.wtsd:type_name -> poker.StatRatio
+	46, // 30: poker.PlayerStats.wsd:type_name -> poker.StatRatio
+	47, // 31: poker.PlayerStats.positions:type_name -> poker.PlayerStats
+	52, // 32: poker.CacheStatsResponse.caches:type_name -> poker.CacheStats
+	0,  // 33: poker.BatchEvaluateHandsRequest.hands:type_name -> poker.EvaluateHandRequest
+	55, // 34: poker.BatchEvaluateHandsResponse.results:type_name -> poker.EvaluateHandResult
+	1,  // 35: poker.EvaluateHandResult.hand:type_name -> poker.EvaluateHandResponse
+	3,  // 36: poker.BatchCompareHandsRequest.comparisons:type_name -> poker.CompareHandsRequest
+	58, // 37: poker.BatchCompareHandsResponse.results:type_name -> poker.CompareHandsResult
+	4,  // 38: poker.CompareHandsResult.comparison:type_name -> poker.CompareHandsResponse
+	61, // 39: poker.HandHistory.seats:type_name -> poker.HandHistorySeat
+	62, // 40: poker.HandHistory.actions:type_name -> poker.HandHistoryAction
+	64, // 41: poker.TableCommand.join:type_name -> poker.JoinTable
+	66, // 42: poker.TableCommand.action:type_name -> poker.TableAction
+	67, // 43: poker.TableCommand.leave:type_name -> poker.LeaveTable
+	68, // 44: poker.TableCommand.client_seed:type_name -> poker.SetClientSeed
+	65, // 45: poker.JoinTable.config:type_name -> poker.TableConfig
+	71, // 46: poker.TableUpdate.state:type_name -> poker.TableState
+	73, // 47: poker.TableUpdate.legal:type_name -> poker.LegalActions
+	70, // 48: poker.TableUpdate.fairness:type_name -> poker.HandFairness
+	72, // 49: poker.TableState.seats:type_name -> poker.TableSeat
+	0,  // 50: poker.PokerEvaluator.EvaluateHand:input_type -> poker.EvaluateHandRequest
+	3,  // 51: poker.PokerEvaluator.CompareHands:input_type -> poker.CompareHandsRequest
+	5,  // 52: poker.PokerEvaluator.CalculateWinProbability:input_type -> poker.ProbabilityRequest
+	7,  // 53: poker.PokerEvaluator.StreamWinProbability:input_type -> poker.StreamProbabilityRequest
+	9,  // 54: poker.PokerEvaluator.AnalyzeBoardTexture:input_type -> poker.BoardTextureRequest
+	11, // 55: poker.PokerEvaluator.AnalyzeNuts:input_type -> poker.NutAnalysisRequest
+	14, // 56: poker.PokerEvaluator.CalculateHandPotential:input_type -> poker.HandPotentialRequest
+	16, // 57: poker.PokerEvaluator.EvaluateCallDecision:input_type -> poker.CallDecisionRequest
+	18, // 58: poker.PokerEvaluator.CalculateICM:input_type -> poker.ICMRequest
+	23, // 59: poker.PokerEvaluator.SolvePushFold:input_type -> poker.PushFoldRequest
+	27, // 60: poker.PokerEvaluator.CalculateEquityBreakdown:input_type -> poker.EquityBreakdownRequest
+	31, // 61: poker.PokerEvaluator.Showdown:input_type -> poker.ShowdownRequest
+	36, // 62: poker.PokerEvaluator.ImportHandHistory:input_type -> poker.ImportHandHistoryRequest
+	38, // 63: poker.PokerEvaluator.ExportHandHistory:input_type -> poker.ExportHandHistoryRequest
+	40, // 64: poker.PokerEvaluator.AnalyzeLuck:input_type -> poker.AnalyzeLuckRequest
+	44, // 65: poker.PokerEvaluator.GetPlayerStats:input_type -> poker.PlayerStatsRequest
+	48, // 66: poker.PokerEvaluator.VerifyShuffle:input_type -> poker.VerifyShuffleRequest
+	50, // 67: poker.PokerEvaluator.GetCacheStats:input_type -> poker.CacheStatsRequest
+	53, // 68: poker.PokerEvaluator.BatchEvaluateHands:input_type -> poker.BatchEvaluateHandsRequest
+	56, // 69: poker.PokerEvaluator.BatchCompareHands:input_type -> poker.BatchCompareHandsRequest
+	0,  // 70: poker.PokerEvaluator.StreamEvaluateHands:input_type -> poker.EvaluateHandRequest
+	3,  // 71: poker.PokerEvaluator.StreamCompareHands:input_type -> poker.CompareHandsRequest
+	63, // 72: poker.TableService.PlayHand:input_type -> poker.TableCommand
+	1,  // 73: poker.PokerEvaluator.EvaluateHand:output_type -> poker.EvaluateHandResponse
+	4,  // 74: poker.PokerEvaluator.CompareHands:output_type -> poker.CompareHandsResponse
+	6,  // 75: poker.PokerEvaluator.CalculateWinProbability:output_type -> poker.ProbabilityResponse
+	8,  // 76: poker.PokerEvaluator.StreamWinProbability:output_type -> poker.ProbabilityUpdate
+	10, // 77: poker.PokerEvaluator.AnalyzeBoardTexture:output_type -> poker.BoardTextureResponse
+	13, // 78: poker.PokerEvaluator.AnalyzeNuts:output_type -> poker.NutAnalysisResponse
+	15, // 79: poker.PokerEvaluator.CalculateHandPotential:output_type -> poker.HandPotentialResponse
+	17, // 80: poker.PokerEvaluator.EvaluateCallDecision:output_type -> poker.CallDecisionResponse
+	21, // 81: poker.PokerEvaluator.CalculateICM:output_type -> poker.ICMResponse
+	26, // 82: poker.PokerEvaluator.SolvePushFold:output_type -> poker.PushFoldResponse
+	30, // 83: poker.PokerEvaluator.CalculateEquityBreakdown:output_type -> poker.EquityBreakdownResponse
+	35, // 84: poker.PokerEvaluator.Showdown:output_type -> poker.ShowdownResponse
+	37, // 85: poker.PokerEvaluator.ImportHandHistory:output_type -> poker.ImportHandHistoryResponse
+	39, // 86: poker.PokerEvaluator.ExportHandHistory:output_type -> poker.ExportHandHistoryResponse
+	41, // 87: poker.PokerEvaluator.AnalyzeLuck:output_type -> poker.AnalyzeLuckResponse
+	45, // 88: poker.PokerEvaluator.GetPlayerStats:output_type -> poker.PlayerStatsResponse
+	49, // 89: poker.PokerEvaluator.VerifyShuffle:output_type -> poker.VerifyShuffleResponse
+	51, // 90: poker.PokerEvaluator.GetCacheStats:output_type -> poker.CacheStatsResponse
+	54, // 91: poker.PokerEvaluator.BatchEvaluateHands:output_type -> poker.BatchEvaluateHandsResponse
+	57, // 92: poker.PokerEvaluator.BatchCompareHands:output_type -> poker.BatchCompareHandsResponse
+	54, // 93: poker.PokerEvaluator.StreamEvaluateHands:output_type -> poker.BatchEvaluateHandsResponse
+	57, // 94: poker.PokerEvaluator.StreamCompareHands:output_type -> poker.BatchCompareHandsResponse
+	69, // 95: poker.TableService.PlayHand:output_type -> poker.TableUpdate
+	73, // [73:96] is the sub-list for method output_type
+	50, // [50:73] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
+}
+
+func init() { file_poker_proto_init() }
+func file_poker_proto_init() {
+	if File_poker_proto != nil {
+		return
+	}
+	file_poker_proto_msgTypes[16].OneofWrappers = []any{}
+	file_poker_proto_msgTypes[63].OneofWrappers = []any{
+		(*TableCommand_Join)(nil),
+		(*TableCommand_Action)(nil),
+		(*TableCommand_Leave)(nil),
+		(*TableCommand_ClientSeed)(nil),
 	}
+	file_poker_proto_msgTypes[64].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_poker_proto_rawDesc), len(file_poker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   74,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_poker_proto_goTypes,
 		DependencyIndexes: file_poker_proto_depIdxs,
@@ -19,9 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PokerEvaluator_EvaluateHand_FullMethodName            = "/poker.PokerEvaluator/EvaluateHand"
-	PokerEvaluator_CompareHands_FullMethodName            = "/poker.PokerEvaluator/CompareHands"
-	PokerEvaluator_CalculateWinProbability_FullMethodName = "/poker.PokerEvaluator/CalculateWinProbability"
+	PokerEvaluator_EvaluateHand_FullMethodName             = "/poker.PokerEvaluator/EvaluateHand"
+	PokerEvaluator_CompareHands_FullMethodName             = "/poker.PokerEvaluator/CompareHands"
+	PokerEvaluator_CalculateWinProbability_FullMethodName  = "/poker.PokerEvaluator/CalculateWinProbability"
+	PokerEvaluator_StreamWinProbability_FullMethodName     = "/poker.PokerEvaluator/StreamWinProbability"
+	PokerEvaluator_AnalyzeBoardTexture_FullMethodName      = "/poker.PokerEvaluator/AnalyzeBoardTexture"
+	PokerEvaluator_AnalyzeNuts_FullMethodName              = "/poker.PokerEvaluator/AnalyzeNuts"
+	PokerEvaluator_CalculateHandPotential_FullMethodName   = "/poker.PokerEvaluator/CalculateHandPotential"
+	PokerEvaluator_EvaluateCallDecision_FullMethodName     = "/poker.PokerEvaluator/EvaluateCallDecision"
+	PokerEvaluator_CalculateICM_FullMethodName             = "/poker.PokerEvaluator/CalculateICM"
+	PokerEvaluator_SolvePushFold_FullMethodName            = "/poker.PokerEvaluator/SolvePushFold"
+	PokerEvaluator_CalculateEquityBreakdown_FullMethodName = "/poker.PokerEvaluator/CalculateEquityBreakdown"
+	PokerEvaluator_Showdown_FullMethodName                 = "/poker.PokerEvaluator/Showdown"
+	PokerEvaluator_ImportHandHistory_FullMethodName        = "/poker.PokerEvaluator/ImportHandHistory"
+	PokerEvaluator_ExportHandHistory_FullMethodName        = "/poker.PokerEvaluator/ExportHandHistory"
+	PokerEvaluator_AnalyzeLuck_FullMethodName              = "/poker.PokerEvaluator/AnalyzeLuck"
+	PokerEvaluator_GetPlayerStats_FullMethodName           = "/poker.PokerEvaluator/GetPlayerStats"
+	PokerEvaluator_VerifyShuffle_FullMethodName            = "/poker.PokerEvaluator/VerifyShuffle"
+	PokerEvaluator_GetCacheStats_FullMethodName            = "/poker.PokerEvaluator/GetCacheStats"
+	PokerEvaluator_BatchEvaluateHands_FullMethodName       = "/poker.PokerEvaluator/BatchEvaluateHands"
+	PokerEvaluator_BatchCompareHands_FullMethodName        = "/poker.PokerEvaluator/BatchCompareHands"
+	PokerEvaluator_StreamEvaluateHands_FullMethodName      = "/poker.PokerEvaluator/StreamEvaluateHands"
+	PokerEvaluator_StreamCompareHands_FullMethodName       = "/poker.PokerEvaluator/StreamCompareHands"
 )
 
 // PokerEvaluatorClient is the client API for PokerEvaluator service.
@@ -30,12 +49,52 @@ const (
 //
 // PokerEvaluator service for Texas Hold'em poker hand evaluation and probability calculation
 type PokerEvaluatorClient interface {
-	// EvaluateHand evaluates the best hand from 2 hole cards + 5 community cards
+	// EvaluateHand evaluates the best hand from 2 hole cards + 3, 4 or 5 community cards (with draws on the flop and turn),
+	// or classifies the 2 hole cards preflop when no community cards are given
 	EvaluateHand(ctx context.Context, in *EvaluateHandRequest, opts ...grpc.CallOption) (*EvaluateHandResponse, error)
 	// CompareHands compares two hands (each with 2 hole cards + 5 community cards) and determines the winner
 	CompareHands(ctx context.Context, in *CompareHandsRequest, opts ...grpc.CallOption) (*CompareHandsResponse, error)
 	// CalculateWinProbability calculates the probability of winning using Monte Carlo simulation
 	CalculateWinProbability(ctx context.Context, in *ProbabilityRequest, opts ...grpc.CallOption) (*ProbabilityResponse, error)
+	// StreamWinProbability runs the same simulation as CalculateWinProbability and streams interim estimates as it converges
+	// (served over REST as Server-Sent Events at /poker/stream-probability rather than by the gateway)
+	StreamWinProbability(ctx context.Context, in *StreamProbabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProbabilityUpdate], error)
+	// AnalyzeBoardTexture classifies a flop, turn or river by pairing, suits, connectedness and wetness
+	AnalyzeBoardTexture(ctx context.Context, in *BoardTextureRequest, opts ...grpc.CallOption) (*BoardTextureResponse, error)
+	// AnalyzeNuts finds the nuts on a board and ranks the hero's hand against every possible opponent holding
+	AnalyzeNuts(ctx context.Context, in *NutAnalysisRequest, opts ...grpc.CallOption) (*NutAnalysisResponse, error)
+	// CalculateHandPotential computes hand strength (HS), positive and negative potential (PPot/NPot) and effective hand strength (EHS)
+	CalculateHandPotential(ctx context.Context, in *HandPotentialRequest, opts ...grpc.CallOption) (*HandPotentialResponse, error)
+	// EvaluateCallDecision computes pot odds, required equity, call EV and implied odds, with a call/fold recommendation
+	EvaluateCallDecision(ctx context.Context, in *CallDecisionRequest, opts ...grpc.CallOption) (*CallDecisionResponse, error)
+	// CalculateICM computes tournament prize equity with the Independent Chip Model, optionally for an all-in call or fold
+	CalculateICM(ctx context.Context, in *ICMRequest, opts ...grpc.CallOption) (*ICMResponse, error)
+	// SolvePushFold computes heads-up Nash push/fold ranges for an effective stack and blind structure as 13x13 charts
+	SolvePushFold(ctx context.Context, in *PushFoldRequest, opts ...grpc.CallOption) (*PushFoldResponse, error)
+	// CalculateEquityBreakdown computes every player's equity street by street and the outcome of every possible next card
+	CalculateEquityBreakdown(ctx context.Context, in *EquityBreakdownRequest, opts ...grpc.CallOption) (*EquityBreakdownResponse, error)
+	// Showdown ranks any number of players on one board and awards the main and side pots
+	Showdown(ctx context.Context, in *ShowdownRequest, opts ...grpc.CallOption) (*ShowdownResponse, error)
+	// ImportHandHistory parses PokerStars-style text or PHH hand histories, stores the hands for analysis and reports errors per hand
+	ImportHandHistory(ctx context.Context, in *ImportHandHistoryRequest, opts ...grpc.CallOption) (*ImportHandHistoryResponse, error)
+	// ExportHandHistory writes stored hands, imported or played at the tables, in the open PHH format
+	ExportHandHistory(ctx context.Context, in *ExportHandHistoryRequest, opts ...grpc.CallOption) (*ExportHandHistoryResponse, error)
+	// AnalyzeLuck compares actual winnings with all-in adjusted expected winnings over stored hands, per hand and cumulatively
+	AnalyzeLuck(ctx context.Context, in *AnalyzeLuckRequest, opts ...grpc.CallOption) (*AnalyzeLuckResponse, error)
+	// GetPlayerStats computes VPIP, PFR, 3-bet, c-bet, showdown and win rate statistics from stored hands, per player and per position
+	GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStatsResponse, error)
+	// VerifyShuffle checks a revealed server seed against its published hash and reproduces the deck it dealt
+	VerifyShuffle(ctx context.Context, in *VerifyShuffleRequest, opts ...grpc.CallOption) (*VerifyShuffleResponse, error)
+	// GetCacheStats reports the size, hits and misses of the result caches behind EvaluateHand and CalculateWinProbability
+	GetCacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsResponse, error)
+	// BatchEvaluateHands evaluates many hands in one call, with a result or an error for each hand
+	BatchEvaluateHands(ctx context.Context, in *BatchEvaluateHandsRequest, opts ...grpc.CallOption) (*BatchEvaluateHandsResponse, error)
+	// BatchCompareHands compares many pairs of hands in one call, with a result or an error for each pair
+	BatchCompareHands(ctx context.Context, in *BatchCompareHandsRequest, opts ...grpc.CallOption) (*BatchCompareHandsResponse, error)
+	// StreamEvaluateHands evaluates hands streamed by the client, for batches too large for one call, and answers when the stream closes
+	StreamEvaluateHands(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[EvaluateHandRequest, BatchEvaluateHandsResponse], error)
+	// StreamCompareHands compares pairs of hands streamed by the client and answers when the stream closes
+	StreamCompareHands(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CompareHandsRequest, BatchCompareHandsResponse], error)
 }
 
 type pokerEvaluatorClient struct {
@@ -76,18 +135,263 @@ func (c *pokerEvaluatorClient) CalculateWinProbability(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *pokerEvaluatorClient) StreamWinProbability(ctx context.Context, in *StreamProbabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProbabilityUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PokerEvaluator_ServiceDesc.Streams[0], PokerEvaluator_StreamWinProbability_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamProbabilityRequest, ProbabilityUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokerEvaluator_StreamWinProbabilityClient = grpc.ServerStreamingClient[ProbabilityUpdate]
+
+func (c *pokerEvaluatorClient) AnalyzeBoardTexture(ctx context.Context, in *BoardTextureRequest, opts ...grpc.CallOption) (*BoardTextureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardTextureResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_AnalyzeBoardTexture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) AnalyzeNuts(ctx context.Context, in *NutAnalysisRequest, opts ...grpc.CallOption) (*NutAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NutAnalysisResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_AnalyzeNuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) CalculateHandPotential(ctx context.Context, in *HandPotentialRequest, opts ...grpc.CallOption) (*HandPotentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandPotentialResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_CalculateHandPotential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) EvaluateCallDecision(ctx context.Context, in *CallDecisionRequest, opts ...grpc.CallOption) (*CallDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallDecisionResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_EvaluateCallDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) CalculateICM(ctx context.Context, in *ICMRequest, opts ...grpc.CallOption) (*ICMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ICMResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_CalculateICM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) SolvePushFold(ctx context.Context, in *PushFoldRequest, opts ...grpc.CallOption) (*PushFoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushFoldResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_SolvePushFold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) CalculateEquityBreakdown(ctx context.Context, in *EquityBreakdownRequest, opts ...grpc.CallOption) (*EquityBreakdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EquityBreakdownResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_CalculateEquityBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) Showdown(ctx context.Context, in *ShowdownRequest, opts ...grpc.CallOption) (*ShowdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShowdownResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_Showdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) ImportHandHistory(ctx context.Context, in *ImportHandHistoryRequest, opts ...grpc.CallOption) (*ImportHandHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportHandHistoryResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_ImportHandHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) ExportHandHistory(ctx context.Context, in *ExportHandHistoryRequest, opts ...grpc.CallOption) (*ExportHandHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportHandHistoryResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_ExportHandHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) AnalyzeLuck(ctx context.Context, in *AnalyzeLuckRequest, opts ...grpc.CallOption) (*AnalyzeLuckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeLuckResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_AnalyzeLuck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerStatsResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_GetPlayerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) VerifyShuffle(ctx context.Context, in *VerifyShuffleRequest, opts ...grpc.CallOption) (*VerifyShuffleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyShuffleResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_VerifyShuffle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) GetCacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheStatsResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) BatchEvaluateHands(ctx context.Context, in *BatchEvaluateHandsRequest, opts ...grpc.CallOption) (*BatchEvaluateHandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchEvaluateHandsResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_BatchEvaluateHands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) BatchCompareHands(ctx context.Context, in *BatchCompareHandsRequest, opts ...grpc.CallOption) (*BatchCompareHandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCompareHandsResponse)
+	err := c.cc.Invoke(ctx, PokerEvaluator_BatchCompareHands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerEvaluatorClient) StreamEvaluateHands(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[EvaluateHandRequest, BatchEvaluateHandsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PokerEvaluator_ServiceDesc.Streams[1], PokerEvaluator_StreamEvaluateHands_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EvaluateHandRequest, BatchEvaluateHandsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokerEvaluator_StreamEvaluateHandsClient = grpc.ClientStreamingClient[EvaluateHandRequest, BatchEvaluateHandsResponse]
+
+func (c *pokerEvaluatorClient) StreamCompareHands(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CompareHandsRequest, BatchCompareHandsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PokerEvaluator_ServiceDesc.Streams[2], PokerEvaluator_StreamCompareHands_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CompareHandsRequest, BatchCompareHandsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokerEvaluator_StreamCompareHandsClient = grpc.ClientStreamingClient[CompareHandsRequest, BatchCompareHandsResponse]
+
 // PokerEvaluatorServer is the server API for PokerEvaluator service.
 // All implementations must embed UnimplementedPokerEvaluatorServer
 // for forward compatibility.
 //
 // PokerEvaluator service for Texas Hold'em poker hand evaluation and probability calculation
 type PokerEvaluatorServer interface {
-	// EvaluateHand evaluates the best hand from 2 hole cards + 5 community cards
+	// EvaluateHand evaluates the best hand from 2 hole cards + 3, 4 or 5 community cards (with draws on the flop and turn),
+	// or classifies the 2 hole cards preflop when no community cards are given
 	EvaluateHand(context.Context, *EvaluateHandRequest) (*EvaluateHandResponse, error)
 	// CompareHands compares two hands (each with 2 hole cards + 5 community cards) and determines the winner
 	CompareHands(context.Context, *CompareHandsRequest) (*CompareHandsResponse, error)
 	// CalculateWinProbability calculates the probability of winning using Monte Carlo simulation
 	CalculateWinProbability(context.Context, *ProbabilityRequest) (*ProbabilityResponse, error)
+	// StreamWinProbability runs the same simulation as CalculateWinProbability and streams interim estimates as it converges
+	// (served over REST as Server-Sent Events at /poker/stream-probability rather than by the gateway)
+	StreamWinProbability(*StreamProbabilityRequest, grpc.ServerStreamingServer[ProbabilityUpdate]) error
+	// AnalyzeBoardTexture classifies a flop, turn or river by pairing, suits, connectedness and wetness
+	AnalyzeBoardTexture(context.Context, *BoardTextureRequest) (*BoardTextureResponse, error)
+	// AnalyzeNuts finds the nuts on a board and ranks the hero's hand against every possible opponent holding
+	AnalyzeNuts(context.Context, *NutAnalysisRequest) (*NutAnalysisResponse, error)
+	// CalculateHandPotential computes hand strength (HS), positive and negative potential (PPot/NPot) and effective hand strength (EHS)
+	CalculateHandPotential(context.Context, *HandPotentialRequest) (*HandPotentialResponse, error)
+	// EvaluateCallDecision computes pot odds, required equity, call EV and implied odds, with a call/fold recommendation
+	EvaluateCallDecision(context.Context, *CallDecisionRequest) (*CallDecisionResponse, error)
+	// CalculateICM computes tournament prize equity with the Independent Chip Model, optionally for an all-in call or fold
+	CalculateICM(context.Context, *ICMRequest) (*ICMResponse, error)
+	// SolvePushFold computes heads-up Nash push/fold ranges for an effective stack and blind structure as 13x13 charts
+	SolvePushFold(context.Context, *PushFoldRequest) (*PushFoldResponse, error)
+	// CalculateEquityBreakdown computes every player's equity street by street and the outcome of every possible next card
+	CalculateEquityBreakdown(context.Context, *EquityBreakdownRequest) (*EquityBreakdownResponse, error)
+	// Showdown ranks any number of players on one board and awards the main and side pots
+	Showdown(context.Context, *ShowdownRequest) (*ShowdownResponse, error)
+	// ImportHandHistory parses PokerStars-style text or PHH hand histories, stores the hands for analysis and reports errors per hand
+	ImportHandHistory(context.Context, *ImportHandHistoryRequest) (*ImportHandHistoryResponse, error)
+	// ExportHandHistory writes stored hands, imported or played at the tables, in the open PHH format
+	ExportHandHistory(context.Context, *ExportHandHistoryRequest) (*ExportHandHistoryResponse, error)
+	// AnalyzeLuck compares actual winnings with all-in adjusted expected winnings over stored hands, per hand and cumulatively
+	AnalyzeLuck(context.Context, *AnalyzeLuckRequest) (*AnalyzeLuckResponse, error)
+	// GetPlayerStats computes VPIP, PFR, 3-bet, c-bet, showdown and win rate statistics from stored hands, per player and per position
+	GetPlayerStats(context.Context, *PlayerStatsRequest) (*PlayerStatsResponse, error)
+	// VerifyShuffle checks a revealed server seed against its published hash and reproduces the deck it dealt
+	VerifyShuffle(context.Context, *VerifyShuffleRequest) (*VerifyShuffleResponse, error)
+	// GetCacheStats reports the size, hits and misses of the result caches behind EvaluateHand and CalculateWinProbability
+	GetCacheStats(context.Context, *CacheStatsRequest) (*CacheStatsResponse, error)
+	// BatchEvaluateHands evaluates many hands in one call, with a result or an error for each hand
+	BatchEvaluateHands(context.Context, *BatchEvaluateHandsRequest) (*BatchEvaluateHandsResponse, error)
+	// BatchCompareHands compares many pairs of hands in one call, with a result or an error for each pair
+	BatchCompareHands(context.Context, *BatchCompareHandsRequest) (*BatchCompareHandsResponse, error)
+	// StreamEvaluateHands evaluates hands streamed by the client, for batches too large for one call, and answers when the stream closes
+	StreamEvaluateHands(grpc.ClientStreamingServer[EvaluateHandRequest, BatchEvaluateHandsResponse]) error
+	// StreamCompareHands compares pairs of hands streamed by the client and answers when the stream closes
+	StreamCompareHands(grpc.ClientStreamingServer[CompareHandsRequest, BatchCompareHandsResponse]) error
 	mustEmbedUnimplementedPokerEvaluatorServer()
 }
 
@@ -107,6 +411,63 @@ func (UnimplementedPokerEvaluatorServer) CompareHands(context.Context, *CompareH
 func (UnimplementedPokerEvaluatorServer) CalculateWinProbability(context.Context, *ProbabilityRequest) (*ProbabilityResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CalculateWinProbability not implemented")
 }
+func (UnimplementedPokerEvaluatorServer) StreamWinProbability(*StreamProbabilityRequest, grpc.ServerStreamingServer[ProbabilityUpdate]) error {
+	return status.Error(codes.Unimplemented, "method StreamWinProbability not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) AnalyzeBoardTexture(context.Context, *BoardTextureRequest) (*BoardTextureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnalyzeBoardTexture not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) AnalyzeNuts(context.Context, *NutAnalysisRequest) (*NutAnalysisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnalyzeNuts not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) CalculateHandPotential(context.Context, *HandPotentialRequest) (*HandPotentialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateHandPotential not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) EvaluateCallDecision(context.Context, *CallDecisionRequest) (*CallDecisionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateCallDecision not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) CalculateICM(context.Context, *ICMRequest) (*ICMResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateICM not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) SolvePushFold(context.Context, *PushFoldRequest) (*PushFoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SolvePushFold not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) CalculateEquityBreakdown(context.Context, *EquityBreakdownRequest) (*EquityBreakdownResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateEquityBreakdown not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) Showdown(context.Context, *ShowdownRequest) (*ShowdownResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Showdown not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) ImportHandHistory(context.Context, *ImportHandHistoryRequest) (*ImportHandHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportHandHistory not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) ExportHandHistory(context.Context, *ExportHandHistoryRequest) (*ExportHandHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportHandHistory not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) AnalyzeLuck(context.Context, *AnalyzeLuckRequest) (*AnalyzeLuckResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnalyzeLuck not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) GetPlayerStats(context.Context, *PlayerStatsRequest) (*PlayerStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) VerifyShuffle(context.Context, *VerifyShuffleRequest) (*VerifyShuffleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyShuffle not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) GetCacheStats(context.Context, *CacheStatsRequest) (*CacheStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) BatchEvaluateHands(context.Context, *BatchEvaluateHandsRequest) (*BatchEvaluateHandsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchEvaluateHands not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) BatchCompareHands(context.Context, *BatchCompareHandsRequest) (*BatchCompareHandsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchCompareHands not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) StreamEvaluateHands(grpc.ClientStreamingServer[EvaluateHandRequest, BatchEvaluateHandsResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamEvaluateHands not implemented")
+}
+func (UnimplementedPokerEvaluatorServer) StreamCompareHands(grpc.ClientStreamingServer[CompareHandsRequest, BatchCompareHandsResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamCompareHands not implemented")
+}
 func (UnimplementedPokerEvaluatorServer) mustEmbedUnimplementedPokerEvaluatorServer() {}
 func (UnimplementedPokerEvaluatorServer) testEmbeddedByValue()                        {}
 
@@ -182,6 +543,319 @@ func _PokerEvaluator_CalculateWinProbability_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PokerEvaluator_StreamWinProbability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProbabilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerEvaluatorServer).StreamWinProbability(m, &grpc.GenericServerStream[StreamProbabilityRequest, ProbabilityUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokerEvaluator_StreamWinProbabilityServer = grpc.ServerStreamingServer[ProbabilityUpdate]
+
+func _PokerEvaluator_AnalyzeBoardTexture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardTextureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).AnalyzeBoardTexture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_AnalyzeBoardTexture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).AnalyzeBoardTexture(ctx, req.(*BoardTextureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_AnalyzeNuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NutAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).AnalyzeNuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_AnalyzeNuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).AnalyzeNuts(ctx, req.(*NutAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_CalculateHandPotential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandPotentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).CalculateHandPotential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_CalculateHandPotential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).CalculateHandPotential(ctx, req.(*HandPotentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_EvaluateCallDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).EvaluateCallDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_EvaluateCallDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).EvaluateCallDecision(ctx, req.(*CallDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_CalculateICM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ICMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).CalculateICM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_CalculateICM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).CalculateICM(ctx, req.(*ICMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_SolvePushFold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushFoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).SolvePushFold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_SolvePushFold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).SolvePushFold(ctx, req.(*PushFoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_CalculateEquityBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquityBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).CalculateEquityBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_CalculateEquityBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).CalculateEquityBreakdown(ctx, req.(*EquityBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_Showdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).Showdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_Showdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).Showdown(ctx, req.(*ShowdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_ImportHandHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHandHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).ImportHandHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_ImportHandHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).ImportHandHistory(ctx, req.(*ImportHandHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_ExportHandHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportHandHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).ExportHandHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_ExportHandHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).ExportHandHistory(ctx, req.(*ExportHandHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_AnalyzeLuck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeLuckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).AnalyzeLuck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_AnalyzeLuck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).AnalyzeLuck(ctx, req.(*AnalyzeLuckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_GetPlayerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).GetPlayerStats(ctx, req.(*PlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_VerifyShuffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).VerifyShuffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_VerifyShuffle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).VerifyShuffle(ctx, req.(*VerifyShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).GetCacheStats(ctx, req.(*CacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_BatchEvaluateHands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchEvaluateHandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).BatchEvaluateHands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_BatchEvaluateHands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).BatchEvaluateHands(ctx, req.(*BatchEvaluateHandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_BatchCompareHands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCompareHandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerEvaluatorServer).BatchCompareHands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerEvaluator_BatchCompareHands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerEvaluatorServer).BatchCompareHands(ctx, req.(*BatchCompareHandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerEvaluator_StreamEvaluateHands_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PokerEvaluatorServer).StreamEvaluateHands(&grpc.GenericServerStream[EvaluateHandRequest, BatchEvaluateHandsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokerEvaluator_StreamEvaluateHandsServer = grpc.ClientStreamingServer[EvaluateHandRequest, BatchEvaluateHandsResponse]
+
+func _PokerEvaluator_StreamCompareHands_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PokerEvaluatorServer).StreamCompareHands(&grpc.GenericServerStream[CompareHandsRequest, BatchCompareHandsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokerEvaluator_StreamCompareHandsServer = grpc.ClientStreamingServer[CompareHandsRequest, BatchCompareHandsResponse]
+
 // PokerEvaluator_ServiceDesc is the grpc.ServiceDesc for PokerEvaluator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,7 +875,195 @@ var PokerEvaluator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateWinProbability",
 			Handler:    _PokerEvaluator_CalculateWinProbability_Handler,
 		},
+		{
+			MethodName: "AnalyzeBoardTexture",
+			Handler:    _PokerEvaluator_AnalyzeBoardTexture_Handler,
+		},
+		{
+			MethodName: "AnalyzeNuts",
+			Handler:    _PokerEvaluator_AnalyzeNuts_Handler,
+		},
+		{
+			MethodName: "CalculateHandPotential",
+			Handler:    _PokerEvaluator_CalculateHandPotential_Handler,
+		},
+		{
+			MethodName: "EvaluateCallDecision",
+			Handler:    _PokerEvaluator_EvaluateCallDecision_Handler,
+		},
+		{
+			MethodName: "CalculateICM",
+			Handler:    _PokerEvaluator_CalculateICM_Handler,
+		},
+		{
+			MethodName: "SolvePushFold",
+			Handler:    _PokerEvaluator_SolvePushFold_Handler,
+		},
+		{
+			MethodName: "CalculateEquityBreakdown",
+			Handler:    _PokerEvaluator_CalculateEquityBreakdown_Handler,
+		},
+		{
+			MethodName: "Showdown",
+			Handler:    _PokerEvaluator_Showdown_Handler,
+		},
+		{
+			MethodName: "ImportHandHistory",
+			Handler:    _PokerEvaluator_ImportHandHistory_Handler,
+		},
+		{
+			MethodName: "ExportHandHistory",
+			Handler:    _PokerEvaluator_ExportHandHistory_Handler,
+		},
+		{
+			MethodName: "AnalyzeLuck",
+			Handler:    _PokerEvaluator_AnalyzeLuck_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _PokerEvaluator_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "VerifyShuffle",
+			Handler:    _PokerEvaluator_VerifyShuffle_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _PokerEvaluator_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "BatchEvaluateHands",
+			Handler:    _PokerEvaluator_BatchEvaluateHands_Handler,
+		},
+		{
+			MethodName: "BatchCompareHands",
+			Handler:    _PokerEvaluator_BatchCompareHands_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWinProbability",
+			Handler:       _PokerEvaluator_StreamWinProbability_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEvaluateHands",
+			Handler:       _PokerEvaluator_StreamEvaluateHands_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamCompareHands",
+			Handler:       _PokerEvaluator_StreamCompareHands_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "poker.proto",
+}
+
+const (
+	TableService_PlayHand_FullMethodName = "/poker.TableService/PlayHand"
+)
+
+// TableServiceClient is the client API for TableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TableService seats players at Texas Hold'em tables and plays hands with them in real time
+type TableServiceClient interface {
+	// PlayHand joins a table and plays every hand until the client leaves. The first command must join a seat;
+	// the server streams public table events, the player's own hole cards, and a turn prompt with a deadline
+	// whenever the player has to act. Players who run out of time check if they can, otherwise fold.
+	// Served over REST as a WebSocket at /poker/table.
+	PlayHand(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TableCommand, TableUpdate], error)
+}
+
+type tableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTableServiceClient(cc grpc.ClientConnInterface) TableServiceClient {
+	return &tableServiceClient{cc}
+}
+
+func (c *tableServiceClient) PlayHand(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TableCommand, TableUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TableService_ServiceDesc.Streams[0], TableService_PlayHand_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TableCommand, TableUpdate]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TableService_PlayHandClient = grpc.BidiStreamingClient[TableCommand, TableUpdate]
+
+// TableServiceServer is the server API for TableService service.
+// All implementations must embed UnimplementedTableServiceServer
+// for forward compatibility.
+//
+// TableService seats players at Texas Hold'em tables and plays hands with them in real time
+type TableServiceServer interface {
+	// PlayHand joins a table and plays every hand until the client leaves. The first command must join a seat;
+	// the server streams public table events, the player's own hole cards, and a turn prompt with a deadline
+	// whenever the player has to act. Players who run out of time check if they can, otherwise fold.
+	// Served over REST as a WebSocket at /poker/table.
+	PlayHand(grpc.BidiStreamingServer[TableCommand, TableUpdate]) error
+	mustEmbedUnimplementedTableServiceServer()
+}
+
+// UnimplementedTableServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTableServiceServer struct{}
+
+func (UnimplementedTableServiceServer) PlayHand(grpc.BidiStreamingServer[TableCommand, TableUpdate]) error {
+	return status.Error(codes.Unimplemented, "method PlayHand not implemented")
+}
+func (UnimplementedTableServiceServer) mustEmbedUnimplementedTableServiceServer() {}
+func (UnimplementedTableServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TableServiceServer will
+// result in compilation errors.
+type UnsafeTableServiceServer interface {
+	mustEmbedUnimplementedTableServiceServer()
+}
+
+func RegisterTableServiceServer(s grpc.ServiceRegistrar, srv TableServiceServer) {
+	// If the following call panics, it indicates UnimplementedTableServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TableService_ServiceDesc, srv)
+}
+
+func _TableService_PlayHand_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TableServiceServer).PlayHand(&grpc.GenericServerStream[TableCommand, TableUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TableService_PlayHandServer = grpc.BidiStreamingServer[TableCommand, TableUpdate]
+
+// TableService_ServiceDesc is the grpc.ServiceDesc for TableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poker.TableService",
+	HandlerType: (*TableServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PlayHand",
+			Handler:       _TableService_PlayHand_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "poker.proto",
 }
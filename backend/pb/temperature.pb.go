@@ -7,6 +7,7 @@
 package pb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -168,7 +169,7 @@ var File_temperature_proto protoreflect.FileDescriptor
 
 const file_temperature_proto_rawDesc = "" +
 	"\n" +
-	"\x11temperature.proto\x12\vtemperature\"*\n" +
+	"\x11temperature.proto\x12\vtemperature\x1a\x1cgoogle/api/annotations.proto\"*\n" +
 	"\x0eCelsiusRequest\x12\x18\n" +
 	"\acelsius\x18\x01 \x01(\x01R\acelsius\"3\n" +
 	"\x11FahrenheitRequest\x12\x1e\n" +
@@ -179,10 +180,10 @@ const file_temperature_proto_rawDesc = "" +
 	"\acelsius\x18\x01 \x01(\x01R\acelsius\x12\x1e\n" +
 	"\n" +
 	"fahrenheit\x18\x02 \x01(\x01R\n" +
-	"fahrenheit2\xd3\x01\n" +
-	"\x14TemperatureConverter\x12[\n" +
-	"\x1aConvertCelsiusToFahrenheit\x12\x1b.temperature.CelsiusRequest\x1a .temperature.TemperatureResponse\x12^\n" +
-	"\x1aConvertFahrenheitToCelsius\x12\x1e.temperature.FahrenheitRequest\x1a .temperature.TemperatureResponseB\x06Z\x04./pbb\x06proto3"
+	"fahrenheit2\x88\x02\n" +
+	"\x14TemperatureConverter\x12p\n" +
+	"\x1aConvertCelsiusToFahrenheit\x12\x1b.temperature.CelsiusRequest\x1a .temperature.TemperatureResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/convert\x12~\n" +
+	"\x1aConvertFahrenheitToCelsius\x12\x1e.temperature.FahrenheitRequest\x1a .temperature.TemperatureResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/convert-fahrenheitB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_temperature_proto_rawDescOnce sync.Once
@@ -0,0 +1,77 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// CallDecision is the pot-odds and expected-value breakdown of calling a bet
+type CallDecision struct {
+	PotOdds              float64 // Pot to call ratio (e.g., 3 for 3:1)
+	RequiredEquity       float64 // Equity needed for a break-even call
+	Equity               float64 // Hero's equity used for the decision
+	CallAmount           float64 // Chips put in by calling, capped at the effective stack
+	CallEV               float64 // Expected chips won or lost by calling, relative to folding
+	ImpliedOddsNeeded    float64 // Extra chips that must be won on later streets to break even (0 if already profitable)
+	ImpliedOddsAvailable bool    // The stack behind after calling covers ImpliedOddsNeeded
+	AllIn                bool    // Calling puts the hero all-in
+	Call                 bool    // Recommendation: call if true, fold if false
+	Reason               string  // Explanation of the recommendation
+}
+
+// EvaluateCall works out whether calling betToCall into potSize (which already includes the bet)
+// is profitable with the given equity. If the bet exceeds the effective stack the hero calls all-in
+// for less and the uncalled part of the bet is left out of the pot.
+func EvaluateCall(potSize, betToCall, effectiveStack, equity float64) (CallDecision, error) {
+	if potSize <= 0 {
+		return CallDecision{}, fmt.Errorf("pot size must be positive")
+	}
+	if betToCall <= 0 {
+		return CallDecision{}, fmt.Errorf("bet to call must be positive")
+	}
+	if betToCall > potSize {
+		return CallDecision{}, fmt.Errorf("pot size must include the bet to call")
+	}
+	if effectiveStack <= 0 {
+		return CallDecision{}, fmt.Errorf("effective stack must be positive")
+	}
+	if equity < 0 || equity > 1 {
+		return CallDecision{}, fmt.Errorf("equity must be between 0 and 1")
+	}
+
+	decision := CallDecision{Equity: equity, CallAmount: betToCall}
+	if betToCall >= effectiveStack {
+		// All-in for less: the part of the bet the hero cannot cover goes back to the bettor
+		decision.AllIn = true
+		decision.CallAmount = effectiveStack
+		potSize -= betToCall - effectiveStack
+	}
+
+	finalPot := potSize + decision.CallAmount
+	decision.PotOdds = potSize / decision.CallAmount
+	decision.RequiredEquity = decision.CallAmount / finalPot
+	decision.CallEV = equity*finalPot - decision.CallAmount
+
+	if decision.CallEV < 0 {
+		if equity > 0 {
+			decision.ImpliedOddsNeeded = decision.CallAmount/equity - finalPot
+		}
+		stackBehind := effectiveStack - decision.CallAmount
+		decision.ImpliedOddsAvailable = equity > 0 && decision.ImpliedOddsNeeded <= stackBehind
+	} else {
+		decision.ImpliedOddsAvailable = true
+	}
+
+	switch {
+	case decision.CallEV >= 0:
+		decision.Call = true
+		decision.Reason = fmt.Sprintf("equity %.1f%% meets the %.1f%% required by the pot odds", equity*100, decision.RequiredEquity*100)
+	case decision.ImpliedOddsAvailable:
+		decision.Reason = fmt.Sprintf("equity %.1f%% is below the %.1f%% required; calling needs %.2f more in implied odds",
+			equity*100, decision.RequiredEquity*100, decision.ImpliedOddsNeeded)
+	default:
+		decision.Reason = fmt.Sprintf("equity %.1f%% is below the %.1f%% required and the stack behind cannot make up the difference",
+			equity*100, decision.RequiredEquity*100)
+	}
+
+	return decision, nil
+}
@@ -0,0 +1,84 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvaluateCall(t *testing.T) {
+	testCases := []struct {
+		name           string
+		pot            float64
+		bet            float64
+		stack          float64
+		equity         float64
+		potOdds        float64
+		requiredEquity float64
+		callEV         float64
+		impliedNeeded  float64
+		impliedOK      bool
+		allIn          bool
+		call           bool
+	}{
+		{
+			name: "Profitable call", pot: 100, bet: 50, stack: 1000, equity: 0.4,
+			potOdds: 2, requiredEquity: 1.0 / 3, callEV: 10, impliedOK: true, call: true,
+		},
+		{
+			name: "Fold without implied odds", pot: 100, bet: 50, stack: 1000, equity: 0.25,
+			potOdds: 2, requiredEquity: 1.0 / 3, callEV: -12.5, impliedNeeded: 50, impliedOK: true,
+		},
+		{
+			name: "All-in for less", pot: 300, bet: 200, stack: 100, equity: 0.3,
+			potOdds: 2, requiredEquity: 1.0 / 3, callEV: -10, impliedNeeded: 100/0.3 - 300, allIn: true,
+		},
+		{
+			name: "Zero equity", pot: 100, bet: 50, stack: 1000, equity: 0,
+			potOdds: 2, requiredEquity: 1.0 / 3, callEV: -50,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decision, err := EvaluateCall(tc.pot, tc.bet, tc.stack, tc.equity)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			check := func(field string, got, want float64) {
+				if math.Abs(got-want) > 1e-9 {
+					t.Errorf("Expected %s %f, got %f", field, want, got)
+				}
+			}
+			check("pot odds", decision.PotOdds, tc.potOdds)
+			check("required equity", decision.RequiredEquity, tc.requiredEquity)
+			check("call EV", decision.CallEV, tc.callEV)
+			check("implied odds needed", decision.ImpliedOddsNeeded, tc.impliedNeeded)
+
+			if decision.ImpliedOddsAvailable != tc.impliedOK {
+				t.Errorf("Expected implied odds available %v, got %v", tc.impliedOK, decision.ImpliedOddsAvailable)
+			}
+			if decision.AllIn != tc.allIn || decision.Call != tc.call {
+				t.Errorf("Expected all-in %v call %v, got %v %v (%s)", tc.allIn, tc.call, decision.AllIn, decision.Call, decision.Reason)
+			}
+			if decision.Reason == "" {
+				t.Error("Expected a reason")
+			}
+		})
+	}
+}
+
+func TestEvaluateCallInvalid(t *testing.T) {
+	invalid := [][4]float64{
+		{0, 10, 100, 0.5},
+		{100, 0, 100, 0.5},
+		{100, 150, 100, 0.5},
+		{100, 50, 0, 0.5},
+		{100, 50, 100, 1.5},
+	}
+	for _, args := range invalid {
+		if _, err := EvaluateCall(args[0], args[1], args[2], args[3]); err == nil {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Draw type names reported by DetectDraws
+const (
+	DrawFlush            = "flush draw"
+	DrawOpenEnded        = "open-ended straight draw"
+	DrawGutshot          = "gutshot"
+	DrawDoubleGutshot    = "double gutshot"
+	DrawBackdoorFlush    = "backdoor flush draw"
+	DrawBackdoorStraight = "backdoor straight draw"
+	DrawOvercards        = "overcards"
+)
+
+// Draw describes a drawing hand and the cards that complete it
+type Draw struct {
+	Type        string // One of the Draw* constants
+	Description string // Human-readable description (e.g., "nut flush draw")
+	Nut         bool   // A flush draw holding the highest card of the suit not on the board
+	Outs        []Card // Cards that complete the draw; for backdoor draws, the cards that turn it into a draw
+}
+
+// DetectDraws finds the flush, straight and overcard draws of 2 hole cards on a 3-card flop or 4-card turn.
+// Backdoor draws are only reported on the flop.
+func DetectDraws(holeCards, communityCards []Card) ([]Draw, error) {
+	if len(holeCards) != 2 {
+		return nil, fmt.Errorf("must provide exactly 2 hole cards")
+	}
+	if len(communityCards) != 3 && len(communityCards) != 4 {
+		return nil, fmt.Errorf("draws need 3 or 4 community cards")
+	}
+
+	knownCards := make([]Card, 0, 6)
+	knownCards = append(knownCards, holeCards...)
+	knownCards = append(knownCards, communityCards...)
+	if err := CheckDuplicateCards(knownCards); err != nil {
+		return nil, err
+	}
+	deck := RemoveCards(GetDeck(), knownCards)
+	onFlop := len(communityCards) == 3
+
+	var draws []Draw
+	draws = append(draws, flushDraws(holeCards, communityCards, deck, onFlop)...)
+	draws = append(draws, straightDraws(holeCards, communityCards, deck, onFlop)...)
+	if draw, ok := overcardDraw(holeCards, communityCards, deck); ok {
+		draws = append(draws, draw)
+	}
+	return draws, nil
+}
+
+// flushDraws reports four-flushes and, on the flop, three-flushes that use at least one hole card
+func flushDraws(holeCards, communityCards, deck []Card, onFlop bool) []Draw {
+	var draws []Draw
+	for suit := Hearts; suit <= Spades; suit++ {
+		holeInSuit := cardsOfSuit(holeCards, suit)
+		if len(holeInSuit) == 0 {
+			continue
+		}
+		total := len(holeInSuit) + len(cardsOfSuit(communityCards, suit))
+
+		drawType := ""
+		switch {
+		case total == 4:
+			drawType = DrawFlush
+		case total == 3 && onFlop:
+			drawType = DrawBackdoorFlush
+		default:
+			continue
+		}
+
+		// The nut flush draw holds the highest card of the suit that is not on the board
+		nut := false
+		for rank := Ace; rank >= Two; rank-- {
+			card := Card{Suit: suit, Rank: rank}
+			if containsCard(communityCards, card) {
+				continue
+			}
+			nut = containsCard(holeInSuit, card)
+			break
+		}
+
+		description := drawType
+		if nut {
+			description = "nut " + drawType
+		}
+		draws = append(draws, Draw{
+			Type:        drawType,
+			Description: description,
+			Nut:         nut,
+			Outs:        cardsOfSuit(deck, suit),
+		})
+	}
+	return draws
+}
+
+// straightDraws reports open-enders, gutshots and double gutshots, or on the flop a backdoor straight draw.
+// Only straights that use at least one hole card count, and nothing is reported once a straight is made.
+func straightDraws(holeCards, communityCards, deck []Card, onFlop bool) []Draw {
+	present := rankSet(holeCards, communityCards)
+	boardRanks := rankSet(communityCards)
+	if straightLow(present, boardRanks) >= 0 {
+		return nil
+	}
+
+	// Ranks that complete a straight with one more card
+	var completing []Rank
+	for rank := Two; rank <= Ace; rank++ {
+		if present[rank] {
+			continue
+		}
+		present[rank], boardRanks[rank] = true, true
+		if straightLow(present, boardRanks) >= 0 {
+			completing = append(completing, rank)
+		}
+		delete(present, rank)
+		delete(boardRanks, rank)
+	}
+
+	if len(completing) > 0 {
+		drawType := DrawGutshot
+		if len(completing) >= 2 {
+			drawType = DrawDoubleGutshot
+			if isOpenEnded(present, completing) {
+				drawType = DrawOpenEnded
+			}
+		}
+		return []Draw{{
+			Type:        drawType,
+			Description: drawType,
+			Outs:        cardsOfRanks(deck, completing),
+		}}
+	}
+
+	if !onFlop {
+		return nil
+	}
+
+	// Backdoor: three ranks of a straight, at least one from the hole cards, needing two more
+	needed := make(map[Rank]bool)
+	for _, low := range straightWindows {
+		var missing []Rank
+		usesHole := false
+		for i := 0; i < 5; i++ {
+			rank := straightRank(low, i)
+			if !present[rank] {
+				missing = append(missing, rank)
+			} else if !boardRanks[rank] {
+				usesHole = true
+			}
+		}
+		if len(missing) == 2 && usesHole {
+			for _, rank := range missing {
+				needed[rank] = true
+			}
+		}
+	}
+	if len(needed) == 0 {
+		return nil
+	}
+	ranks := make([]Rank, 0, len(needed))
+	for rank := range needed {
+		ranks = append(ranks, rank)
+	}
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+	return []Draw{{
+		Type:        DrawBackdoorStraight,
+		Description: DrawBackdoorStraight,
+		Outs:        cardsOfRanks(deck, ranks),
+	}}
+}
+
+// overcardDraw reports hole cards ranked above every board card when the hero has nothing better than high card
+func overcardDraw(holeCards, communityCards, deck []Card) (Draw, bool) {
+	boardHigh := Two
+	boardRanks := rankSet(communityCards)
+	for rank := range boardRanks {
+		if rank > boardHigh {
+			boardHigh = rank
+		}
+	}
+	if holeCards[0].Rank == holeCards[1].Rank || boardRanks[holeCards[0].Rank] || boardRanks[holeCards[1].Rank] {
+		return Draw{}, false
+	}
+	if straightLow(rankSet(holeCards, communityCards), boardRanks) >= 0 {
+		return Draw{}, false
+	}
+	for suit := Hearts; suit <= Spades; suit++ {
+		if len(cardsOfSuit(holeCards, suit))+len(cardsOfSuit(communityCards, suit)) >= 5 {
+			return Draw{}, false
+		}
+	}
+
+	var overs []Rank
+	for _, card := range holeCards {
+		if card.Rank > boardHigh {
+			overs = append(overs, card.Rank)
+		}
+	}
+	if len(overs) == 0 {
+		return Draw{}, false
+	}
+
+	description := "two overcards"
+	if len(overs) == 1 {
+		description = "one overcard"
+	}
+	return Draw{
+		Type:        DrawOvercards,
+		Description: description,
+		Outs:        cardsOfRanks(deck, overs),
+	}, true
+}
+
+// straightLow returns the lowest rank of the highest straight in present that is not made by board alone, or -1
+func straightLow(present, boardRanks map[Rank]bool) Rank {
+	for i := len(straightWindows) - 1; i >= 0; i-- {
+		low := straightWindows[i]
+		inPresent, inBoard := 0, 0
+		for j := 0; j < 5; j++ {
+			rank := straightRank(low, j)
+			if present[rank] {
+				inPresent++
+			}
+			if boardRanks[rank] {
+				inBoard++
+			}
+		}
+		if inPresent == 5 && inBoard < 5 {
+			return low
+		}
+	}
+	return -1
+}
+
+// isOpenEnded reports whether two completing ranks sit at both ends of four consecutive present ranks
+func isOpenEnded(present map[Rank]bool, completing []Rank) bool {
+	for _, low := range completing {
+		start := int(low)
+		if low == Ace {
+			// The ace also completes a wheel below 2-3-4-5
+			start = -1
+		}
+		high := Rank(start + 5)
+		if high > Ace {
+			continue
+		}
+		run := true
+		for rank := Rank(start + 1); rank < high; rank++ {
+			if !present[rank] {
+				run = false
+				break
+			}
+		}
+		if run && containsRank(completing, high) {
+			return true
+		}
+	}
+	return false
+}
+
+// rankSet returns the set of ranks in the given card groups
+func rankSet(groups ...[]Card) map[Rank]bool {
+	ranks := make(map[Rank]bool)
+	for _, cards := range groups {
+		for _, card := range cards {
+			ranks[card.Rank] = true
+		}
+	}
+	return ranks
+}
+
+// cardsOfSuit returns the cards of the given suit
+func cardsOfSuit(cards []Card, suit Suit) []Card {
+	var result []Card
+	for _, card := range cards {
+		if card.Suit == suit {
+			result = append(result, card)
+		}
+	}
+	return result
+}
+
+// cardsOfRanks returns the cards whose rank is one of ranks
+func cardsOfRanks(cards []Card, ranks []Rank) []Card {
+	var result []Card
+	for _, card := range cards {
+		if containsRank(ranks, card.Rank) {
+			result = append(result, card)
+		}
+	}
+	return result
+}
+
+// containsCard reports whether cards contains card
+func containsCard(cards []Card, card Card) bool {
+	for _, c := range cards {
+		if c == card {
+			return true
+		}
+	}
+	return false
+}
+
+// containsRank reports whether ranks contains rank
+func containsRank(ranks []Rank, rank Rank) bool {
+	for _, r := range ranks {
+		if r == rank {
+			return true
+		}
+	}
+	return false
+}
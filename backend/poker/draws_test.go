@@ -0,0 +1,107 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestDetectDraws(t *testing.T) {
+	testCases := []struct {
+		name           string
+		holeCards      []string
+		communityCards []string
+		expected       []string // Draw descriptions in order
+		outs           []int    // Number of outs per draw
+	}{
+		{
+			name:           "Nut flush draw with gutshot",
+			holeCards:      []string{"HA", "HT"},
+			communityCards: []string{"HK", "HQ", "C4"},
+			expected:       []string{"nut flush draw", "gutshot", "one overcard"},
+			outs:           []int{9, 4, 3},
+		},
+		{
+			name:           "Non-nut flush draw",
+			holeCards:      []string{"S9", "S8"},
+			communityCards: []string{"SK", "S2", "D4"},
+			expected:       []string{"flush draw"},
+			outs:           []int{9},
+		},
+		{
+			name:           "Open-ended straight draw",
+			holeCards:      []string{"H9", "D8"},
+			communityCards: []string{"C7", "S6", "HK", "D2"},
+			expected:       []string{"open-ended straight draw"},
+			outs:           []int{8},
+		},
+		{
+			name:           "Wheel-side open-ender",
+			holeCards:      []string{"H2", "D3"},
+			communityCards: []string{"C4", "S5", "HK"},
+			expected:       []string{"open-ended straight draw"},
+			outs:           []int{8},
+		},
+		{
+			name:           "Double gutshot",
+			holeCards:      []string{"H9", "D7"},
+			communityCards: []string{"C6", "S5", "H3", "DK"},
+			expected:       []string{"double gutshot"},
+			outs:           []int{8},
+		},
+		{
+			name:           "Backdoor draws and overcards",
+			holeCards:      []string{"DA", "DK"},
+			communityCards: []string{"D7", "C4", "H2"},
+			expected:       []string{"nut backdoor flush draw", "backdoor straight draw", "two overcards"},
+			outs:           []int{10, 8, 6},
+		},
+		{
+			name:           "Made straight has no straight draw",
+			holeCards:      []string{"H9", "D8"},
+			communityCards: []string{"C7", "S6", "H5"},
+			expected:       nil,
+		},
+		{
+			name:           "Board straight draw does not count",
+			holeCards:      []string{"H2", "D2"},
+			communityCards: []string{"C7", "S8", "H9", "DT"},
+			expected:       nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			holeCards, _ := ParseCards(tc.holeCards)
+			communityCards, _ := ParseCards(tc.communityCards)
+
+			draws, err := DetectDraws(holeCards, communityCards)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(draws) != len(tc.expected) {
+				t.Fatalf("Expected draws %v, got %+v", tc.expected, draws)
+			}
+			for i, draw := range draws {
+				if draw.Description != tc.expected[i] {
+					t.Errorf("Draw %d: expected %s, got %s", i, tc.expected[i], draw.Description)
+				}
+				if len(draw.Outs) != tc.outs[i] {
+					t.Errorf("Draw %d (%s): expected %d outs, got %d", i, draw.Description, tc.outs[i], len(draw.Outs))
+				}
+			}
+		})
+	}
+}
+
+func TestDetectDrawsInvalid(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HA", "HK"})
+	river, _ := ParseCards([]string{"H2", "H3", "H4", "C9", "DJ"})
+	if _, err := DetectDraws(holeCards, river); err == nil {
+		t.Error("Expected error for a complete board")
+	}
+
+	flop, _ := ParseCards([]string{"HA", "H3", "H4"})
+	if _, err := DetectDraws(holeCards, flop); err == nil {
+		t.Error("Expected error for duplicate cards")
+	}
+}
@@ -1,8 +1,10 @@
 package poker
 
 import (
+	cryptorand "crypto/rand"
 	"fmt"
 	"math/rand"
+	randv2 "math/rand/v2"
 	"sort"
 	"strings"
 	"time"
@@ -62,7 +64,8 @@ const (
 // Hand represents an evaluated poker hand
 type Hand struct {
 	Type        HandType
-	Value       int32
+	Value       int32 // Legacy score, higher is better; it is sparse, so prefer Rank
+	Rank        int   // Equivalence class from 1 (royal flush) to WorstHandRank (7-5-4-3-2 offsuit); 0 for an invalid hand
 	Description string
 	Cards       []Card
 }
@@ -91,39 +94,45 @@ func ParseCard(cardStr string) (Card, error) {
 	}
 
 	// Parse rank (second character)
-	var rank Rank
-	switch cardStr[1] {
+	rank, err := parseRank(cardStr[1])
+	if err != nil {
+		return Card{}, err
+	}
+
+	return Card{Suit: suit, Rank: rank}, nil
+}
+
+// parseRank parses a rank character (2-9, T, J, Q, K, or A)
+func parseRank(c byte) (Rank, error) {
+	switch c {
 	case '2':
-		rank = Two
+		return Two, nil
 	case '3':
-		rank = Three
+		return Three, nil
 	case '4':
-		rank = Four
+		return Four, nil
 	case '5':
-		rank = Five
+		return Five, nil
 	case '6':
-		rank = Six
+		return Six, nil
 	case '7':
-		rank = Seven
+		return Seven, nil
 	case '8':
-		rank = Eight
+		return Eight, nil
 	case '9':
-		rank = Nine
+		return Nine, nil
 	case 'T':
-		rank = Ten
+		return Ten, nil
 	case 'J':
-		rank = Jack
+		return Jack, nil
 	case 'Q':
-		rank = Queen
+		return Queen, nil
 	case 'K':
-		rank = King
+		return King, nil
 	case 'A':
-		rank = Ace
-	default:
-		return Card{}, fmt.Errorf("invalid rank: %c (must be 2-9, T, J, Q, K, or A)", cardStr[1])
+		return Ace, nil
 	}
-
-	return Card{Suit: suit, Rank: rank}, nil
+	return 0, fmt.Errorf("invalid rank: %c (must be 2-9, T, J, Q, K, or A)", c)
 }
 
 // ParseCards parses multiple card strings
@@ -139,39 +148,35 @@ func ParseCards(cardStrs []string) ([]Card, error) {
 	return cards, nil
 }
 
-// EvaluateBestHand evaluates the best 5-card hand from 7 cards (2 hole + 5 community)
+// EvaluateBestHand evaluates the best 5-card hand from the hole cards and community cards.
+// Any total of 5 to 7 cards is accepted, so a hand can be evaluated on the flop, turn or river.
 func EvaluateBestHand(holeCards, communityCards []Card) Hand {
-	allCards := append(holeCards, communityCards...)
-	if len(allCards) != 7 {
+	return evaluateCards(combineCards(holeCards, communityCards))
+}
+
+// evaluateCards evaluates the best 5-card hand from 5, 6 or 7 cards
+func evaluateCards(cards []Card) Hand {
+	if len(cards) < 5 || len(cards) > 7 {
 		return Hand{Type: HighCard, Value: 0, Description: "Invalid number of cards"}
 	}
 
-	// Try all combinations of 5 cards from 7
-	bestHand := Hand{Type: HighCard, Value: 0, Cards: make([]Card, 5)}
-	
-	// Generate all combinations of 5 cards from 7
-	for i := 0; i < 7; i++ {
-		for j := i + 1; j < 7; j++ {
-			fiveCards := make([]Card, 0, 5)
-			for k := 0; k < 7; k++ {
-				if k != i && k != j {
-					fiveCards = append(fiveCards, allCards[k])
-				}
-			}
-			
+	bestHand := Hand{Type: HighCard, Value: 0}
+	fiveCards := make([]Card, 5)
+	var choose func(start, picked int)
+	choose = func(start, picked int) {
+		if picked == 5 {
 			hand := evaluateFiveCards(fiveCards)
-			if compareHands(hand, bestHand) > 0 {
-				// Make a copy of the hand to preserve the cards
-				bestHand = Hand{
-					Type:        hand.Type,
-					Value:       hand.Value,
-					Description: hand.Description,
-					Cards:       make([]Card, len(hand.Cards)),
-				}
-				copy(bestHand.Cards, hand.Cards)
+			if CompareHands(hand, bestHand) > 0 {
+				bestHand = hand
 			}
+			return
+		}
+		for i := start; i <= len(cards)-(5-picked); i++ {
+			fiveCards[picked] = cards[i]
+			choose(i+1, picked+1)
 		}
 	}
+	choose(0, 0)
 
 	return bestHand
 }
@@ -199,12 +204,14 @@ func evaluateFiveCards(cards []Card) Hand {
 
 	isFlush := len(suitCount) == 1
 	isStraight := isStraightSequence(sortedCards)
+	rank := fiveCardRank(sortedCards, isFlush)
 
 	// Check for Royal Flush
 	if isFlush && isStraight && sortedCards[0].Rank == Ten && sortedCards[4].Rank == Ace {
 		return Hand{
 			Type:        RoyalFlush,
 			Value:       int32(RoyalFlush)*100000000 + int32(sortedCards[4].Rank)*1000000,
+			Rank:        rank,
 			Description: "Royal Flush",
 			Cards:       sortedCards,
 		}
@@ -220,6 +227,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        StraightFlush,
 			Value:       int32(StraightFlush)*100000000 + int32(highCard)*1000000,
+			Rank:        rank,
 			Description: "Straight Flush",
 			Cards:       sortedCards,
 		}
@@ -257,6 +265,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        FourOfAKind,
 			Value:       int32(FourOfAKind)*100000000 + int32(quads)*10000 + int32(kicker),
+			Rank:        rank,
 			Description: "Four of a Kind",
 			Cards:       sortedCards,
 		}
@@ -270,6 +279,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        FullHouse,
 			Value:       int32(FullHouse)*100000000 + int32(trips)*10000 + int32(pairs[0])*100,
+			Rank:        rank,
 			Description: "Full House",
 			Cards:       sortedCards,
 		}
@@ -287,6 +297,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        Flush,
 			Value:       value,
+			Rank:        rank,
 			Description: "Flush",
 			Cards:       sortedCards,
 		}
@@ -302,6 +313,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        Straight,
 			Value:       int32(Straight)*100000000 + int32(highCard)*1000000,
+			Rank:        rank,
 			Description: "Straight",
 			Cards:       sortedCards,
 		}
@@ -321,6 +333,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        ThreeOfAKind,
 			Value:       int32(ThreeOfAKind)*100000000 + int32(trips)*10000 + int32(kickers[0])*100 + int32(kickers[1]),
+			Rank:        rank,
 			Description: "Three of a Kind",
 			Cards:       sortedCards,
 		}
@@ -341,6 +354,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        TwoPair,
 			Value:       int32(TwoPair)*100000000 + int32(pairs[0])*10000 + int32(pairs[1])*100 + int32(kicker),
+			Rank:        rank,
 			Description: "Two Pair",
 			Cards:       sortedCards,
 		}
@@ -360,6 +374,7 @@ func evaluateFiveCards(cards []Card) Hand {
 		return Hand{
 			Type:        Pair,
 			Value:       int32(Pair)*100000000 + int32(pairs[0])*10000 + int32(kickers[0])*100 + int32(kickers[1])*10 + int32(kickers[2]),
+			Rank:        rank,
 			Description: "Pair",
 			Cards:       sortedCards,
 		}
@@ -376,6 +391,7 @@ func evaluateFiveCards(cards []Card) Hand {
 	return Hand{
 		Type:        HighCard,
 		Value:       value,
+		Rank:        rank,
 		Description: "High Card",
 		Cards:       sortedCards,
 	}
@@ -402,16 +418,6 @@ func isStraightSequence(cards []Card) bool {
 	return isRegularStraight || isWheel
 }
 
-// compareHands compares two hands. Returns 1 if hand1 > hand2, -1 if hand1 < hand2, 0 if equal
-func compareHands(hand1, hand2 Hand) int {
-	if hand1.Value > hand2.Value {
-		return 1
-	} else if hand1.Value < hand2.Value {
-		return -1
-	}
-	return 0
-}
-
 // GetDeck returns a full deck of 52 cards
 func GetDeck() []Card {
 	deck := make([]Card, 0, 52)
@@ -441,19 +447,35 @@ func RemoveCards(deck []Card, toRemove []Card) []Card {
 	return result
 }
 
-// ShuffleDeck shuffles a deck of cards
+// CheckDuplicateCards returns an error naming the first card that appears more than once
+func CheckDuplicateCards(cards []Card) error {
+	seen := make(map[Card]bool, len(cards))
+	for _, card := range cards {
+		if seen[card] {
+			return fmt.Errorf("duplicate card: %s", CardToString(card))
+		}
+		seen[card] = true
+	}
+	return nil
+}
+
+// ShuffleDeck shuffles a deck of cards with a cryptographically secure generator seeded from crypto/rand,
+// so the order cannot be predicted from the time or from earlier shuffles
 func ShuffleDeck(deck []Card) []Card {
 	shuffled := make([]Card, len(deck))
 	copy(shuffled, deck)
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var seed [32]byte
+	cryptorand.Read(seed[:])
+	r := randv2.New(randv2.NewChaCha8(seed))
 	r.Shuffle(len(shuffled), func(i, j int) {
 		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 	})
 	return shuffled
 }
 
-// CalculateWinProbability calculates win probability using Monte Carlo simulation
-func CalculateWinProbability(holeCards []Card, communityCards []Card, numPlayers int, numSimulations int) (float64, float64) {
+// CalculateWinProbability calculates win probability using Monte Carlo simulation.
+// Dead cards are removed from the deck and never dealt to the board or to other players.
+func CalculateWinProbability(holeCards []Card, communityCards []Card, deadCards []Card, numPlayers int, numSimulations int) (float64, float64) {
 	if numPlayers < 2 {
 		return 0.0, 0.0
 	}
@@ -462,73 +484,100 @@ func CalculateWinProbability(holeCards []Card, communityCards []Card, numPlayers
 	ties := 0
 
 	// Create initial deck and remove known cards
-	deck := GetDeck()
-	knownCards := append(holeCards, communityCards...)
-	deck = RemoveCards(deck, knownCards)
+	deck := remainingDeck(holeCards, communityCards, deadCards)
 
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	for sim := 0; sim < numSimulations; sim++ {
-		// Shuffle remaining deck
-		shuffled := make([]Card, len(deck))
-		copy(shuffled, deck)
-		r.Shuffle(len(shuffled), func(i, j int) {
-			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-		})
-
-		// Complete community cards if needed
-		simCommunityCards := make([]Card, len(communityCards))
-		copy(simCommunityCards, communityCards)
-		
-		cardsNeeded := 5 - len(communityCards)
-		for i := 0; i < cardsNeeded; i++ {
-			simCommunityCards = append(simCommunityCards, shuffled[i])
+		outcome := simulateHand(r, deck, holeCards, communityCards, numPlayers)
+		if outcome.win {
+			wins++
+		} else if outcome.tie {
+			ties++
 		}
+	}
 
-		// Deal cards to other players
-		otherPlayersCards := make([][]Card, numPlayers-1)
-		cardIndex := cardsNeeded
-		for i := 0; i < numPlayers-1; i++ {
-			otherPlayersCards[i] = []Card{shuffled[cardIndex], shuffled[cardIndex+1]}
-			cardIndex += 2
-		}
+	winProb := float64(wins) / float64(numSimulations)
+	tieProb := float64(ties) / float64(numSimulations)
 
-		// Evaluate our hand
-		ourHand := EvaluateBestHand(holeCards, simCommunityCards)
+	return winProb, tieProb
+}
 
-		// Evaluate other players' hands
-		bestOtherHand := Hand{Type: HighCard, Value: 0}
-		for _, playerCards := range otherPlayersCards {
-			playerHand := EvaluateBestHand(playerCards, simCommunityCards)
-			if compareHands(playerHand, bestOtherHand) > 0 {
-				bestOtherHand = playerHand
-			}
-		}
+// remainingDeck returns the deck without any of the given known cards
+func remainingDeck(knownCards ...[]Card) []Card {
+	deck := GetDeck()
+	for _, cards := range knownCards {
+		deck = RemoveCards(deck, cards)
+	}
+	return deck
+}
 
-		// Count wins and ties
-		comparison := compareHands(ourHand, bestOtherHand)
-		if comparison > 0 {
-			wins++
-		} else if comparison == 0 {
-			// Check if we tie with all other players
-			allTie := true
-			for _, playerCards := range otherPlayersCards {
-				playerHand := EvaluateBestHand(playerCards, simCommunityCards)
-				if compareHands(ourHand, playerHand) != 0 {
-					allTie = false
-					break
-				}
-			}
-			if allTie {
-				ties++
-			}
+// simulationOutcome is the result of one simulated runout from the hero's point of view
+type simulationOutcome struct {
+	win   bool    // hero beats every other player
+	tie   bool    // hero ties with every other player
+	share float64 // hero's share of the pot, split evenly between the best hands
+}
+
+// simulateHand shuffles the remaining deck, completes the board, deals the other players and scores the hero's hand
+func simulateHand(r *rand.Rand, deck []Card, holeCards []Card, communityCards []Card, numPlayers int) simulationOutcome {
+	// Shuffle remaining deck
+	shuffled := make([]Card, len(deck))
+	copy(shuffled, deck)
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	// Complete community cards if needed
+	simCommunityCards := make([]Card, len(communityCards))
+	copy(simCommunityCards, communityCards)
+
+	cardsNeeded := 5 - len(communityCards)
+	for i := 0; i < cardsNeeded; i++ {
+		simCommunityCards = append(simCommunityCards, shuffled[i])
+	}
+
+	// Deal cards to other players
+	otherPlayersCards := make([][]Card, numPlayers-1)
+	cardIndex := cardsNeeded
+	for i := 0; i < numPlayers-1; i++ {
+		otherPlayersCards[i] = []Card{shuffled[cardIndex], shuffled[cardIndex+1]}
+		cardIndex += 2
+	}
+
+	// Evaluate our hand
+	ourHand := EvaluateBestHand(holeCards, simCommunityCards)
+
+	// Evaluate other players' hands
+	bestOtherHand := Hand{Type: HighCard, Value: 0}
+	otherHands := make([]Hand, len(otherPlayersCards))
+	for i, playerCards := range otherPlayersCards {
+		otherHands[i] = EvaluateBestHand(playerCards, simCommunityCards)
+		if CompareHands(otherHands[i], bestOtherHand) > 0 {
+			bestOtherHand = otherHands[i]
 		}
 	}
 
-	winProb := float64(wins) / float64(numSimulations)
-	tieProb := float64(ties) / float64(numSimulations)
+	// Count wins and ties
+	comparison := CompareHands(ourHand, bestOtherHand)
+	if comparison > 0 {
+		return simulationOutcome{win: true, share: 1.0}
+	}
+	if comparison < 0 {
+		return simulationOutcome{}
+	}
 
-	return winProb, tieProb
+	// We share the best hand with at least one other player
+	tiedPlayers := 0
+	for _, playerHand := range otherHands {
+		if CompareHands(ourHand, playerHand) == 0 {
+			tiedPlayers++
+		}
+	}
+	return simulationOutcome{
+		tie:   tiedPlayers == len(otherHands),
+		share: 1.0 / float64(tiedPlayers+1),
+	}
 }
 
 // CardToString converts a Card back to string format
@@ -545,8 +594,13 @@ func CardToString(card Card) string {
 		suitStr = "S"
 	}
 
+	return suitStr + RankToString(card.Rank)
+}
+
+// RankToString converts a Rank to its single-character format (e.g., "A", "T", "7")
+func RankToString(rank Rank) string {
 	rankStr := ""
-	switch card.Rank {
+	switch rank {
 	case Two:
 		rankStr = "2"
 	case Three:
@@ -575,6 +629,6 @@ func CardToString(card Card) string {
 		rankStr = "A"
 	}
 
-	return suitStr + rankStr
+	return rankStr
 }
 
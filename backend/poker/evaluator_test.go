@@ -89,6 +89,37 @@ func TestEvaluateHand(t *testing.T) {
 			communityCards: []string{"H3", "H4", "H5", "S7", "C9"},
 			expectedHand:   "Straight Flush",
 		},
+		// Partial boards
+		{
+			name:           "Flop - Pair",
+			holeCards:      []string{"HA", "S7"},
+			communityCards: []string{"DA", "CK", "H2"},
+			expectedHand:   "Pair",
+		},
+		{
+			name:           "Flop - Straight",
+			holeCards:      []string{"H5", "S6"},
+			communityCards: []string{"H7", "D8", "C9"},
+			expectedHand:   "Straight",
+		},
+		{
+			name:           "Turn - Two Pair",
+			holeCards:      []string{"HA", "SK"},
+			communityCards: []string{"DA", "CK", "H5", "S2"},
+			expectedHand:   "Two Pair",
+		},
+		{
+			name:           "Turn - Flush",
+			holeCards:      []string{"H2", "H7"},
+			communityCards: []string{"H5", "HK", "HQ", "S2"},
+			expectedHand:   "Flush",
+		},
+		{
+			name:           "Too few cards",
+			holeCards:      []string{"HA", "SA"},
+			communityCards: []string{"DA", "CA"},
+			expectedHand:   "Invalid number of cards",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -168,3 +199,55 @@ func TestCompareHands(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateWinProbabilityDeadCards(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HA", "SA"})
+	communityCards, _ := ParseCards([]string{"D2", "C7", "H9", "ST", "SJ"})
+	opponentCards, _ := ParseCards([]string{"SQ", "SK"})
+
+	// Kill every card except the opponent's, so they always hold a king-high straight
+	deadCards := RemoveCards(GetDeck(), append(append(holeCards, communityCards...), opponentCards...))
+
+	winProb, tieProb := CalculateWinProbability(holeCards, communityCards, deadCards, 2, 100)
+	if winProb != 0.0 || tieProb != 0.0 {
+		t.Errorf("Expected certain loss against the only live hand, got win %f tie %f", winProb, tieProb)
+	}
+
+	winProb, _ = CalculateWinProbability(holeCards, communityCards, nil, 2, 500)
+	if winProb < 0.5 {
+		t.Errorf("Expected aces to usually win without dead cards, got win %f", winProb)
+	}
+}
+
+func TestCheckDuplicateCards(t *testing.T) {
+	cards, _ := ParseCards([]string{"HA", "SK", "D2"})
+	if err := CheckDuplicateCards(cards); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	cards, _ = ParseCards([]string{"HA", "SK", "ha"})
+	if err := CheckDuplicateCards(cards); err == nil || err.Error() != "duplicate card: HA" {
+		t.Errorf("Expected duplicate card error for HA, got %v", err)
+	}
+}
+
+func TestShuffleDeck(t *testing.T) {
+	deck := GetDeck()
+	first, second := ShuffleDeck(deck), ShuffleDeck(deck)
+	if len(first) != 52 || CheckDuplicateCards(first) != nil {
+		t.Fatalf("Expected a full deck, got %d cards", len(first))
+	}
+	if deck[0] != (Card{Suit: Hearts, Rank: Two}) {
+		t.Error("Expected the original deck to be left alone")
+	}
+	same := 0
+	for i := range first {
+		if first[i] == second[i] {
+			same++
+		}
+	}
+	// Two independent shuffles share about one position on average
+	if same > 10 {
+		t.Errorf("Expected two shuffles to differ, got %d cards in the same place", same)
+	}
+}
@@ -0,0 +1,144 @@
+package poker
+
+import "sort"
+
+// WorstHandRank is the rank of the weakest 5-card hand, 7-5-4-3-2 offsuit. Every 5-card hand falls in
+// one of 7462 equivalence classes, ranked from 1 for a royal flush; hands of the same rank tie.
+const WorstHandRank = 7462
+
+// handRanks maps the rank key of a 5-card hand to its rank
+var handRanks = buildHandRanks()
+
+// rankKey packs five ranks from highest to lowest, four bits each, with a bit above them for a flush
+func rankKey(descending [5]Rank, flush bool) uint32 {
+	var key uint32
+	for _, rank := range descending {
+		key = key<<4 | uint32(rank)
+	}
+	if flush {
+		key |= 1 << 20
+	}
+	return key
+}
+
+// fiveCardRank returns the rank of five cards sorted by ascending rank
+func fiveCardRank(sortedCards []Card, flush bool) int {
+	var descending [5]Rank
+	for i, card := range sortedCards {
+		descending[4-i] = card.Rank
+	}
+	return handRanks[rankKey(descending, flush)]
+}
+
+// rankClass is one equivalence class of 5-card hands while the rank table is built
+type rankClass struct {
+	key      uint32
+	handType HandType
+	kickers  []Rank // Ranks in order of importance: larger groups first, then higher ranks
+}
+
+// buildHandRanks lists every equivalence class and ranks them from the strongest
+func buildHandRanks() map[uint32]int {
+	var classes []rankClass
+	var descending [5]Rank
+	var build func(index int, highest Rank)
+	build = func(index int, highest Rank) {
+		if index == 5 {
+			classes = append(classes, newRankClass(descending, false))
+			if descending[0] > descending[1] && descending[1] > descending[2] && descending[2] > descending[3] && descending[3] > descending[4] {
+				classes = append(classes, newRankClass(descending, true))
+			}
+			return
+		}
+		for rank := highest; rank >= Two; rank-- {
+			// No more than four cards of a rank
+			if index >= 4 && descending[index-4] == rank {
+				continue
+			}
+			descending[index] = rank
+			build(index+1, rank)
+		}
+	}
+	build(0, Ace)
+
+	sort.Slice(classes, func(i, j int) bool {
+		if classes[i].handType != classes[j].handType {
+			return classes[i].handType > classes[j].handType
+		}
+		for k := range classes[i].kickers {
+			if classes[i].kickers[k] != classes[j].kickers[k] {
+				return classes[i].kickers[k] > classes[j].kickers[k]
+			}
+		}
+		return false
+	})
+
+	ranks := make(map[uint32]int, len(classes))
+	for i, class := range classes {
+		ranks[class.key] = i + 1
+	}
+	return ranks
+}
+
+// newRankClass classifies five ranks from highest to lowest
+func newRankClass(descending [5]Rank, flush bool) rankClass {
+	class := rankClass{key: rankKey(descending, flush)}
+
+	counts := make(map[Rank]int)
+	for _, rank := range descending {
+		counts[rank]++
+	}
+	for rank := range counts {
+		class.kickers = append(class.kickers, rank)
+	}
+	sort.Slice(class.kickers, func(i, j int) bool {
+		a, b := class.kickers[i], class.kickers[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		return a > b
+	})
+
+	straight := len(counts) == 5 && descending[0]-descending[4] == 4
+	if len(counts) == 5 && descending[0] == Ace && descending[1] == Five {
+		// The wheel is a five-high straight
+		straight = true
+		class.kickers = []Rank{Five}
+	}
+
+	switch first := counts[class.kickers[0]]; {
+	case straight && flush && class.kickers[0] == Ace:
+		class.handType = RoyalFlush
+	case straight && flush:
+		class.handType = StraightFlush
+	case first == 4:
+		class.handType = FourOfAKind
+	case first == 3 && len(counts) == 2:
+		class.handType = FullHouse
+	case flush:
+		class.handType = Flush
+	case straight:
+		class.handType = Straight
+	case first == 3:
+		class.handType = ThreeOfAKind
+	case first == 2 && len(counts) == 3:
+		class.handType = TwoPair
+	case first == 2:
+		class.handType = Pair
+	default:
+		class.handType = HighCard
+	}
+	return class
+}
+
+// CompareHands compares two evaluated hands by rank. It returns 1 if hand1 is stronger, -1 if hand2 is
+// stronger and 0 if they tie. A hand without a rank, such as an invalid one, is weaker than any other.
+func CompareHands(hand1, hand2 Hand) int {
+	switch {
+	case hand1.Rank == hand2.Rank:
+		return 0
+	case hand2.Rank == 0 || (hand1.Rank != 0 && hand1.Rank < hand2.Rank):
+		return 1
+	}
+	return -1
+}
@@ -0,0 +1,115 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestHandRank(t *testing.T) {
+	testCases := []struct {
+		cards    []string
+		expected int
+	}{
+		// The first and last hand of each type
+		{[]string{"HA", "HK", "HQ", "HJ", "HT"}, 1},
+		{[]string{"SK", "SQ", "SJ", "ST", "S9"}, 2},
+		{[]string{"D5", "D4", "D3", "D2", "DA"}, 10},
+		{[]string{"HA", "DA", "CA", "SA", "HK"}, 11},
+		{[]string{"H2", "D2", "C2", "S2", "H3"}, 166},
+		{[]string{"HA", "DA", "CA", "SK", "HK"}, 167},
+		{[]string{"H2", "D2", "C2", "S3", "H3"}, 322},
+		{[]string{"CA", "CK", "CQ", "CJ", "C9"}, 323},
+		{[]string{"C7", "C5", "C4", "C3", "C2"}, 1599},
+		{[]string{"HA", "SK", "HQ", "HJ", "HT"}, 1600},
+		{[]string{"H5", "S4", "H3", "H2", "HA"}, 1609},
+		{[]string{"HA", "DA", "CA", "SK", "HQ"}, 1610},
+		{[]string{"H2", "D2", "C2", "S4", "H3"}, 2467},
+		{[]string{"HA", "DA", "CK", "SK", "HQ"}, 2468},
+		{[]string{"H3", "D3", "C2", "S2", "H4"}, 3325},
+		{[]string{"HA", "DA", "CK", "SQ", "HJ"}, 3326},
+		{[]string{"H2", "D2", "C5", "S4", "H3"}, 6185},
+		{[]string{"HA", "DK", "CQ", "SJ", "H9"}, 6186},
+		{[]string{"H7", "D5", "C4", "S3", "H2"}, WorstHandRank},
+	}
+	for _, tc := range testCases {
+		cards, err := ParseCards(tc.cards)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if hand := evaluateCards(cards); hand.Rank != tc.expected {
+			t.Errorf("%v: expected rank %d, got %d (%s)", tc.cards, tc.expected, hand.Rank, hand.Description)
+		}
+	}
+}
+
+func TestHandRankClasses(t *testing.T) {
+	// Every 5-card hand falls in one of 7462 classes, and each class holds hands of one type only
+	deck := GetDeck()
+	types := make(map[int]HandType)
+	counts := make(map[HandType]int)
+	cards := make([]Card, 5)
+	for a := 0; a < 52; a++ {
+		for b := a + 1; b < 52; b++ {
+			for c := b + 1; c < 52; c++ {
+				for d := c + 1; d < 52; d++ {
+					for e := d + 1; e < 52; e++ {
+						cards[0], cards[1], cards[2], cards[3], cards[4] = deck[a], deck[b], deck[c], deck[d], deck[e]
+						hand := evaluateFiveCards(cards)
+						if hand.Rank < 1 || hand.Rank > WorstHandRank {
+							t.Fatalf("%s: rank %d out of range", hand.Description, hand.Rank)
+						}
+						if handType, ok := types[hand.Rank]; ok && handType != hand.Type {
+							t.Fatalf("Rank %d holds both %v and %v", hand.Rank, handType, hand.Type)
+						}
+						types[hand.Rank] = hand.Type
+						counts[hand.Type]++
+					}
+				}
+			}
+		}
+	}
+	if len(types) != WorstHandRank {
+		t.Errorf("Expected %d classes, got %d", WorstHandRank, len(types))
+	}
+
+	expected := map[HandType]int{
+		RoyalFlush: 4, StraightFlush: 36, FourOfAKind: 624, FullHouse: 3744, Flush: 5108,
+		Straight: 10200, ThreeOfAKind: 54912, TwoPair: 123552, Pair: 1098240, HighCard: 1302540,
+	}
+	for handType, count := range expected {
+		if counts[handType] != count {
+			t.Errorf("Expected %d hands of type %v, got %d", count, handType, counts[handType])
+		}
+	}
+}
+
+func TestCompareHandsByRank(t *testing.T) {
+	evaluate := func(texts ...string) Hand {
+		cards, err := ParseCards(texts)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return evaluateCards(cards)
+	}
+
+	testCases := []struct {
+		name     string
+		hand1    Hand
+		hand2    Hand
+		expected int
+	}{
+		{"Flush beats straight", evaluate("H2", "H7", "H9", "HJ", "HK"), evaluate("S9", "HT", "DJ", "CQ", "SK"), 1},
+		{"Kicker decides", evaluate("HA", "DA", "C9", "S5", "H3"), evaluate("SA", "CA", "HT", "D4", "C2"), -1},
+		{"Suits do not matter", evaluate("HA", "DK", "CQ", "SJ", "H9"), evaluate("SA", "HK", "DQ", "CJ", "S9"), 0},
+		{"Wheel is the lowest straight", evaluate("HA", "D2", "C3", "S4", "H5"), evaluate("H2", "D3", "C4", "S5", "H6"), -1},
+		{"Invalid hand loses", Hand{}, evaluate("H7", "D5", "C4", "S3", "H2"), -1},
+		{"Invalid hands tie", Hand{}, Hand{}, 0},
+	}
+	for _, tc := range testCases {
+		if got := CompareHands(tc.hand1, tc.hand2); got != tc.expected {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.expected, got)
+		}
+		if got := CompareHands(tc.hand2, tc.hand1); got != -tc.expected {
+			t.Errorf("%s (swapped): expected %d, got %d", tc.name, -tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package poker
+
+import (
+	"sort"
+	"strings"
+)
+
+// SuitMapping relabels suits: mapping[suit] is the suit that suit becomes. Suits are interchangeable in
+// hold'em, so relabelling every card of a situation leaves its equities, hand ranks and draws unchanged.
+type SuitMapping [4]Suit
+
+// IdentitySuitMapping leaves every suit as it is
+var IdentitySuitMapping = SuitMapping{Hearts, Diamonds, Clubs, Spades}
+
+// suitPermutations holds the 24 ways to relabel the four suits
+var suitPermutations = buildSuitPermutations()
+
+// buildSuitPermutations lists every suit mapping
+func buildSuitPermutations() []SuitMapping {
+	var permutations []SuitMapping
+	var mapping SuitMapping
+	var used [4]bool
+	var build func(suit int)
+	build = func(suit int) {
+		if suit == 4 {
+			permutations = append(permutations, mapping)
+			return
+		}
+		for target := Hearts; target <= Spades; target++ {
+			if !used[target] {
+				used[target] = true
+				mapping[suit] = target
+				build(suit + 1)
+				used[target] = false
+			}
+		}
+	}
+	build(0)
+	return permutations
+}
+
+// Card relabels the suit of a card
+func (m SuitMapping) Card(card Card) Card {
+	return Card{Suit: m[card.Suit], Rank: card.Rank}
+}
+
+// Cards relabels the suits of cards, keeping their order
+func (m SuitMapping) Cards(cards []Card) []Card {
+	result := make([]Card, len(cards))
+	for i, card := range cards {
+		result[i] = m.Card(card)
+	}
+	return result
+}
+
+// Inverse returns the mapping that undoes this one
+func (m SuitMapping) Inverse() SuitMapping {
+	var inverse SuitMapping
+	for suit, target := range m {
+		inverse[target] = Suit(suit)
+	}
+	return inverse
+}
+
+// CanonicalHand is a situation relabelled to its canonical suits. Situations that differ only by a
+// permutation of suits (e.g., SA SK on SQ H7 D2 and HA HK on HQ S7 D2) have the same canonical form.
+type CanonicalHand struct {
+	HoleCards []Card
+	Board     []Card
+	DeadCards []Card
+	Mapping   SuitMapping // From the original suits to the canonical ones
+}
+
+// Canonicalize relabels the suits of the hole cards, board and dead cards to a canonical form. Each
+// group is treated as a set and comes back sorted from the highest card; among the 24 suit mappings the
+// one giving the smallest cards in order of the groups is chosen, hearts before diamonds, clubs and spades.
+func Canonicalize(holeCards, board, deadCards []Card) CanonicalHand {
+	groups := [][]Card{holeCards, board, deadCards}
+	var best [][]Card
+	var bestMapping SuitMapping
+	for _, mapping := range suitPermutations {
+		candidate := make([][]Card, len(groups))
+		for i, group := range groups {
+			candidate[i] = mapping.Cards(group)
+			sortCanonical(candidate[i])
+		}
+		if best == nil || lessCanonical(candidate, best) {
+			best, bestMapping = candidate, mapping
+		}
+	}
+	return CanonicalHand{HoleCards: best[0], Board: best[1], DeadCards: best[2], Mapping: bestMapping}
+}
+
+// Restore maps cards from the canonical suits back to the original ones, e.g., the outs or nut holdings
+// found for the canonical form
+func (c CanonicalHand) Restore(cards []Card) []Card {
+	return c.Mapping.Inverse().Cards(cards)
+}
+
+// Key returns a string that identifies the canonical form (e.g., "HAHK|HQD7C2|"), for use as a map or
+// cache key
+func (c CanonicalHand) Key() string {
+	var b strings.Builder
+	for i, group := range [][]Card{c.HoleCards, c.Board, c.DeadCards} {
+		if i > 0 {
+			b.WriteByte('|')
+		}
+		for _, card := range group {
+			b.WriteString(CardToString(card))
+		}
+	}
+	return b.String()
+}
+
+// canonicalOrder orders cards from the highest rank, and by suit within a rank
+func canonicalOrder(card Card) int {
+	return int(Ace-card.Rank)*4 + int(card.Suit)
+}
+
+// sortCanonical sorts cards in canonical order
+func sortCanonical(cards []Card) {
+	sort.Slice(cards, func(i, j int) bool {
+		return canonicalOrder(cards[i]) < canonicalOrder(cards[j])
+	})
+}
+
+// lessCanonical reports whether the sorted groups a come before b, comparing card by card
+func lessCanonical(a, b [][]Card) bool {
+	for i := range a {
+		for j := range a[i] {
+			if x, y := canonicalOrder(a[i][j]), canonicalOrder(b[i][j]); x != y {
+				return x < y
+			}
+		}
+	}
+	return false
+}
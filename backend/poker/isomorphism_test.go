@@ -0,0 +1,111 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	parse := func(texts ...string) []Card {
+		cards, err := ParseCards(texts)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return cards
+	}
+
+	testCases := []struct {
+		name      string
+		holeCards []Card
+		board     []Card
+		deadCards []Card
+		expected  string
+	}{
+		{"Spades", parse("SA", "SK"), parse("SQ", "H7", "D2"), nil, "HAHK|HQD7C2|"},
+		{"Hearts", parse("HA", "HK"), parse("HQ", "S7", "D2"), nil, "HAHK|HQD7C2|"},
+		{"Card order", parse("DK", "DA"), parse("C2", "DQ", "H7"), nil, "HAHK|HQD7C2|"},
+		{"Offsuit", parse("SA", "HK"), parse("CQ", "C7", "C2"), nil, "HADK|CQC7C2|"},
+		{"Pair", parse("CT", "ST"), nil, nil, "HTDT||"},
+		{"Dead cards", parse("SA", "SK"), nil, parse("H3", "D3"), "HAHK||D3C3"},
+		{"Preflop suited", parse("C9", "C8"), nil, nil, "H9H8||"},
+	}
+	for _, tc := range testCases {
+		canonical := Canonicalize(tc.holeCards, tc.board, tc.deadCards)
+		if got := canonical.Key(); got != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.expected, got)
+		}
+
+		// The mapping takes the original cards to the canonical ones and back
+		if !sameCards(canonical.Mapping.Cards(tc.holeCards), canonical.HoleCards) || !sameCards(canonical.Mapping.Cards(tc.board), canonical.Board) {
+			t.Errorf("%s: mapping %v does not give the canonical cards", tc.name, canonical.Mapping)
+		}
+		if restored := canonical.Restore(canonical.Board); !sameCards(restored, tc.board) {
+			t.Errorf("%s: expected the board back, got %v", tc.name, restored)
+		}
+	}
+}
+
+func TestCanonicalizeIsomorphic(t *testing.T) {
+	// Relabelling the suits of a situation in any way gives the same canonical form
+	holeCards, _ := ParseCards([]string{"SA", "HJ"})
+	board, _ := ParseCards([]string{"SQ", "S7", "H2", "DJ"})
+	deadCards, _ := ParseCards([]string{"C4"})
+	expected := Canonicalize(holeCards, board, deadCards)
+
+	for _, mapping := range suitPermutations {
+		canonical := Canonicalize(mapping.Cards(holeCards), mapping.Cards(board), mapping.Cards(deadCards))
+		if canonical.Key() != expected.Key() {
+			t.Errorf("Mapping %v: expected %s, got %s", mapping, expected.Key(), canonical.Key())
+		}
+	}
+
+	// Hand strength and equity against a known hand do not change
+	if EvaluateBestHand(holeCards, board).Rank != EvaluateBestHand(expected.HoleCards, expected.Board).Rank {
+		t.Error("Expected the same hand rank")
+	}
+	opponent, _ := ParseCards([]string{"DQ", "CQ"})
+	equity, err := CalculateEquity([][]Card{holeCards, opponent}, board, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	canonicalEquity, err := CalculateEquity([][]Card{expected.HoleCards, expected.Mapping.Cards(opponent)}, expected.Board, 0)
+	if err != nil || !reflect.DeepEqual(equity, canonicalEquity) {
+		t.Errorf("Expected the same equity, got %v and %v (%v)", equity, canonicalEquity, err)
+	}
+}
+
+func TestSuitMappingInverse(t *testing.T) {
+	if len(suitPermutations) != 24 {
+		t.Fatalf("Expected 24 suit mappings, got %d", len(suitPermutations))
+	}
+	deck := GetDeck()
+	for _, mapping := range suitPermutations {
+		if got := mapping.Inverse().Cards(mapping.Cards(deck)); !reflect.DeepEqual(got, deck) {
+			t.Errorf("Mapping %v: inverse does not restore the deck", mapping)
+		}
+		if CheckDuplicateCards(mapping.Cards(deck)) != nil {
+			t.Errorf("Mapping %v is not a permutation", mapping)
+		}
+	}
+	if IdentitySuitMapping.Card(Card{Suit: Clubs, Rank: Ace}) != (Card{Suit: Clubs, Rank: Ace}) {
+		t.Error("Expected the identity mapping to keep the suit")
+	}
+}
+
+// sameCards reports whether two card slices hold the same cards in any order
+func sameCards(a, b []Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[Card]int)
+	for _, card := range a {
+		counts[card]++
+	}
+	for _, card := range b {
+		counts[card]--
+		if counts[card] < 0 {
+			return false
+		}
+	}
+	return true
+}
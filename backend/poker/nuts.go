@@ -0,0 +1,103 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// NutAnalysis ranks the hero's hand against every two-card holding an opponent could have
+type NutAnalysis struct {
+	Nuts        Hand     // Best hand any holding makes on the board
+	NutHoldings [][]Card // Holdings that make the nuts
+	HeroHand    Hand     // Hero's best hand
+	HeroRank    int      // 1 for the nuts, 2 for the second nuts, and so on
+	BeatenBy    int      // Opponent holdings that beat the hero
+	Ties        int      // Opponent holdings that tie the hero
+	Beats       int      // Opponent holdings the hero beats
+	TotalCombos int      // Opponent holdings consistent with the known cards
+}
+
+// AnalyzeNuts enumerates every two-card holding not blocked by the hero's cards or the board,
+// finds the current nuts on a flop, turn or river and counts how many holdings beat, tie or lose
+// to the hero. Cards still to come are not dealt out.
+func AnalyzeNuts(holeCards, communityCards []Card) (NutAnalysis, error) {
+	if len(holeCards) != 2 {
+		return NutAnalysis{}, fmt.Errorf("must provide exactly 2 hole cards")
+	}
+	if len(communityCards) < 3 || len(communityCards) > 5 {
+		return NutAnalysis{}, fmt.Errorf("must provide 3, 4 or 5 community cards")
+	}
+	knownCards := make([]Card, 0, 7)
+	knownCards = append(knownCards, holeCards...)
+	knownCards = append(knownCards, communityCards...)
+	if err := CheckDuplicateCards(knownCards); err != nil {
+		return NutAnalysis{}, err
+	}
+
+	analysis := NutAnalysis{HeroHand: EvaluateBestHand(holeCards, communityCards)}
+	strongerRanks := make(map[int]bool)
+
+	deck := RemoveCards(GetDeck(), knownCards)
+	for i := 0; i < len(deck); i++ {
+		for j := i + 1; j < len(deck); j++ {
+			holding := []Card{deck[i], deck[j]}
+			hand := EvaluateBestHand(holding, communityCards)
+			analysis.TotalCombos++
+
+			switch CompareHands(hand, analysis.HeroHand) {
+			case 1:
+				analysis.BeatenBy++
+				strongerRanks[hand.Rank] = true
+			case 0:
+				analysis.Ties++
+			default:
+				analysis.Beats++
+			}
+
+			switch CompareHands(hand, analysis.Nuts) {
+			case 1:
+				analysis.Nuts = hand
+				analysis.NutHoldings = [][]Card{holding}
+			case 0:
+				analysis.NutHoldings = append(analysis.NutHoldings, holding)
+			}
+		}
+	}
+
+	// The hero may hold the nuts with cards no opponent can have
+	switch CompareHands(analysis.HeroHand, analysis.Nuts) {
+	case 1:
+		analysis.Nuts = analysis.HeroHand
+		analysis.NutHoldings = [][]Card{holeCards}
+	case 0:
+		analysis.NutHoldings = append(analysis.NutHoldings, holeCards)
+	}
+
+	analysis.HeroRank = len(strongerRanks) + 1
+	return analysis, nil
+}
+
+// Summary describes the hero's relative rank (e.g., "3rd nuts; beaten by 14 combos, ties 3, beats 973")
+func (a NutAnalysis) Summary() string {
+	rank := "the nuts"
+	if a.HeroRank > 1 {
+		rank = ordinal(a.HeroRank) + " nuts"
+	}
+	return fmt.Sprintf("%s; beaten by %d combos, ties %d, beats %d", rank, a.BeatenBy, a.Ties, a.Beats)
+}
+
+// ordinal formats n as an English ordinal (1st, 2nd, 3rd, 4th, ...)
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
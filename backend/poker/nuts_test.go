@@ -0,0 +1,102 @@
+package poker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeNuts(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HA", "SA"})
+	communityCards, _ := ParseCards([]string{"D2", "C7", "H9", "ST", "SJ"})
+
+	analysis, err := AnalyzeNuts(holeCards, communityCards)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 45 unseen cards give 990 opponent holdings
+	if analysis.TotalCombos != 990 {
+		t.Errorf("Expected 990 combos, got %d", analysis.TotalCombos)
+	}
+	if analysis.BeatenBy+analysis.Ties+analysis.Beats != analysis.TotalCombos {
+		t.Errorf("Counts do not add up: %+v", analysis)
+	}
+
+	// King-queen makes the king-high straight: 16 combos
+	if analysis.Nuts.Description != "Straight" || len(analysis.NutHoldings) != 16 {
+		t.Errorf("Expected 16 nut straight holdings, got %s with %d holdings", analysis.Nuts.Description, len(analysis.NutHoldings))
+	}
+	if analysis.HeroRank <= 1 || analysis.BeatenBy == 0 {
+		t.Errorf("Expected aces to be beaten by straights, sets and two pairs, got rank %d", analysis.HeroRank)
+	}
+	if !strings.HasPrefix(analysis.Summary(), ordinal(analysis.HeroRank)+" nuts; beaten by") {
+		t.Errorf("Unexpected summary: %s", analysis.Summary())
+	}
+}
+
+func TestAnalyzeNutsFlopAndTurn(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HA", "SA"})
+
+	// On a dry flop no straight or flush is possible yet, so top set is the nuts
+	flop, _ := ParseCards([]string{"D2", "C7", "H9"})
+	analysis, err := AnalyzeNuts(holeCards, flop)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if analysis.TotalCombos != 1081 || analysis.Nuts.Description != "Three of a Kind" || len(analysis.NutHoldings) != 3 {
+		t.Errorf("Expected 3 combos of top set among 1081, got %s with %d holdings among %d", analysis.Nuts.Description, len(analysis.NutHoldings), analysis.TotalCombos)
+	}
+	// Aces lose to the three sets and the three two pairs the board allows
+	if analysis.HeroRank != 7 || analysis.BeatenBy != 36 || analysis.Ties != 1 {
+		t.Errorf("Expected aces to be the 7th nuts behind 36 combos, tied by 1, got %s", analysis.Summary())
+	}
+
+	// The ten on the turn makes jack-eight the nut straight
+	turn, _ := ParseCards([]string{"D2", "C7", "H9", "ST"})
+	if analysis, err = AnalyzeNuts(holeCards, turn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if analysis.TotalCombos != 1035 || analysis.Nuts.Description != "Straight" || len(analysis.NutHoldings) != 16 {
+		t.Errorf("Expected 16 nut straight combos among 1035, got %s with %d holdings among %d", analysis.Nuts.Description, len(analysis.NutHoldings), analysis.TotalCombos)
+	}
+	if analysis.BeatenBy+analysis.Ties+analysis.Beats != analysis.TotalCombos {
+		t.Errorf("Counts do not add up: %+v", analysis)
+	}
+
+	for _, board := range [][]string{{"D2", "C7"}, {"D2", "C7", "H9", "ST", "SJ", "S2"}} {
+		cards, _ := ParseCards(board)
+		if _, err := AnalyzeNuts(holeCards, cards); err == nil {
+			t.Errorf("Expected an error for %d community cards", len(board))
+		}
+	}
+}
+
+func TestAnalyzeNutsHeroBlocksNuts(t *testing.T) {
+	// Holding the jack of hearts blocks the royal flush, so the ace-high flush is the nuts
+	holeCards, _ := ParseCards([]string{"HJ", "H9"})
+	communityCards, _ := ParseCards([]string{"HA", "HK", "HQ", "D2", "C7"})
+
+	analysis, err := AnalyzeNuts(holeCards, communityCards)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if analysis.HeroRank != 1 || analysis.BeatenBy != 0 || analysis.Ties != 0 {
+		t.Errorf("Expected hero to hold the unique nuts, got %+v", analysis.Summary())
+	}
+	if len(analysis.NutHoldings) != 1 || analysis.Nuts.Description != "Flush" {
+		t.Errorf("Expected the hero's flush as the only nut holding, got %s with %d holdings", analysis.Nuts.Description, len(analysis.NutHoldings))
+	}
+	if analysis.Summary() != "the nuts; beaten by 0 combos, ties 0, beats 990" {
+		t.Errorf("Unexpected summary: %s", analysis.Summary())
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	expected := map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 102: "102nd"}
+	for n, want := range expected {
+		if got := ordinal(n); got != want {
+			t.Errorf("ordinal(%d): expected %s, got %s", n, want, got)
+		}
+	}
+}
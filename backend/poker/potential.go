@@ -0,0 +1,194 @@
+package poker
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// HandPotential holds the hand strength and potential metrics of Billings et al.
+type HandPotential struct {
+	HandStrength          float64 // HS: share of opponent holdings the hero is ahead of now (ties count half)
+	HandStrengthN         float64 // HS raised to the number of opponents
+	PositivePotential     float64 // PPot: chance of pulling ahead by the river when behind now
+	NegativePotential     float64 // NPot: chance of falling behind by the river when ahead now
+	EffectiveHandStrength float64 // EHS = HSn * (1 - NPot) + (1 - HSn) * PPot
+	Samples               int     // Opponent holdings (or holding and runout pairs) evaluated
+	Exact                 bool    // True if every holding and runout was enumerated
+}
+
+// Indexes into the hand potential tables
+const (
+	potentialAhead = iota
+	potentialTied
+	potentialBehind
+)
+
+// CalculateHandPotential computes HS, PPot, NPot and EHS for 2 hole cards on a 3, 4 or 5-card board.
+// Opponents hold any two unseen cards, or one of opponentRange when it is not empty.
+// With numSamples of 0 every holding and runout is enumerated, which takes seconds on the flop;
+// otherwise numSamples random holding and runout pairs are drawn.
+func CalculateHandPotential(holeCards, communityCards []Card, opponentRange [][]Card, numOpponents int, numSamples int) (HandPotential, error) {
+	if len(holeCards) != 2 {
+		return HandPotential{}, fmt.Errorf("must provide exactly 2 hole cards")
+	}
+	if len(communityCards) < 3 || len(communityCards) > 5 {
+		return HandPotential{}, fmt.Errorf("must provide 3, 4, or 5 community cards")
+	}
+	if numOpponents < 1 {
+		return HandPotential{}, fmt.Errorf("must have at least 1 opponent")
+	}
+	if numSamples < 0 {
+		return HandPotential{}, fmt.Errorf("number of samples must not be negative")
+	}
+
+	knownCards := make([]Card, 0, 7)
+	knownCards = append(knownCards, holeCards...)
+	knownCards = append(knownCards, communityCards...)
+	if err := CheckDuplicateCards(knownCards); err != nil {
+		return HandPotential{}, err
+	}
+	deck := RemoveCards(GetDeck(), knownCards)
+
+	// Opponent holdings
+	holdings := opponentRange
+	if len(holdings) == 0 {
+		for i := 0; i < len(deck); i++ {
+			for j := i + 1; j < len(deck); j++ {
+				holdings = append(holdings, []Card{deck[i], deck[j]})
+			}
+		}
+	}
+	holdings = FilterCombos(holdings, knownCards)
+	if len(holdings) == 0 {
+		return HandPotential{}, fmt.Errorf("opponent range is empty after removing known cards")
+	}
+
+	heroNow := evaluateCards(knownCards)
+	cardsToCome := 5 - len(communityCards)
+
+	// hp[now][river] counts how the hero compares now and after the runout
+	var hp [3][3]float64
+	var hpTotal [3]float64
+	var strength [3]float64
+	samples := 0
+
+	// The hero's final hand only depends on the runout, so cache it
+	heroFinal := make(map[[2]int]Hand)
+	compareRiver := func(holding, runout []Card) int {
+		key := [2]int{cardIndex(runout[0]), -1}
+		if len(runout) == 2 {
+			key[1] = cardIndex(runout[1])
+		}
+		hero, ok := heroFinal[key]
+		if !ok {
+			hero = evaluateCards(combineCards(holeCards, communityCards, runout))
+			heroFinal[key] = hero
+		}
+		opponent := evaluateCards(combineCards(holding, communityCards, runout))
+		return potentialIndex(CompareHands(hero, opponent))
+	}
+	record := func(now, river int) {
+		hp[now][river]++
+		hpTotal[now]++
+		strength[now]++
+		samples++
+	}
+
+	exact := numSamples == 0
+	switch {
+	case cardsToCome == 0:
+		// On the river there is no potential, only strength
+		for _, holding := range holdings {
+			opponentNow := evaluateCards(combineCards(holding, communityCards))
+			strength[potentialIndex(CompareHands(heroNow, opponentNow))]++
+			samples++
+		}
+		exact = true
+	case exact:
+		for _, holding := range holdings {
+			opponentNow := evaluateCards(combineCards(holding, communityCards))
+			now := potentialIndex(CompareHands(heroNow, opponentNow))
+			forEachRunout(RemoveCards(deck, holding), cardsToCome, func(runout []Card) {
+				record(now, compareRiver(holding, runout))
+			})
+		}
+	default:
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		for i := 0; i < numSamples; i++ {
+			holding := holdings[r.Intn(len(holdings))]
+			opponentNow := evaluateCards(combineCards(holding, communityCards))
+			remaining := RemoveCards(deck, holding)
+			runout := make([]Card, cardsToCome)
+			for j, k := range r.Perm(len(remaining))[:cardsToCome] {
+				runout[j] = remaining[k]
+			}
+			record(potentialIndex(CompareHands(heroNow, opponentNow)), compareRiver(holding, runout))
+		}
+	}
+
+	total := strength[potentialAhead] + strength[potentialTied] + strength[potentialBehind]
+	hs := (strength[potentialAhead] + strength[potentialTied]/2) / total
+	potential := HandPotential{
+		HandStrength:  hs,
+		HandStrengthN: math.Pow(hs, float64(numOpponents)),
+		Samples:       samples,
+		Exact:         exact,
+	}
+
+	if denominator := hpTotal[potentialBehind] + hpTotal[potentialTied]/2; denominator > 0 {
+		potential.PositivePotential = (hp[potentialBehind][potentialAhead] + hp[potentialBehind][potentialTied]/2 +
+			hp[potentialTied][potentialAhead]/2) / denominator
+	}
+	if denominator := hpTotal[potentialAhead] + hpTotal[potentialTied]/2; denominator > 0 {
+		potential.NegativePotential = (hp[potentialAhead][potentialBehind] + hp[potentialTied][potentialBehind]/2 +
+			hp[potentialAhead][potentialTied]/2) / denominator
+	}
+	potential.EffectiveHandStrength = potential.HandStrengthN*(1-potential.NegativePotential) +
+		(1-potential.HandStrengthN)*potential.PositivePotential
+
+	return potential, nil
+}
+
+// potentialIndex maps a hand comparison result to an ahead, tied or behind index
+func potentialIndex(comparison int) int {
+	switch {
+	case comparison > 0:
+		return potentialAhead
+	case comparison == 0:
+		return potentialTied
+	default:
+		return potentialBehind
+	}
+}
+
+// combineCards concatenates card groups into a new slice
+func combineCards(groups ...[]Card) []Card {
+	size := 0
+	for _, cards := range groups {
+		size += len(cards)
+	}
+	combined := make([]Card, 0, size)
+	for _, cards := range groups {
+		combined = append(combined, cards...)
+	}
+	return combined
+}
+
+// forEachRunout calls fn with every combination of n cards from deck
+func forEachRunout(deck []Card, n int, fn func([]Card)) {
+	runout := make([]Card, n)
+	var choose func(start, picked int)
+	choose = func(start, picked int) {
+		if picked == n {
+			fn(runout)
+			return
+		}
+		for i := start; i <= len(deck)-(n-picked); i++ {
+			runout[picked] = deck[i]
+			choose(i+1, picked+1)
+		}
+	}
+	choose(0, 0)
+}
@@ -0,0 +1,81 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestCalculateHandPotentialRiver(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HT", "HJ"})
+	communityCards, _ := ParseCards([]string{"HQ", "HK", "HA", "S2", "C3"})
+
+	potential, err := CalculateHandPotential(holeCards, communityCards, nil, 3, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if potential.HandStrength != 1.0 || potential.HandStrengthN != 1.0 {
+		t.Errorf("Expected a royal flush to have full strength, got %+v", potential)
+	}
+	if potential.PositivePotential != 0 || potential.NegativePotential != 0 || potential.EffectiveHandStrength != 1.0 {
+		t.Errorf("Expected no potential on the river, got %+v", potential)
+	}
+	if !potential.Exact || potential.Samples != 990 {
+		t.Errorf("Expected 990 enumerated holdings, got %d", potential.Samples)
+	}
+}
+
+func TestCalculateHandPotentialFlushDraw(t *testing.T) {
+	// Nut flush draw on the turn: behind many made hands now, but often ahead on the river
+	holeCards, _ := ParseCards([]string{"SA", "S5"})
+	communityCards, _ := ParseCards([]string{"SK", "S9", "D4", "C2"})
+
+	potential, err := CalculateHandPotential(holeCards, communityCards, nil, 1, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !potential.Exact || potential.Samples != 1035*44 {
+		t.Errorf("Expected exact enumeration of 1035 holdings x 44 rivers, got %d samples", potential.Samples)
+	}
+	if potential.PositivePotential < 0.15 {
+		t.Errorf("Expected a flush draw to have positive potential, got %f", potential.PositivePotential)
+	}
+	if potential.EffectiveHandStrength < potential.HandStrength {
+		t.Errorf("Expected EHS (%f) above HS (%f) for a strong draw", potential.EffectiveHandStrength, potential.HandStrength)
+	}
+}
+
+func TestCalculateHandPotentialRange(t *testing.T) {
+	// A set of nines against an overpair range is ahead now and mostly stays ahead
+	holeCards, _ := ParseCards([]string{"H9", "D9"})
+	communityCards, _ := ParseCards([]string{"C9", "S6", "D2"})
+	opponentRange, _ := ParseRange("TT+")
+
+	potential, err := CalculateHandPotential(holeCards, communityCards, opponentRange, 1, 2000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if potential.HandStrength != 1.0 {
+		t.Errorf("Expected a set to be ahead of every overpair, got HS %f", potential.HandStrength)
+	}
+	if potential.NegativePotential <= 0 || potential.NegativePotential > 0.2 {
+		t.Errorf("Expected small negative potential, got %f", potential.NegativePotential)
+	}
+	if potential.Exact || potential.Samples != 2000 {
+		t.Errorf("Expected 2000 sampled runouts, got %d", potential.Samples)
+	}
+}
+
+func TestCalculateHandPotentialInvalid(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HA", "SA"})
+	communityCards, _ := ParseCards([]string{"DA", "CA", "H2"})
+	opponentRange, _ := ParseRange("AA")
+
+	if _, err := CalculateHandPotential(holeCards, communityCards, opponentRange, 1, 100); err == nil {
+		t.Error("Expected error when every opponent combo is blocked")
+	}
+	if _, err := CalculateHandPotential(holeCards, communityCards[:2], nil, 1, 100); err == nil {
+		t.Error("Expected error for a 2-card board")
+	}
+}
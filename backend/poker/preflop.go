@@ -0,0 +1,80 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// StartingHand is the preflop classification of two hole cards
+type StartingHand struct {
+	Class       string // Hand class such as "AA", "AKs" or "72o"
+	Description string // Category such as "Pocket Pair" or "Suited Connectors"
+	Pair        bool
+	Suited      bool
+	Gap         int // Ranks between the two cards (0 for connectors)
+}
+
+// Starting hand categories, from the most to the least specific
+const (
+	PocketPair        = "Pocket Pair"
+	SuitedBroadway    = "Suited Broadway"
+	SuitedAce         = "Suited Ace"
+	SuitedConnectors  = "Suited Connectors"
+	SuitedOneGapper   = "Suited One-Gapper"
+	OffsuitBroadway   = "Offsuit Broadway"
+	OffsuitConnectors = "Offsuit Connectors"
+	SuitedHand        = "Suited"
+	OffsuitHand       = "Offsuit"
+)
+
+// ClassifyStartingHand describes two hole cards before the flop
+func ClassifyStartingHand(holeCards []Card) (StartingHand, error) {
+	if len(holeCards) != 2 {
+		return StartingHand{}, fmt.Errorf("must provide exactly 2 hole cards")
+	}
+	if err := CheckDuplicateCards(holeCards); err != nil {
+		return StartingHand{}, err
+	}
+
+	high, low := holeCards[0].Rank, holeCards[1].Rank
+	if low > high {
+		high, low = low, high
+	}
+	hand := StartingHand{
+		Pair:   high == low,
+		Suited: holeCards[0].Suit == holeCards[1].Suit,
+		Gap:    int(high-low) - 1,
+	}
+
+	hand.Class = RankToString(high) + RankToString(low)
+	switch {
+	case hand.Pair:
+		hand.Gap = 0
+		hand.Description = PocketPair
+		return hand, nil
+	case hand.Suited:
+		hand.Class += "s"
+	default:
+		hand.Class += "o"
+	}
+
+	broadway := low >= Ten
+	switch {
+	case hand.Suited && broadway:
+		hand.Description = SuitedBroadway
+	case hand.Suited && high == Ace:
+		hand.Description = SuitedAce
+	case hand.Suited && hand.Gap == 0:
+		hand.Description = SuitedConnectors
+	case hand.Suited && hand.Gap == 1:
+		hand.Description = SuitedOneGapper
+	case broadway:
+		hand.Description = OffsuitBroadway
+	case hand.Gap == 0:
+		hand.Description = OffsuitConnectors
+	case hand.Suited:
+		hand.Description = SuitedHand
+	default:
+		hand.Description = OffsuitHand
+	}
+	return hand, nil
+}
@@ -0,0 +1,56 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestClassifyStartingHand(t *testing.T) {
+	testCases := []struct {
+		holeCards   []string
+		class       string
+		description string
+		gap         int
+	}{
+		{[]string{"HA", "SA"}, "AA", PocketPair, 0},
+		{[]string{"D2", "C2"}, "22", PocketPair, 0},
+		{[]string{"HK", "HA"}, "AKs", SuitedBroadway, 0},
+		{[]string{"ST", "SQ"}, "QTs", SuitedBroadway, 1},
+		{[]string{"H9", "H8"}, "98s", SuitedConnectors, 0},
+		{[]string{"C7", "C5"}, "75s", SuitedOneGapper, 1},
+		{[]string{"D5", "DA"}, "A5s", SuitedAce, 8},
+		{[]string{"HK", "SJ"}, "KJo", OffsuitBroadway, 1},
+		{[]string{"H7", "S6"}, "76o", OffsuitConnectors, 0},
+		{[]string{"HQ", "H4"}, "Q4s", SuitedHand, 7},
+		{[]string{"H7", "S2"}, "72o", OffsuitHand, 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.class, func(t *testing.T) {
+			holeCards, err := ParseCards(tc.holeCards)
+			if err != nil {
+				t.Fatalf("Failed to parse hole cards: %v", err)
+			}
+
+			hand, err := ClassifyStartingHand(holeCards)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if hand.Class != tc.class {
+				t.Errorf("Expected class %s, got %s", tc.class, hand.Class)
+			}
+			if hand.Description != tc.description {
+				t.Errorf("Expected %s, got %s", tc.description, hand.Description)
+			}
+			if hand.Gap != tc.gap {
+				t.Errorf("Expected gap %d, got %d", tc.gap, hand.Gap)
+			}
+		})
+	}
+
+	for _, cards := range [][]string{{"HA"}, {"HA", "HA"}, {"HA", "SK", "C2"}} {
+		holeCards, _ := ParseCards(cards)
+		if _, err := ClassifyStartingHand(holeCards); err == nil {
+			t.Errorf("Expected error for %v", cards)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseRange expands a comma-separated hand range into its two-card combos.
+// Supported terms are pairs ("TT", "TT+", "22-55"), suited and offsuit hands ("AKs", "AKo", "AK"),
+// kicker ranges ("A2s+", "KTo-K8o") and exact combos ("HASK").
+func ParseRange(rangeStr string) ([][]Card, error) {
+	var combos [][]Card
+	seen := make(map[[2]Card]bool)
+	add := func(c1, c2 Card) {
+		key := [2]Card{c1, c2}
+		if cardIndex(c2) < cardIndex(c1) {
+			key = [2]Card{c2, c1}
+		}
+		if c1 == c2 || seen[key] {
+			return
+		}
+		seen[key] = true
+		combos = append(combos, []Card{key[0], key[1]})
+	}
+
+	for _, term := range strings.Split(rangeStr, ",") {
+		term = strings.ToUpper(strings.TrimSpace(term))
+		if term == "" {
+			continue
+		}
+		if err := expandRangeTerm(term, add); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(combos) == 0 {
+		return nil, fmt.Errorf("empty range: %q", rangeStr)
+	}
+	return combos, nil
+}
+
+// expandRangeTerm calls add for every combo described by a single range term
+func expandRangeTerm(term string, add func(Card, Card)) error {
+	// Exact combo such as "HASK"
+	if len(term) == 4 {
+		if c1, err := ParseCard(term[:2]); err == nil {
+			c2, err := ParseCard(term[2:])
+			if err != nil {
+				return fmt.Errorf("invalid range term %s: %v", term, err)
+			}
+			add(c1, c2)
+			return nil
+		}
+	}
+
+	// Dash range such as "22-55" or "KTo-K8o"
+	if first, last, ok := strings.Cut(term, "-"); ok {
+		high1, low1, kind1, err := parseHandClass(first)
+		if err != nil {
+			return fmt.Errorf("invalid range term %s: %v", term, err)
+		}
+		high2, low2, kind2, err := parseHandClass(last)
+		if err != nil {
+			return fmt.Errorf("invalid range term %s: %v", term, err)
+		}
+		if kind1 != kind2 || (high1 == low1) != (high2 == low2) || (high1 != low1 && high1 != high2) {
+			return fmt.Errorf("invalid range term %s: ends must be the same kind of hand", term)
+		}
+		if high1 == low1 {
+			// Pairs
+			from, to := minRank(high1, high2), maxRank(high1, high2)
+			for rank := from; rank <= to; rank++ {
+				addClassCombos(rank, rank, kind1, add)
+			}
+			return nil
+		}
+		from, to := minRank(low1, low2), maxRank(low1, low2)
+		for kicker := from; kicker <= to; kicker++ {
+			addClassCombos(high1, kicker, kind1, add)
+		}
+		return nil
+	}
+
+	// Plus range such as "TT+" or "A2s+"
+	if strings.HasSuffix(term, "+") {
+		high, low, kind, err := parseHandClass(strings.TrimSuffix(term, "+"))
+		if err != nil {
+			return fmt.Errorf("invalid range term %s: %v", term, err)
+		}
+		if high == low {
+			for rank := high; rank <= Ace; rank++ {
+				addClassCombos(rank, rank, kind, add)
+			}
+			return nil
+		}
+		for kicker := low; kicker < high; kicker++ {
+			addClassCombos(high, kicker, kind, add)
+		}
+		return nil
+	}
+
+	high, low, kind, err := parseHandClass(term)
+	if err != nil {
+		return fmt.Errorf("invalid range term %s: %v", term, err)
+	}
+	addClassCombos(high, low, kind, add)
+	return nil
+}
+
+// parseHandClass parses a starting hand class such as "AKs", "AKo", "AK" or "TT".
+// kind is 's' for suited, 'o' for offsuit and 0 for both.
+func parseHandClass(class string) (high, low Rank, kind byte, err error) {
+	if len(class) != 2 && len(class) != 3 {
+		return 0, 0, 0, fmt.Errorf("expected a hand such as AKs, AKo, AK or TT")
+	}
+	if high, err = parseRank(class[0]); err != nil {
+		return 0, 0, 0, err
+	}
+	if low, err = parseRank(class[1]); err != nil {
+		return 0, 0, 0, err
+	}
+	if low > high {
+		high, low = low, high
+	}
+	if len(class) == 3 {
+		kind = class[2]
+		if kind != 'S' && kind != 'O' {
+			return 0, 0, 0, fmt.Errorf("suffix must be s or o")
+		}
+		kind = kind + 'a' - 'A'
+		if high == low {
+			return 0, 0, 0, fmt.Errorf("pairs cannot be suited or offsuit")
+		}
+	}
+	return high, low, kind, nil
+}
+
+// addClassCombos calls add for every combo of a starting hand class
+func addClassCombos(high, low Rank, kind byte, add func(Card, Card)) {
+	for s1 := Hearts; s1 <= Spades; s1++ {
+		for s2 := Hearts; s2 <= Spades; s2++ {
+			if high == low && s2 <= s1 {
+				continue
+			}
+			if (kind == 's' && s1 != s2) || (kind == 'o' && s1 == s2) {
+				continue
+			}
+			add(Card{Suit: s1, Rank: high}, Card{Suit: s2, Rank: low})
+		}
+	}
+}
+
+// cardIndex orders cards by suit then rank, matching GetDeck
+func cardIndex(card Card) int {
+	return int(card.Suit)*13 + int(card.Rank)
+}
+
+// minRank returns the lower of two ranks
+func minRank(a, b Rank) Rank {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// maxRank returns the higher of two ranks
+func maxRank(a, b Rank) Rank {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// FilterCombos removes combos that contain any of the known cards
+func FilterCombos(combos [][]Card, knownCards []Card) [][]Card {
+	var result [][]Card
+	for _, combo := range combos {
+		blocked := false
+		for _, card := range combo {
+			if containsCard(knownCards, card) {
+				blocked = true
+				break
+			}
+		}
+		if !blocked {
+			result = append(result, combo)
+		}
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	testCases := []struct {
+		rangeStr string
+		combos   int
+	}{
+		{"AA", 6},
+		{"TT+", 30},
+		{"22-44", 18},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"KA", 16},
+		{"A2s+", 48},
+		{"KTo-K8o", 36},
+		{"HASK", 1},
+		{"AA, KK, AKs", 16},
+		{"AA, AA, HASA", 6},
+		{"qq+, aks", 22},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.rangeStr, func(t *testing.T) {
+			combos, err := ParseRange(tc.rangeStr)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(combos) != tc.combos {
+				t.Errorf("Expected %d combos, got %d", tc.combos, len(combos))
+			}
+			for _, combo := range combos {
+				if len(combo) != 2 || combo[0] == combo[1] {
+					t.Errorf("Invalid combo %v", combo)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRangeInvalid(t *testing.T) {
+	for _, rangeStr := range []string{"", "AKx", "AAs", "A", "AK-QJ", "22-AKs", "XYZ"} {
+		if _, err := ParseRange(rangeStr); err == nil {
+			t.Errorf("Expected error for %q", rangeStr)
+		}
+	}
+}
+
+func TestFilterCombos(t *testing.T) {
+	combos, _ := ParseRange("AA")
+	known, _ := ParseCards([]string{"HA"})
+	if filtered := FilterCombos(combos, known); len(filtered) != 3 {
+		t.Errorf("Expected 3 combos without the ace of hearts, got %d", len(filtered))
+	}
+}
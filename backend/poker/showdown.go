@@ -0,0 +1,250 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ShowdownPlayer is a player at showdown with the chips they put into the pot
+type ShowdownPlayer struct {
+	Name      string // Used in explanations; defaults to "player <index>"
+	HoleCards []Card
+	Committed int64 // Chips put into the pot during the hand
+	Folded    bool
+}
+
+// PotResult is how a main or side pot was awarded
+type PotResult struct {
+	Name        string // "main pot", "side pot 1", ...
+	Amount      int64
+	Eligible    []int  // Players who could win the pot
+	Winners     []int  // Players who won or split the pot
+	WinningHand string // Description of the winning hand, or empty when uncontested
+	OddChips    int64  // Chips left over from an uneven split
+}
+
+// ShowdownResult ranks the players' hands and awards every pot
+type ShowdownResult struct {
+	Hands        []Hand // Each player's best hand (empty for folded players)
+	Ranks        []int  // 1 for the best hand among players who did not fold, 0 for folded players
+	Pots         []PotResult
+	Payouts      []int64 // Chips awarded to each player
+	Explanations []string
+}
+
+// Showdown awards the pot between players listed in seat order. Commitments are split into a main pot
+// and side pots; folded players' chips stay in the pots but they cannot win them. A pot that cannot be
+// split evenly gives its odd chips one at a time to the winners in seat order starting left of the button.
+func Showdown(communityCards []Card, players []ShowdownPlayer, button int) (ShowdownResult, error) {
+	if len(players) < 2 {
+		return ShowdownResult{}, fmt.Errorf("must provide at least 2 players")
+	}
+	if button < 0 || button >= len(players) {
+		return ShowdownResult{}, fmt.Errorf("button must be a player index between 0 and %d", len(players)-1)
+	}
+
+	live := 0
+	knownCards := append([]Card{}, communityCards...)
+	for i, player := range players {
+		if player.Committed < 0 {
+			return ShowdownResult{}, fmt.Errorf("%s committed a negative amount", playerName(players, i))
+		}
+		if player.Folded {
+			continue
+		}
+		live++
+		if len(player.HoleCards) != 2 {
+			return ShowdownResult{}, fmt.Errorf("%s must have exactly 2 hole cards", playerName(players, i))
+		}
+		knownCards = append(knownCards, player.HoleCards...)
+	}
+	if live == 0 {
+		return ShowdownResult{}, fmt.Errorf("at least one player must not have folded")
+	}
+	if live > 1 && len(communityCards) != 5 {
+		return ShowdownResult{}, fmt.Errorf("must provide exactly 5 community cards for a showdown")
+	}
+	if err := CheckDuplicateCards(knownCards); err != nil {
+		return ShowdownResult{}, err
+	}
+
+	result := ShowdownResult{
+		Hands:   make([]Hand, len(players)),
+		Ranks:   make([]int, len(players)),
+		Payouts: make([]int64, len(players)),
+	}
+
+	// Rank the hands of the players still in; equal hands share a rank
+	distinct := make(map[int]bool)
+	for i, player := range players {
+		if !player.Folded && live > 1 {
+			result.Hands[i] = EvaluateBestHand(player.HoleCards, communityCards)
+			distinct[result.Hands[i].Rank] = true
+		}
+	}
+	for i, player := range players {
+		if player.Folded {
+			continue
+		}
+		result.Ranks[i] = 1
+		for rank := range distinct {
+			if rank < result.Hands[i].Rank {
+				result.Ranks[i]++
+			}
+		}
+	}
+
+	for index, pot := range BuildPots(players) {
+		if index == 0 {
+			pot.Name = "main pot"
+		} else {
+			pot.Name = fmt.Sprintf("side pot %d", index)
+		}
+
+		for _, player := range pot.Eligible {
+			switch {
+			case len(pot.Winners) == 0 || result.Ranks[player] < result.Ranks[pot.Winners[0]]:
+				pot.Winners = []int{player}
+			case result.Ranks[player] == result.Ranks[pot.Winners[0]]:
+				pot.Winners = append(pot.Winners, player)
+			}
+		}
+		if len(pot.Eligible) > 1 && live > 1 {
+			pot.WinningHand = result.Hands[pot.Winners[0]].Description
+		}
+
+		// Odd chips go to the winners closest to the left of the button
+		sort.Slice(pot.Winners, func(a, b int) bool {
+			return seatsFromButton(pot.Winners[a], button, len(players)) < seatsFromButton(pot.Winners[b], button, len(players))
+		})
+		share := pot.Amount / int64(len(pot.Winners))
+		pot.OddChips = pot.Amount % int64(len(pot.Winners))
+		for i, winner := range pot.Winners {
+			result.Payouts[winner] += share
+			if int64(i) < pot.OddChips {
+				result.Payouts[winner]++
+			}
+		}
+
+		result.Pots = append(result.Pots, pot)
+		result.Explanations = append(result.Explanations, explainPot(players, pot, live))
+	}
+
+	return result, nil
+}
+
+// BuildPots splits the players' commitments into a main pot and side pots, in order, as SplitPots does
+func BuildPots(players []ShowdownPlayer) []PotResult {
+	committed := make([]int64, len(players))
+	folded := make([]bool, len(players))
+	for i, player := range players {
+		committed[i], folded[i] = player.Committed, player.Folded
+	}
+	var pots []PotResult
+	for _, pot := range SplitPots(committed, folded) {
+		pots = append(pots, PotResult{Amount: pot.Amount, Eligible: pot.Eligible})
+	}
+	return pots
+}
+
+// Chips is an amount of chips: whole chips at a table, or fractional amounts such as dollars or big blinds
+type Chips interface {
+	~int64 | ~float64
+}
+
+// SidePot is a main or side pot and the players who can win it
+type SidePot[C Chips] struct {
+	Amount   C
+	Eligible []int // Players who did not fold and put in at least the pot's level
+}
+
+// SplitPots splits what each player committed into a main pot and side pots, in order. Each pot is
+// capped at the commitment of a player who did not fold; folded players' chips are added to the pots
+// they reach but make them eligible for none, and chips they put in beyond every live commitment go to
+// the last pot. The showdown, the all-in ICM and the all-in luck analysis all split pots this way.
+func SplitPots[C Chips](committed []C, folded []bool) []SidePot[C] {
+	var levels []C
+	for i, amount := range committed {
+		if !folded[i] && amount > 0 {
+			levels = append(levels, amount)
+		}
+	}
+	slices.Sort(levels)
+
+	var pots []SidePot[C]
+	var previous C
+	for _, level := range levels {
+		if level == previous {
+			continue
+		}
+		pot := SidePot[C]{}
+		for i, amount := range committed {
+			pot.Amount += min(amount, level) - min(amount, previous)
+			if !folded[i] && amount >= level {
+				pot.Eligible = append(pot.Eligible, i)
+			}
+		}
+		pots = append(pots, pot)
+		previous = level
+	}
+
+	// When only folded players put chips in, they form one pot for whoever is still live
+	if len(pots) == 0 {
+		pot := SidePot[C]{}
+		for i := range committed {
+			if !folded[i] {
+				pot.Eligible = append(pot.Eligible, i)
+			}
+		}
+		pots = append(pots, pot)
+	}
+
+	// Chips a folded player put in beyond every live commitment go to the last pot
+	for _, amount := range committed {
+		if excess := amount - previous; excess > 0 {
+			pots[len(pots)-1].Amount += excess
+		}
+	}
+	if pots[len(pots)-1].Amount == 0 {
+		pots = pots[:len(pots)-1]
+	}
+	return pots
+}
+
+// seatsFromButton counts seats clockwise from the button, with the seat left of the button first
+func seatsFromButton(player, button, seats int) int {
+	return (player - button - 1 + seats) % seats
+}
+
+// playerName returns the player's name, or a name based on their index
+func playerName(players []ShowdownPlayer, i int) string {
+	if players[i].Name != "" {
+		return players[i].Name
+	}
+	return fmt.Sprintf("player %d", i)
+}
+
+// explainPot describes who won a pot and why
+func explainPot(players []ShowdownPlayer, pot PotResult, live int) string {
+	names := make([]string, len(pot.Winners))
+	for i, winner := range pot.Winners {
+		names[i] = playerName(players, winner)
+	}
+
+	switch {
+	case live == 1:
+		return fmt.Sprintf("%s wins the %s of %d uncontested after everyone else folded", names[0], pot.Name, pot.Amount)
+	case len(pot.Eligible) == 1:
+		return fmt.Sprintf("%s gets back the %s of %d that no one else matched", names[0], pot.Name, pot.Amount)
+	case len(pot.Winners) == 1:
+		return fmt.Sprintf("%s wins the %s of %d with %s", names[0], pot.Name, pot.Amount, pot.WinningHand)
+	}
+
+	explanation := fmt.Sprintf("%s split the %s of %d with %s", strings.Join(names, " and "), pot.Name, pot.Amount, pot.WinningHand)
+	if pot.OddChips > 0 {
+		explanation += fmt.Sprintf("; %d odd chip(s) to %s, first left of the button", pot.OddChips, strings.Join(names[:pot.OddChips], " and "))
+	}
+	return explanation
+}
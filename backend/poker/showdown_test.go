@@ -0,0 +1,205 @@
+package poker
+
+import (
+	"strings"
+	"testing"
+)
+
+func showdownPlayer(t *testing.T, cards []string, committed int64, folded bool) ShowdownPlayer {
+	holeCards, err := ParseCards(cards)
+	if err != nil {
+		t.Fatalf("Failed to parse hole cards: %v", err)
+	}
+	return ShowdownPlayer{HoleCards: holeCards, Committed: committed, Folded: folded}
+}
+
+func TestShowdownSidePots(t *testing.T) {
+	board, _ := ParseCards([]string{"C2", "D7", "C9", "DK", "H3"})
+	players := []ShowdownPlayer{
+		showdownPlayer(t, []string{"HA", "SA"}, 100, false), // All-in short stack with the best hand
+		showdownPlayer(t, []string{"HK", "SK"}, 300, false), // Second best hand
+		showdownPlayer(t, []string{"HQ", "SQ"}, 300, false),
+		showdownPlayer(t, []string{"H4", "S5"}, 50, true),
+	}
+	players[0].Name = "Alice"
+
+	result, err := Showdown(board, players, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedRanks := []int{2, 1, 3, 0}
+	for i, rank := range expectedRanks {
+		if result.Ranks[i] != rank {
+			t.Errorf("Expected rank %d for player %d, got %d", rank, i, result.Ranks[i])
+		}
+	}
+
+	// Kings make a set, so they win both pots
+	if len(result.Pots) != 2 {
+		t.Fatalf("Expected 2 pots, got %d", len(result.Pots))
+	}
+	if result.Pots[0].Amount != 350 || len(result.Pots[0].Eligible) != 3 {
+		t.Errorf("Expected main pot of 350 for 3 players, got %d for %d", result.Pots[0].Amount, len(result.Pots[0].Eligible))
+	}
+	if result.Pots[1].Amount != 400 || len(result.Pots[1].Eligible) != 2 {
+		t.Errorf("Expected side pot of 400 for 2 players, got %d for %d", result.Pots[1].Amount, len(result.Pots[1].Eligible))
+	}
+	expectedPayouts := []int64{0, 750, 0, 0}
+	for i, payout := range expectedPayouts {
+		if result.Payouts[i] != payout {
+			t.Errorf("Expected payout %d for player %d, got %d", payout, i, result.Payouts[i])
+		}
+	}
+	if !strings.Contains(result.Explanations[0], "player 1 wins the main pot of 350 with Three of a Kind") {
+		t.Errorf("Unexpected explanation: %s", result.Explanations[0])
+	}
+}
+
+func TestShowdownShortStackWins(t *testing.T) {
+	board, _ := ParseCards([]string{"C2", "D7", "C9", "DA", "H3"})
+	players := []ShowdownPlayer{
+		showdownPlayer(t, []string{"HA", "SA"}, 100, false),
+		showdownPlayer(t, []string{"HK", "SK"}, 300, false),
+		showdownPlayer(t, []string{"HQ", "SQ"}, 300, false),
+	}
+
+	result, err := Showdown(board, players, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedPayouts := []int64{300, 400, 0}
+	for i, payout := range expectedPayouts {
+		if result.Payouts[i] != payout {
+			t.Errorf("Expected payout %d for player %d, got %d", payout, i, result.Payouts[i])
+		}
+	}
+}
+
+func TestShowdownOddChip(t *testing.T) {
+	// Both players play the board's straight and split 3 chips
+	board, _ := ParseCards([]string{"C5", "D6", "C7", "D8", "H9"})
+	players := []ShowdownPlayer{
+		showdownPlayer(t, []string{"H2", "S2"}, 1, false),
+		showdownPlayer(t, []string{"H3", "S3"}, 1, false),
+		showdownPlayer(t, []string{"H4", "S4"}, 1, true),
+	}
+
+	testCases := []struct {
+		button   int
+		expected []int64
+	}{
+		{0, []int64{1, 2, 0}},
+		{1, []int64{2, 1, 0}},
+		{2, []int64{2, 1, 0}},
+	}
+	for _, tc := range testCases {
+		result, err := Showdown(board, players, tc.button)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for i, payout := range tc.expected {
+			if result.Payouts[i] != payout {
+				t.Errorf("Button %d: expected payout %d for player %d, got %d", tc.button, payout, i, result.Payouts[i])
+			}
+		}
+		if result.Pots[0].OddChips != 1 {
+			t.Errorf("Expected 1 odd chip, got %d", result.Pots[0].OddChips)
+		}
+	}
+}
+
+func TestShowdownUncontested(t *testing.T) {
+	players := []ShowdownPlayer{
+		showdownPlayer(t, []string{"H2", "S7"}, 200, false),
+		showdownPlayer(t, nil, 50, true),
+		showdownPlayer(t, nil, 100, true),
+	}
+
+	// No board is needed when everyone else folded
+	result, err := Showdown(nil, players, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Payouts[0] != 350 {
+		t.Errorf("Expected payout 350, got %d", result.Payouts[0])
+	}
+	if !strings.Contains(result.Explanations[0], "uncontested") {
+		t.Errorf("Unexpected explanation: %s", result.Explanations[0])
+	}
+}
+
+func TestShowdownOnlyFoldedChips(t *testing.T) {
+	// Only folded players put chips in; the player still live wins them
+	players := []ShowdownPlayer{
+		showdownPlayer(t, nil, 25, true),
+		showdownPlayer(t, nil, 50, true),
+		showdownPlayer(t, []string{"H2", "S7"}, 0, false),
+	}
+
+	var committed int64
+	for _, player := range players {
+		committed += player.Committed
+	}
+	var potted int64
+	for _, pot := range BuildPots(players) {
+		potted += pot.Amount
+	}
+	if potted != committed {
+		t.Errorf("Expected pots to hold all %d committed chips, got %d", committed, potted)
+	}
+
+	result, err := Showdown(nil, players, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Payouts[2] != 75 {
+		t.Errorf("Expected payout 75, got %d", result.Payouts[2])
+	}
+}
+
+func TestShowdownErrors(t *testing.T) {
+	board, _ := ParseCards([]string{"C5", "D6", "C7", "D8", "H9"})
+	aces := showdownPlayer(t, []string{"HA", "SA"}, 100, false)
+	kings := showdownPlayer(t, []string{"HK", "SK"}, 100, false)
+	duplicate := showdownPlayer(t, []string{"HA", "DA"}, 100, false)
+	folded := showdownPlayer(t, nil, 10, true)
+
+	testCases := []struct {
+		name    string
+		board   []Card
+		players []ShowdownPlayer
+		button  int
+	}{
+		{"One player", board, []ShowdownPlayer{aces}, 0},
+		{"Bad button", board, []ShowdownPlayer{aces, kings}, 2},
+		{"Incomplete board", board[:4], []ShowdownPlayer{aces, kings}, 0},
+		{"Duplicate card", board, []ShowdownPlayer{aces, duplicate}, 0},
+		{"Everyone folded", board, []ShowdownPlayer{folded, folded}, 0},
+		{"Negative commitment", board, []ShowdownPlayer{aces, {HoleCards: kings.HoleCards, Committed: -1}}, 0},
+	}
+	for _, tc := range testCases {
+		if _, err := Showdown(tc.board, tc.players, tc.button); err == nil {
+			t.Errorf("Expected error for %s", tc.name)
+		}
+	}
+}
+
+func TestSplitPots(t *testing.T) {
+	pots := SplitPots([]float64{1000, 400, 1000, 50}, []bool{false, false, false, true})
+	if len(pots) != 2 {
+		t.Fatalf("Expected 2 pots, got %d", len(pots))
+	}
+	if pots[0].Amount != 1250 || len(pots[0].Eligible) != 3 {
+		t.Errorf("Expected main pot of 1250 for 3 players, got %.0f for %d", pots[0].Amount, len(pots[0].Eligible))
+	}
+	if pots[1].Amount != 1200 || len(pots[1].Eligible) != 2 {
+		t.Errorf("Expected side pot of 1200 for 2 players, got %.0f for %d", pots[1].Amount, len(pots[1].Eligible))
+	}
+
+	// The big blind folds to a shorter all-in: the chips above the call go to the last pot
+	chips := SplitPots([]int64{0, 50, 100, 50}, []bool{true, false, true, false})
+	if len(chips) != 1 || chips[0].Amount != 200 || len(chips[0].Eligible) != 2 {
+		t.Errorf("Expected one pot of 200 for 2 players, got %+v", chips)
+	}
+}
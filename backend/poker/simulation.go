@@ -0,0 +1,96 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// confidenceZ is the z-score used for the 95% confidence intervals of simulation estimates
+const confidenceZ = 1.96
+
+// ProbabilityEstimate is a snapshot of a Monte Carlo win probability simulation
+type ProbabilityEstimate struct {
+	Iterations     int     // Number of simulations run so far
+	WinProbability float64 // Probability of winning outright
+	TieProbability float64 // Probability of tying with every other player
+	Equity         float64 // Expected share of the pot, with split pots divided between the winners
+	WinLow         float64 // Lower bound of the 95% confidence interval for WinProbability
+	WinHigh        float64 // Upper bound of the 95% confidence interval for WinProbability
+	EquityLow      float64 // Lower bound of the 95% confidence interval for Equity
+	EquityHigh     float64 // Upper bound of the 95% confidence interval for Equity
+	Final          bool    // True once all requested simulations have run
+}
+
+// CalculateWinProbabilityProgressive runs the same simulation as CalculateWinProbability and
+// reports an interim estimate to progress every interval simulations, followed by a final estimate.
+// Returning false from progress stops the simulation early.
+func CalculateWinProbabilityProgressive(holeCards []Card, communityCards []Card, deadCards []Card, numPlayers int, numSimulations int, interval int, progress func(ProbabilityEstimate) bool) ProbabilityEstimate {
+	if numPlayers < 2 || numSimulations < 1 {
+		return ProbabilityEstimate{Final: true}
+	}
+	if interval < 1 {
+		interval = numSimulations
+	}
+
+	// Create initial deck and remove known cards
+	deck := remainingDeck(holeCards, communityCards, deadCards)
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	var wins, ties int
+	var shareSum, shareSquares float64
+	for sim := 1; sim <= numSimulations; sim++ {
+		outcome := simulateHand(r, deck, holeCards, communityCards, numPlayers)
+		if outcome.win {
+			wins++
+		} else if outcome.tie {
+			ties++
+		}
+		shareSum += outcome.share
+		shareSquares += outcome.share * outcome.share
+
+		if sim == numSimulations {
+			break
+		}
+		if sim%interval == 0 && progress != nil {
+			if !progress(newProbabilityEstimate(sim, wins, ties, shareSum, shareSquares)) {
+				return newProbabilityEstimate(sim, wins, ties, shareSum, shareSquares)
+			}
+		}
+	}
+
+	estimate := newProbabilityEstimate(numSimulations, wins, ties, shareSum, shareSquares)
+	estimate.Final = true
+	if progress != nil {
+		progress(estimate)
+	}
+	return estimate
+}
+
+// newProbabilityEstimate builds an estimate with normal-approximation confidence intervals from running totals
+func newProbabilityEstimate(iterations, wins, ties int, shareSum, shareSquares float64) ProbabilityEstimate {
+	n := float64(iterations)
+	winProb := float64(wins) / n
+	equity := shareSum / n
+
+	winMargin := confidenceZ * math.Sqrt(winProb*(1-winProb)/n)
+	variance := math.Max(shareSquares/n-equity*equity, 0)
+	equityMargin := confidenceZ * math.Sqrt(variance/n)
+
+	return ProbabilityEstimate{
+		Iterations:     iterations,
+		WinProbability: winProb,
+		TieProbability: float64(ties) / n,
+		Equity:         equity,
+		WinLow:         clampProbability(winProb - winMargin),
+		WinHigh:        clampProbability(winProb + winMargin),
+		EquityLow:      clampProbability(equity - equityMargin),
+		EquityHigh:     clampProbability(equity + equityMargin),
+	}
+}
+
+// clampProbability limits p to the range [0, 1]
+func clampProbability(p float64) float64 {
+	return math.Min(math.Max(p, 0), 1)
+}
@@ -0,0 +1,73 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestCalculateWinProbabilityProgressive(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HA", "SA"})
+
+	var updates []ProbabilityEstimate
+	final := CalculateWinProbabilityProgressive(holeCards, nil, nil, 2, 2000, 500, func(e ProbabilityEstimate) bool {
+		updates = append(updates, e)
+		return true
+	})
+
+	if len(updates) != 4 {
+		t.Fatalf("Expected 4 updates, got %d", len(updates))
+	}
+	for i, update := range updates {
+		if update.Iterations != (i+1)*500 {
+			t.Errorf("Update %d: expected %d iterations, got %d", i, (i+1)*500, update.Iterations)
+		}
+		if update.Final != (i == len(updates)-1) {
+			t.Errorf("Update %d: unexpected final flag %v", i, update.Final)
+		}
+		if update.EquityLow > update.Equity || update.Equity > update.EquityHigh {
+			t.Errorf("Update %d: equity %f outside interval [%f, %f]", i, update.Equity, update.EquityLow, update.EquityHigh)
+		}
+		if update.WinLow > update.WinProbability || update.WinProbability > update.WinHigh {
+			t.Errorf("Update %d: win %f outside interval [%f, %f]", i, update.WinProbability, update.WinLow, update.WinHigh)
+		}
+	}
+
+	if !final.Final || final.Iterations != 2000 {
+		t.Errorf("Expected final estimate after 2000 iterations, got %+v", final)
+	}
+	// Pocket aces have roughly 85% equity heads-up
+	if final.Equity < 0.78 || final.Equity > 0.92 {
+		t.Errorf("Expected equity around 0.85 for AA heads-up, got %f", final.Equity)
+	}
+}
+
+func TestCalculateWinProbabilityProgressiveSplitPot(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"H2", "S3"})
+	communityCards, _ := ParseCards([]string{"ST", "SJ", "SQ", "SK", "SA"})
+
+	final := CalculateWinProbabilityProgressive(holeCards, communityCards, nil, 3, 200, 0, nil)
+
+	// The board plays for everyone, so every runout is a three-way split
+	if final.TieProbability != 1.0 || final.WinProbability != 0.0 {
+		t.Errorf("Expected certain tie, got win %f tie %f", final.WinProbability, final.TieProbability)
+	}
+	if final.Equity < 0.333 || final.Equity > 0.334 {
+		t.Errorf("Expected one third equity, got %f", final.Equity)
+	}
+}
+
+func TestCalculateWinProbabilityProgressiveStop(t *testing.T) {
+	holeCards, _ := ParseCards([]string{"HK", "SK"})
+
+	calls := 0
+	estimate := CalculateWinProbabilityProgressive(holeCards, nil, nil, 2, 10000, 100, func(e ProbabilityEstimate) bool {
+		calls++
+		return calls < 3
+	})
+
+	if calls != 3 {
+		t.Errorf("Expected 3 progress calls, got %d", calls)
+	}
+	if estimate.Final || estimate.Iterations != 300 {
+		t.Errorf("Expected stopped estimate after 300 iterations, got %+v", estimate)
+	}
+}
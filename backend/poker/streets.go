@@ -0,0 +1,205 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// DefaultPreflopSimulations is the number of boards sampled for preflop equity in an equity breakdown
+const DefaultPreflopSimulations = 20000
+
+// Street names used by the equity breakdown
+const (
+	StreetPreflop = "preflop"
+	StreetFlop    = "flop"
+	StreetTurn    = "turn"
+	StreetRiver   = "river"
+)
+
+// StreetEquity is every player's equity once a street has been dealt
+type StreetEquity struct {
+	Street  string
+	Board   []Card    // Community cards dealt by this street
+	Equity  []float64 // Each player's expected share of the pot
+	Win     []float64 // Each player's probability of winning outright
+	Hands   []Hand    // Each player's current made hand (empty preflop)
+	Leaders []int     // Players holding the best current hand (empty preflop)
+	Exact   bool      // True if every runout was enumerated
+	Runouts int       // Runouts evaluated
+}
+
+// RunoutCard is the result of dealing one particular next card
+type RunoutCard struct {
+	Card     Card
+	Equity   []float64 // Each player's equity after the card (exact)
+	Leaders  []int     // Players holding the best hand after the card; on the river, the winners
+	BestHand Hand      // The leaders' hand
+}
+
+// EquityBreakdown is the street-by-street equity of a hand and the table of possible next cards
+type EquityBreakdown struct {
+	Streets    []StreetEquity
+	NextStreet string       // Street dealt by Runouts, or empty once the board is complete or preflop
+	Runouts    []RunoutCard // Every possible next card on the flop or turn
+}
+
+// CalculateEquityBreakdown computes every player's equity at each street up to the known board
+// and, on the flop or turn, the outcome of every possible next card. Flop, turn and river equities
+// are enumerated exactly; preflop equity samples numSimulations boards (DefaultPreflopSimulations if 0).
+func CalculateEquityBreakdown(players [][]Card, communityCards []Card, numSimulations int) (EquityBreakdown, error) {
+	if err := checkEquityInput(players, communityCards, numSimulations); err != nil {
+		return EquityBreakdown{}, err
+	}
+	if numSimulations == 0 {
+		numSimulations = DefaultPreflopSimulations
+	}
+	// Later community cards are still unseen when an earlier street is scored
+	unseen := RemoveCards(GetDeck(), combineCards(players...))
+
+	var breakdown EquityBreakdown
+	breakdown.Streets = append(breakdown.Streets, preflopEquity(players, unseen, numSimulations))
+	for _, street := range []struct {
+		name  string
+		cards int
+	}{{StreetFlop, 3}, {StreetTurn, 4}, {StreetRiver, 5}} {
+		if len(communityCards) < street.cards {
+			break
+		}
+		board := communityCards[:street.cards]
+		equity := StreetEquity{
+			Street: street.name,
+			Board:  board,
+			Exact:  true,
+		}
+		equity.Equity, equity.Win, equity.Runouts = enumerateEquity(players, board, RemoveCards(unseen, board))
+		equity.Hands, equity.Leaders = currentLeaders(players, board)
+		breakdown.Streets = append(breakdown.Streets, equity)
+	}
+
+	// Table of every possible next card
+	if len(communityCards) == 3 || len(communityCards) == 4 {
+		breakdown.NextStreet = StreetTurn
+		if len(communityCards) == 4 {
+			breakdown.NextStreet = StreetRiver
+		}
+		deck := RemoveCards(unseen, communityCards)
+		for _, card := range deck {
+			board := combineCards(communityCards, []Card{card})
+			runout := RunoutCard{Card: card}
+			runout.Equity, _, _ = enumerateEquity(players, board, RemoveCards(deck, []Card{card}))
+			hands, leaders := currentLeaders(players, board)
+			runout.Leaders = leaders
+			runout.BestHand = hands[leaders[0]]
+			breakdown.Runouts = append(breakdown.Runouts, runout)
+		}
+	}
+
+	return breakdown, nil
+}
+
+// CalculateEquity returns every player's expected share of the pot with the community cards known so far.
+// Flop, turn and river equities are enumerated exactly; preflop equity samples numSimulations boards
+// (DefaultPreflopSimulations if 0).
+func CalculateEquity(players [][]Card, communityCards []Card, numSimulations int) ([]float64, error) {
+	if err := checkEquityInput(players, communityCards, numSimulations); err != nil {
+		return nil, err
+	}
+	if numSimulations == 0 {
+		numSimulations = DefaultPreflopSimulations
+	}
+	unseen := RemoveCards(GetDeck(), combineCards(append(players, communityCards)...))
+	if len(communityCards) == 0 {
+		return preflopEquity(players, unseen, numSimulations).Equity, nil
+	}
+	equity, _, _ := enumerateEquity(players, communityCards, unseen)
+	return equity, nil
+}
+
+// checkEquityInput validates the players' hole cards, the community cards and the number of simulations
+func checkEquityInput(players [][]Card, communityCards []Card, numSimulations int) error {
+	if len(players) < 2 || len(players) > 10 {
+		return fmt.Errorf("must provide between 2 and 10 players")
+	}
+	for i, holeCards := range players {
+		if len(holeCards) != 2 {
+			return fmt.Errorf("player %d must have exactly 2 hole cards", i)
+		}
+	}
+	if len(communityCards) != 0 && len(communityCards) != 3 && len(communityCards) != 4 && len(communityCards) != 5 {
+		return fmt.Errorf("must provide 0, 3, 4, or 5 community cards")
+	}
+	if numSimulations < 0 {
+		return fmt.Errorf("number of simulations must not be negative")
+	}
+	return CheckDuplicateCards(combineCards(append(players, communityCards)...))
+}
+
+// preflopEquity samples boards to estimate every player's equity before the flop
+func preflopEquity(players [][]Card, deck []Card, numSimulations int) StreetEquity {
+	equity := StreetEquity{
+		Street:  StreetPreflop,
+		Board:   []Card{},
+		Equity:  make([]float64, len(players)),
+		Win:     make([]float64, len(players)),
+		Runouts: numSimulations,
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	board := make([]Card, 5)
+	for sim := 0; sim < numSimulations; sim++ {
+		for i, k := range r.Perm(len(deck))[:5] {
+			board[i] = deck[k]
+		}
+		addShowdown(players, board, equity.Equity, equity.Win)
+	}
+	for i := range players {
+		equity.Equity[i] /= float64(numSimulations)
+		equity.Win[i] /= float64(numSimulations)
+	}
+	return equity
+}
+
+// enumerateEquity deals every completion of the board from deck and returns each player's
+// equity and outright win probability, with the number of runouts
+func enumerateEquity(players [][]Card, board []Card, deck []Card) ([]float64, []float64, int) {
+	equity := make([]float64, len(players))
+	win := make([]float64, len(players))
+	runouts := 0
+	forEachRunout(deck, 5-len(board), func(runout []Card) {
+		addShowdown(players, combineCards(board, runout), equity, win)
+		runouts++
+	})
+	for i := range players {
+		equity[i] /= float64(runouts)
+		win[i] /= float64(runouts)
+	}
+	return equity, win, runouts
+}
+
+// addShowdown scores one complete board, splitting the pot between the best hands
+func addShowdown(players [][]Card, board []Card, equity, win []float64) {
+	_, winners := currentLeaders(players, board)
+	for _, player := range winners {
+		equity[player] += 1 / float64(len(winners))
+	}
+	if len(winners) == 1 {
+		win[winners[0]]++
+	}
+}
+
+// currentLeaders evaluates every player's hand on a board of 3 to 5 cards and returns
+// the hands along with the players holding the best one
+func currentLeaders(players [][]Card, board []Card) ([]Hand, []int) {
+	hands := make([]Hand, len(players))
+	var leaders []int
+	for i, holeCards := range players {
+		hands[i] = evaluateCards(combineCards(holeCards, board))
+		switch {
+		case len(leaders) == 0 || CompareHands(hands[i], hands[leaders[0]]) > 0:
+			leaders = []int{i}
+		case CompareHands(hands[i], hands[leaders[0]]) == 0:
+			leaders = append(leaders, i)
+		}
+	}
+	return hands, leaders
+}
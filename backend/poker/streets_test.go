@@ -0,0 +1,145 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateEquityBreakdown(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"HK", "SK"})
+	board, _ := ParseCards([]string{"C2", "D7", "C9", "DK", "DA"})
+	players := [][]Card{aces, kings}
+
+	testCases := []struct {
+		name        string
+		board       []Card
+		streets     int
+		nextStreet  string
+		runouts     int
+		finalEquity float64 // Exact equity of the aces on the last known street
+	}{
+		{"Preflop", nil, 1, "", 0, -1},
+		{"Flop", board[:3], 2, StreetTurn, 45, 907.0 / 990},
+		{"Turn", board[:4], 3, StreetRiver, 44, 2.0 / 44},
+		{"River", board, 4, "", 0, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			breakdown, err := CalculateEquityBreakdown(players, tc.board, 5000)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(breakdown.Streets) != tc.streets {
+				t.Fatalf("Expected %d streets, got %d", tc.streets, len(breakdown.Streets))
+			}
+			if breakdown.NextStreet != tc.nextStreet || len(breakdown.Runouts) != tc.runouts {
+				t.Errorf("Expected %d %s runouts, got %d %s", tc.runouts, tc.nextStreet, len(breakdown.Runouts), breakdown.NextStreet)
+			}
+
+			preflop := breakdown.Streets[0]
+			if preflop.Exact || math.Abs(preflop.Equity[0]-0.82) > 0.03 {
+				t.Errorf("Expected sampled preflop equity near 0.82, got %.3f (exact %v)", preflop.Equity[0], preflop.Exact)
+			}
+			for _, street := range breakdown.Streets {
+				if sum := street.Equity[0] + street.Equity[1]; math.Abs(sum-1) > 1e-9 {
+					t.Errorf("Expected %s equities to sum to 1, got %.6f", street.Street, sum)
+				}
+			}
+
+			last := breakdown.Streets[len(breakdown.Streets)-1]
+			if tc.finalEquity >= 0 && math.Abs(last.Equity[0]-tc.finalEquity) > 1e-9 {
+				t.Errorf("Expected %s equity %.4f, got %.4f", last.Street, tc.finalEquity, last.Equity[0])
+			}
+		})
+	}
+}
+
+func TestEquityBreakdownRunouts(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"HK", "SK"})
+	board, _ := ParseCards([]string{"C2", "D7", "C9", "DK"})
+
+	breakdown, err := CalculateEquityBreakdown([][]Card{aces, kings}, board, 100)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	turn := breakdown.Streets[2]
+	if len(turn.Leaders) != 1 || turn.Leaders[0] != 1 || turn.Hands[1].Type != ThreeOfAKind {
+		t.Errorf("Expected kings to lead with a set on the turn, got leaders %v", turn.Leaders)
+	}
+
+	acesWin := 0
+	for _, runout := range breakdown.Runouts {
+		if len(runout.Leaders) != 1 {
+			t.Errorf("Expected a single winner on %s, got %v", CardToString(runout.Card), runout.Leaders)
+			continue
+		}
+		if runout.Leaders[0] == 0 {
+			acesWin++
+			if runout.Card.Rank != Ace || runout.Equity[0] != 1 {
+				t.Errorf("Expected aces to win only by hitting an ace, won on %s", CardToString(runout.Card))
+			}
+		}
+	}
+	if acesWin != 2 {
+		t.Errorf("Expected aces to win on 2 river cards, got %d", acesWin)
+	}
+}
+
+func TestCalculateEquityBreakdownErrors(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"HK", "SK"})
+	board, _ := ParseCards([]string{"C2", "D7"})
+	duplicate, _ := ParseCards([]string{"HA", "C3"})
+
+	testCases := []struct {
+		name    string
+		players [][]Card
+		board   []Card
+	}{
+		{"One player", [][]Card{aces}, nil},
+		{"Missing hole card", [][]Card{aces, kings[:1]}, nil},
+		{"Two community cards", [][]Card{aces, kings}, board},
+		{"Duplicate card", [][]Card{aces, duplicate}, nil},
+	}
+	for _, tc := range testCases {
+		if _, err := CalculateEquityBreakdown(tc.players, tc.board, 100); err == nil {
+			t.Errorf("Expected error for %s", tc.name)
+		}
+	}
+}
+
+func TestCalculateEquity(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"HK", "SK"})
+	board, _ := ParseCards([]string{"C2", "D7", "C9", "DK", "DA"})
+	players := [][]Card{aces, kings}
+
+	testCases := []struct {
+		name      string
+		board     []Card
+		equity    float64
+		tolerance float64
+	}{
+		{"Preflop", nil, 0.82, 0.03},
+		{"Flop", board[:3], 907.0 / 990, 1e-9},
+		{"Turn", board[:4], 2.0 / 44, 1e-9},
+		{"River", board, 1, 1e-9},
+	}
+	for _, tc := range testCases {
+		equity, err := CalculateEquity(players, tc.board, 5000)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if math.Abs(equity[0]-tc.equity) > tc.tolerance || math.Abs(equity[0]+equity[1]-1) > 1e-9 {
+			t.Errorf("%s: expected equity %.4f, got %v", tc.name, tc.equity, equity)
+		}
+	}
+
+	if _, err := CalculateEquity([][]Card{aces, aces}, nil, 0); err == nil {
+		t.Error("Expected error for duplicate cards")
+	}
+}
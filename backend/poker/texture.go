@@ -0,0 +1,187 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// BoardTexture classifies a flop, turn or river board
+type BoardTexture struct {
+	Pairing              string // "unpaired", "paired", "two pair", "trips", "full house" or "quads"
+	SuitPattern          string // "rainbow", "two-tone", "monotone", "three-flush" or "four-flush"
+	Connectedness        string // "connected", "semi-connected" or "disconnected"
+	MaxSuitCount         int    // Number of cards of the most common suit
+	ConnectedRanks       int    // Most distinct board ranks that fit inside one straight
+	StraightPossible     bool   // Some holding makes a straight (or the board is one)
+	FlushPossible        bool   // Some holding makes a flush (or the board is one)
+	StraightDrawPossible bool   // A straight draw is possible and cards are still to come
+	FlushDrawPossible    bool   // A flush draw is possible and cards are still to come
+	HighCard             Rank   // Highest rank on the board
+	HighCardClass        string // "ace-high", "broadway", "middle" or "low"
+	Wetness              int    // 0 (dry) to 100 (wet): how many draws and strong holdings the board allows
+}
+
+// straightWindows lists the lowest rank of every 5-rank straight; the wheel starts at the ace
+var straightWindows = []Rank{Ace, Two, Three, Four, Five, Six, Seven, Eight, Nine, Ten}
+
+// AnalyzeBoardTexture classifies 3 to 5 community cards
+func AnalyzeBoardTexture(communityCards []Card) (BoardTexture, error) {
+	if len(communityCards) < 3 || len(communityCards) > 5 {
+		return BoardTexture{}, fmt.Errorf("board texture needs 3, 4, or 5 community cards")
+	}
+	if err := CheckDuplicateCards(communityCards); err != nil {
+		return BoardTexture{}, err
+	}
+
+	rankCount := make(map[Rank]int)
+	suitCount := make(map[Suit]int)
+	texture := BoardTexture{HighCard: communityCards[0].Rank}
+	for _, card := range communityCards {
+		rankCount[card.Rank]++
+		suitCount[card.Suit]++
+		if card.Rank > texture.HighCard {
+			texture.HighCard = card.Rank
+		}
+	}
+	cardsToCome := len(communityCards) < 5
+
+	// Pairing
+	pairs, trips, quads := 0, 0, 0
+	for _, count := range rankCount {
+		switch count {
+		case 2:
+			pairs++
+		case 3:
+			trips++
+		case 4:
+			quads++
+		}
+	}
+	switch {
+	case quads > 0:
+		texture.Pairing = "quads"
+	case trips > 0 && pairs > 0:
+		texture.Pairing = "full house"
+	case trips > 0:
+		texture.Pairing = "trips"
+	case pairs > 1:
+		texture.Pairing = "two pair"
+	case pairs == 1:
+		texture.Pairing = "paired"
+	default:
+		texture.Pairing = "unpaired"
+	}
+
+	// Suits
+	for _, count := range suitCount {
+		if count > texture.MaxSuitCount {
+			texture.MaxSuitCount = count
+		}
+	}
+	switch {
+	case len(suitCount) == 1:
+		texture.SuitPattern = "monotone"
+	case texture.MaxSuitCount == 1:
+		texture.SuitPattern = "rainbow"
+	case texture.MaxSuitCount == 2:
+		texture.SuitPattern = "two-tone"
+	case texture.MaxSuitCount == 3:
+		texture.SuitPattern = "three-flush"
+	default:
+		texture.SuitPattern = "four-flush"
+	}
+	texture.FlushPossible = texture.MaxSuitCount >= 3
+	texture.FlushDrawPossible = cardsToCome && texture.MaxSuitCount >= 2 && !texture.FlushPossible
+
+	// Straights
+	for _, low := range straightWindows {
+		inWindow := 0
+		for i := 0; i < 5; i++ {
+			if rankCount[straightRank(low, i)] > 0 {
+				inWindow++
+			}
+		}
+		if inWindow > texture.ConnectedRanks {
+			texture.ConnectedRanks = inWindow
+		}
+	}
+	texture.StraightPossible = texture.ConnectedRanks >= 3
+	texture.StraightDrawPossible = cardsToCome && texture.ConnectedRanks >= 2 && !texture.StraightPossible
+	switch {
+	case texture.ConnectedRanks >= 3:
+		texture.Connectedness = "connected"
+	case texture.ConnectedRanks == 2:
+		texture.Connectedness = "semi-connected"
+	default:
+		texture.Connectedness = "disconnected"
+	}
+
+	// High card
+	switch {
+	case texture.HighCard == Ace:
+		texture.HighCardClass = "ace-high"
+	case texture.HighCard >= Ten:
+		texture.HighCardClass = "broadway"
+	case texture.HighCard >= Seven:
+		texture.HighCardClass = "middle"
+	default:
+		texture.HighCardClass = "low"
+	}
+
+	texture.Wetness = boardWetness(texture, rankCount)
+	return texture, nil
+}
+
+// straightRank returns the i-th rank (0-4) of the straight starting at low
+func straightRank(low Rank, i int) Rank {
+	if low == Ace {
+		// Wheel: A-2-3-4-5
+		if i == 0 {
+			return Ace
+		}
+		return Two + Rank(i-1)
+	}
+	return low + Rank(i)
+}
+
+// boardWetness scores how many made hands and draws a board allows, from 0 to 100
+func boardWetness(texture BoardTexture, rankCount map[Rank]int) int {
+	score := 0
+
+	// Flushes and flush draws
+	if texture.FlushPossible {
+		score += 30 + 10*(texture.MaxSuitCount-3)
+	} else if texture.FlushDrawPossible {
+		score += 20
+	}
+
+	// Straights and straight draws
+	if texture.StraightPossible {
+		score += 30 + 10*(texture.ConnectedRanks-3)
+	} else if texture.StraightDrawPossible {
+		score += 15
+	}
+
+	// Boards with several broadway cards connect with most calling ranges
+	broadways := 0
+	for rank := range rankCount {
+		if rank >= Ten {
+			broadways++
+		}
+	}
+	if broadways >= 2 {
+		score += 10
+	}
+
+	// Pairs take ranks (and so straight and two pair combinations) out of play
+	if texture.Pairing != "unpaired" {
+		score -= 10
+	}
+
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
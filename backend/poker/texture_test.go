@@ -0,0 +1,143 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestAnalyzeBoardTexture(t *testing.T) {
+	testCases := []struct {
+		name             string
+		board            []string
+		pairing          string
+		suitPattern      string
+		connectedness    string
+		highCardClass    string
+		straightPossible bool
+		flushPossible    bool
+		straightDraw     bool
+		flushDraw        bool
+	}{
+		{
+			name:          "Dry rainbow king-high flop",
+			board:         []string{"HK", "D7", "C2"},
+			pairing:       "unpaired",
+			suitPattern:   "rainbow",
+			connectedness: "disconnected",
+			highCardClass: "broadway",
+		},
+		{
+			name:             "Wet two-tone connected flop",
+			board:            []string{"H9", "H8", "C7"},
+			pairing:          "unpaired",
+			suitPattern:      "two-tone",
+			connectedness:    "connected",
+			highCardClass:    "middle",
+			straightPossible: true,
+			flushDraw:        true,
+		},
+		{
+			name:          "Monotone broadway flop",
+			board:         []string{"SK", "SQ", "S4"},
+			pairing:       "unpaired",
+			suitPattern:   "monotone",
+			connectedness: "semi-connected",
+			highCardClass: "broadway",
+			flushPossible: true,
+			straightDraw:  true,
+		},
+		{
+			name:             "Wheel cards count as connected",
+			board:            []string{"DA", "C2", "H3"},
+			pairing:          "unpaired",
+			suitPattern:      "rainbow",
+			connectedness:    "connected",
+			highCardClass:    "ace-high",
+			straightPossible: true,
+		},
+		{
+			name:          "Paired low flop",
+			board:         []string{"D5", "C5", "HQ"},
+			pairing:       "paired",
+			suitPattern:   "rainbow",
+			connectedness: "disconnected",
+			highCardClass: "broadway",
+		},
+		{
+			name:          "Full house river",
+			board:         []string{"D6", "C6", "H6", "S9", "H9"},
+			pairing:       "full house",
+			suitPattern:   "two-tone",
+			connectedness: "semi-connected",
+			highCardClass: "middle",
+		},
+		{
+			name:          "Four-flush turn",
+			board:         []string{"D2", "D6", "DT", "DK"},
+			pairing:       "unpaired",
+			suitPattern:   "monotone",
+			connectedness: "semi-connected",
+			highCardClass: "broadway",
+			flushPossible: true,
+			straightDraw:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board, err := ParseCards(tc.board)
+			if err != nil {
+				t.Fatalf("Failed to parse board: %v", err)
+			}
+
+			texture, err := AnalyzeBoardTexture(board)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if texture.Pairing != tc.pairing {
+				t.Errorf("Expected pairing %s, got %s", tc.pairing, texture.Pairing)
+			}
+			if texture.SuitPattern != tc.suitPattern {
+				t.Errorf("Expected suit pattern %s, got %s", tc.suitPattern, texture.SuitPattern)
+			}
+			if texture.Connectedness != tc.connectedness {
+				t.Errorf("Expected connectedness %s, got %s", tc.connectedness, texture.Connectedness)
+			}
+			if texture.HighCardClass != tc.highCardClass {
+				t.Errorf("Expected high card class %s, got %s", tc.highCardClass, texture.HighCardClass)
+			}
+			if texture.StraightPossible != tc.straightPossible || texture.FlushPossible != tc.flushPossible {
+				t.Errorf("Expected straight/flush possible %v/%v, got %v/%v",
+					tc.straightPossible, tc.flushPossible, texture.StraightPossible, texture.FlushPossible)
+			}
+			if texture.StraightDrawPossible != tc.straightDraw || texture.FlushDrawPossible != tc.flushDraw {
+				t.Errorf("Expected straight/flush draw %v/%v, got %v/%v",
+					tc.straightDraw, tc.flushDraw, texture.StraightDrawPossible, texture.FlushDrawPossible)
+			}
+		})
+	}
+}
+
+func TestBoardWetnessOrdering(t *testing.T) {
+	dry, _ := ParseCards([]string{"HK", "D7", "C2"})
+	wet, _ := ParseCards([]string{"HJ", "HT", "C9"})
+
+	dryTexture, _ := AnalyzeBoardTexture(dry)
+	wetTexture, _ := AnalyzeBoardTexture(wet)
+
+	if dryTexture.Wetness >= wetTexture.Wetness {
+		t.Errorf("Expected K72 rainbow (%d) to be drier than JT9 two-tone (%d)", dryTexture.Wetness, wetTexture.Wetness)
+	}
+}
+
+func TestAnalyzeBoardTextureInvalid(t *testing.T) {
+	twoCards, _ := ParseCards([]string{"HA", "HK"})
+	if _, err := AnalyzeBoardTexture(twoCards); err == nil {
+		t.Error("Expected error for a 2-card board")
+	}
+
+	duplicate, _ := ParseCards([]string{"HA", "HK", "HA"})
+	if _, err := AnalyzeBoardTexture(duplicate); err == nil {
+		t.Error("Expected error for duplicate cards")
+	}
+}
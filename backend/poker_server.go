@@ -2,20 +2,52 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"temperature-converter/fairshuffle"
+	"temperature-converter/handhistory"
+	"temperature-converter/icm"
 	pb "temperature-converter/pb"
 	"temperature-converter/poker"
+	"temperature-converter/pushfold"
+	"temperature-converter/resultcache"
 )
 
 // pokerServer implements the PokerEvaluator service
 type pokerServer struct {
 	pb.UnimplementedPokerEvaluatorServer
+	hands         *handhistory.Store                     // Imported hand histories
+	evaluations   *resultcache.Cache[handEvaluation]     // Evaluated hands by canonical cards
+	probabilities *resultcache.Cache[probabilitySamples] // Simulation counts by canonical cards and players
+}
+
+const (
+	resultCacheSize = 10000     // Most results each cache holds
+	resultCacheTTL  = time.Hour // How long a cached result is served
+	cacheVariant    = "holdem"  // Game variant at the start of every cache key
+)
+
+// newPokerServer creates the PokerEvaluator service with empty result caches
+func newPokerServer(hands *handhistory.Store) *pokerServer {
+	return &pokerServer{
+		hands:         hands,
+		evaluations:   resultcache.New[handEvaluation](resultCacheSize, resultCacheTTL),
+		probabilities: resultcache.New[probabilitySamples](resultCacheSize, resultCacheTTL),
+	}
 }
 
-// EvaluateHand evaluates the best hand from 2 hole cards + 5 community cards
+// EvaluateHand evaluates the best hand from 2 hole cards and 3 to 5 community cards, or classifies the hole cards preflop
 func (s *pokerServer) EvaluateHand(ctx context.Context, req *pb.EvaluateHandRequest) (*pb.EvaluateHandResponse, error) {
 	// Parse hole cards
 	holeCards, err := poker.ParseCards(req.HoleCards)
@@ -31,24 +63,125 @@ func (s *pokerServer) EvaluateHand(ctx context.Context, req *pb.EvaluateHandRequ
 	if err != nil {
 		return nil, fmt.Errorf("invalid community cards: %v", err)
 	}
-	if len(communityCards) != 5 {
-		return nil, fmt.Errorf("must provide exactly 5 community cards")
+	if len(communityCards) != 0 && len(communityCards) != 3 && len(communityCards) != 4 && len(communityCards) != 5 {
+		return nil, fmt.Errorf("must provide 0, 3, 4, or 5 community cards")
+	}
+
+	// Preflop, classify the starting hand
+	if len(communityCards) == 0 {
+		startingHand, err := poker.ClassifyStartingHand(holeCards)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.EvaluateHandResponse{
+			BestHand:     startingHand.Description,
+			StartingHand: startingHand.Class,
+		}, nil
+	}
+
+	if err := poker.CheckDuplicateCards(append(append([]poker.Card{}, holeCards...), communityCards...)); err != nil {
+		return nil, err
+	}
+
+	evaluation, err := s.evaluate(holeCards, communityCards, req.NoCache)
+	if err != nil {
+		return nil, err
+	}
+	response := handToProto(evaluation.hand)
+	response.Draws = drawsToProto(evaluation.draws)
+	return response, nil
+}
+
+// handEvaluation is an evaluated hand with its draws on the flop or turn
+type handEvaluation struct {
+	hand  poker.Hand
+	draws []poker.Draw
+}
+
+// evaluate evaluates the hole cards on the board. Unless noCache is set, the result is looked up and
+// stored under the canonical form of the cards, so hands that differ only by suits share one entry.
+func (s *pokerServer) evaluate(holeCards, communityCards []poker.Card, noCache bool) (handEvaluation, error) {
+	if noCache {
+		return evaluateHand(holeCards, communityCards)
+	}
+
+	canonical := poker.Canonicalize(holeCards, communityCards, nil)
+	key := cacheVariant + ":" + canonical.Key()
+	evaluation, ok := s.evaluations.Get(key)
+	if !ok {
+		var err error
+		evaluation, err = evaluateHand(canonical.HoleCards, canonical.Board)
+		if err != nil {
+			return handEvaluation{}, err
+		}
+		s.evaluations.Put(key, evaluation)
 	}
 
-	// Evaluate hand
-	hand := poker.EvaluateBestHand(holeCards, communityCards)
+	// Relabel the cached cards back to the suits asked about
+	restored := handEvaluation{hand: evaluation.hand}
+	restored.hand.Cards = canonical.Restore(evaluation.hand.Cards)
+	for _, draw := range evaluation.draws {
+		draw.Outs = canonical.Restore(draw.Outs)
+		sortDeckOrder(draw.Outs)
+		restored.draws = append(restored.draws, draw)
+	}
+	return restored, nil
+}
 
-	// Convert cards to strings
-	bestFiveCards := make([]string, len(hand.Cards))
-	for i, card := range hand.Cards {
-		bestFiveCards[i] = poker.CardToString(card)
+// evaluateHand evaluates the hole cards on the board and, on the flop or turn, finds their draws
+func evaluateHand(holeCards, communityCards []poker.Card) (handEvaluation, error) {
+	evaluation := handEvaluation{hand: poker.EvaluateBestHand(holeCards, communityCards)}
+	if len(communityCards) < 5 {
+		draws, err := poker.DetectDraws(holeCards, communityCards)
+		if err != nil {
+			return handEvaluation{}, err
+		}
+		evaluation.draws = draws
 	}
+	return evaluation, nil
+}
 
+// sortDeckOrder sorts cards in poker.GetDeck order, by suit and then rank
+func sortDeckOrder(cards []poker.Card) {
+	sort.Slice(cards, func(i, j int) bool {
+		if cards[i].Suit != cards[j].Suit {
+			return cards[i].Suit < cards[j].Suit
+		}
+		return cards[i].Rank < cards[j].Rank
+	})
+}
+
+// handToProto converts an evaluated hand to its protobuf message
+func handToProto(hand poker.Hand) *pb.EvaluateHandResponse {
 	return &pb.EvaluateHandResponse{
 		BestHand:      hand.Description,
 		HandValue:     hand.Value,
-		BestFiveCards: bestFiveCards,
-	}, nil
+		BestFiveCards: cardsToStrings(hand.Cards),
+		HandRank:      int32(hand.Rank),
+	}
+}
+
+// drawsToProto converts detected draws to their protobuf messages
+func drawsToProto(draws []poker.Draw) []*pb.Draw {
+	result := make([]*pb.Draw, len(draws))
+	for i, draw := range draws {
+		result[i] = &pb.Draw{
+			Type:        draw.Type,
+			Description: draw.Description,
+			Nut:         draw.Nut,
+			Outs:        cardsToStrings(draw.Outs),
+		}
+	}
+	return result
+}
+
+// cardsToStrings converts cards to their string format
+func cardsToStrings(cards []poker.Card) []string {
+	result := make([]string, len(cards))
+	for i, card := range cards {
+		result[i] = poker.CardToString(card)
+	}
+	return result
 }
 
 // CompareHands compares two hands and determines the winner
@@ -91,261 +224,1016 @@ func (s *pokerServer) CompareHands(ctx context.Context, req *pb.CompareHandsRequ
 	hand1 := poker.EvaluateBestHand(player1HoleCards, player1CommunityCards)
 	hand2 := poker.EvaluateBestHand(player2HoleCards, player2CommunityCards)
 
-	// Convert cards to strings
-	bestFiveCards1 := make([]string, len(hand1.Cards))
-	for i, card := range hand1.Cards {
-		bestFiveCards1[i] = poker.CardToString(card)
-	}
-
-	bestFiveCards2 := make([]string, len(hand2.Cards))
-	for i, card := range hand2.Cards {
-		bestFiveCards2[i] = poker.CardToString(card)
-	}
-
-	player1Response := &pb.EvaluateHandResponse{
-		BestHand:      hand1.Description,
-		HandValue:     hand1.Value,
-		BestFiveCards: bestFiveCards1,
-	}
-
-	player2Response := &pb.EvaluateHandResponse{
-		BestHand:      hand2.Description,
-		HandValue:     hand2.Value,
-		BestFiveCards: bestFiveCards2,
-	}
-
 	// Determine winner
 	winner := 0
-	if hand1.Value > hand2.Value {
+	switch poker.CompareHands(hand1, hand2) {
+	case 1:
 		winner = 1
-	} else if hand2.Value > hand1.Value {
+	case -1:
 		winner = 2
 	}
 
 	return &pb.CompareHandsResponse{
-		Player1Hand: player1Response,
-		Player2Hand: player2Response,
+		Player1Hand: handToProto(hand1),
+		Player2Hand: handToProto(hand2),
 		Winner:      int32(winner),
 	}, nil
 }
 
 // CalculateWinProbability calculates win probability using Monte Carlo simulation
 func (s *pokerServer) CalculateWinProbability(ctx context.Context, req *pb.ProbabilityRequest) (*pb.ProbabilityResponse, error) {
-	// Parse hole cards
+	holeCards, communityCards, deadCards, err := parseProbabilityRequest(req.HoleCards, req.CommunityCards, req.DeadCards, req.NumPlayers, req.NumSimulations)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NoCache {
+		winProb, tieProb := poker.CalculateWinProbability(holeCards, communityCards, deadCards, int(req.NumPlayers), int(req.NumSimulations))
+		return &pb.ProbabilityResponse{
+			WinProbability: winProb,
+			TieProbability: tieProb,
+			Simulations:    req.NumSimulations,
+		}, nil
+	}
+
+	// Reuse the samples of an equivalent earlier request, topping them up when more simulations are asked for
+	canonical := poker.Canonicalize(holeCards, communityCards, deadCards)
+	key := fmt.Sprintf("%s:%d:%s", cacheVariant, req.NumPlayers, canonical.Key())
+	samples, cached := s.probabilities.Get(key)
+	if missing := int(req.NumSimulations) - samples.simulations; missing > 0 {
+		winProb, tieProb := poker.CalculateWinProbability(canonical.HoleCards, canonical.Board, canonical.DeadCards, int(req.NumPlayers), missing)
+		// Merge under the cache's lock so that samples added by a concurrent request are kept
+		samples = s.probabilities.Update(key, func(current probabilitySamples, _ bool) probabilitySamples {
+			return current.add(winProb, tieProb, missing)
+		})
+	}
+
+	return &pb.ProbabilityResponse{
+		WinProbability: float64(samples.wins) / float64(samples.simulations),
+		TieProbability: float64(samples.ties) / float64(samples.simulations),
+		Simulations:    int32(samples.simulations),
+		Cached:         cached,
+	}, nil
+}
+
+// probabilitySamples counts the simulated outcomes behind a cached win probability
+type probabilitySamples struct {
+	simulations int
+	wins        int
+	ties        int
+}
+
+// add combines the samples with a fresh batch of simulations
+func (p probabilitySamples) add(winProb, tieProb float64, simulations int) probabilitySamples {
+	return probabilitySamples{
+		simulations: p.simulations + simulations,
+		wins:        p.wins + int(math.Round(winProb*float64(simulations))),
+		ties:        p.ties + int(math.Round(tieProb*float64(simulations))),
+	}
+}
+
+// defaultUpdateInterval is the number of simulations between streamed updates when the request does not set one
+const defaultUpdateInterval = 1000
+
+// StreamWinProbability streams interim win probability estimates followed by a final estimate
+func (s *pokerServer) StreamWinProbability(req *pb.StreamProbabilityRequest, stream pb.PokerEvaluator_StreamWinProbabilityServer) error {
+	holeCards, communityCards, deadCards, err := parseProbabilityRequest(req.HoleCards, req.CommunityCards, req.DeadCards, req.NumPlayers, req.NumSimulations)
+	if err != nil {
+		return err
+	}
+
+	updateInterval := int(req.UpdateInterval)
+	if updateInterval < 0 {
+		return fmt.Errorf("update interval must not be negative")
+	}
+	if updateInterval == 0 {
+		updateInterval = defaultUpdateInterval
+	}
+
+	var sendErr error
+	poker.CalculateWinProbabilityProgressive(holeCards, communityCards, deadCards, int(req.NumPlayers), int(req.NumSimulations), updateInterval,
+		func(estimate poker.ProbabilityEstimate) bool {
+			if err := stream.Context().Err(); err != nil {
+				sendErr = err
+				return false
+			}
+			if err := stream.Send(probabilityUpdate(estimate)); err != nil {
+				sendErr = err
+				return false
+			}
+			return true
+		})
+
+	return sendErr
+}
+
+// AnalyzeBoardTexture classifies a flop, turn or river board
+func (s *pokerServer) AnalyzeBoardTexture(ctx context.Context, req *pb.BoardTextureRequest) (*pb.BoardTextureResponse, error) {
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community cards: %v", err)
+	}
+
+	texture, err := poker.AnalyzeBoardTexture(communityCards)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BoardTextureResponse{
+		Pairing:              texture.Pairing,
+		SuitPattern:          texture.SuitPattern,
+		Connectedness:        texture.Connectedness,
+		MaxSuitCount:         int32(texture.MaxSuitCount),
+		ConnectedRanks:       int32(texture.ConnectedRanks),
+		StraightPossible:     texture.StraightPossible,
+		FlushPossible:        texture.FlushPossible,
+		StraightDrawPossible: texture.StraightDrawPossible,
+		FlushDrawPossible:    texture.FlushDrawPossible,
+		HighCard:             poker.RankToString(texture.HighCard),
+		HighCardClass:        texture.HighCardClass,
+		Wetness:              int32(texture.Wetness),
+	}, nil
+}
+
+// AnalyzeNuts finds the nuts and ranks the hero's hand against every possible opponent holding
+func (s *pokerServer) AnalyzeNuts(ctx context.Context, req *pb.NutAnalysisRequest) (*pb.NutAnalysisResponse, error) {
 	holeCards, err := poker.ParseCards(req.HoleCards)
 	if err != nil {
 		return nil, fmt.Errorf("invalid hole cards: %v", err)
 	}
-	if len(holeCards) != 2 {
-		return nil, fmt.Errorf("must provide exactly 2 hole cards")
+
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community cards: %v", err)
+	}
+
+	analysis, err := poker.AnalyzeNuts(holeCards, communityCards)
+	if err != nil {
+		return nil, err
+	}
+
+	nutHoldings := make([]*pb.Holding, len(analysis.NutHoldings))
+	for i, holding := range analysis.NutHoldings {
+		nutHoldings[i] = &pb.Holding{Cards: cardsToStrings(holding)}
+	}
+
+	return &pb.NutAnalysisResponse{
+		NutHand:     handToProto(analysis.Nuts),
+		NutHoldings: nutHoldings,
+		HeroHand:    handToProto(analysis.HeroHand),
+		HeroRank:    int32(analysis.HeroRank),
+		BeatenBy:    int32(analysis.BeatenBy),
+		Ties:        int32(analysis.Ties),
+		Beats:       int32(analysis.Beats),
+		TotalCombos: int32(analysis.TotalCombos),
+		Summary:     analysis.Summary(),
+	}, nil
+}
+
+// CalculateHandPotential computes HS, PPot, NPot and EHS against random or range-based opponents
+func (s *pokerServer) CalculateHandPotential(ctx context.Context, req *pb.HandPotentialRequest) (*pb.HandPotentialResponse, error) {
+	holeCards, err := poker.ParseCards(req.HoleCards)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hole cards: %v", err)
 	}
 
-	// Parse community cards (0, 3, 4, or 5)
 	communityCards, err := poker.ParseCards(req.CommunityCards)
 	if err != nil {
 		return nil, fmt.Errorf("invalid community cards: %v", err)
 	}
-	if len(communityCards) != 0 && len(communityCards) != 3 && len(communityCards) != 4 && len(communityCards) != 5 {
-		return nil, fmt.Errorf("must provide 0, 3, 4, or 5 community cards")
+
+	var opponentRange [][]poker.Card
+	if req.OpponentRange != "" {
+		opponentRange, err = poker.ParseRange(req.OpponentRange)
+		if err != nil {
+			return nil, fmt.Errorf("invalid opponent range: %v", err)
+		}
 	}
 
-	numPlayers := int(req.NumPlayers)
-	if numPlayers < 2 {
-		return nil, fmt.Errorf("must have at least 2 players")
+	numOpponents := int(req.NumOpponents)
+	if numOpponents == 0 {
+		numOpponents = 1
 	}
 
-	numSimulations := int(req.NumSimulations)
-	if numSimulations < 1 {
-		return nil, fmt.Errorf("must run at least 1 simulation")
+	potential, err := poker.CalculateHandPotential(holeCards, communityCards, opponentRange, numOpponents, int(req.NumSamples))
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate probability
-	winProb, tieProb := poker.CalculateWinProbability(holeCards, communityCards, numPlayers, numSimulations)
+	return &pb.HandPotentialResponse{
+		HandStrength:          potential.HandStrength,
+		HandStrengthN:         potential.HandStrengthN,
+		PositivePotential:     potential.PositivePotential,
+		NegativePotential:     potential.NegativePotential,
+		EffectiveHandStrength: potential.EffectiveHandStrength,
+		Samples:               int32(potential.Samples),
+		Exact:                 potential.Exact,
+	}, nil
+}
 
-	return &pb.ProbabilityResponse{
-		WinProbability: winProb,
-		TieProbability: tieProb,
+// Defaults for equity calculated by EvaluateCallDecision
+const (
+	defaultDecisionPlayers     = 2
+	defaultDecisionSimulations = 10000
+)
+
+// EvaluateCallDecision computes pot odds and call EV, calculating the hero's equity from the cards when it is not supplied
+func (s *pokerServer) EvaluateCallDecision(ctx context.Context, req *pb.CallDecisionRequest) (*pb.CallDecisionResponse, error) {
+	var equity float64
+	if req.Equity != nil {
+		equity = req.GetEquity()
+	} else {
+		numPlayers := req.NumPlayers
+		if numPlayers == 0 {
+			numPlayers = defaultDecisionPlayers
+		}
+		numSimulations := req.NumSimulations
+		if numSimulations == 0 {
+			numSimulations = defaultDecisionSimulations
+		}
+
+		holeCards, communityCards, deadCards, err := parseProbabilityRequest(req.HoleCards, req.CommunityCards, req.DeadCards, numPlayers, numSimulations)
+		if err != nil {
+			return nil, err
+		}
+		estimate := poker.CalculateWinProbabilityProgressive(holeCards, communityCards, deadCards, int(numPlayers), int(numSimulations), 0, nil)
+		equity = estimate.Equity
+	}
+
+	decision, err := poker.EvaluateCall(req.PotSize, req.BetToCall, req.EffectiveStack, equity)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendation := "fold"
+	if decision.Call {
+		recommendation = "call"
+	}
+
+	return &pb.CallDecisionResponse{
+		PotOdds:              decision.PotOdds,
+		RequiredEquity:       decision.RequiredEquity,
+		Equity:               decision.Equity,
+		CallAmount:           decision.CallAmount,
+		CallEv:               decision.CallEV,
+		ImpliedOddsNeeded:    decision.ImpliedOddsNeeded,
+		ImpliedOddsAvailable: decision.ImpliedOddsAvailable,
+		AllIn:                decision.AllIn,
+		Recommendation:       recommendation,
+		Reason:               decision.Reason,
 	}, nil
 }
 
-// REST request/response types
-type EvaluateHandRESTRequest struct {
-	HoleCards      []string `json:"hole_cards"`
-	CommunityCards []string `json:"community_cards"`
+// CalculateICM computes ICM prize equity for the stacks and, when requested, compares calling and folding an all-in
+func (s *pokerServer) CalculateICM(ctx context.Context, req *pb.ICMRequest) (*pb.ICMResponse, error) {
+	equities, exact, err := icm.Equity(req.Stacks, req.Payouts, int(req.MonteCarloIterations))
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ICMResponse{Equities: equities, Exact: exact}
+	if req.AllIn == nil {
+		return response, nil
+	}
+
+	scenario := icm.AllInScenario{
+		Stacks:      req.Stacks,
+		Payouts:     req.Payouts,
+		Posted:      req.AllIn.Posted,
+		Hero:        int(req.AllIn.Hero),
+		Simulations: int(req.AllIn.NumSimulations),
+		Iterations:  int(req.MonteCarloIterations),
+	}
+	if scenario.HeroCards, err = poker.ParseCards(req.AllIn.HeroCards); err != nil {
+		return nil, fmt.Errorf("invalid hero cards: %v", err)
+	}
+	if scenario.CommunityCards, err = poker.ParseCards(req.AllIn.CommunityCards); err != nil {
+		return nil, fmt.Errorf("invalid community cards: %v", err)
+	}
+	for _, opponent := range req.AllIn.Opponents {
+		player := icm.AllInPlayer{Player: int(opponent.Player)}
+		if player.Cards, err = poker.ParseCards(opponent.HoleCards); err != nil {
+			return nil, fmt.Errorf("invalid hole cards for player %d: %v", opponent.Player, err)
+		}
+		if len(player.Cards) == 0 && opponent.Range != "" {
+			if player.Range, err = poker.ParseRange(opponent.Range); err != nil {
+				return nil, fmt.Errorf("invalid range for player %d: %v", opponent.Player, err)
+			}
+		}
+		scenario.Opponents = append(scenario.Opponents, player)
+	}
+
+	result, err := icm.EvaluateAllIn(scenario)
+	if err != nil {
+		return nil, err
+	}
+	recommendation := "fold"
+	if result.Call {
+		recommendation = "call"
+	}
+	response.AllIn = &pb.AllInResult{
+		HeroEquity:     result.HeroEquity,
+		CallEv:         result.CallEV,
+		FoldEv:         result.FoldEV,
+		CallEquities:   result.CallEquities,
+		FoldEquities:   result.FoldEquities,
+		Recommendation: recommendation,
+	}
+	return response, nil
 }
 
-type EvaluateHandRESTResponse struct {
-	BestHand      string   `json:"best_hand"`
-	HandValue     int32    `json:"hand_value"`
-	BestFiveCards []string `json:"best_five_cards"`
+// defaultSmallBlind is the small blind in big blinds when a push/fold request leaves it unset
+const defaultSmallBlind = 0.5
+
+// SolvePushFold computes heads-up Nash push/fold ranges for the requested stack and blinds
+func (s *pokerServer) SolvePushFold(ctx context.Context, req *pb.PushFoldRequest) (*pb.PushFoldResponse, error) {
+	structure := pushfold.Structure{
+		Stack:      req.Stack,
+		SmallBlind: req.SmallBlind,
+		Ante:       req.Ante,
+	}
+	if structure.SmallBlind == 0 {
+		structure.SmallBlind = defaultSmallBlind
+	}
+
+	solution, err := pushfold.Solve(structure, int(req.Iterations))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PushFoldResponse{
+		PushChart:      chartToProto(solution.Push),
+		CallChart:      chartToProto(solution.Call),
+		PushPercent:    solution.PushPercent,
+		CallPercent:    solution.CallPercent,
+		SmallBlindEv:   solution.SmallBlindEV,
+		Exploitability: solution.Exploitability,
+		Iterations:     int32(solution.Iterations),
+	}, nil
 }
 
-type CompareHandsRESTRequest struct {
-	Player1HoleCards      []string `json:"player1_hole_cards"`
-	Player1CommunityCards []string `json:"player1_community_cards"`
-	Player2HoleCards      []string `json:"player2_hole_cards"`
-	Player2CommunityCards []string `json:"player2_community_cards"`
+// chartToProto lays out a push/fold strategy as 13 chart rows
+func chartToProto(strategy [pushfold.NumClasses]float64) []*pb.ChartRow {
+	chart := pushfold.Chart(strategy)
+	rows := make([]*pb.ChartRow, len(chart))
+	for i, frequencies := range chart {
+		rows[i] = &pb.ChartRow{}
+		for j, frequency := range frequencies {
+			rows[i].Cells = append(rows[i].Cells, &pb.ChartCell{
+				Hand:      pushfold.ClassName(i*13 + j),
+				Frequency: frequency,
+			})
+		}
+	}
+	return rows
 }
 
-type CompareHandsRESTResponse struct {
-	Player1Hand EvaluateHandRESTResponse `json:"player1_hand"`
-	Player2Hand EvaluateHandRESTResponse `json:"player2_hand"`
-	Winner      int32                    `json:"winner"`
+// CalculateEquityBreakdown computes equity at each known street and the outcome of every possible next card
+func (s *pokerServer) CalculateEquityBreakdown(ctx context.Context, req *pb.EquityBreakdownRequest) (*pb.EquityBreakdownResponse, error) {
+	players := make([][]poker.Card, len(req.Players))
+	for i, holding := range req.Players {
+		holeCards, err := poker.ParseCards(holding.Cards)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hole cards for player %d: %v", i, err)
+		}
+		players[i] = holeCards
+	}
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community cards: %v", err)
+	}
+
+	breakdown, err := poker.CalculateEquityBreakdown(players, communityCards, int(req.NumSimulations))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.EquityBreakdownResponse{NextStreet: breakdown.NextStreet}
+	for _, street := range breakdown.Streets {
+		streetEquity := &pb.StreetEquity{
+			Street:           street.Street,
+			Board:            cardsToStrings(street.Board),
+			Equities:         street.Equity,
+			WinProbabilities: street.Win,
+			Leaders:          intsToInt32s(street.Leaders),
+			Exact:            street.Exact,
+			Runouts:          int32(street.Runouts),
+		}
+		for _, hand := range street.Hands {
+			streetEquity.Hands = append(streetEquity.Hands, hand.Description)
+		}
+		response.Streets = append(response.Streets, streetEquity)
+	}
+	for _, runout := range breakdown.Runouts {
+		response.Runouts = append(response.Runouts, &pb.RunoutCard{
+			Card:     poker.CardToString(runout.Card),
+			Equities: runout.Equity,
+			Leaders:  intsToInt32s(runout.Leaders),
+			BestHand: runout.BestHand.Description,
+		})
+	}
+	return response, nil
 }
 
-type ProbabilityRESTRequest struct {
-	HoleCards      []string `json:"hole_cards"`
-	CommunityCards []string `json:"community_cards"`
-	NumPlayers     int32    `json:"num_players"`
-	NumSimulations int32    `json:"num_simulations"`
+// intsToInt32s converts player indexes for a protobuf message
+func intsToInt32s(values []int) []int32 {
+	converted := make([]int32, len(values))
+	for i, value := range values {
+		converted[i] = int32(value)
+	}
+	return converted
 }
 
-type ProbabilityRESTResponse struct {
-	WinProbability float64 `json:"win_probability"`
-	TieProbability float64 `json:"tie_probability"`
+// Showdown ranks the players' hands on a shared board and awards the main and side pots
+func (s *pokerServer) Showdown(ctx context.Context, req *pb.ShowdownRequest) (*pb.ShowdownResponse, error) {
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community cards: %v", err)
+	}
+	players := make([]poker.ShowdownPlayer, len(req.Players))
+	for i, player := range req.Players {
+		holeCards, err := poker.ParseCards(player.HoleCards)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hole cards for player %d: %v", i, err)
+		}
+		players[i] = poker.ShowdownPlayer{
+			Name:      player.Name,
+			HoleCards: holeCards,
+			Committed: player.Committed,
+			Folded:    player.Folded,
+		}
+	}
+
+	result, err := poker.Showdown(communityCards, players, int(req.Button))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ShowdownResponse{Explanations: result.Explanations}
+	for i, player := range players {
+		playerResult := &pb.ShowdownPlayerResult{
+			Name:   player.Name,
+			Rank:   int32(result.Ranks[i]),
+			Payout: result.Payouts[i],
+			Net:    result.Payouts[i] - player.Committed,
+		}
+		if len(result.Hands[i].Cards) > 0 {
+			playerResult.Hand = handToProto(result.Hands[i])
+		}
+		response.Players = append(response.Players, playerResult)
+	}
+	for _, pot := range result.Pots {
+		response.Pots = append(response.Pots, &pb.Pot{
+			Name:        pot.Name,
+			Amount:      pot.Amount,
+			Eligible:    intsToInt32s(pot.Eligible),
+			Winners:     intsToInt32s(pot.Winners),
+			WinningHand: pot.WinningHand,
+			OddChips:    pot.OddChips,
+		})
+	}
+	return response, nil
 }
 
-// REST handlers
-func evaluateHandHandler(grpcClient pb.PokerEvaluatorClient) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+// ImportHandHistory parses text or PHH hand histories and stores the hands that were not imported before
+func (s *pokerServer) ImportHandHistory(ctx context.Context, req *pb.ImportHandHistoryRequest) (*pb.ImportHandHistoryResponse, error) {
+	if strings.TrimSpace(req.Text) == "" {
+		return nil, fmt.Errorf("hand history text is required")
+	}
 
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
+	result, err := handhistory.Parse(req.Text, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	imported := s.hands.Add(result.Hands...)
+	response := &pb.ImportHandHistoryResponse{
+		Parsed:     int32(len(result.Hands)),
+		Imported:   int32(imported),
+		Duplicates: int32(len(result.Hands) - imported),
+	}
+	for _, parseErr := range result.Errors {
+		response.Errors = append(response.Errors, &pb.HandParseError{
+			Index:  int32(parseErr.Index),
+			HandId: parseErr.HandID,
+			Line:   int32(parseErr.Line),
+			Error:  parseErr.Err.Error(),
+		})
+	}
+	if req.IncludeHands {
+		for _, hand := range result.Hands {
+			response.Hands = append(response.Hands, handHistoryToProto(hand))
 		}
+	}
+	return response, nil
+}
 
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
+// ExportHandHistory writes stored hands in the PHH format: a PHH file for one hand, a PHHS file for several
+func (s *pokerServer) ExportHandHistory(ctx context.Context, req *pb.ExportHandHistoryRequest) (*pb.ExportHandHistoryResponse, error) {
+	hands := s.hands.Hands()
+	var missing []string
+	if len(req.HandIds) > 0 {
+		hands, missing = s.hands.Find(req.HandIds...)
+	}
+	if len(hands) == 0 {
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("hands not found: %s", strings.Join(missing, ", "))
 		}
+		return nil, fmt.Errorf("no hands to export")
+	}
 
-		var req EvaluateHandRESTRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
-			return
+	var text string
+	var err error
+	if len(hands) == 1 {
+		text, err = handhistory.WritePHH(hands[0])
+	} else {
+		text, err = handhistory.WritePHHS(hands)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportHandHistoryResponse{
+		Text:     text,
+		Exported: int32(len(hands)),
+		Missing:  missing,
+	}, nil
+}
+
+// handHistoryToProto converts a parsed hand for a protobuf message
+func handHistoryToProto(hand handhistory.Hand) *pb.HandHistory {
+	result := &pb.HandHistory{
+		Site:         hand.Site,
+		HandId:       hand.ID,
+		TournamentId: hand.TournamentID,
+		Game:         hand.Game,
+		Structure:    hand.Structure.String(),
+		Currency:     hand.Currency,
+		SmallBlind:   hand.SmallBlind,
+		BigBlind:     hand.BigBlind,
+		Ante:         hand.Ante,
+		Table:        hand.Table,
+		MaxSeats:     int32(hand.MaxSeats),
+		Button:       int32(hand.Button),
+		Board:        cardsToStrings(hand.Board),
+		TotalPot:     hand.TotalPot,
+		Rake:         hand.Rake,
+		Key:          hand.Key(),
+	}
+	if !hand.Time.IsZero() {
+		result.Time = hand.Time.Format(time.RFC3339)
+	}
+	for _, seat := range hand.Seats {
+		result.Seats = append(result.Seats, &pb.HandHistorySeat{
+			Seat:       int32(seat.Number),
+			Player:     seat.Player,
+			Stack:      seat.Stack,
+			HoleCards:  cardsToStrings(seat.HoleCards),
+			SittingOut: seat.SittingOut,
+			Net:        hand.Net(seat.Player),
+		})
+	}
+	for _, action := range hand.Actions {
+		result.Actions = append(result.Actions, &pb.HandHistoryAction{
+			Street: action.Street.String(),
+			Player: action.Player,
+			Type:   string(action.Type),
+			Amount: action.Amount,
+			AllIn:  action.AllIn,
+			Cards:  cardsToStrings(action.Cards),
+		})
+	}
+	return result
+}
+
+// AnalyzeLuck builds each player's actual and all-in adjusted winnings over the stored hands
+func (s *pokerServer) AnalyzeLuck(ctx context.Context, req *pb.AnalyzeLuckRequest) (*pb.AnalyzeLuckResponse, error) {
+	if req.Simulations < 0 {
+		return nil, fmt.Errorf("number of simulations must not be negative")
+	}
+	hands := s.hands.Hands()
+	var missing []string
+	if len(req.HandIds) > 0 {
+		hands, missing = s.hands.Find(req.HandIds...)
+		if len(hands) == 0 {
+			return nil, fmt.Errorf("hands not found: %s", strings.Join(missing, ", "))
 		}
+	}
 
-		// Call gRPC service
-		grpcReq := &pb.EvaluateHandRequest{
-			HoleCards:      req.HoleCards,
-			CommunityCards: req.CommunityCards,
+	series, err := handhistory.AnalyzeLuck(hands, req.Player, int(req.Simulations))
+	if err != nil {
+		return nil, err
+	}
+	if req.Player != "" && len(series) == 0 {
+		return nil, fmt.Errorf("no hands played by %s", req.Player)
+	}
+	response := &pb.AnalyzeLuckResponse{Missing: missing}
+	for _, player := range series {
+		result := &pb.LuckSeries{
+			Player:      player.Player,
+			Net:         player.Net,
+			AdjustedNet: player.AdjustedNet,
+			Luck:        player.Luck,
+			Hands:       int32(player.Hands),
+			AllInHands:  int32(player.AllInHands),
 		}
-		resp, err := grpcClient.EvaluateHand(context.Background(), grpcReq)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
+		for _, point := range player.Points {
+			luckPoint := &pb.LuckPoint{
+				HandId:                point.HandID,
+				Net:                   point.Net,
+				AdjustedNet:           point.AdjustedNet,
+				AllIn:                 point.AllIn,
+				Equity:                point.Equity,
+				CumulativeNet:         point.CumulativeNet,
+				CumulativeAdjustedNet: point.CumulativeAdjustedNet,
+			}
+			if !point.Time.IsZero() {
+				luckPoint.Time = point.Time.Format(time.RFC3339)
+			}
+			if point.AllIn {
+				luckPoint.Street = point.Street.String()
+			}
+			result.Points = append(result.Points, luckPoint)
 		}
+		response.Players = append(response.Players, result)
+	}
+	return response, nil
+}
 
-		response := EvaluateHandRESTResponse{
-			BestHand:      resp.BestHand,
-			HandValue:     resp.HandValue,
-			BestFiveCards: resp.BestFiveCards,
+// GetPlayerStats computes player statistics over the stored hands the filters select
+func (s *pokerServer) GetPlayerStats(ctx context.Context, req *pb.PlayerStatsRequest) (*pb.PlayerStatsResponse, error) {
+	filter := handhistory.StatsFilter{Player: req.Player, Position: req.Position}
+	var err error
+	if filter.From, err = parseStatsTime(req.From, false); err != nil {
+		return nil, err
+	}
+	if filter.To, err = parseStatsTime(req.To, true); err != nil {
+		return nil, err
+	}
+	if req.Stakes != "" {
+		if filter.SmallBlind, filter.BigBlind, err = handhistory.ParseStakes(req.Stakes); err != nil {
+			return nil, err
 		}
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(response)
+	stats, err := handhistory.ComputeStats(s.hands.Hands(), filter)
+	if err != nil {
+		return nil, err
+	}
+	// A player asked for by name who has no hands is not found
+	if req.Player != "" && len(stats) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no hands found for %s", req.Player)
+	}
+	response := &pb.PlayerStatsResponse{}
+	for _, player := range stats {
+		response.Players = append(response.Players, playerStatsToProto(player))
 	}
+	return response, nil
 }
 
-func compareHandsHandler(grpcClient pb.PokerEvaluatorClient) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+// parseStatsTime parses an RFC 3339 time or a date. A date ending a range includes the whole day.
+func parseStatsTime(text string, end bool) (time.Time, error) {
+	if text == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, text); err == nil {
+		return t, nil
+	}
+	day, err := time.Parse(time.DateOnly, text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected RFC 3339 or YYYY-MM-DD", text)
+	}
+	if end {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day, nil
+}
 
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+// playerStatsToProto converts a player's statistics and their breakdown by position
+func playerStatsToProto(stats handhistory.PlayerStats) *pb.PlayerStats {
+	ratio := func(r handhistory.Ratio) *pb.StatRatio {
+		return &pb.StatRatio{Count: int32(r.Count), Opportunities: int32(r.Opportunities), Percent: r.Percent()}
+	}
+	result := &pb.PlayerStats{
+		Player:            stats.Player,
+		Position:          stats.Position,
+		Hands:             int32(stats.Hands),
+		Vpip:              ratio(stats.VPIP),
+		Pfr:               ratio(stats.PFR),
+		ThreeBet:          ratio(stats.ThreeBet),
+		FoldToThreeBet:    ratio(stats.FoldToThreeBet),
+		Cbet:              ratio(stats.CBet),
+		Wtsd:              ratio(stats.WTSD),
+		Wsd:               ratio(stats.WonAtShowdown),
+		AggressionFactor:  stats.AggressionFactor(),
+		AggressiveActions: int32(stats.Aggressive),
+		Calls:             int32(stats.Calls),
+		Net:               stats.Net,
+		BbPer_100:         stats.BigBlindsPer100(),
+	}
+	for _, position := range stats.Positions {
+		result.Positions = append(result.Positions, playerStatsToProto(position))
+	}
+	return result
+}
 
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+// VerifyShuffle checks a revealed server seed against the hash published before the hand and reproduces the deck
+func (s *pokerServer) VerifyShuffle(ctx context.Context, req *pb.VerifyShuffleRequest) (*pb.VerifyShuffleResponse, error) {
+	deck, err := fairshuffle.Verify(req.ServerSeed, req.ServerSeedHash, req.ClientSeeds, req.Nonce)
+	if err != nil {
+		return &pb.VerifyShuffleResponse{Error: err.Error()}, nil
+	}
+	return &pb.VerifyShuffleResponse{Valid: true, Deck: cardsToStrings(deck)}, nil
+}
 
-		var req CompareHandsRESTRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
-			return
+// GetCacheStats reports the size, hits and misses of the result caches
+func (s *pokerServer) GetCacheStats(ctx context.Context, req *pb.CacheStatsRequest) (*pb.CacheStatsResponse, error) {
+	return &pb.CacheStatsResponse{
+		Caches: []*pb.CacheStats{
+			cacheStatsToProto("evaluation", s.evaluations.Stats()),
+			cacheStatsToProto("probability", s.probabilities.Stats()),
+		},
+	}, nil
+}
+
+// cacheStatsToProto converts the statistics of a named cache to their protobuf message
+func cacheStatsToProto(name string, stats resultcache.Stats) *pb.CacheStats {
+	return &pb.CacheStats{
+		Name:        name,
+		Entries:     int32(stats.Entries),
+		Capacity:    int32(stats.Capacity),
+		TtlSeconds:  int64(stats.TTL / time.Second),
+		Hits:        stats.Hits,
+		Misses:      stats.Misses,
+		Evictions:   stats.Evictions,
+		Expirations: stats.Expirations,
+		HitRate:     stats.HitRate(),
+	}
+}
+
+// maxBatchSize is the most items a BatchEvaluateHands or BatchCompareHands call takes; larger batches are streamed
+const maxBatchSize = 10000
+
+// BatchEvaluateHands evaluates many hands, reporting an error for each hand that cannot be evaluated
+func (s *pokerServer) BatchEvaluateHands(ctx context.Context, req *pb.BatchEvaluateHandsRequest) (*pb.BatchEvaluateHandsResponse, error) {
+	if len(req.Hands) > maxBatchSize {
+		return nil, fmt.Errorf("at most %d hands per batch, got %d: stream larger batches", maxBatchSize, len(req.Hands))
+	}
+	response := &pb.BatchEvaluateHandsResponse{}
+	for _, hand := range req.Hands {
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
+		s.addEvaluation(ctx, response, hand)
+	}
+	return response, nil
+}
 
-		// Call gRPC service
-		grpcReq := &pb.CompareHandsRequest{
-			Player1HoleCards:      req.Player1HoleCards,
-			Player1CommunityCards: req.Player1CommunityCards,
-			Player2HoleCards:      req.Player2HoleCards,
-			Player2CommunityCards: req.Player2CommunityCards,
+// StreamEvaluateHands evaluates the hands streamed by the client and answers once the stream is closed
+func (s *pokerServer) StreamEvaluateHands(stream pb.PokerEvaluator_StreamEvaluateHandsServer) error {
+	response := &pb.BatchEvaluateHandsResponse{}
+	for {
+		hand, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(response)
 		}
-		resp, err := grpcClient.CompareHands(context.Background(), grpcReq)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
+			return err
 		}
+		s.addEvaluation(stream.Context(), response, hand)
+	}
+}
+
+// addEvaluation evaluates the next hand of a batch and adds its result
+func (s *pokerServer) addEvaluation(ctx context.Context, response *pb.BatchEvaluateHandsResponse, req *pb.EvaluateHandRequest) {
+	result := &pb.EvaluateHandResult{Index: int32(len(response.Results))}
+	hand, err := s.EvaluateHand(ctx, req)
+	if err != nil {
+		result.Error = err.Error()
+		response.Errors++
+	} else {
+		result.Hand = hand
+	}
+	response.Results = append(response.Results, result)
+}
 
-		response := CompareHandsRESTResponse{
-			Player1Hand: EvaluateHandRESTResponse{
-				BestHand:      resp.Player1Hand.BestHand,
-				HandValue:     resp.Player1Hand.HandValue,
-				BestFiveCards: resp.Player1Hand.BestFiveCards,
-			},
-			Player2Hand: EvaluateHandRESTResponse{
-				BestHand:      resp.Player2Hand.BestHand,
-				HandValue:     resp.Player2Hand.HandValue,
-				BestFiveCards: resp.Player2Hand.BestFiveCards,
-			},
-			Winner: resp.Winner,
+// BatchCompareHands compares many pairs of hands, reporting an error for each pair that cannot be compared
+func (s *pokerServer) BatchCompareHands(ctx context.Context, req *pb.BatchCompareHandsRequest) (*pb.BatchCompareHandsResponse, error) {
+	if len(req.Comparisons) > maxBatchSize {
+		return nil, fmt.Errorf("at most %d comparisons per batch, got %d: stream larger batches", maxBatchSize, len(req.Comparisons))
+	}
+	response := &pb.BatchCompareHandsResponse{}
+	for _, comparison := range req.Comparisons {
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
+		s.addComparison(ctx, response, comparison)
+	}
+	return response, nil
+}
 
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(response)
+// StreamCompareHands compares the pairs of hands streamed by the client and answers once the stream is closed
+func (s *pokerServer) StreamCompareHands(stream pb.PokerEvaluator_StreamCompareHandsServer) error {
+	response := &pb.BatchCompareHandsResponse{}
+	for {
+		comparison, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(response)
+		}
+		if err != nil {
+			return err
+		}
+		s.addComparison(stream.Context(), response, comparison)
 	}
 }
 
-func calculateProbabilityHandler(grpcClient pb.PokerEvaluatorClient) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+// addComparison compares the next pair of hands of a batch and adds its result
+func (s *pokerServer) addComparison(ctx context.Context, response *pb.BatchCompareHandsResponse, req *pb.CompareHandsRequest) {
+	result := &pb.CompareHandsResult{Index: int32(len(response.Results))}
+	comparison, err := s.CompareHands(ctx, req)
+	if err != nil {
+		result.Error = err.Error()
+		response.Errors++
+	} else {
+		result.Comparison = comparison
+	}
+	response.Results = append(response.Results, result)
+}
 
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+// parseProbabilityRequest parses and validates the inputs shared by the probability RPCs
+func parseProbabilityRequest(holeCardStrs, communityCardStrs, deadCardStrs []string, numPlayers, numSimulations int32) (holeCards, communityCards, deadCards []poker.Card, err error) {
+	// Parse hole cards
+	holeCards, err = poker.ParseCards(holeCardStrs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid hole cards: %v", err)
+	}
+	if len(holeCards) != 2 {
+		return nil, nil, nil, fmt.Errorf("must provide exactly 2 hole cards")
+	}
 
-		if r.Method != http.MethodPost {
+	// Parse community cards (0, 3, 4, or 5)
+	communityCards, err = poker.ParseCards(communityCardStrs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid community cards: %v", err)
+	}
+	if len(communityCards) != 0 && len(communityCards) != 3 && len(communityCards) != 4 && len(communityCards) != 5 {
+		return nil, nil, nil, fmt.Errorf("must provide 0, 3, 4, or 5 community cards")
+	}
+
+	// Parse dead cards (optional)
+	deadCards, err = poker.ParseCards(deadCardStrs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid dead cards: %v", err)
+	}
+
+	knownCards := make([]poker.Card, 0, len(holeCards)+len(communityCards)+len(deadCards))
+	knownCards = append(knownCards, holeCards...)
+	knownCards = append(knownCards, communityCards...)
+	knownCards = append(knownCards, deadCards...)
+	if err := poker.CheckDuplicateCards(knownCards); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if numPlayers < 2 {
+		return nil, nil, nil, fmt.Errorf("must have at least 2 players")
+	}
+
+	// The rest of the board and every opponent's hole cards must come from the remaining deck
+	cardsNeeded := 5 - len(communityCards) + 2*(int(numPlayers)-1)
+	if cardsNeeded > 52-len(knownCards) {
+		return nil, nil, nil, fmt.Errorf("not enough cards left in the deck for %d players", numPlayers)
+	}
+
+	if numSimulations < 1 {
+		return nil, nil, nil, fmt.Errorf("must run at least 1 simulation")
+	}
+
+	return holeCards, communityCards, deadCards, nil
+}
+
+// probabilityUpdate converts a simulation estimate to its protobuf message
+func probabilityUpdate(estimate poker.ProbabilityEstimate) *pb.ProbabilityUpdate {
+	return &pb.ProbabilityUpdate{
+		Iterations:     int32(estimate.Iterations),
+		WinProbability: estimate.WinProbability,
+		TieProbability: estimate.TieProbability,
+		Equity:         estimate.Equity,
+		WinCiLow:       estimate.WinLow,
+		WinCiHigh:      estimate.WinHigh,
+		EquityCiLow:    estimate.EquityLow,
+		EquityCiHigh:   estimate.EquityHigh,
+		Final:          estimate.Final,
+	}
+}
+
+// streamProbabilityHandler relays StreamWinProbability as Server-Sent Events.
+// It accepts a JSON body on POST, or query parameters on GET so browsers can use EventSource
+// (e.g. ?hole_cards=HA,SA&num_players=2&num_simulations=100000&update_interval=5000).
+func streamProbabilityHandler(grpcClient pb.PokerEvaluatorClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.StreamProbabilityRequest{}
+		switch r.Method {
+		case http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			if err == nil {
+				err = restUnmarshalOptions.Unmarshal(body, req)
+			}
+			if err != nil {
+				http.Error(w, "Invalid request body", http.StatusBadRequest)
+				return
+			}
+		case http.MethodGet:
+			var err error
+			if req, err = streamProbabilityQuery(r.URL.Query()); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		var req ProbabilityRESTRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming not supported", http.StatusInternalServerError)
 			return
 		}
 
 		// Call gRPC service
-		grpcReq := &pb.ProbabilityRequest{
-			HoleCards:      req.HoleCards,
-			CommunityCards: req.CommunityCards,
-			NumPlayers:     req.NumPlayers,
-			NumSimulations: req.NumSimulations,
+		stream, err := grpcClient.StreamWinProbability(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
-		resp, err := grpcClient.CalculateWinProbability(context.Background(), grpcReq)
+
+		// Validation errors arrive with the first message, so report them as a plain HTTP error
+		update, err := stream.Recv()
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		response := ProbabilityRESTResponse{
-			WinProbability: resp.WinProbability,
-			TieProbability: resp.TieProbability,
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+
+		for {
+			event := "update"
+			if update.Final {
+				event = "final"
+			}
+			data, err := marshalJSON(update)
+			if err != nil {
+				fmt.Fprintf(w, "event: error\ndata: %q\n\n", err.Error())
+				flusher.Flush()
+				return
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+			flusher.Flush()
+
+			if update.Final {
+				return
+			}
+			if update, err = stream.Recv(); err != nil {
+				if err != io.EOF && r.Context().Err() == nil {
+					fmt.Fprintf(w, "event: error\ndata: %q\n\n", err.Error())
+					flusher.Flush()
+				}
+				return
+			}
 		}
+	}
+}
+
+// streamProbabilityQuery reads a streamed probability request from URL query parameters
+func streamProbabilityQuery(query url.Values) (*pb.StreamProbabilityRequest, error) {
+	req := &pb.StreamProbabilityRequest{
+		HoleCards:      splitCardList(query.Get("hole_cards")),
+		CommunityCards: splitCardList(query.Get("community_cards")),
+		DeadCards:      splitCardList(query.Get("dead_cards")),
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(response)
+	intParams := map[string]*int32{
+		"num_players":     &req.NumPlayers,
+		"num_simulations": &req.NumSimulations,
+		"update_interval": &req.UpdateInterval,
+	}
+	for name, target := range intParams {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", name, value)
+		}
+		*target = int32(parsed)
 	}
+
+	return req, nil
 }
 
+// splitCardList splits a comma-separated card list such as "HA,SA"
+func splitCardList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,95 @@
+package pushfold
+
+import (
+	"temperature-converter/poker"
+)
+
+// NumClasses is the number of distinct starting hands once suits are ignored
+const NumClasses = 169
+
+// Classes are laid out like a 13x13 chart: row and column run from Ace down to Two,
+// pairs sit on the diagonal, suited hands above it and offsuit hands below it.
+// A class index is row*13 + column.
+
+// chartRank returns the rank of a chart row or column
+func chartRank(i int) poker.Rank {
+	return poker.Ace - poker.Rank(i)
+}
+
+// ClassOf returns the class index of a two-card starting hand
+func ClassOf(c1, c2 poker.Card) int {
+	high, low := c1.Rank, c2.Rank
+	if low > high {
+		high, low = low, high
+	}
+	row, col := int(poker.Ace-high), int(poker.Ace-low)
+	if c1.Suit != c2.Suit {
+		// Offsuit hands sit below the diagonal
+		row, col = col, row
+	}
+	return row*13 + col
+}
+
+// ClassName returns the conventional name of a class, such as "AA", "AKs" or "72o"
+func ClassName(class int) string {
+	row, col := class/13, class%13
+	switch {
+	case row == col:
+		return poker.RankToString(chartRank(row)) + poker.RankToString(chartRank(col))
+	case row < col:
+		return poker.RankToString(chartRank(row)) + poker.RankToString(chartRank(col)) + "s"
+	default:
+		return poker.RankToString(chartRank(col)) + poker.RankToString(chartRank(row)) + "o"
+	}
+}
+
+// ClassCombos returns every two-card combo of a class: 6 for pairs, 4 suited, 12 offsuit
+func ClassCombos(class int) [][]poker.Card {
+	row, col := class/13, class%13
+	high, low := chartRank(row), chartRank(col)
+	if row > col {
+		high, low = low, high
+	}
+
+	var combos [][]poker.Card
+	for s1 := poker.Hearts; s1 <= poker.Spades; s1++ {
+		for s2 := poker.Hearts; s2 <= poker.Spades; s2++ {
+			switch {
+			case row == col && s2 <= s1:
+				continue
+			case row < col && s1 != s2:
+				continue
+			case row > col && s1 == s2:
+				continue
+			}
+			combos = append(combos, []poker.Card{{Suit: s1, Rank: high}, {Suit: s2, Rank: low}})
+		}
+	}
+	return combos
+}
+
+// comboCount returns the number of combos in a class
+func comboCount(class int) int {
+	row, col := class/13, class%13
+	switch {
+	case row == col:
+		return 6
+	case row < col:
+		return 4
+	default:
+		return 12
+	}
+}
+
+// matchupCombos returns how many combo pairs of two classes share no card
+func matchupCombos(class1, class2 int) int {
+	count := 0
+	for _, combo1 := range ClassCombos(class1) {
+		for _, combo2 := range ClassCombos(class2) {
+			if combo1[0] != combo2[0] && combo1[0] != combo2[1] && combo1[1] != combo2[0] && combo1[1] != combo2[1] {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,2542 @@
+// Code generated by go run ./gen -boards 20000 -seed 1; DO NOT EDIT.
+
+package pushfold
+
+// preflopEquity[i][j] is the all-in equity of class i against class j in hundredths of a percent
+var preflopEquity = [NumClasses][NumClasses]uint16{
+	{ // AA
+		5000, 8811, 8777, 8691, 8629, 8873, 8800, 8810, 8842, 8678, 8704, 8722, 8782,
+		9347, 8172, 8294, 8237, 8221, 8297, 8390, 8352, 8377, 8367, 8400, 8449, 8494,
+		9301, 8703, 8175, 8103, 8016, 8155, 8226, 8367, 8342, 8319, 8361, 8421, 8461,
+		9223, 8663, 8505, 8105, 7894, 7967, 8067, 8181, 8328, 8255, 8317, 8347, 8409,
+		9156, 8638, 8423, 8288, 8069, 7728, 7885, 8005, 8150, 8239, 8264, 8290, 8361,
+		9406, 8722, 8564, 8367, 8121, 8090, 7812, 7948, 8066, 8186, 8350, 8375, 8403,
+		9328, 8816, 8628, 8477, 8278, 8204, 8015, 7697, 7923, 7995, 8164, 8345, 8329,
+		9336, 8768, 8778, 8594, 8400, 8338, 8071, 8055, 7745, 7879, 8055, 8204, 8406,
+		9373, 8797, 8751, 8741, 8557, 8462, 8319, 8121, 8033, 7733, 7903, 8071, 8243,
+		9205, 8797, 8744, 8679, 8665, 8606, 8394, 8275, 8112, 8065, 7820, 8011, 8206,
+		9241, 8830, 8786, 8743, 8691, 8773, 8572, 8457, 8296, 8226, 8114, 8079, 8226,
+		9247, 8881, 8845, 8776, 8710, 8794, 8755, 8611, 8475, 8415, 8490, 8157, 8280,
+		9315, 8933, 8894, 8836, 8779, 8827, 8743, 8828, 8659, 8625, 8636, 8701, 8241,
+	},
+	{ // AKs
+		1189, 5000, 7181, 7087, 7047, 7117, 7080, 7101, 7075, 6963, 7032, 7034, 7121,
+		5249, 3341, 7164, 7099, 7085, 7246, 7203, 7233, 7205, 7227, 7299, 7320, 7404,
+		7599, 7577, 4595, 6334, 6299, 6449, 6518, 6626, 6575, 6601, 6691, 6646, 6748,
+		7506, 7522, 6629, 4557, 6203, 6298, 6404, 6491, 6574, 6532, 6591, 6606, 6637,
+		7455, 7496, 6589, 6483, 4582, 6124, 6254, 6342, 6428, 6534, 6548, 6570, 6637,
+		7540, 7686, 6752, 6589, 6416, 4680, 6262, 6240, 6339, 6421, 6587, 6563, 6622,
+		7494, 7631, 6813, 6703, 6536, 6555, 4706, 6104, 6198, 6291, 6422, 6549, 6572,
+		7505, 7658, 6927, 6796, 6631, 6533, 6385, 4700, 6082, 6197, 6306, 6458, 6582,
+		7484, 7620, 6870, 6867, 6725, 6631, 6485, 6362, 4737, 6051, 6201, 6319, 6473,
+		7374, 7665, 6911, 6839, 6842, 6723, 6589, 6486, 6338, 4777, 6080, 6198, 6359,
+		7449, 7737, 7003, 6893, 6853, 6897, 6718, 6598, 6486, 6371, 4805, 6284, 6421,
+		7446, 7765, 6958, 6918, 6881, 6867, 6855, 6759, 6614, 6493, 6582, 4889, 6446,
+		7540, 7846, 7065, 6946, 6944, 6935, 6872, 6890, 6769, 6662, 6713, 6755, 4974,
+	},
+	{ // AQs
+		1223, 2819, 5000, 7019, 7040, 7051, 7033, 7059, 7008, 6904, 6956, 6963, 7046,
+		2933, 3121, 7116, 6131, 6103, 6198, 6291, 6283, 6217, 6271, 6336, 6284, 6371,
+		5245, 7527, 3380, 6957, 6939, 7136, 7105, 7241, 7195, 7197, 7276, 7318, 7396,
+		7435, 6429, 7353, 4559, 6207, 6281, 6435, 6497, 6576, 6530, 6596, 6613, 6651,
+		7452, 6393, 7336, 6484, 4523, 6154, 6280, 6376, 6476, 6562, 6583, 6620, 6650,
+		7475, 6502, 7561, 6576, 6448, 4644, 6185, 6249, 6308, 6409, 6548, 6545, 6607,
+		7441, 6594, 7511, 6729, 6561, 6476, 4667, 6102, 6189, 6294, 6398, 6541, 6578,
+		7465, 6581, 7661, 6800, 6664, 6543, 6381, 4722, 6106, 6205, 6347, 6478, 6628,
+		7416, 6512, 7602, 6870, 6773, 6597, 6473, 6385, 4710, 6019, 6196, 6331, 6480,
+		7315, 6576, 7631, 6835, 6873, 6713, 6592, 6496, 6298, 4767, 6064, 6186, 6349,
+		7375, 6640, 7714, 6901, 6888, 6857, 6692, 6642, 6483, 6356, 4795, 6256, 6394,
+		7365, 6596, 7752, 6925, 6926, 6848, 6842, 6774, 6626, 6474, 6550, 4877, 6421,
+		7462, 6684, 7832, 6962, 6952, 6917, 6877, 6929, 6774, 6650, 6686, 6726, 4956,
+	},
+	{ // AJs
+		1309, 2913, 2981, 5000, 6977, 7017, 7017, 6985, 6968, 6821, 6850, 6895, 6966,
+		3030, 3178, 5917, 7118, 6165, 6271, 6360, 6312, 6288, 6282, 6337, 6338, 6381,
+		3096, 6201, 3191, 7000, 6075, 6208, 6304, 6381, 6349, 6308, 6392, 6403, 6445,
+		5251, 7546, 7402, 3421, 6876, 7036, 7030, 7132, 7224, 7184, 7253, 7297, 7378,
+		7385, 6455, 6356, 7259, 4561, 6194, 6328, 6418, 6507, 6604, 6604, 6639, 6725,
+		7431, 6572, 6506, 7449, 6483, 4685, 6198, 6240, 6388, 6464, 6599, 6589, 6661,
+		7422, 6657, 6591, 7442, 6605, 6481, 4684, 6125, 6269, 6343, 6440, 6591, 6603,
+		7388, 6612, 6678, 7554, 6706, 6531, 6401, 4746, 6147, 6216, 6350, 6516, 6647,
+		7373, 6585, 6638, 7636, 6803, 6678, 6555, 6429, 4797, 6087, 6242, 6364, 6526,
+		7225, 6586, 6613, 7619, 6914, 6769, 6636, 6505, 6369, 4846, 6081, 6209, 6344,
+		7258, 6639, 6695, 7685, 6911, 6908, 6728, 6644, 6531, 6370, 4920, 6276, 6410,
+		7293, 6649, 6709, 7735, 6945, 6895, 6887, 6813, 6664, 6498, 6573, 4972, 6451,
+		7378, 6691, 6752, 7813, 7031, 6971, 6899, 6953, 6822, 6648, 6705, 6754, 5068,
+	},
+	{ // ATs
+		1371, 2953, 2960, 3023, 5000, 6928, 6893, 6890, 6843, 6753, 6722, 6783, 6866,
+		3080, 3223, 5936, 5916, 7106, 6311, 6389, 6350, 6303, 6351, 6357, 6366, 6421,
+		3080, 6221, 3250, 5812, 6972, 6252, 6308, 6413, 6354, 6362, 6385, 6422, 6449,
+		3145, 6210, 6090, 3202, 6849, 6106, 6220, 6281, 6353, 6358, 6336, 6378, 6428,
+		5248, 7527, 7376, 7236, 3452, 6884, 6866, 7003, 7093, 7210, 7217, 7291, 7380,
+		7344, 6616, 6553, 6390, 7294, 4666, 6165, 6295, 6379, 6492, 6609, 6650, 6671,
+		7289, 6691, 6596, 6506, 7258, 6451, 4705, 6126, 6212, 6339, 6445, 6626, 6612,
+		7282, 6648, 6709, 6574, 7405, 6583, 6403, 4727, 6126, 6272, 6355, 6539, 6684,
+		7242, 6599, 6645, 6639, 7503, 6668, 6494, 6406, 4787, 6130, 6238, 6372, 6554,
+		7153, 6661, 6671, 6661, 7648, 6798, 6637, 6566, 6419, 4865, 6100, 6262, 6416,
+		7126, 6660, 6688, 6629, 7654, 6919, 6738, 6650, 6527, 6395, 4943, 6271, 6421,
+		7176, 6683, 6730, 6682, 7728, 6961, 6929, 6835, 6670, 6554, 6567, 4974, 6489,
+		7269, 6736, 6757, 6734, 7809, 6985, 6911, 6992, 6851, 6724, 6712, 6800, 5059,
+	},
+	{ // A9s
+		1127, 2883, 2949, 2983, 3072, 5000, 6673, 6642, 6654, 6489, 6523, 6511, 6635,
+		3008, 3118, 5751, 5722, 5693, 7072, 6245, 6198, 6166, 6157, 6225, 6179, 6265,
+		3067, 6034, 3138, 5622, 5632, 6997, 6183, 6256, 6217, 6191, 6263, 6240, 6316,
+		3110, 6013, 5899, 3099, 5550, 6823, 6093, 6109, 6229, 6194, 6224, 6204, 6279,
+		3195, 5973, 5907, 5814, 3093, 6650, 5915, 6015, 6097, 6191, 6186, 6227, 6267,
+		5248, 7505, 7415, 7227, 7044, 3265, 6730, 6855, 6974, 7075, 7239, 7258, 7359,
+		7065, 6550, 6474, 6386, 6189, 7120, 4501, 6056, 6204, 6304, 6406, 6564, 6607,
+		7028, 6496, 6553, 6405, 6297, 7248, 6332, 4571, 6081, 6185, 6327, 6469, 6643,
+		7040, 6462, 6508, 6518, 6386, 7368, 6487, 6355, 4582, 6072, 6201, 6323, 6513,
+		6875, 6460, 6495, 6493, 6493, 7498, 6603, 6475, 6357, 4696, 6073, 6189, 6348,
+		6921, 6533, 6571, 6521, 6488, 7676, 6705, 6621, 6491, 6368, 4801, 6264, 6400,
+		6892, 6491, 6545, 6510, 6529, 7691, 6871, 6767, 6621, 6480, 6565, 4859, 6425,
+		7025, 6579, 6622, 6580, 6563, 7791, 6909, 6952, 6808, 6651, 6697, 6734, 4908,
+	},
+	{ // A8s
+		1200, 2920, 2967, 2983, 3107, 3327, 5000, 6476, 6411, 6313, 6300, 6346, 6405,
+		3048, 3134, 5706, 5699, 5676, 5824, 7191, 6260, 6180, 6198, 6224, 6240, 6268,
+		3094, 5987, 3206, 5611, 5629, 5806, 7096, 6290, 6223, 6220, 6241, 6280, 6311,
+		3113, 5986, 5886, 3166, 5546, 5692, 6979, 6178, 6244, 6226, 6213, 6267, 6266,
+		3243, 5954, 5902, 5809, 3149, 5555, 6823, 6078, 6108, 6236, 6191, 6289, 6287,
+		3474, 6118, 6097, 5972, 5834, 3158, 6728, 5966, 6055, 6168, 6270, 6299, 6333,
+		5246, 7627, 7510, 7394, 7214, 7117, 3357, 6720, 6839, 6954, 7103, 7279, 7323,
+		6845, 6559, 6587, 6471, 6360, 6249, 7100, 4578, 6117, 6239, 6364, 6523, 6687,
+		6780, 6472, 6508, 6529, 6392, 6340, 7229, 6391, 4644, 6074, 6218, 6363, 6509,
+		6683, 6505, 6526, 6524, 6543, 6465, 7367, 6531, 6359, 4721, 6064, 6233, 6356,
+		6676, 6527, 6544, 6506, 6489, 6574, 7518, 6657, 6505, 6358, 4850, 6288, 6391,
+		6709, 6556, 6586, 6570, 6591, 6603, 7707, 6820, 6663, 6529, 6586, 4926, 6457,
+		6779, 6579, 6620, 6567, 6584, 6639, 7748, 6996, 6810, 6667, 6687, 6769, 4973,
+	},
+	{ // A7s
+		1190, 2899, 2941, 3015, 3110, 3358, 3524, 5000, 6135, 6001, 6001, 6008, 6098,
+		3035, 3163, 5713, 5707, 5679, 5820, 5928, 7176, 6208, 6216, 6227, 6232, 6284,
+		3073, 5993, 3168, 5531, 5548, 5721, 5815, 7206, 6231, 6200, 6253, 6256, 6308,
+		3148, 5995, 5801, 3165, 5485, 5613, 5776, 7030, 6218, 6192, 6198, 6240, 6275,
+		3247, 5954, 5818, 5747, 3144, 5497, 5634, 6906, 6109, 6231, 6177, 6245, 6296,
+		3509, 6112, 6007, 5889, 5777, 3171, 5582, 6796, 6049, 6143, 6272, 6260, 6329,
+		3681, 6220, 6091, 6055, 5899, 5849, 3208, 6617, 5929, 6020, 6121, 6250, 6309,
+		5244, 7603, 7627, 7448, 7304, 7188, 6991, 3343, 6715, 6829, 6993, 7165, 7369,
+		6487, 6498, 6516, 6501, 6397, 6332, 6200, 7090, 4542, 6068, 6210, 6380, 6529,
+		6355, 6520, 6504, 6486, 6533, 6437, 6309, 7229, 6349, 4658, 6059, 6217, 6359,
+		6356, 6528, 6556, 6490, 6474, 6575, 6408, 7401, 6497, 6351, 4753, 6273, 6424,
+		6353, 6542, 6559, 6540, 6544, 6557, 6541, 7589, 6675, 6508, 6570, 4817, 6484,
+		6454, 6594, 6611, 6577, 6593, 6634, 6601, 7795, 6827, 6665, 6723, 6795, 4958,
+	},
+	{ // A6s
+		1158, 2925, 2992, 3032, 3157, 3346, 3589, 3865, 5000, 5692, 5684, 5697, 5791,
+		3060, 3136, 5694, 5652, 5620, 5774, 5895, 5896, 7176, 6219, 6237, 6224, 6268,
+		3127, 5976, 3168, 5529, 5543, 5714, 5821, 5943, 7205, 6219, 6260, 6280, 6306,
+		3169, 5939, 5799, 3118, 5404, 5558, 5723, 5768, 7157, 6184, 6189, 6211, 6235,
+		3297, 5896, 5815, 5662, 3130, 5434, 5576, 5687, 7019, 6195, 6161, 6209, 6261,
+		3499, 6065, 6000, 5831, 5710, 3156, 5539, 5612, 6924, 6138, 6235, 6225, 6276,
+		3756, 6188, 6097, 6000, 5842, 5810, 3184, 5525, 6775, 6022, 6107, 6258, 6270,
+		4048, 6183, 6228, 6054, 5960, 5886, 5787, 3175, 6691, 5924, 6029, 6200, 6329,
+		5246, 7600, 7620, 7569, 7429, 7324, 7165, 7071, 3359, 6698, 6883, 7039, 7244,
+		6014, 6527, 6522, 6477, 6495, 6432, 6312, 6204, 7086, 4617, 6066, 6218, 6384,
+		6012, 6539, 6563, 6479, 6461, 6537, 6395, 6317, 7281, 6353, 4701, 6277, 6417,
+		6010, 6533, 6579, 6510, 6504, 6520, 6552, 6489, 7451, 6505, 6572, 4783, 6441,
+		6118, 6579, 6609, 6530, 6551, 6572, 6561, 6624, 7660, 6686, 6710, 6741, 4893,
+	},
+	{ // A5s
+		1322, 3037, 3096, 3179, 3247, 3511, 3687, 3999, 4308, 5000, 5518, 5530, 5575,
+		3170, 3284, 5775, 5716, 5664, 5798, 5910, 5925, 5882, 7201, 6366, 6334, 6389,
+		3228, 6056, 3323, 5593, 5575, 5752, 5845, 5984, 5923, 7223, 6396, 6374, 6413,
+		3320, 6003, 5865, 3302, 5473, 5605, 5773, 5833, 5901, 7160, 6321, 6311, 6335,
+		3388, 5941, 5849, 5731, 3255, 5424, 5575, 5700, 5753, 7156, 6256, 6290, 6330,
+		3669, 6088, 6041, 5881, 5702, 3321, 5546, 5624, 5722, 7048, 6331, 6316, 6360,
+		3855, 6204, 6125, 6053, 5842, 5821, 3355, 5542, 5624, 6900, 6190, 6324, 6333,
+		4185, 6214, 6271, 6121, 5975, 5901, 5811, 3342, 5572, 6818, 6162, 6294, 6415,
+		4524, 6167, 6204, 6177, 6032, 5998, 5896, 5835, 3365, 6648, 6040, 6169, 6322,
+		5253, 7640, 7660, 7589, 7586, 7468, 7304, 7213, 7026, 3604, 6837, 7012, 7202,
+		5824, 6669, 6701, 6616, 6552, 6635, 6479, 6447, 6319, 7241, 4790, 6404, 6532,
+		5824, 6647, 6677, 6611, 6586, 6614, 6621, 6584, 6458, 7417, 6701, 4879, 6587,
+		5876, 6701, 6719, 6635, 6623, 6661, 6625, 6717, 6611, 7623, 6825, 6895, 5013,
+	},
+	{ // A4s
+		1296, 2968, 3044, 3150, 3278, 3477, 3700, 3999, 4316, 4482, 5000, 5258, 5334,
+		3095, 3250, 5766, 5709, 5671, 5781, 5880, 5911, 5872, 5985, 7223, 6333, 6397,
+		3167, 6048, 3286, 5594, 5582, 5718, 5811, 5949, 5909, 6001, 7240, 6367, 6419,
+		3291, 5996, 5866, 3269, 5471, 5569, 5721, 5812, 5868, 5956, 7168, 6312, 6355,
+		3422, 5947, 5853, 5731, 3267, 5430, 5574, 5707, 5767, 5962, 7136, 6291, 6350,
+		3630, 6072, 6002, 5844, 5707, 3264, 5456, 5547, 5665, 5834, 7173, 6289, 6339,
+		3867, 6171, 6085, 5998, 5836, 5724, 3344, 5481, 5559, 5746, 6989, 6282, 6318,
+		4187, 6196, 6233, 6096, 5977, 5819, 5741, 3326, 5521, 5703, 6936, 6267, 6425,
+		4527, 6156, 6184, 6142, 6046, 5937, 5823, 5778, 3338, 5601, 6801, 6158, 6322,
+		4711, 6283, 6296, 6243, 6253, 6116, 6029, 5978, 5868, 3351, 6738, 6017, 6161,
+		5255, 7663, 7679, 7597, 7569, 7605, 7400, 7340, 7187, 7141, 3641, 7053, 7243,
+		5530, 6644, 6666, 6614, 6588, 6586, 6574, 6557, 6441, 6299, 7467, 4829, 6619,
+		5614, 6709, 6726, 6656, 6645, 6640, 6609, 6724, 6608, 6457, 7658, 6927, 4925,
+	},
+	{ // A3s
+		1278, 2966, 3037, 3105, 3217, 3489, 3654, 3992, 4303, 4470, 4742, 5000, 5313,
+		3096, 3238, 5746, 5693, 5644, 5767, 5833, 5867, 5830, 5960, 6042, 7214, 6394,
+		3174, 6031, 3262, 5576, 5565, 5725, 5779, 5907, 5886, 5978, 6058, 7249, 6411,
+		3246, 5985, 5852, 3260, 5444, 5554, 5694, 5766, 5844, 5934, 5970, 7172, 6344,
+		3361, 5924, 5839, 5707, 3254, 5423, 5517, 5664, 5732, 5939, 5923, 7160, 6339,
+		3654, 6066, 6018, 5834, 5707, 3287, 5451, 5552, 5668, 5852, 5991, 7172, 6356,
+		3830, 6129, 6057, 5976, 5783, 5726, 3310, 5390, 5483, 5684, 5781, 7123, 6280,
+		4187, 6162, 6194, 6056, 5937, 5832, 5656, 3330, 5470, 5655, 5789, 7052, 6376,
+		4528, 6118, 6164, 6121, 6009, 5946, 5748, 5733, 3339, 5570, 5730, 6907, 6288,
+		4709, 6262, 6275, 6226, 6232, 6142, 5967, 5933, 5838, 3327, 5568, 6901, 6141,
+		5004, 6339, 6357, 6260, 6215, 6289, 6061, 6073, 6006, 5847, 3379, 6983, 6246,
+		5249, 7661, 7684, 7606, 7591, 7602, 7539, 7472, 7307, 7304, 7400, 3710, 7277,
+		5600, 6711, 6723, 6648, 6636, 6667, 6576, 6680, 6580, 6443, 6541, 7708, 4911,
+	},
+	{ // A2s
+		1218, 2879, 2954, 3034, 3134, 3365, 3595, 3902, 4209, 4425, 4666, 4687, 5000,
+		3002, 3183, 5748, 5695, 5664, 5759, 5862, 5808, 5803, 5923, 5991, 5979, 7248,
+		3076, 6029, 3217, 5570, 5581, 5698, 5784, 5859, 5843, 5932, 6004, 6016, 7263,
+		3163, 5982, 5840, 3208, 5462, 5558, 5699, 5732, 5808, 5894, 5929, 5959, 7194,
+		3271, 5939, 5849, 5720, 3190, 5418, 5547, 5634, 5713, 5908, 5888, 5960, 7202,
+		3517, 6050, 5981, 5830, 5693, 3248, 5457, 5497, 5615, 5817, 5945, 5933, 7183,
+		3761, 6151, 6057, 5975, 5808, 5725, 3287, 5400, 5485, 5688, 5787, 5929, 7141,
+		4087, 6093, 6139, 6014, 5903, 5769, 5660, 3254, 5361, 5559, 5686, 5842, 7186,
+		4421, 6083, 6113, 6078, 5986, 5881, 5747, 5616, 3267, 5474, 5625, 5747, 7043,
+		4659, 6221, 6225, 6182, 6194, 6097, 5968, 5830, 5736, 3308, 5507, 5647, 7010,
+		4922, 6281, 6294, 6213, 6171, 6235, 6061, 5963, 5893, 5781, 3361, 5726, 7086,
+		4933, 6284, 6309, 6252, 6246, 6222, 6213, 6121, 6020, 5923, 6006, 3422, 7133,
+		5249, 7692, 7699, 7623, 7619, 7610, 7555, 7604, 7447, 7427, 7498, 7558, 3730,
+	},
+	{ // AKo
+		653, 4751, 7067, 6970, 6920, 6992, 6952, 6965, 6940, 6830, 6905, 6904, 6998,
+		5000, 2934, 7061, 6995, 6976, 7151, 7104, 7130, 7093, 7133, 7208, 7232, 7317,
+		7498, 7496, 4312, 6087, 6051, 6200, 6271, 6384, 6333, 6360, 6457, 6413, 6518,
+		7399, 7436, 6469, 4278, 5946, 6043, 6154, 6248, 6326, 6289, 6347, 6368, 6398,
+		7339, 7405, 6424, 6305, 4307, 5865, 5998, 6088, 6172, 6285, 6303, 6326, 6397,
+		7428, 7609, 6594, 6418, 6237, 4405, 6005, 5980, 6079, 6168, 6344, 6315, 6381,
+		7376, 7549, 6653, 6538, 6360, 6379, 4431, 5841, 5935, 6034, 6171, 6307, 6325,
+		7383, 7575, 6775, 6643, 6461, 6352, 6198, 4426, 5816, 5939, 6048, 6210, 6336,
+		7359, 7529, 6716, 6709, 6552, 6453, 6302, 6172, 4470, 5784, 5934, 6057, 6221,
+		7251, 7587, 6761, 6684, 6681, 6554, 6414, 6309, 6144, 4501, 5816, 5948, 6112,
+		7329, 7660, 6863, 6738, 6697, 6744, 6552, 6420, 6295, 6186, 4538, 6031, 6170,
+		7326, 7694, 6815, 6771, 6726, 6709, 6700, 6598, 6434, 6326, 6410, 4624, 6198,
+		7423, 7774, 6929, 6796, 6789, 6782, 6710, 6732, 6601, 6499, 6547, 6592, 4714,
+	},
+	{ // KK
+		1828, 6659, 6879, 6822, 6777, 6882, 6866, 6837, 6864, 6716, 6750, 6762, 6817,
+		7066, 5000, 8629, 8585, 8540, 8718, 8825, 8790, 8813, 8786, 8811, 8860, 8912,
+		7239, 9143, 8234, 8264, 8174, 8259, 8277, 8395, 8372, 8326, 8361, 8427, 8473,
+		7193, 9116, 8680, 8162, 8044, 8071, 8124, 8224, 8349, 8278, 8334, 8354, 8436,
+		7141, 9068, 8585, 8451, 8100, 7849, 7928, 8035, 8168, 8252, 8270, 8295, 8370,
+		7247, 9254, 8677, 8478, 8256, 8080, 7839, 7902, 8034, 8149, 8299, 8316, 8364,
+		7233, 9365, 8682, 8537, 8326, 8230, 8060, 7747, 7943, 8007, 8164, 8346, 8355,
+		7187, 9327, 8810, 8643, 8441, 8289, 8125, 8045, 7752, 7856, 8012, 8187, 8383,
+		7224, 9344, 8780, 8763, 8584, 8433, 8345, 8134, 8029, 7698, 7901, 8044, 8251,
+		7072, 9330, 8752, 8706, 8683, 8561, 8421, 8252, 8085, 8046, 7681, 7875, 8064,
+		7112, 9363, 8792, 8767, 8701, 8726, 8577, 8418, 8296, 8078, 8109, 7928, 8073,
+		7116, 9409, 8856, 8793, 8725, 8740, 8768, 8592, 8454, 8278, 8336, 8123, 8150,
+		7182, 9458, 8912, 8873, 8798, 8788, 8770, 8809, 8663, 8478, 8484, 8571, 8211,
+	},
+	{ // KQs
+		1706, 2836, 2884, 4083, 4064, 4249, 4294, 4287, 4306, 4225, 4234, 4254, 4252,
+		2939, 1371, 5000, 7030, 7059, 7039, 7054, 7062, 7029, 7053, 7088, 7089, 7185,
+		2989, 5247, 3540, 7061, 7019, 7095, 7224, 7219, 7186, 7195, 7261, 7263, 7356,
+		4350, 7453, 7468, 4624, 6307, 6381, 6500, 6561, 6665, 6605, 6683, 6681, 6732,
+		4336, 7471, 7420, 6589, 4618, 6227, 6378, 6447, 6571, 6638, 6658, 6658, 6715,
+		4521, 7469, 7516, 6677, 6517, 4720, 6254, 6309, 6391, 6468, 6603, 6586, 6658,
+		4572, 7485, 7647, 6800, 6662, 6550, 4854, 6258, 6290, 6366, 6482, 6583, 6630,
+		4560, 7480, 7633, 6860, 6737, 6598, 6544, 4847, 6144, 6257, 6362, 6483, 6646,
+		4581, 7437, 7593, 6965, 6870, 6681, 6582, 6424, 4866, 6115, 6243, 6376, 6507,
+		4503, 7480, 7624, 6912, 6950, 6772, 6667, 6547, 6400, 4878, 6110, 6211, 6409,
+		4513, 7525, 7699, 6988, 6970, 6913, 6785, 6654, 6534, 6402, 4919, 6276, 6449,
+		4528, 7519, 7696, 6994, 6969, 6890, 6894, 6779, 6671, 6505, 6578, 5019, 6438,
+		4529, 7617, 7795, 7045, 7021, 6971, 6933, 6950, 6805, 6711, 6746, 6745, 5078,
+	},
+	{ // KJs
+		1763, 2901, 3869, 2882, 4084, 4278, 4301, 4293, 4348, 4284, 4291, 4307, 4305,
+		3005, 1415, 2970, 5000, 6986, 6997, 7013, 6975, 6977, 6936, 6987, 7033, 7111,
+		4129, 3074, 3232, 7092, 6160, 6294, 6369, 6482, 6453, 6422, 6506, 6492, 6545,
+		2981, 5256, 7499, 3544, 6934, 6974, 7122, 7090, 7198, 7144, 7240, 7247, 7344,
+		4354, 7395, 6442, 7326, 4640, 6272, 6420, 6464, 6584, 6637, 6690, 6692, 6791,
+		4552, 7418, 6592, 7380, 6560, 4728, 6265, 6309, 6444, 6455, 6644, 6637, 6693,
+		4577, 7431, 6659, 7548, 6705, 6549, 4839, 6185, 6335, 6359, 6488, 6607, 6621,
+		4567, 7387, 6771, 7506, 6757, 6599, 6462, 4854, 6166, 6251, 6353, 6503, 6654,
+		4630, 7381, 6745, 7609, 6882, 6733, 6628, 6449, 4924, 6138, 6281, 6412, 6555,
+		4567, 7355, 6726, 7575, 6952, 6761, 6657, 6539, 6425, 4993, 6123, 6224, 6387,
+		4575, 7414, 6809, 7673, 7002, 6954, 6785, 6644, 6571, 6414, 5020, 6303, 6477,
+		4584, 7459, 6796, 7688, 7002, 6942, 6913, 6801, 6712, 6519, 6604, 5074, 6492,
+		4589, 7536, 6852, 7781, 7102, 7004, 6923, 6955, 6853, 6692, 6776, 6800, 5151,
+	},
+	{ // KTs
+		1779, 2915, 3897, 3835, 2894, 4307, 4324, 4321, 4380, 4336, 4329, 4356, 4336,
+		3024, 1460, 2941, 3014, 5000, 6902, 6909, 6901, 6874, 6882, 6874, 6914, 7010,
+		4161, 3053, 3284, 5887, 7056, 6326, 6393, 6516, 6468, 6476, 6497, 6502, 6543,
+		4097, 3136, 6163, 3232, 6920, 6191, 6291, 6352, 6458, 6399, 6429, 6453, 6512,
+		2995, 5253, 7459, 7314, 3594, 6804, 6973, 6971, 7081, 7180, 7202, 7229, 7336,
+		4581, 7321, 6628, 6477, 7208, 4745, 6265, 6360, 6455, 6530, 6671, 6658, 6709,
+		4600, 7319, 6681, 6583, 7378, 6552, 4845, 6174, 6292, 6382, 6499, 6629, 6648,
+		4593, 7304, 6808, 6645, 7374, 6643, 6450, 4850, 6171, 6306, 6387, 6528, 6703,
+		4660, 7267, 6757, 6745, 7492, 6742, 6578, 6450, 4924, 6204, 6290, 6437, 6580,
+		4620, 7300, 6783, 6703, 7616, 6834, 6683, 6596, 6493, 5006, 6153, 6286, 6468,
+		4614, 7292, 6802, 6729, 7640, 6984, 6798, 6678, 6580, 6449, 5050, 6306, 6452,
+		4637, 7332, 6808, 6762, 7667, 6964, 6940, 6825, 6735, 6586, 6611, 5116, 6522,
+		4624, 7426, 6854, 6819, 7766, 7022, 6948, 7010, 6877, 6777, 6747, 6836, 5168,
+	},
+	{ // K9s
+		1703, 2754, 3802, 3729, 3689, 2928, 4176, 4180, 4226, 4202, 4219, 4233, 4241,
+		2849, 1282, 2961, 3003, 3098, 5000, 6720, 6693, 6695, 6650, 6690, 6689, 6797,
+		4064, 3068, 3210, 5720, 5706, 7106, 6290, 6402, 6355, 6339, 6402, 6370, 6443,
+		3991, 3124, 6000, 3160, 5635, 6930, 6185, 6243, 6375, 6254, 6332, 6329, 6378,
+		3944, 3217, 5982, 5899, 3204, 6726, 6028, 6115, 6223, 6275, 6303, 6292, 6363,
+		3033, 5254, 7525, 7333, 7122, 3470, 6844, 6836, 6954, 7051, 7215, 7214, 7310,
+		4449, 7119, 6583, 6480, 6306, 7239, 4708, 6119, 6287, 6340, 6457, 6628, 6629,
+		4448, 7085, 6692, 6541, 6399, 7224, 6394, 4706, 6128, 6247, 6370, 6504, 6665,
+		4500, 7079, 6646, 6665, 6511, 7346, 6572, 6400, 4768, 6138, 6243, 6401, 6549,
+		4479, 7048, 6641, 6553, 6579, 7464, 6643, 6536, 6423, 4889, 6139, 6233, 6404,
+		4503, 7100, 6710, 6634, 6608, 7652, 6759, 6661, 6529, 6434, 4968, 6306, 6460,
+		4513, 7092, 6671, 6634, 6592, 7647, 6940, 6803, 6700, 6531, 6613, 5022, 6461,
+		4523, 7201, 6754, 6683, 6660, 7739, 6933, 6973, 6846, 6707, 6760, 6771, 5081,
+	},
+	{ // K8s
+		1610, 2797, 3709, 3640, 3611, 3755, 2809, 4072, 4105, 4090, 4120, 4167, 4138,
+		2896, 1175, 2946, 2987, 3091, 3280, 5000, 6461, 6413, 6415, 6419, 6452, 6511,
+		3968, 3060, 3187, 5608, 5611, 5786, 7085, 6328, 6268, 6265, 6297, 6289, 6320,
+		3899, 3114, 5879, 3141, 5529, 5692, 6951, 6191, 6286, 6208, 6231, 6261, 6258,
+		3864, 3222, 5878, 5788, 3154, 5524, 6779, 6065, 6131, 6215, 6201, 6249, 6273,
+		4016, 3426, 6075, 5965, 5795, 3187, 6689, 5964, 6065, 6104, 6242, 6267, 6280,
+		2905, 5252, 7495, 7365, 7168, 7072, 3428, 6696, 6819, 6920, 7079, 7212, 7267,
+		4333, 6837, 6616, 6479, 6344, 6241, 7067, 4612, 6122, 6260, 6365, 6500, 6660,
+		4375, 6774, 6552, 6571, 6414, 6347, 7206, 6396, 4695, 6104, 6244, 6388, 6506,
+		4364, 6797, 6563, 6503, 6518, 6398, 7330, 6546, 6384, 4788, 6077, 6227, 6369,
+		4395, 6808, 6597, 6524, 6497, 6546, 7493, 6653, 6530, 6366, 4878, 6289, 6403,
+		4444, 6839, 6591, 6563, 6549, 6569, 7646, 6800, 6689, 6526, 6593, 4976, 6431,
+		4418, 6895, 6627, 6556, 6565, 6582, 7688, 6965, 6804, 6674, 6703, 6746, 5019,
+	},
+	{ // K7s
+		1648, 2767, 3717, 3688, 3650, 3802, 3740, 2824, 4104, 4075, 4089, 4133, 4192,
+		2870, 1210, 2938, 3025, 3099, 3307, 3539, 5000, 6139, 6139, 6133, 6136, 6231,
+		3978, 3065, 3165, 5546, 5554, 5722, 5838, 7228, 6276, 6280, 6310, 6288, 6349,
+		3948, 3160, 5816, 3159, 5491, 5645, 5778, 7035, 6279, 6225, 6229, 6252, 6298,
+		3905, 3233, 5821, 5752, 3180, 5491, 5660, 6885, 6158, 6256, 6222, 6236, 6317,
+		4064, 3456, 6005, 5919, 5763, 3211, 5596, 6760, 6066, 6127, 6269, 6240, 6308,
+		3995, 3702, 6118, 6060, 5926, 5864, 3297, 6616, 5974, 6061, 6160, 6268, 6321,
+		2919, 5250, 7643, 7454, 7285, 7147, 6988, 3452, 6670, 6799, 6953, 7094, 7308,
+		4375, 6487, 6563, 6566, 6445, 6345, 6251, 7042, 4671, 6089, 6251, 6388, 6545,
+		4347, 6504, 6583, 6522, 6556, 6423, 6350, 7196, 6376, 4736, 6090, 6241, 6408,
+		4368, 6502, 6612, 6524, 6516, 6570, 6452, 7358, 6537, 6383, 4843, 6275, 6441,
+		4405, 6503, 6589, 6556, 6534, 6536, 6570, 7515, 6683, 6539, 6577, 4924, 6466,
+		4473, 6596, 6657, 6598, 6613, 6612, 6612, 7732, 6842, 6715, 6742, 6780, 5041,
+	},
+	{ // K6s
+		1623, 2795, 3783, 3712, 3697, 3834, 3820, 3792, 2824, 4118, 4128, 4170, 4197,
+		2907, 1187, 2971, 3023, 3126, 3305, 3587, 3861, 5000, 5829, 5820, 5848, 5889,
+		4048, 3103, 3198, 5542, 5546, 5719, 5853, 5999, 7220, 6314, 6330, 6334, 6343,
+		3974, 3164, 5813, 3137, 5411, 5589, 5734, 5803, 7167, 6221, 6227, 6261, 6262,
+		3959, 3270, 5815, 5667, 3188, 5435, 5609, 5710, 7003, 6228, 6204, 6243, 6270,
+		4100, 3457, 6004, 5858, 5706, 3226, 5568, 5644, 6891, 6130, 6239, 6247, 6267,
+		4087, 3762, 6132, 6012, 5876, 5839, 3292, 5563, 6778, 6071, 6167, 6310, 6296,
+		4053, 4047, 6275, 6086, 5981, 5913, 5820, 3292, 6646, 5955, 6066, 6207, 6335,
+		2926, 5246, 7634, 7580, 7415, 7288, 7169, 7022, 3495, 6678, 6869, 6999, 7197,
+		4390, 6166, 6614, 6515, 6530, 6422, 6359, 6232, 7065, 4723, 6122, 6268, 6420,
+		4408, 6165, 6634, 6519, 6505, 6539, 6460, 6348, 7265, 6409, 4828, 6325, 6457,
+		4448, 6188, 6631, 6559, 6539, 6541, 6612, 6492, 7411, 6561, 6626, 4893, 6447,
+		4480, 6229, 6648, 6555, 6562, 6565, 6587, 6627, 7609, 6725, 6753, 6749, 5020,
+	},
+	{ // K5s
+		1633, 2773, 3729, 3718, 3649, 3843, 3802, 3784, 3781, 2799, 4015, 4040, 4077,
+		2867, 1214, 2947, 3064, 3118, 3350, 3585, 3861, 4171, 5000, 5533, 5546, 5591,
+		3992, 3068, 3211, 5576, 5537, 5725, 5824, 5997, 5956, 7224, 6377, 6329, 6387,
+		3981, 3197, 5848, 3191, 5432, 5589, 5738, 5834, 5946, 7134, 6299, 6259, 6301,
+		3909, 3249, 5807, 5690, 3191, 5376, 5548, 5666, 5773, 7106, 6223, 6217, 6279,
+		4110, 3500, 6011, 5859, 5649, 3269, 5514, 5611, 5728, 6973, 6268, 6227, 6284,
+		4065, 3752, 6107, 6019, 5817, 5788, 3349, 5541, 5655, 6861, 6162, 6271, 6289,
+		4043, 4043, 6276, 6118, 5940, 5883, 5804, 3327, 5557, 6762, 6088, 6205, 6348,
+		4039, 4370, 6239, 6224, 6051, 6001, 5927, 5820, 3366, 6605, 5983, 6109, 6238,
+		2897, 5255, 7658, 7563, 7534, 7386, 7269, 7152, 6986, 3566, 6695, 6835, 7046,
+		4283, 5843, 6681, 6591, 6519, 6572, 6452, 6367, 6261, 7090, 4782, 6311, 6461,
+		4306, 5857, 6631, 6560, 6516, 6525, 6575, 6495, 6399, 7239, 6609, 4884, 6477,
+		4351, 5900, 6695, 6598, 6571, 6586, 6579, 6646, 6528, 7460, 6753, 6786, 4984,
+	},
+	{ // K4s
+		1600, 2701, 3664, 3663, 3643, 3775, 3776, 3773, 3763, 3634, 2777, 3958, 4009,
+		2792, 1189, 2912, 3013, 3126, 3310, 3581, 3867, 4180, 4467, 5000, 5270, 5345,
+		3920, 3023, 3177, 5576, 5539, 5682, 5797, 5966, 5938, 5982, 7240, 6321, 6395,
+		3927, 3143, 5847, 3160, 5442, 5551, 5691, 5801, 5908, 5879, 7150, 6259, 6319,
+		3904, 3259, 5808, 5703, 3188, 5384, 5544, 5679, 5784, 5887, 7093, 6221, 6281,
+		4041, 3455, 5965, 5822, 5655, 3208, 5419, 5537, 5656, 5725, 7096, 6199, 6264,
+		4039, 3743, 6077, 5971, 5809, 5690, 3325, 5468, 5586, 5685, 6953, 6222, 6263,
+		4036, 4050, 6242, 6082, 5948, 5805, 5724, 3321, 5501, 5647, 6863, 6170, 6345,
+		4024, 4377, 6215, 6183, 6063, 5926, 5856, 5759, 3349, 5542, 6757, 6100, 6240,
+		3891, 4706, 6274, 6167, 6180, 6009, 5967, 5918, 5813, 3402, 6586, 5942, 6107,
+		2875, 5258, 7677, 7579, 7523, 7525, 7366, 7260, 7141, 6978, 3584, 6865, 7074,
+		4221, 5556, 6620, 6561, 6516, 6495, 6525, 6456, 6381, 6226, 7275, 4818, 6482,
+		4283, 5631, 6700, 6619, 6574, 6565, 6555, 6641, 6527, 6400, 7484, 6789, 4898,
+	},
+	{ // K3s
+		1551, 2680, 3716, 3662, 3634, 3821, 3760, 3768, 3776, 3666, 3667, 2786, 4021,
+		2768, 1140, 2911, 2967, 3086, 3311, 3548, 3864, 4152, 4454, 4730, 5000, 5320,
+		3971, 3032, 3138, 5552, 5513, 5679, 5754, 5929, 5902, 5945, 6025, 7241, 6366,
+		3915, 3097, 5824, 3127, 5407, 5541, 5651, 5758, 5878, 5833, 5903, 7152, 6286,
+		3887, 3216, 5782, 5664, 3166, 5353, 5486, 5635, 5748, 5851, 5870, 7110, 6277,
+		4084, 3465, 5967, 5812, 5622, 3213, 5418, 5542, 5657, 5722, 5891, 7095, 6253,
+		4017, 3716, 6033, 5932, 5750, 5691, 3275, 5389, 5513, 5616, 5735, 7086, 6211,
+		4023, 4051, 6204, 6041, 5902, 5812, 5647, 3291, 5448, 5595, 5707, 6986, 6285,
+		4035, 4358, 6179, 6153, 6021, 5927, 5780, 5707, 3332, 5502, 5646, 6873, 6174,
+		3915, 4696, 6236, 6122, 6142, 6008, 5893, 5865, 5770, 3374, 5527, 6731, 6072,
+		3921, 4995, 6320, 6191, 6159, 6185, 6017, 5980, 5915, 5800, 3404, 6822, 6164,
+		2878, 5256, 7669, 7586, 7535, 7518, 7508, 7396, 7268, 7128, 7229, 3649, 7087,
+		4289, 5610, 6675, 6583, 6571, 6554, 6502, 6581, 6462, 6367, 6455, 7507, 4917,
+	},
+	{ // K2s
+		1506, 2596, 3629, 3619, 3579, 3735, 3732, 3716, 3732, 3611, 3603, 3606, 2752,
+		2683, 1088, 2815, 2889, 2990, 3203, 3489, 3769, 4111, 4409, 4655, 4680, 5000,
+		3880, 2928, 3099, 5545, 5517, 5664, 5760, 5867, 5861, 5904, 5974, 5949, 7258,
+		3873, 3014, 5812, 3079, 5415, 5545, 5658, 5711, 5846, 5805, 5882, 5896, 7175,
+		3830, 3123, 5783, 5671, 3109, 5365, 5516, 5596, 5724, 5823, 5827, 5867, 7149,
+		3993, 3350, 5946, 5812, 5632, 3176, 5431, 5483, 5622, 5691, 5858, 5829, 7120,
+		3992, 3658, 6037, 5936, 5778, 5701, 3256, 5392, 5522, 5631, 5749, 5859, 7106,
+		3971, 3952, 6138, 5988, 5862, 5749, 5648, 3227, 5365, 5498, 5599, 5722, 7117,
+		3990, 4314, 6135, 6119, 5996, 5889, 5788, 5621, 3293, 5435, 5573, 5696, 7001,
+		3867, 4651, 6192, 6089, 6109, 5971, 5907, 5765, 5700, 3342, 5461, 5575, 6852,
+		3862, 4918, 6265, 6167, 6111, 6147, 6029, 5867, 5840, 5732, 3386, 5644, 6936,
+		3861, 4943, 6240, 6187, 6156, 6114, 6153, 6001, 5970, 5855, 5927, 3465, 6948,
+		2848, 5253, 7692, 7602, 7566, 7542, 7518, 7531, 7400, 7261, 7345, 7367, 3667,
+	},
+	{ // AQo
+		699, 2401, 4755, 6904, 6920, 6933, 6906, 6927, 6873, 6772, 6833, 6826, 6924,
+		2502, 2761, 7011, 5871, 5839, 5936, 6032, 6022, 5952, 6008, 6080, 6029, 6120,
+		5000, 7418, 2981, 6843, 6821, 7033, 6996, 7142, 7084, 7105, 7190, 7228, 7311,
+		7326, 6250, 7259, 4281, 5956, 6038, 6190, 6254, 6329, 6287, 6356, 6375, 6418,
+		7342, 6207, 7237, 6316, 4248, 5902, 6027, 6127, 6225, 6320, 6344, 6382, 6411,
+		7364, 6322, 7478, 6416, 6279, 4366, 5931, 5994, 6054, 6162, 6305, 6297, 6368,
+		7322, 6417, 7420, 6570, 6389, 6301, 4395, 5847, 5928, 6041, 6147, 6296, 6338,
+		7344, 6401, 7581, 6644, 6498, 6368, 6202, 4452, 5844, 5948, 6096, 6233, 6385,
+		7292, 6324, 7512, 6709, 6607, 6423, 6289, 6199, 4441, 5750, 5935, 6071, 6231,
+		7191, 6394, 7555, 6681, 6720, 6552, 6421, 6320, 6102, 4492, 5805, 5928, 6102,
+		7259, 6467, 7643, 6751, 6740, 6703, 6524, 6474, 6299, 6176, 4522, 6004, 6145,
+		7239, 6422, 7678, 6777, 6778, 6687, 6681, 6614, 6445, 6297, 6380, 4618, 6176,
+		7347, 6515, 7761, 6818, 6800, 6765, 6721, 6774, 6608, 6487, 6522, 6566, 4701,
+	},
+	{ // KQo
+		1297, 2423, 2473, 3799, 3779, 3966, 4013, 4007, 4024, 3944, 3952, 3969, 3971,
+		2504, 857, 4753, 6926, 6947, 6932, 6940, 6935, 6897, 6932, 6977, 6968, 7072,
+		2582, 5000, 3157, 6967, 6916, 6998, 7128, 7116, 7078, 7100, 7173, 7172, 7272,
+		4007, 7354, 7394, 4354, 6064, 6146, 6268, 6324, 6431, 6375, 6454, 6456, 6510,
+		3993, 7368, 7336, 6430, 4350, 5983, 6136, 6207, 6329, 6401, 6424, 6426, 6486,
+		4179, 7367, 7440, 6528, 6357, 4451, 6013, 6060, 6144, 6229, 6369, 6345, 6428,
+		4237, 7377, 7572, 6657, 6504, 6390, 4597, 6004, 6040, 6118, 6237, 6344, 6393,
+		4227, 7361, 7551, 6713, 6581, 6431, 6373, 4601, 5890, 6005, 6111, 6236, 6405,
+		4246, 7314, 7505, 6819, 6713, 6514, 6413, 6246, 4614, 5858, 5988, 6125, 6262,
+		4167, 7365, 7546, 6771, 6804, 6619, 6502, 6376, 6216, 4624, 5851, 5957, 6164,
+		4175, 7418, 7627, 6852, 6827, 6769, 6628, 6485, 6356, 6221, 4660, 6026, 6202,
+		4189, 7406, 7624, 6861, 6829, 6738, 6746, 6620, 6502, 6331, 6408, 4773, 6193,
+		4192, 7510, 7727, 6915, 6881, 6831, 6784, 6800, 6645, 6550, 6585, 6585, 4830,
+	},
+	{ // QQ
+		1825, 5405, 6620, 6809, 6750, 6862, 6794, 6832, 6832, 6677, 6714, 6738, 6783,
+		5688, 1766, 6460, 6768, 6716, 6790, 6813, 6835, 6802, 6789, 6823, 6862, 6901,
+		7019, 6843, 5000, 8419, 8331, 8586, 8659, 8819, 8802, 8763, 8805, 8858, 8887,
+		7178, 7140, 8926, 8166, 8178, 8200, 8206, 8262, 8380, 8317, 8371, 8396, 8440,
+		7118, 7081, 8833, 8591, 8161, 7975, 8030, 8067, 8193, 8276, 8289, 8324, 8383,
+		7232, 7155, 9102, 8623, 8391, 8111, 7895, 7954, 8068, 8166, 8343, 8357, 8373,
+		7157, 7182, 9177, 8621, 8432, 8295, 8072, 7788, 7928, 7988, 8173, 8342, 8318,
+		7191, 7197, 9348, 8680, 8468, 8345, 8163, 8082, 7809, 7896, 8065, 8241, 8425,
+		7198, 7162, 9323, 8797, 8606, 8462, 8323, 8190, 8022, 7733, 7910, 8062, 8246,
+		7039, 7160, 9299, 8741, 8708, 8578, 8397, 8290, 8108, 8056, 7696, 7884, 8053,
+		7078, 7196, 9348, 8802, 8721, 8769, 8583, 8470, 8303, 8088, 8103, 7959, 8088,
+		7093, 7236, 9398, 8825, 8751, 8773, 8760, 8646, 8470, 8275, 8367, 8105, 8162,
+		7150, 7282, 9427, 8877, 8809, 8797, 8732, 8847, 8656, 8464, 8494, 8579, 8188,
+	},
+	{ // QJs
+		1897, 3666, 3043, 3000, 4188, 4378, 4389, 4469, 4471, 4407, 4406, 4424, 4430,
+		3913, 1736, 2939, 2908, 4113, 4280, 4392, 4454, 4458, 4424, 4424, 4448, 4455,
+		3157, 3033, 1581, 5000, 6958, 7015, 7021, 7040, 6998, 6962, 7019, 7040, 7133,
+		3110, 3008, 5245, 3665, 7035, 7015, 7083, 7191, 7162, 7130, 7204, 7179, 7295,
+		4458, 4382, 7355, 7434, 4699, 6409, 6528, 6598, 6677, 6765, 6786, 6778, 6855,
+		4650, 4553, 7426, 7430, 6702, 4796, 6372, 6407, 6504, 6571, 6704, 6694, 6790,
+		4665, 4670, 7424, 7502, 6815, 6660, 4866, 6280, 6401, 6443, 6549, 6645, 6713,
+		4747, 4736, 7447, 7616, 6893, 6695, 6558, 5016, 6318, 6326, 6465, 6564, 6730,
+		4755, 4740, 7394, 7577, 6977, 6797, 6695, 6605, 5001, 6152, 6343, 6426, 6581,
+		4693, 4711, 7376, 7560, 7078, 6876, 6740, 6620, 6436, 5064, 6140, 6239, 6427,
+		4694, 4712, 7440, 7641, 7096, 7013, 6847, 6762, 6639, 6432, 5086, 6321, 6488,
+		4705, 4733, 7456, 7612, 7089, 7000, 6948, 6862, 6728, 6532, 6620, 5173, 6483,
+		4714, 4743, 7551, 7729, 7161, 7100, 7015, 7031, 6882, 6727, 6784, 6791, 5248,
+	},
+	{ // QTs
+		1984, 3701, 3061, 3925, 3028, 4368, 4371, 4452, 4457, 4425, 4418, 4435, 4419,
+		3949, 1826, 2981, 3840, 2944, 4294, 4389, 4446, 4454, 4463, 4461, 4487, 4483,
+		3179, 3084, 1669, 3042, 5000, 6933, 6923, 6933, 6894, 6903, 6893, 6945, 7021,
+		4188, 4102, 3155, 3276, 7003, 6280, 6401, 6460, 6544, 6518, 6530, 6540, 6585,
+		3141, 3047, 5249, 7403, 3692, 6885, 6959, 7105, 7062, 7177, 7174, 7202, 7292,
+		4639, 4565, 7342, 6571, 7296, 4754, 6355, 6454, 6517, 6637, 6705, 6753, 6791,
+		4645, 4666, 7314, 6691, 7359, 6642, 4863, 6268, 6356, 6445, 6544, 6678, 6710,
+		4727, 4726, 7330, 6752, 7520, 6740, 6542, 4978, 6222, 6369, 6440, 6575, 6725,
+		4740, 4735, 7281, 6835, 7471, 6806, 6643, 6504, 4979, 6206, 6318, 6447, 6592,
+		4710, 4754, 7317, 6818, 7611, 6946, 6741, 6663, 6492, 5050, 6154, 6289, 6486,
+		4706, 4754, 7305, 6828, 7611, 7016, 6839, 6734, 6612, 6447, 5107, 6307, 6470,
+		4715, 4779, 7356, 6846, 7634, 7059, 6982, 6868, 6742, 6581, 6605, 5178, 6502,
+		4709, 4777, 7432, 6896, 7719, 7102, 7007, 7027, 6888, 6788, 6766, 6814, 5256,
+	},
+	{ // Q9s
+		1845, 3551, 2864, 3792, 3748, 3003, 4194, 4279, 4286, 4248, 4282, 4275, 4302,
+		3800, 1741, 2905, 3706, 3674, 2894, 4214, 4278, 4281, 4275, 4318, 4321, 4336,
+		2967, 3002, 1414, 2985, 3067, 5000, 6725, 6708, 6713, 6677, 6713, 6717, 6822,
+		4050, 3962, 3097, 3173, 5667, 6959, 6270, 6283, 6411, 6314, 6376, 6357, 6437,
+		4004, 3926, 3180, 5933, 3147, 6825, 6117, 6198, 6298, 6388, 6373, 6386, 6436,
+		3116, 3004, 5248, 7373, 7231, 3522, 6800, 6920, 6903, 7027, 7165, 7163, 7266,
+		4461, 4485, 7109, 6565, 6396, 7198, 4665, 6201, 6317, 6402, 6501, 6635, 6693,
+		4550, 4551, 7094, 6577, 6484, 7321, 6477, 4825, 6131, 6246, 6399, 6508, 6669,
+		4560, 4552, 7091, 6704, 6591, 7294, 6602, 6406, 4817, 6112, 6264, 6389, 6563,
+		4526, 4558, 7074, 6612, 6696, 7444, 6704, 6536, 6396, 4884, 6117, 6226, 6404,
+		4569, 4605, 7115, 6679, 6678, 7602, 6802, 6692, 6555, 6411, 5003, 6306, 6441,
+		4553, 4607, 7112, 6663, 6687, 7587, 6941, 6803, 6687, 6513, 6607, 5045, 6452,
+		4584, 4623, 7222, 6743, 6732, 7692, 6994, 6972, 6859, 6706, 6738, 6760, 5102,
+	},
+	{ // Q8s
+		1774, 3482, 2895, 3696, 3692, 3817, 2904, 4185, 4179, 4155, 4189, 4221, 4216,
+		3729, 1723, 2776, 3631, 3607, 3710, 2915, 4162, 4147, 4176, 4203, 4246, 4240,
+		3004, 2872, 1341, 2979, 3077, 3275, 5000, 6508, 6463, 6464, 6456, 6491, 6552,
+		3955, 3885, 3098, 3157, 5580, 5729, 7017, 6233, 6343, 6270, 6282, 6302, 6338,
+		3953, 3862, 3209, 5842, 3123, 5624, 6888, 6151, 6237, 6319, 6299, 6331, 6364,
+		4077, 3968, 3414, 6008, 5900, 3171, 6742, 6023, 6115, 6210, 6293, 6313, 6370,
+		3011, 3031, 5246, 7433, 7286, 7140, 3488, 6792, 6778, 6904, 7027, 7160, 7218,
+		4455, 4434, 6881, 6521, 6436, 6307, 7178, 4729, 6119, 6266, 6386, 6520, 6670,
+		4453, 4418, 6823, 6633, 6527, 6399, 7166, 6398, 4732, 6101, 6252, 6387, 6513,
+		4433, 4454, 6846, 6566, 6628, 6508, 7318, 6558, 6380, 4793, 6072, 6232, 6383,
+		4473, 4486, 6842, 6579, 6599, 6598, 7444, 6683, 6543, 6363, 4918, 6271, 6372,
+		4503, 4535, 6872, 6605, 6636, 6616, 7585, 6819, 6687, 6524, 6570, 5017, 6423,
+		4499, 4530, 6939, 6642, 6663, 6676, 7641, 6978, 6814, 6688, 6673, 6735, 5049,
+	},
+	{ // Q7s
+		1633, 3374, 2759, 3619, 3587, 3744, 3710, 2794, 4057, 4016, 4051, 4093, 4141,
+		3616, 1605, 2781, 3518, 3484, 3598, 3672, 2772, 4001, 4003, 4034, 4071, 4133,
+		2858, 2884, 1181, 2960, 3067, 3292, 3492, 5000, 6123, 6115, 6126, 6123, 6208,
+		3874, 3777, 3086, 3099, 5427, 5570, 5757, 6949, 6212, 6154, 6191, 6192, 6241,
+		3841, 3735, 3202, 5686, 3056, 5471, 5634, 6854, 6120, 6238, 6223, 6215, 6274,
+		4003, 3860, 3439, 5845, 5747, 3112, 5553, 6697, 5998, 6091, 6224, 6198, 6265,
+		3961, 3935, 3646, 6035, 5900, 5824, 3172, 6578, 5910, 6020, 6125, 6225, 6293,
+		2888, 2874, 5243, 7361, 7254, 7088, 6952, 3388, 6611, 6758, 6912, 7032, 7238,
+		4324, 4269, 6462, 6499, 6411, 6281, 6187, 6984, 4608, 6034, 6224, 6353, 6463,
+		4288, 4282, 6475, 6447, 6543, 6390, 6310, 7157, 6318, 4650, 6051, 6181, 6359,
+		4326, 4315, 6489, 6486, 6519, 6527, 6414, 7321, 6516, 6345, 4738, 6221, 6394,
+		4363, 4357, 6481, 6491, 6512, 6494, 6520, 7448, 6649, 6473, 6520, 4845, 6408,
+		4420, 4422, 6565, 6541, 6567, 6570, 6586, 7656, 6760, 6662, 6694, 6718, 4998,
+	},
+	{ // Q6s
+		1658, 3425, 2805, 3651, 3646, 3783, 3777, 3769, 2795, 4077, 4091, 4114, 4157,
+		3667, 1628, 2814, 3547, 3532, 3645, 3732, 3724, 2780, 4044, 4062, 4098, 4139,
+		2916, 2922, 1198, 3002, 3106, 3287, 3537, 3877, 5000, 5826, 5831, 5857, 5885,
+		3908, 3800, 3133, 3079, 5371, 5532, 5732, 5774, 7094, 6173, 6218, 6227, 6231,
+		3904, 3785, 3247, 5624, 3074, 5442, 5605, 5715, 6988, 6239, 6224, 6237, 6257,
+		4044, 3902, 3437, 5805, 5715, 3142, 5533, 5613, 6835, 6124, 6218, 6224, 6262,
+		4039, 3995, 3701, 6004, 5872, 5806, 3192, 5550, 6715, 6049, 6136, 6267, 6281,
+		4028, 3981, 4063, 6051, 5987, 5884, 5810, 3251, 6605, 5950, 6087, 6213, 6305,
+		2895, 2879, 5241, 7505, 7400, 7233, 7104, 6981, 3433, 6622, 6797, 6914, 7096,
+		4349, 4315, 6159, 6462, 6542, 6420, 6337, 6231, 7002, 4692, 6082, 6218, 6381,
+		4366, 4334, 6171, 6510, 6524, 6518, 6426, 6372, 7194, 6370, 4756, 6270, 6389,
+		4386, 4376, 6187, 6520, 6533, 6520, 6561, 6494, 7321, 6504, 6566, 4844, 6405,
+		4436, 4422, 6220, 6524, 6547, 6560, 6572, 6594, 7505, 6683, 6681, 6704, 4997,
+	},
+	{ // Q5s
+		1681, 3399, 2803, 3692, 3638, 3809, 3780, 3800, 3781, 2777, 3999, 4022, 4068,
+		3640, 1674, 2805, 3578, 3524, 3661, 3735, 3720, 3686, 2776, 4018, 4055, 4096,
+		2895, 2900, 1237, 3038, 3097, 3323, 3536, 3885, 4174, 5000, 5533, 5549, 5587,
+		3949, 3833, 3162, 3149, 5399, 5537, 5731, 5800, 5906, 7055, 6250, 6223, 6268,
+		3893, 3776, 3225, 5654, 3089, 5395, 5540, 5676, 5774, 7087, 6228, 6205, 6274,
+		4069, 3922, 3469, 5811, 5670, 3176, 5491, 5584, 5685, 6925, 6218, 6200, 6266,
+		4034, 3998, 3688, 6010, 5805, 5767, 3250, 5524, 5613, 6803, 6117, 6232, 6272,
+		4057, 3978, 4063, 6082, 5951, 5857, 5790, 3291, 5540, 6716, 6101, 6193, 6333,
+		4037, 3939, 4373, 6186, 6055, 5958, 5887, 5805, 3314, 6521, 5947, 6066, 6189,
+		2872, 2876, 5251, 7479, 7517, 7343, 7210, 7109, 6892, 3470, 6610, 6743, 6949,
+		4262, 4286, 5840, 6544, 6523, 6520, 6407, 6387, 6231, 7005, 4710, 6248, 6407,
+		4286, 4328, 5851, 6526, 6503, 6496, 6530, 6481, 6354, 7135, 6544, 4823, 6414,
+		4339, 4374, 5888, 6567, 6564, 6567, 6563, 6628, 6477, 7360, 6699, 6723, 4936,
+	},
+	{ // Q4s
+		1639, 3309, 2724, 3608, 3615, 3737, 3759, 3747, 3740, 3604, 2760, 3942, 3996,
+		3543, 1639, 2739, 3494, 3503, 3598, 3703, 3690, 3670, 3623, 2760, 3975, 4026,
+		2810, 2827, 1195, 2981, 3107, 3287, 3544, 3874, 4169, 4467, 5000, 5273, 5338,
+		3861, 3746, 3100, 3093, 5394, 5489, 5681, 5760, 5873, 5838, 7078, 6218, 6274,
+		3870, 3755, 3238, 5651, 3071, 5378, 5534, 5675, 5782, 5901, 7085, 6219, 6288,
+		3997, 3857, 3430, 5763, 5651, 3120, 5389, 5497, 5615, 5721, 7043, 6189, 6238,
+		4017, 3966, 3697, 5957, 5800, 5664, 3215, 5449, 5552, 5672, 6902, 6191, 6241,
+		4001, 3947, 4053, 6036, 5945, 5767, 5709, 3275, 5482, 5637, 6835, 6146, 6318,
+		3992, 3920, 4357, 6148, 6063, 5886, 5820, 5741, 3275, 5486, 6687, 6046, 6170,
+		3854, 3879, 4696, 6121, 6194, 6008, 5953, 5911, 5751, 3296, 6531, 5896, 6077,
+		2853, 2858, 5253, 7504, 7514, 7472, 7310, 7234, 7070, 6919, 3507, 6783, 6980,
+		4197, 4242, 5552, 6515, 6512, 6484, 6484, 6431, 6326, 6172, 7183, 4777, 6430,
+		4263, 4299, 5619, 6574, 6580, 6538, 6532, 6610, 6453, 6366, 7385, 6733, 4872,
+	},
+	{ // Q3s
+		1579, 3354, 2682, 3597, 3578, 3760, 3720, 3744, 3720, 3626, 3633, 2751, 3984,
+		3587, 1573, 2737, 3508, 3498, 3630, 3711, 3712, 3666, 3671, 3679, 2759, 4051,
+		2772, 2828, 1142, 2960, 3055, 3283, 3509, 3877, 4143, 4451, 4727, 5000, 5309,
+		3848, 3759, 3082, 3062, 5358, 5477, 5648, 5718, 5836, 5801, 5867, 7086, 6245,
+		3830, 3747, 3184, 5616, 3053, 5364, 5480, 5629, 5735, 5856, 5874, 7094, 6251,
+		4022, 3895, 3433, 5752, 5637, 3110, 5379, 5497, 5607, 5720, 5848, 7055, 6233,
+		3979, 3976, 3668, 5926, 5745, 5652, 3166, 5377, 5473, 5602, 5692, 7034, 6197,
+		4001, 3975, 4062, 5999, 5900, 5771, 5639, 3249, 5430, 5578, 5713, 6952, 6260,
+		3978, 3926, 4344, 6114, 6013, 5880, 5740, 5694, 3260, 5431, 5611, 6801, 6120,
+		3881, 3931, 4690, 6086, 6148, 6008, 5880, 5853, 5694, 3272, 5481, 6682, 6037,
+		3890, 3938, 4989, 6155, 6160, 6140, 5970, 5991, 5885, 5754, 3326, 6741, 6098,
+		2842, 2861, 5249, 7512, 7523, 7478, 7452, 7363, 7197, 7069, 7147, 3583, 6996,
+		4250, 4327, 5595, 6545, 6542, 6536, 6489, 6554, 6406, 6328, 6386, 7410, 4902,
+	},
+	{ // Q2s
+		1539, 3252, 2604, 3555, 3551, 3684, 3689, 3692, 3694, 3587, 3581, 3589, 2737,
+		3482, 1527, 2644, 3455, 3457, 3557, 3680, 3651, 3657, 3613, 3605, 3634, 2742,
+		2689, 2728, 1113, 2867, 2979, 3178, 3448, 3792, 4115, 4413, 4662, 4691, 5000,
+		3803, 3700, 2981, 3041, 5372, 5491, 5663, 5687, 5807, 5777, 5845, 5861, 7113,
+		3806, 3703, 3108, 5625, 3017, 5375, 5516, 5601, 5723, 5844, 5847, 5866, 7140,
+		3943, 3810, 3318, 5761, 5647, 3100, 5407, 5453, 5584, 5695, 5808, 5811, 7084,
+		3945, 3940, 3602, 5936, 5778, 5677, 3166, 5390, 5487, 5620, 5703, 5826, 7069,
+		3952, 3906, 3976, 5961, 5870, 5721, 5648, 3212, 5341, 5494, 5617, 5730, 7092,
+		3953, 3910, 4314, 6080, 5998, 5850, 5754, 5601, 3246, 5371, 5537, 5665, 6931,
+		3843, 3869, 4652, 6054, 6131, 5977, 5896, 5764, 5631, 3265, 5421, 5538, 6818,
+		3842, 3864, 4922, 6130, 6134, 6097, 5981, 5894, 5810, 5692, 3330, 5594, 6863,
+		3839, 3894, 4946, 6148, 6152, 6095, 6109, 6005, 5937, 5808, 5872, 3417, 6873,
+		2831, 2841, 5249, 7540, 7557, 7510, 7481, 7503, 7327, 7221, 7270, 7287, 3629,
+	},
+	{ // AJo
+		777, 2494, 2565, 4749, 6855, 6890, 6887, 6852, 6831, 6680, 6709, 6754, 6837,
+		2601, 2807, 5650, 7019, 5903, 6009, 6101, 6052, 6026, 6019, 6073, 6085, 6127,
+		2674, 5993, 2822, 6890, 5812, 5950, 6045, 6126, 6092, 6051, 6139, 6152, 6197,
+		5000, 7439, 7290, 3024, 6747, 6918, 6914, 7029, 7115, 7088, 7158, 7208, 7290,
+		7276, 6274, 6172, 7151, 4287, 5942, 6080, 6174, 6261, 6368, 6366, 6404, 6494,
+		7316, 6395, 6332, 7351, 6318, 4411, 5938, 5981, 6134, 6219, 6356, 6347, 6424,
+		7304, 6485, 6415, 7343, 6442, 6303, 4409, 5867, 6015, 6094, 6189, 6348, 6360,
+		7267, 6436, 6507, 7469, 6549, 6355, 6223, 4474, 5889, 5964, 6098, 6273, 6409,
+		7250, 6404, 6463, 7548, 6642, 6508, 6383, 6247, 4542, 5824, 5977, 6110, 6282,
+		7094, 6407, 6441, 7540, 6769, 6613, 6472, 6337, 6183, 4584, 5817, 5954, 6099,
+		7128, 6461, 6529, 7607, 6765, 6758, 6564, 6476, 6346, 6187, 4667, 6025, 6164,
+		7162, 6479, 6540, 7661, 6800, 6744, 6733, 6657, 6493, 6326, 6405, 4717, 6206,
+		7257, 6521, 6588, 7741, 6890, 6822, 6742, 6803, 6662, 6486, 6544, 6596, 4818,
+	},
+	{ // KJo
+		1337, 2478, 3571, 2454, 3790, 3987, 4014, 4005, 4061, 3997, 4004, 4015, 4018,
+		2564, 884, 2547, 4744, 6864, 6876, 6886, 6840, 6836, 6803, 6857, 6903, 6986,
+		3750, 2646, 2860, 6992, 5898, 6038, 6115, 6223, 6200, 6167, 6254, 6241, 6300,
+		2561, 5000, 7397, 3155, 6815, 6855, 7013, 6978, 7086, 7041, 7142, 7154, 7251,
+		4000, 7284, 6261, 7227, 4364, 6021, 6173, 6223, 6338, 6398, 6451, 6455, 6558,
+		4202, 7307, 6420, 7282, 6399, 4453, 6010, 6053, 6189, 6207, 6403, 6394, 6453,
+		4234, 7314, 6490, 7459, 6546, 6379, 4574, 5921, 6082, 6103, 6238, 6363, 6376,
+		4224, 7262, 6598, 7417, 6605, 6428, 6280, 4597, 5908, 5993, 6096, 6254, 6407,
+		4290, 7254, 6573, 7520, 6723, 6563, 6460, 6270, 4673, 5879, 6016, 6156, 6304,
+		4223, 7231, 6553, 7493, 6806, 6601, 6488, 6365, 6244, 4732, 5857, 5965, 6136,
+		4232, 7292, 6645, 7593, 6857, 6807, 6624, 6471, 6386, 6227, 4767, 6046, 6227,
+		4236, 7340, 6630, 7614, 6858, 6791, 6761, 6641, 6542, 6343, 6430, 4821, 6241,
+		4244, 7418, 6693, 7705, 6961, 6855, 6765, 6800, 6689, 6524, 6612, 6635, 4903,
+	},
+	{ // QJo
+		1495, 3371, 2647, 2598, 3910, 4101, 4114, 4199, 4201, 4135, 4134, 4148, 4160,
+		3531, 1320, 2532, 2501, 3837, 4000, 4121, 4184, 4187, 4152, 4153, 4176, 4188,
+		2741, 2606, 1074, 4755, 6845, 6903, 6902, 6914, 6867, 6838, 6900, 6918, 7019,
+		2710, 2603, 5000, 3293, 6943, 6923, 6986, 7094, 7059, 7036, 7117, 7087, 7207,
+		4122, 4047, 7253, 7359, 4431, 6174, 6292, 6367, 6444, 6541, 6560, 6555, 6636,
+		4313, 4213, 7324, 7351, 6556, 4522, 6130, 6163, 6264, 6337, 6472, 6458, 6561,
+		4338, 4343, 7317, 7421, 6668, 6502, 4606, 6030, 6157, 6201, 6308, 6406, 6482,
+		4428, 4416, 7332, 7536, 6752, 6534, 6391, 4780, 6072, 6080, 6221, 6323, 6491,
+		4437, 4419, 7277, 7493, 6831, 6639, 6535, 6439, 4765, 5898, 6093, 6181, 6341,
+		4368, 4387, 7262, 7481, 6948, 6731, 6585, 6457, 6258, 4822, 5885, 5987, 6183,
+		4369, 4386, 7330, 7568, 6964, 6873, 6694, 6604, 6469, 6254, 4845, 6076, 6244,
+		4379, 4409, 7344, 7535, 6961, 6854, 6801, 6710, 6566, 6359, 6457, 4938, 6243,
+		4391, 4421, 7444, 7655, 7033, 6962, 6872, 6882, 6727, 6565, 6625, 6636, 5017,
+	},
+	{ // JJ
+		1895, 5443, 5441, 6579, 6798, 6901, 6834, 6835, 6882, 6698, 6731, 6740, 6792,
+		5722, 1838, 5376, 6456, 6768, 6840, 6859, 6841, 6863, 6809, 6840, 6873, 6921,
+		5719, 5646, 1834, 6335, 6724, 6827, 6843, 6901, 6921, 6851, 6907, 6938, 6959,
+		6976, 6845, 6707, 5000, 8222, 8408, 8555, 8654, 8811, 8738, 8774, 8810, 8859,
+		7163, 7133, 7089, 8715, 8193, 8148, 8161, 8169, 8243, 8315, 8318, 8358, 8409,
+		7266, 7203, 7195, 8913, 8570, 8159, 8062, 8056, 8105, 8217, 8360, 8370, 8415,
+		7199, 7227, 7204, 9068, 8570, 8465, 8132, 7858, 7973, 8037, 8208, 8384, 8383,
+		7187, 7201, 7260, 9176, 8582, 8456, 8245, 8078, 7819, 7872, 8025, 8204, 8412,
+		7245, 7222, 7270, 9335, 8657, 8500, 8364, 8198, 8060, 7782, 7938, 8087, 8290,
+		7053, 7172, 7212, 9270, 8747, 8631, 8445, 8266, 8169, 8038, 7698, 7870, 8061,
+		7090, 7212, 7271, 9312, 8744, 8786, 8614, 8428, 8328, 8088, 8081, 7935, 8082,
+		7090, 7246, 7305, 9351, 8785, 8789, 8802, 8609, 8495, 8265, 8339, 8117, 8152,
+		7154, 7295, 7329, 9398, 8830, 8843, 8797, 8831, 8699, 8469, 8480, 8574, 8193,
+	},
+	{ // JTs
+		2106, 3797, 3793, 3124, 3151, 4450, 4454, 4515, 4596, 4527, 4529, 4556, 4538,
+		4054, 1956, 3693, 3066, 3080, 4365, 4471, 4509, 4589, 4568, 4558, 4593, 4585,
+		4044, 3936, 1822, 2965, 2997, 4333, 4420, 4573, 4629, 4601, 4606, 4642, 4628,
+		3253, 3185, 3057, 1778, 5000, 6885, 6919, 6907, 6931, 6902, 6900, 6934, 7027,
+		3276, 3193, 3091, 5251, 3865, 6966, 7025, 7032, 7158, 7140, 7148, 7178, 7284,
+		4733, 4647, 4603, 7289, 7377, 4863, 6475, 6536, 6606, 6686, 6810, 6818, 6888,
+		4733, 4751, 4686, 7323, 7426, 6765, 4917, 6352, 6463, 6523, 6625, 6772, 6797,
+		4795, 4792, 4844, 7309, 7441, 6827, 6626, 5045, 6321, 6408, 6501, 6641, 6790,
+		4890, 4883, 4908, 7328, 7578, 6899, 6755, 6605, 5167, 6357, 6400, 6514, 6661,
+		4818, 4866, 4890, 7319, 7575, 6994, 6818, 6699, 6649, 5196, 6192, 6345, 6503,
+		4825, 4857, 4894, 7312, 7582, 7125, 6919, 6797, 6695, 6484, 5239, 6353, 6520,
+		4845, 4893, 4926, 7351, 7612, 7129, 7074, 6938, 6818, 6640, 6652, 5322, 6543,
+		4834, 4885, 4917, 7444, 7709, 7201, 7096, 7092, 6961, 6811, 6814, 6854, 5340,
+	},
+	{ // J9s
+		2033, 3702, 3719, 2964, 3894, 3177, 4308, 4387, 4442, 4395, 4431, 4446, 4442,
+		3957, 1929, 3619, 3026, 3809, 3070, 4308, 4355, 4411, 4411, 4449, 4459, 4455,
+		3962, 3854, 1800, 2985, 3720, 3041, 4271, 4430, 4468, 4463, 4511, 4523, 4509,
+		3082, 3145, 3077, 1592, 3115, 5000, 6751, 6700, 6744, 6678, 6717, 6730, 6833,
+		4154, 4068, 3965, 3228, 3258, 6908, 6250, 6308, 6386, 6466, 6499, 6480, 6581,
+		3307, 3193, 3142, 5255, 7315, 3729, 6893, 6868, 7025, 6977, 7124, 7147, 7232,
+		4581, 4583, 4529, 7146, 6529, 7288, 4765, 6295, 6463, 6480, 6588, 6754, 6780,
+		4663, 4632, 4695, 7096, 6600, 7268, 6572, 4932, 6245, 6314, 6452, 6596, 6729,
+		4727, 4692, 4732, 7132, 6676, 7428, 6753, 6524, 4982, 6201, 6341, 6445, 6602,
+		4680, 4702, 4741, 7077, 6774, 7393, 6779, 6603, 6483, 5070, 6159, 6268, 6413,
+		4724, 4744, 4794, 7122, 6805, 7557, 6888, 6747, 6634, 6452, 5164, 6351, 6478,
+		4730, 4749, 4802, 7135, 6781, 7573, 7057, 6890, 6744, 6559, 6649, 5205, 6492,
+		4732, 4750, 4787, 7238, 6882, 7660, 7081, 7033, 6895, 6714, 6776, 6798, 5253,
+	},
+	{ // J8s
+		1933, 3596, 3565, 2970, 3780, 3907, 3021, 4224, 4277, 4227, 4279, 4306, 4301,
+		3846, 1876, 3500, 2878, 3709, 3815, 3049, 4222, 4266, 4262, 4309, 4349, 4342,
+		3810, 3732, 1794, 2917, 3599, 3730, 2983, 4243, 4268, 4269, 4319, 4352, 4337,
+		3086, 2987, 3014, 1445, 3081, 3249, 5000, 6466, 6470, 6462, 6460, 6507, 6559,
+		4042, 3966, 3850, 3206, 3212, 5684, 6938, 6226, 6280, 6369, 6359, 6388, 6460,
+		4172, 4078, 3983, 3383, 5959, 3242, 6809, 6088, 6216, 6257, 6385, 6409, 6439,
+		3136, 3167, 3079, 5252, 7339, 7199, 3597, 6705, 6861, 6858, 6984, 7153, 7194,
+		4493, 4494, 4509, 6840, 6517, 6369, 7086, 4764, 6202, 6306, 6401, 6562, 6690,
+		4556, 4544, 4535, 6839, 6569, 6502, 7260, 6482, 4833, 6139, 6266, 6407, 6537,
+		4507, 4545, 4544, 6848, 6675, 6556, 7269, 6592, 6418, 4874, 6088, 6263, 6384,
+		4566, 4598, 4599, 6844, 6660, 6692, 7391, 6697, 6558, 6377, 5021, 6324, 6402,
+		4589, 4638, 4634, 6894, 6690, 6713, 7579, 6857, 6710, 6556, 6624, 5090, 6461,
+		4588, 4634, 4618, 6945, 6758, 6744, 7611, 6993, 6834, 6686, 6700, 6771, 5136,
+	},
+	{ // J7s
+		1819, 3509, 3503, 2868, 3719, 3891, 3822, 2970, 4232, 4167, 4188, 4234, 4268,
+		3752, 1776, 3439, 2910, 3648, 3757, 3809, 2965, 4197, 4166, 4199, 4242, 4289,
+		3746, 3676, 1738, 2809, 3540, 3717, 3767, 3051, 4226, 4200, 4240, 4282, 4313,
+		2971, 3022, 2906, 1346, 3093, 3300, 3534, 5000, 6183, 6177, 6178, 6177, 6268,
+		3971, 3898, 3788, 3218, 3196, 5563, 5751, 6941, 6218, 6323, 6317, 6316, 6412,
+		4151, 4012, 3971, 3437, 5833, 3229, 5661, 6794, 6140, 6212, 6353, 6342, 6388,
+		4077, 4070, 4020, 3689, 6016, 5925, 3252, 6666, 6069, 6157, 6223, 6350, 6383,
+		3073, 3069, 3158, 5248, 7345, 7186, 7039, 3589, 6726, 6729, 6868, 7029, 7233,
+		4506, 4465, 4496, 6530, 6504, 6422, 6351, 7109, 4807, 6104, 6259, 6399, 6560,
+		4438, 4441, 4478, 6538, 6623, 6508, 6444, 7125, 6387, 4815, 6095, 6226, 6393,
+		4469, 4482, 4524, 6541, 6614, 6655, 6514, 7272, 6548, 6387, 4925, 6269, 6444,
+		4507, 4522, 4565, 6541, 6614, 6638, 6640, 7444, 6695, 6517, 6567, 4999, 6493,
+		4551, 4577, 4600, 6630, 6709, 6688, 6672, 7650, 6857, 6696, 6740, 6801, 5129,
+	},
+	{ // J6s
+		1672, 3426, 3424, 2776, 3647, 3771, 3756, 3782, 2843, 4099, 4132, 4156, 4192,
+		3674, 1651, 3335, 2802, 3542, 3625, 3714, 3721, 2833, 4054, 4092, 4122, 4154,
+		3671, 3569, 1620, 2838, 3456, 3589, 3657, 3788, 2906, 4094, 4127, 4164, 4193,
+		2885, 2914, 2941, 1189, 3069, 3256, 3530, 3817, 5000, 5809, 5812, 5836, 5881,
+		3905, 3799, 3705, 3206, 3119, 5423, 5621, 5702, 6939, 6215, 6221, 6223, 6292,
+		4036, 3883, 3841, 3402, 5689, 3140, 5536, 5588, 6824, 6118, 6245, 6249, 6273,
+		4015, 3974, 3904, 3694, 5882, 5799, 3162, 5530, 6717, 6063, 6130, 6276, 6268,
+		4041, 3976, 4042, 4000, 5974, 5858, 5785, 3271, 6568, 5922, 6038, 6192, 6305,
+		2949, 2934, 3003, 5245, 7346, 7216, 7106, 6945, 3507, 6574, 6739, 6885, 7077,
+		4374, 4327, 4369, 6139, 6515, 6410, 6347, 6197, 6956, 4725, 6076, 6208, 6377,
+		4414, 4372, 4401, 6148, 6519, 6546, 6415, 6319, 7128, 6359, 4829, 6264, 6386,
+		4433, 4401, 4438, 6170, 6520, 6544, 6564, 6473, 7292, 6496, 6559, 4898, 6424,
+		4476, 4441, 4472, 6212, 6585, 6568, 6555, 6592, 7483, 6678, 6679, 6724, 5020,
+	},
+	{ // J5s
+		1745, 3468, 3470, 2816, 3642, 3806, 3774, 3808, 3816, 2840, 4044, 4066, 4106,
+		3711, 1722, 3395, 2856, 3601, 3746, 3792, 3775, 3779, 2866, 4121, 4167, 4195,
+		3713, 3625, 1683, 2870, 3482, 3686, 3730, 3846, 3827, 2945, 4162, 4199, 4223,
+		2912, 2959, 2964, 1262, 3098, 3322, 3538, 3823, 4191, 5000, 5541, 5554, 5595,
+		3895, 3852, 3730, 3221, 3146, 5384, 5582, 5674, 5779, 7052, 6242, 6229, 6314,
+		4067, 4004, 3942, 3465, 5649, 3212, 5505, 5571, 5732, 6919, 6277, 6249, 6290,
+		4029, 4054, 3980, 3695, 5844, 5769, 3234, 5521, 5689, 6818, 6152, 6283, 6291,
+		4064, 4030, 4100, 4002, 5948, 5840, 5781, 3331, 5564, 6664, 6080, 6192, 6323,
+		4080, 4034, 4086, 4392, 6057, 6003, 5964, 5829, 3382, 6515, 5974, 6083, 6238,
+		2946, 2971, 3050, 5254, 7481, 7334, 7220, 7053, 6890, 3587, 6551, 6711, 6898,
+		4317, 4394, 4434, 5845, 6534, 6579, 6436, 6360, 6255, 6938, 4811, 6269, 6413,
+		4335, 4438, 4471, 5863, 6527, 6547, 6577, 6478, 6377, 7103, 6560, 4907, 6436,
+		4384, 4475, 4500, 5898, 6607, 6589, 6579, 6617, 6528, 7306, 6704, 6739, 5015,
+	},
+	{ // J4s
+		1683, 3409, 3404, 2747, 3664, 3776, 3787, 3802, 3811, 3679, 2832, 4030, 4071,
+		3653, 1666, 3317, 2760, 3571, 3668, 3769, 3771, 3773, 3701, 2850, 4097, 4118,
+		3644, 3546, 1629, 2796, 3470, 3624, 3718, 3809, 3782, 3750, 2922, 4133, 4155,
+		2842, 2858, 2883, 1226, 3100, 3283, 3540, 3822, 4188, 4459, 5000, 5282, 5349,
+		3922, 3828, 3719, 3230, 3135, 5386, 5576, 5690, 5787, 5890, 7058, 6242, 6341,
+		4038, 3926, 3878, 3426, 5652, 3153, 5413, 5490, 5664, 5733, 7048, 6244, 6287,
+		4047, 4032, 3969, 3700, 5837, 5676, 3223, 5452, 5609, 5702, 6909, 6258, 6269,
+		4061, 4031, 4066, 4004, 5961, 5756, 5705, 3320, 5508, 5639, 6778, 6175, 6335,
+		4076, 4034, 4034, 4387, 6065, 5932, 5879, 5766, 3374, 5516, 6664, 6068, 6208,
+		3940, 3966, 4011, 4696, 6184, 6017, 5980, 5908, 5783, 3408, 6475, 5915, 6062,
+		2938, 2957, 3026, 5256, 7485, 7479, 7311, 7174, 7049, 6859, 3636, 6756, 6946,
+		4296, 4370, 4401, 5568, 6538, 6540, 6549, 6461, 6354, 6194, 7156, 4870, 6487,
+		4346, 4398, 4428, 5633, 6636, 6586, 6558, 6626, 6495, 6354, 7349, 6791, 4953,
+	},
+	{ // J3s
+		1653, 3394, 3387, 2703, 3622, 3796, 3733, 3760, 3789, 3689, 3688, 2828, 4041,
+		3632, 1646, 3319, 2753, 3547, 3671, 3739, 3748, 3739, 3741, 3741, 2848, 4104,
+		3625, 3544, 1604, 2821, 3460, 3643, 3698, 3808, 3773, 3777, 3782, 2914, 4139,
+		2792, 2846, 2913, 1190, 3066, 3270, 3493, 3823, 4164, 4446, 4718, 5000, 5318,
+		3876, 3797, 3705, 3190, 3130, 5360, 5528, 5647, 5750, 5868, 5885, 7062, 6315,
+		4054, 3928, 3899, 3414, 5627, 3172, 5408, 5493, 5663, 5731, 5895, 7047, 6275,
+		3990, 4000, 3951, 3653, 5788, 5670, 3178, 5375, 5544, 5641, 5721, 7043, 6227,
+		4018, 4006, 4066, 4006, 5919, 5763, 5630, 3308, 5465, 5576, 5691, 6900, 6284,
+		4052, 4000, 4032, 4367, 6027, 5934, 5815, 5729, 3363, 5474, 5624, 6775, 6179,
+		3947, 4002, 4037, 4683, 6160, 6016, 5914, 5842, 5739, 3396, 5480, 6619, 6029,
+		3950, 4005, 4046, 4978, 6174, 6187, 5996, 5968, 5897, 5750, 3462, 6684, 6121,
+		2927, 2949, 3015, 5254, 7489, 7472, 7457, 7308, 7173, 7009, 7083, 3676, 6962,
+		4312, 4381, 4412, 5602, 6608, 6574, 6516, 6574, 6466, 6319, 6410, 7376, 4944,
+	},
+	{ // J2s
+		1591, 3363, 3349, 2622, 3572, 3721, 3734, 3725, 3765, 3665, 3645, 3656, 2806,
+		3602, 1564, 3268, 2656, 3488, 3622, 3742, 3702, 3738, 3699, 3681, 3714, 2825,
+		3582, 3490, 1560, 2705, 3415, 3563, 3662, 3759, 3769, 3732, 3726, 3755, 2887,
+		2710, 2749, 2793, 1141, 2973, 3167, 3441, 3732, 4119, 4405, 4651, 4682, 5000,
+		3821, 3736, 3656, 3097, 3066, 5375, 5548, 5606, 5717, 5827, 5854, 5863, 7117,
+		3978, 3877, 3811, 3304, 5639, 3122, 5424, 5445, 5626, 5702, 5871, 5860, 7078,
+		3992, 4003, 3908, 3600, 5806, 5684, 3163, 5374, 5541, 5649, 5730, 5864, 7058,
+		3981, 3959, 4014, 3912, 5875, 5711, 5626, 3236, 5374, 5487, 5604, 5748, 7036,
+		4029, 3999, 4022, 4324, 5989, 5890, 5809, 5633, 3325, 5404, 5550, 5673, 6912,
+		3927, 3964, 3993, 4643, 6117, 5983, 5922, 5752, 5667, 3362, 5419, 5540, 6739,
+		3908, 3944, 3984, 4911, 6138, 6158, 6004, 5875, 5821, 5689, 3430, 5618, 6805,
+		3912, 3978, 4014, 4941, 6150, 6145, 6144, 6022, 5949, 5812, 5895, 3490, 6844,
+		2908, 2930, 2987, 5252, 7534, 7503, 7468, 7446, 7310, 7144, 7207, 7258, 3703,
+	},
+	{ // ATo
+		844, 2545, 2548, 2615, 4752, 6805, 6757, 6753, 6703, 6612, 6578, 6639, 6729,
+		2661, 2859, 5664, 5646, 7005, 6056, 6136, 6095, 6041, 6091, 6096, 6113, 6170,
+		2658, 6007, 2882, 5542, 6859, 5996, 6047, 6159, 6096, 6107, 6130, 6170, 6194,
+		2724, 6000, 5878, 2837, 6724, 5846, 5958, 6029, 6095, 6105, 6078, 6124, 6179,
+		5000, 7418, 7257, 7103, 3064, 6761, 6742, 6888, 6980, 7117, 7126, 7202, 7289,
+		7229, 6446, 6383, 6210, 7189, 4389, 5911, 6046, 6131, 6249, 6371, 6415, 6436,
+		7164, 6522, 6415, 6324, 7153, 6282, 4438, 5877, 5957, 6093, 6199, 6392, 6374,
+		7158, 6475, 6538, 6404, 7310, 6420, 6235, 4462, 5874, 6023, 6109, 6300, 6451,
+		7111, 6417, 6468, 6461, 7408, 6506, 6322, 6233, 4533, 5873, 5981, 6118, 6311,
+		7020, 6484, 6499, 6490, 7572, 6644, 6474, 6399, 6241, 4602, 5840, 6008, 6175,
+		6992, 6485, 6520, 6454, 7578, 6776, 6579, 6488, 6353, 6216, 4693, 6017, 6170,
+		7038, 6512, 6560, 6511, 7652, 6815, 6784, 6682, 6500, 6383, 6396, 4719, 6247,
+		7141, 6567, 6586, 6567, 7734, 6839, 6757, 6846, 6695, 6566, 6549, 6645, 4818,
+	},
+	{ // KTo
+		1362, 2504, 3607, 3545, 2473, 4027, 4046, 4046, 4104, 4059, 4053, 4076, 4061,
+		2595, 932, 2529, 2605, 4747, 6783, 6778, 6767, 6730, 6751, 6741, 6784, 6877,
+		3793, 2632, 2919, 5618, 6953, 6074, 6138, 6265, 6215, 6224, 6245, 6253, 6297,
+		3726, 2716, 5953, 2867, 6807, 5932, 6034, 6102, 6201, 6148, 6172, 6203, 6264,
+		2582, 5000, 7350, 7193, 3211, 6682, 6856, 6853, 6966, 7081, 7108, 7135, 7241,
+		4243, 7210, 6461, 6302, 7107, 4474, 6019, 6116, 6209, 6289, 6438, 6422, 6473,
+		4268, 7198, 6511, 6409, 7282, 6395, 4588, 5921, 6042, 6134, 6254, 6394, 6407,
+		4264, 7183, 6642, 6480, 7277, 6488, 6283, 4599, 5923, 6056, 6140, 6287, 6468,
+		4331, 7133, 6584, 6571, 7395, 6583, 6412, 6282, 4681, 5951, 6035, 6187, 6334,
+		4290, 7177, 6616, 6536, 7535, 6683, 6521, 6429, 6320, 4754, 5889, 6034, 6221,
+		4284, 7168, 6637, 6558, 7561, 6848, 6643, 6517, 6407, 6267, 4804, 6053, 6199,
+		4304, 7209, 6644, 6598, 7590, 6823, 6799, 6673, 6571, 6417, 6441, 4871, 6277,
+		4296, 7303, 6692, 6655, 7688, 6878, 6795, 6867, 6720, 6616, 6581, 6679, 4932,
+	},
+	{ // QTo
+		1577, 3411, 2664, 3644, 2624, 4093, 4098, 4182, 4185, 4151, 4147, 4161, 4151,
+		3576, 1415, 2580, 3558, 2541, 4018, 4122, 4179, 4185, 4193, 4192, 4218, 4217,
+		2763, 2664, 1167, 2645, 4751, 6820, 6791, 6798, 6753, 6775, 6762, 6816, 6892,
+		3828, 3739, 2747, 2911, 6909, 6035, 6150, 6212, 6295, 6270, 6281, 6295, 6344,
+		2743, 2650, 5000, 7300, 3325, 6783, 6851, 6999, 6949, 7080, 7083, 7108, 7198,
+		4303, 4231, 7237, 6408, 7208, 4472, 6121, 6216, 6279, 6404, 6476, 6523, 6563,
+		4319, 4343, 7195, 6523, 7267, 6494, 4605, 6021, 6111, 6198, 6299, 6441, 6473,
+		4409, 4411, 7208, 6589, 7432, 6591, 6380, 4737, 5973, 6120, 6194, 6332, 6485,
+		4420, 4417, 7151, 6666, 7376, 6654, 6481, 6336, 4735, 5951, 6065, 6197, 6349,
+		4386, 4435, 7198, 6655, 7531, 6805, 6580, 6498, 6315, 4804, 5893, 6031, 6239,
+		4384, 4435, 7184, 6664, 7535, 6881, 6684, 6574, 6440, 6266, 4868, 6053, 6217,
+		4390, 4459, 7234, 6685, 7555, 6920, 6836, 6714, 6577, 6404, 6433, 4937, 6261,
+		4391, 4461, 7311, 6738, 7641, 6965, 6858, 6876, 6731, 6625, 6601, 6660, 5031,
+	},
+	{ // JTo
+		1712, 3517, 3516, 2741, 2764, 4186, 4191, 4253, 4338, 4269, 4269, 4293, 4280,
+		3695, 1549, 3411, 2674, 2686, 4101, 4212, 4248, 4333, 4310, 4297, 4336, 4329,
+		3684, 3570, 1409, 2566, 2597, 4067, 4158, 4314, 4376, 4346, 4349, 4384, 4375,
+		2849, 2773, 2641, 1285, 4749, 6772, 6794, 6782, 6794, 6779, 6770, 6810, 6903,
+		2897, 2807, 2700, 5000, 3513, 6874, 6925, 6929, 7057, 7046, 7055, 7087, 7193,
+		4413, 4325, 4281, 7184, 7305, 4599, 6236, 6304, 6374, 6459, 6584, 6592, 6668,
+		4420, 4438, 4372, 7209, 7346, 6616, 4668, 6110, 6225, 6285, 6386, 6542, 6567,
+		4485, 4480, 4535, 7196, 7358, 6687, 6472, 4804, 6080, 6167, 6262, 6406, 6559,
+		4590, 4581, 4610, 7202, 7498, 6757, 6604, 6448, 4950, 6112, 6149, 6273, 6423,
+		4513, 4560, 4585, 7204, 7499, 6860, 6668, 6543, 6490, 4965, 5936, 6097, 6266,
+		4519, 4549, 4589, 7192, 7505, 6998, 6771, 6647, 6530, 6310, 5017, 6106, 6276,
+		4536, 4586, 4619, 7233, 7537, 6997, 6939, 6794, 6666, 6475, 6488, 5097, 6305,
+		4531, 4582, 4616, 7329, 7636, 7076, 6957, 6954, 6813, 6659, 6659, 6703, 5124,
+	},
+	{ // TT
+		1931, 5418, 5477, 5439, 6548, 6907, 6851, 6856, 6870, 6745, 6733, 6746, 6810,
+		5693, 1900, 5382, 5360, 6406, 6796, 6846, 6820, 6812, 6809, 6812, 6834, 6891,
+		5752, 5650, 1839, 5301, 6308, 6853, 6877, 6944, 6926, 6911, 6929, 6947, 6983,
+		5713, 5636, 5569, 1807, 6135, 6742, 6788, 6804, 6881, 6854, 6865, 6870, 6934,
+		6936, 6789, 6675, 6487, 5000, 8195, 8368, 8477, 8649, 8740, 8737, 8768, 8831,
+		7274, 7165, 7224, 7102, 8685, 8203, 8168, 8188, 8197, 8223, 8381, 8385, 8435,
+		7209, 7212, 7237, 7151, 8866, 8587, 8140, 7994, 8058, 8058, 8213, 8380, 8404,
+		7206, 7180, 7307, 7163, 8986, 8603, 8392, 8129, 7901, 7903, 8081, 8236, 8446,
+		7229, 7169, 7278, 7233, 9165, 8610, 8457, 8288, 8063, 7806, 7933, 8075, 8277,
+		7094, 7171, 7279, 7214, 9272, 8643, 8462, 8299, 8194, 8100, 7728, 7919, 8100,
+		7094, 7176, 7292, 7221, 9274, 8814, 8626, 8481, 8329, 8122, 8075, 7935, 8084,
+		7094, 7197, 7318, 7237, 9309, 8815, 8804, 8647, 8489, 8316, 8339, 8136, 8149,
+		7166, 7260, 7352, 7300, 9361, 8862, 8819, 8868, 8690, 8510, 8487, 8570, 8210,
+	},
+	{ // T9s
+		2272, 3876, 3846, 3806, 3116, 3350, 4445, 4503, 4566, 4576, 4570, 4577, 4582,
+		4135, 2151, 3773, 3728, 3196, 3274, 4476, 4509, 4565, 4624, 4616, 4647, 4635,
+		4098, 4017, 2025, 3591, 3115, 3175, 4376, 4529, 4558, 4605, 4622, 4636, 4625,
+		4058, 3979, 3826, 1852, 3034, 3092, 4316, 4437, 4577, 4616, 4614, 4640, 4625,
+		3239, 3318, 3217, 3126, 1805, 5000, 6723, 6701, 6732, 6754, 6737, 6761, 6861,
+		3494, 3410, 3290, 3193, 5256, 3887, 6947, 6953, 6963, 7123, 7105, 7137, 7218,
+		4718, 4750, 4635, 4580, 7105, 7347, 4876, 6422, 6500, 6577, 6692, 6842, 6856,
+		4777, 4782, 4797, 4706, 7091, 7352, 6701, 4977, 6320, 6453, 6551, 6703, 6846,
+		4853, 4844, 4830, 4850, 7117, 7363, 6784, 6598, 5086, 6324, 6402, 6533, 6687,
+		4865, 4919, 4891, 4906, 7163, 7556, 6877, 6745, 6614, 5212, 6333, 6363, 6529,
+		4864, 4912, 4912, 4901, 7144, 7539, 6989, 6841, 6691, 6635, 5284, 6377, 6510,
+		4861, 4943, 4921, 4929, 7164, 7565, 7147, 6998, 6831, 6659, 6675, 5320, 6551,
+		4877, 4936, 4914, 4918, 7258, 7641, 7155, 7151, 6980, 6834, 6805, 6858, 5375,
+	},
+	{ // T8s
+		2115, 3746, 3720, 3672, 3134, 4085, 3177, 4366, 4424, 4425, 4426, 4483, 4453,
+		4002, 2072, 3622, 3580, 3027, 3972, 3221, 4340, 4391, 4452, 4456, 4514, 4484,
+		3973, 3864, 1970, 3472, 3041, 3883, 3112, 4366, 4395, 4460, 4466, 4520, 4484,
+		3920, 3827, 3708, 1839, 2975, 3750, 3062, 4249, 4379, 4418, 4424, 4472, 4452,
+		3258, 3144, 3149, 3075, 1632, 3277, 5000, 6478, 6472, 6516, 6485, 6540, 6601,
+		4354, 4235, 4138, 4003, 3407, 3283, 6878, 6255, 6299, 6383, 6500, 6530, 6545,
+		3306, 3355, 3222, 3167, 5249, 7277, 3774, 6767, 6803, 6956, 6933, 7103, 7151,
+		4634, 4611, 4630, 4513, 6847, 6532, 7143, 4842, 6272, 6415, 6504, 6670, 6815,
+		4705, 4669, 4664, 4649, 6841, 6585, 7192, 6547, 4959, 6239, 6327, 6499, 6621,
+		4713, 4743, 4745, 4700, 6903, 6681, 7378, 6701, 6526, 5065, 6153, 6336, 6452,
+		4715, 4748, 4752, 4709, 6877, 6805, 7342, 6796, 6617, 6443, 5134, 6338, 6437,
+		4767, 4811, 4806, 4761, 6928, 6832, 7529, 6964, 6801, 6629, 6634, 5213, 6513,
+		4745, 4784, 4776, 4742, 6981, 6848, 7565, 7117, 6919, 6758, 6731, 6825, 5249,
+	},
+	{ // T7s
+		1995, 3658, 3624, 3582, 2997, 3985, 3922, 3094, 4313, 4300, 4293, 4336, 4366,
+		3912, 1965, 3553, 3536, 3029, 3885, 3935, 3115, 4290, 4334, 4321, 4365, 4404,
+		3873, 3793, 1933, 3402, 2895, 3802, 3849, 3146, 4285, 4324, 4325, 4371, 4399,
+		3826, 3777, 3633, 1831, 2968, 3692, 3774, 3059, 4298, 4326, 4310, 4353, 4394,
+		3112, 3147, 3001, 3071, 1523, 3299, 3522, 5000, 6174, 6223, 6204, 6221, 6317,
+		4252, 4146, 4058, 3937, 3436, 3234, 5703, 6883, 6221, 6317, 6432, 6429, 6477,
+		4177, 4196, 4098, 4021, 3667, 5969, 3302, 6695, 6082, 6202, 6315, 6434, 6461,
+		3210, 3234, 3260, 3162, 5248, 7280, 7067, 3699, 6644, 6817, 6806, 6982, 7185,
+		4588, 4560, 4552, 4558, 6523, 6502, 6355, 7015, 4841, 6205, 6307, 6470, 6628,
+		4582, 4618, 4606, 4600, 6588, 6615, 6487, 7222, 6489, 4943, 6109, 6273, 6429,
+		4580, 4610, 4612, 4587, 6570, 6737, 6603, 7202, 6596, 6400, 5019, 6279, 6452,
+		4614, 4656, 4657, 4634, 6584, 6727, 6728, 7392, 6762, 6563, 6577, 5076, 6519,
+		4655, 4698, 4691, 4679, 6675, 6781, 6750, 7600, 6924, 6733, 6746, 6830, 5187,
+	},
+	{ // T6s
+		1850, 3572, 3524, 3493, 2907, 3903, 3892, 3891, 2981, 4247, 4233, 4268, 4287,
+		3828, 1832, 3429, 3416, 2919, 3777, 3869, 3842, 2997, 4227, 4216, 4252, 4276,
+		3775, 3671, 1807, 3323, 2938, 3702, 3763, 3880, 3012, 4226, 4218, 4265, 4277,
+		3739, 3662, 3556, 1757, 2842, 3614, 3720, 3782, 3061, 4221, 4213, 4250, 4283,
+		3020, 3034, 3051, 2943, 1351, 3268, 3528, 3826, 5000, 5883, 5858, 5896, 5946,
+		4174, 4040, 3960, 3863, 3417, 3182, 5567, 5701, 6880, 6219, 6313, 6340, 6363,
+		4160, 4136, 4017, 3971, 3685, 5834, 3235, 5559, 6722, 6107, 6215, 6377, 6351,
+		4152, 4101, 4135, 4036, 4003, 5970, 5815, 3292, 6602, 6026, 6122, 6286, 6405,
+		3098, 3109, 3114, 3169, 5248, 7280, 7105, 6976, 3626, 6670, 6685, 6857, 7048,
+		4527, 4510, 4505, 4496, 6222, 6514, 6394, 6303, 7063, 4864, 6075, 6263, 6408,
+		4517, 4499, 4496, 4487, 6202, 6619, 6501, 6405, 7076, 6361, 4917, 6276, 6417,
+		4546, 4539, 4543, 4526, 6233, 6636, 6670, 6566, 7261, 6548, 6568, 4968, 6469,
+		4577, 4572, 4563, 4567, 6275, 6662, 6639, 6698, 7451, 6709, 6707, 6771, 5072,
+	},
+	{ // T5s
+		1761, 3466, 3438, 3396, 2790, 3809, 3764, 3769, 3805, 2844, 4038, 4061, 4092,
+		3715, 1748, 3362, 3363, 2820, 3725, 3785, 3744, 3772, 2894, 4113, 4149, 4177,
+		3680, 3599, 1724, 3235, 2823, 3612, 3681, 3762, 3761, 2913, 4099, 4144, 4156,
+		3632, 3602, 3459, 1685, 2860, 3534, 3631, 3677, 3785, 2948, 4110, 4132, 4173,
+		2883, 2919, 2920, 2954, 1260, 3246, 3484, 3777, 4117, 5000, 5501, 5529, 5577,
+		4070, 3985, 3864, 3776, 3387, 3174, 5460, 5594, 5699, 6858, 6241, 6222, 6274,
+		4019, 4047, 3928, 3877, 3639, 5725, 3231, 5469, 5588, 6715, 6128, 6257, 6262,
+		4023, 4000, 4012, 3927, 3952, 5859, 5725, 3266, 5506, 6610, 6051, 6176, 6307,
+		4065, 4028, 4013, 4035, 4320, 5968, 5854, 5766, 3358, 6450, 5906, 6058, 6195,
+		2950, 3005, 3015, 3052, 5253, 7272, 7109, 6996, 6825, 3560, 6456, 6650, 6854,
+		4311, 4391, 4369, 4376, 5811, 6544, 6411, 6332, 6185, 6843, 4809, 6206, 6375,
+		4330, 4428, 4416, 4402, 5837, 6518, 6551, 6460, 6345, 7039, 6497, 4871, 6439,
+		4372, 4461, 4434, 4444, 5877, 6571, 6546, 6603, 6477, 7257, 6660, 6743, 4954,
+	},
+	{ // T4s
+		1736, 3452, 3417, 3396, 2783, 3814, 3809, 3823, 3839, 3744, 2864, 4077, 4112,
+		3697, 1730, 3342, 3310, 2798, 3697, 3799, 3778, 3796, 3777, 2907, 4130, 4173,
+		3656, 3576, 1711, 3214, 2826, 3627, 3701, 3777, 3776, 3772, 2915, 4126, 4153,
+		3634, 3549, 3440, 1682, 2852, 3501, 3641, 3683, 3779, 3758, 2942, 4115, 4146,
+		2874, 2892, 2917, 2945, 1263, 3263, 3515, 3796, 4142, 4499, 5000, 5289, 5362,
+		4076, 3956, 3882, 3747, 3407, 3129, 5394, 5540, 5650, 5772, 6999, 6232, 6284,
+		4068, 4062, 3951, 3894, 3665, 5657, 3224, 5426, 5543, 5685, 6837, 6246, 6262,
+		4078, 4037, 4031, 3936, 3972, 5801, 5676, 3273, 5479, 5664, 6747, 6189, 6347,
+		4098, 4052, 4023, 4028, 4339, 5917, 5806, 5734, 3355, 5543, 6590, 6066, 6221,
+		4006, 4047, 4032, 4020, 4736, 6055, 5963, 5935, 5813, 3418, 6428, 5927, 6099,
+		2971, 3018, 3021, 3047, 5257, 7429, 7238, 7136, 6971, 6816, 3651, 6670, 6872,
+		4345, 4410, 4397, 4390, 5573, 6528, 6539, 6471, 6346, 6205, 7066, 4902, 6470,
+		4390, 4458, 4430, 4422, 5644, 6583, 6547, 6639, 6501, 6389, 7269, 6776, 4969,
+	},
+	{ // T3s
+		1710, 3430, 3380, 3361, 2709, 3773, 3711, 3755, 3791, 3710, 3709, 2840, 4040,
+		3674, 1705, 3342, 3308, 2771, 3708, 3751, 3764, 3757, 3783, 3779, 2890, 4133,
+		3618, 3574, 1676, 3222, 2798, 3614, 3669, 3785, 3763, 3795, 3781, 2906, 4134,
+		3596, 3545, 3445, 1642, 2822, 3520, 3612, 3684, 3777, 3771, 3758, 2938, 4137,
+		2798, 2865, 2892, 2913, 1232, 3239, 3460, 3779, 4104, 4471, 4711, 5000, 5319,
+		4032, 3972, 3869, 3766, 3385, 3144, 5374, 5549, 5655, 5770, 5886, 7001, 6279,
+		3964, 4013, 3918, 3861, 3611, 5638, 3187, 5350, 5474, 5615, 5724, 6969, 6215,
+		4009, 4026, 4039, 3938, 3959, 5813, 5604, 3256, 5440, 5611, 5705, 6869, 6301,
+		4054, 4021, 4017, 4029, 4308, 5923, 5735, 5698, 3345, 5507, 5606, 6712, 6180,
+		3971, 4053, 4057, 4032, 4711, 6056, 5889, 5882, 5774, 3394, 5468, 6594, 6077,
+		3975, 4050, 4047, 4021, 4975, 6181, 5997, 5980, 5878, 5741, 3474, 6592, 6097,
+		2939, 3002, 3005, 3042, 5255, 7427, 7382, 7271, 7106, 6981, 6988, 3666, 6902,
+		4312, 4414, 4406, 4408, 5600, 6579, 6500, 6593, 6462, 6368, 6381, 7313, 4914,
+	},
+	{ // T2s
+		1639, 3363, 3350, 3275, 2620, 3733, 3713, 3704, 3739, 3670, 3650, 3661, 2798,
+		3603, 1630, 3285, 3209, 2664, 3637, 3727, 3683, 3730, 3721, 3719, 3723, 2851,
+		3589, 3514, 1617, 3145, 2708, 3564, 3636, 3726, 3743, 3726, 3712, 3749, 2860,
+		3506, 3442, 3364, 1591, 2716, 3419, 3540, 3588, 3708, 3686, 3659, 3685, 2883,
+		2711, 2759, 2802, 2807, 1169, 3139, 3399, 3683, 4054, 4423, 4638, 4681, 5000,
+		3995, 3896, 3816, 3659, 3286, 3092, 5390, 5489, 5611, 5736, 5851, 5857, 7032,
+		3971, 3990, 3884, 3788, 3556, 5649, 3158, 5345, 5467, 5619, 5727, 5868, 6985,
+		3958, 3936, 3980, 3836, 3860, 5748, 5595, 3180, 5339, 5506, 5599, 5743, 7003,
+		4004, 3991, 3995, 3961, 4262, 5874, 5727, 5594, 3293, 5424, 5526, 5676, 6842,
+		3933, 3990, 3989, 3945, 4665, 6015, 5891, 5771, 5686, 3358, 5400, 5562, 6715,
+		3918, 3989, 3977, 3920, 4901, 6140, 6000, 5869, 5795, 5669, 3447, 5569, 6714,
+		3917, 3992, 4008, 3948, 4939, 6140, 6151, 6014, 5947, 5832, 5844, 3483, 6776,
+		2908, 2966, 2967, 2988, 5249, 7456, 7388, 7409, 7236, 7120, 7113, 7193, 3677,
+	},
+	{ // A9o
+		594, 2460, 2525, 2569, 2656, 4752, 6526, 6491, 6501, 6331, 6370, 6346, 6483,
+		2572, 2753, 5479, 5448, 5419, 6967, 5984, 5936, 5900, 5890, 5959, 5916, 6007,
+		2636, 5821, 2768, 5350, 5361, 6884, 5923, 5997, 5956, 5931, 6003, 5978, 6057,
+		2684, 5798, 5687, 2734, 5267, 6693, 5828, 5849, 5964, 5933, 5962, 5946, 6022,
+		2771, 5757, 5697, 5587, 2726, 6506, 5646, 5748, 5826, 5930, 5924, 5968, 6005,
+		5000, 7393, 7298, 7089, 6892, 2857, 6590, 6721, 6842, 6968, 7144, 7161, 7266,
+		6927, 6373, 6297, 6203, 6000, 6998, 4218, 5799, 5950, 6058, 6155, 6323, 6365,
+		6887, 6316, 6377, 6228, 6113, 7135, 6159, 4294, 5825, 5932, 6071, 6225, 6403,
+		6896, 6274, 6325, 6333, 6194, 7257, 6318, 6179, 4302, 5812, 5939, 6063, 6263,
+		6722, 6271, 6317, 6313, 6313, 7406, 6442, 6305, 6176, 4418, 5808, 5931, 6100,
+		6773, 6349, 6395, 6341, 6310, 7596, 6540, 6448, 6308, 6182, 4532, 6009, 6147,
+		6736, 6307, 6363, 6333, 6351, 7611, 6719, 6609, 6444, 6302, 6392, 4596, 6177,
+		6879, 6402, 6447, 6405, 6379, 7712, 6755, 6801, 6643, 6483, 6531, 6571, 4649,
+	},
+	{ // K9o
+		1278, 2314, 3498, 3428, 3384, 2495, 3882, 3888, 3935, 3912, 3928, 3934, 3950,
+		2391, 746, 2531, 2582, 2679, 4746, 6574, 6544, 6543, 6500, 6545, 6535, 6650,
+		3678, 2633, 2845, 5447, 5435, 6996, 6032, 6140, 6098, 6078, 6143, 6105, 6190,
+		3605, 2693, 5787, 2797, 5353, 6807, 5922, 5988, 6117, 5996, 6074, 6072, 6123,
+		3554, 2790, 5769, 5675, 2835, 6590, 5765, 5854, 5960, 6015, 6044, 6028, 6104,
+		2607, 5000, 7414, 7205, 6979, 3061, 6709, 6698, 6821, 6935, 7117, 7111, 7209,
+		4097, 6983, 6411, 6301, 6124, 7126, 4433, 5858, 6039, 6092, 6206, 6385, 6385,
+		4098, 6948, 6516, 6372, 6222, 7109, 6220, 4437, 5875, 5993, 6114, 6258, 6425,
+		4150, 6935, 6468, 6489, 6326, 7234, 6409, 6228, 4501, 5885, 5983, 6150, 6303,
+		4130, 6903, 6461, 6375, 6400, 7369, 6481, 6365, 6247, 4622, 5874, 5977, 6155,
+		4153, 6961, 6536, 6459, 6433, 7571, 6595, 6488, 6347, 6245, 4701, 6049, 6206,
+		4155, 6947, 6489, 6460, 6410, 7564, 6791, 6645, 6532, 6354, 6436, 4762, 6207,
+		4174, 7061, 6584, 6512, 6479, 7657, 6777, 6824, 6685, 6539, 6591, 6602, 4823,
+	},
+	{ // Q9o
+		1436, 3248, 2439, 3494, 3447, 2585, 3903, 3993, 4000, 3959, 3998, 3982, 4019,
+		3406, 1323, 2484, 3408, 3372, 2475, 3925, 3995, 3996, 3989, 4035, 4033, 4054,
+		2522, 2560, 898, 2574, 2658, 4752, 6586, 6561, 6563, 6531, 6570, 6567, 6682,
+		3668, 3580, 2676, 2805, 5397, 6858, 6017, 6029, 6159, 6058, 6122, 6101, 6189,
+		3617, 3539, 2763, 5719, 2776, 6710, 5862, 5942, 6040, 6136, 6118, 6131, 6184,
+		2702, 2586, 5000, 7261, 7105, 3124, 6678, 6795, 6770, 6915, 7069, 7057, 7166,
+		4111, 4135, 6982, 6394, 6222, 7092, 4379, 5952, 6071, 6159, 6255, 6391, 6458,
+		4211, 4212, 6958, 6407, 6312, 7216, 6314, 4566, 5877, 5994, 6144, 6258, 6425,
+		4220, 4211, 6952, 6532, 6412, 7180, 6440, 6231, 4551, 5854, 6007, 6137, 6315,
+		4181, 4214, 6935, 6435, 6526, 7349, 6547, 6365, 6214, 4613, 5853, 5963, 6154,
+		4230, 4264, 6979, 6508, 6508, 7521, 6643, 6520, 6375, 6222, 4747, 6051, 6188,
+		4206, 4264, 6971, 6488, 6515, 7498, 6789, 6641, 6517, 6328, 6431, 4794, 6203,
+		4244, 4283, 7089, 6577, 6560, 7608, 6848, 6817, 6695, 6535, 6569, 6594, 4854,
+	},
+	{ // J9o
+		1633, 3411, 3424, 2551, 3610, 2773, 4028, 4111, 4169, 4119, 4156, 4166, 4170,
+		3582, 1522, 3323, 2620, 3523, 2667, 4035, 4081, 4142, 4141, 4178, 4188, 4188,
+		3584, 3472, 1377, 2570, 3429, 2627, 3992, 4155, 4195, 4189, 4237, 4248, 4239,
+		2649, 2718, 2649, 1087, 2711, 4745, 6617, 6563, 6598, 6535, 6574, 6586, 6696,
+		3790, 3698, 3592, 2816, 2898, 6807, 5997, 6063, 6137, 6224, 6253, 6234, 6341,
+		2911, 2795, 2739, 5000, 7208, 3349, 6779, 6745, 6906, 6863, 7021, 7042, 7134,
+		4249, 4252, 4195, 7024, 6360, 7195, 4496, 6048, 6226, 6241, 6347, 6519, 6553,
+		4337, 4304, 4368, 6971, 6440, 7168, 6415, 4677, 6002, 6069, 6204, 6356, 6494,
+		4408, 4372, 4411, 6997, 6509, 7334, 6605, 6365, 4740, 5951, 6085, 6196, 6357,
+		4356, 4379, 4416, 6941, 6619, 7301, 6630, 6444, 6315, 4822, 5898, 6012, 6168,
+		4401, 4422, 4471, 6988, 6646, 7473, 6738, 6586, 6461, 6271, 4929, 6098, 6232,
+		4403, 4425, 4478, 7000, 6621, 7488, 6918, 6740, 6583, 6385, 6479, 4968, 6248,
+		4410, 4429, 4466, 7108, 6725, 7579, 6945, 6891, 6738, 6551, 6615, 6639, 5020,
+	},
+	{ // T9o
+		1879, 3584, 3552, 3517, 2706, 2956, 4166, 4223, 4290, 4298, 4293, 4293, 4307,
+		3763, 1744, 3483, 3440, 2792, 2878, 4205, 4237, 4294, 4351, 4345, 4378, 4368,
+		3721, 3643, 1609, 3298, 2704, 2769, 4100, 4253, 4285, 4330, 4349, 4363, 4353,
+		3682, 3601, 3444, 1430, 2623, 2685, 4041, 4167, 4311, 4351, 4348, 4373, 4361,
+		2811, 2893, 2792, 2695, 1315, 4744, 6593, 6564, 6583, 6613, 6593, 6615, 6714,
+		3108, 3021, 2895, 2792, 5000, 3520, 6843, 6844, 6844, 7023, 7002, 7034, 7109,
+		4386, 4421, 4302, 4245, 6988, 7265, 4604, 6185, 6262, 6345, 6455, 6615, 6626,
+		4446, 4456, 4465, 4379, 6965, 7267, 6555, 4714, 6077, 6211, 6306, 6465, 6612,
+		4531, 4524, 4507, 4530, 6980, 7266, 6636, 6440, 4844, 6077, 6151, 6283, 6441,
+		4542, 4600, 4567, 4588, 7030, 7478, 6736, 6591, 6452, 4966, 6072, 6107, 6280,
+		4541, 4593, 4590, 4582, 7008, 7455, 6846, 6684, 6527, 6461, 5049, 6120, 6256,
+		4532, 4625, 4598, 4609, 7025, 7480, 7016, 6851, 6671, 6486, 6501, 5084, 6302,
+		4558, 4622, 4595, 4603, 7122, 7554, 7017, 7011, 6824, 6669, 6639, 6696, 5151,
+	},
+	{ // 99
+		1910, 5320, 5356, 5315, 5334, 6735, 6842, 6829, 6844, 6679, 6736, 6713, 6752,
+		5595, 1920, 5280, 5272, 5255, 6530, 6813, 6789, 6774, 6731, 6792, 6787, 6824,
+		5634, 5549, 1889, 5204, 5246, 6478, 6829, 6888, 6858, 6824, 6880, 6890, 6900,
+		5589, 5547, 5478, 1841, 5137, 6271, 6758, 6771, 6860, 6788, 6847, 6828, 6878,
+		5611, 5526, 5528, 5401, 1797, 6113, 6717, 6766, 6818, 6826, 6871, 6856, 6908,
+		7143, 6939, 6876, 6651, 6480, 5000, 8234, 8354, 8502, 8602, 8775, 8783, 8822,
+		7204, 7179, 7198, 7126, 7076, 8726, 8186, 8129, 8197, 8154, 8251, 8411, 8415,
+		7188, 7149, 7253, 7138, 7133, 8852, 8534, 8137, 8033, 8011, 8087, 8258, 8454,
+		7207, 7132, 7215, 7219, 7180, 9011, 8611, 8428, 8112, 7836, 7963, 8093, 8281,
+		7036, 7096, 7194, 7149, 7201, 9126, 8574, 8415, 8233, 8073, 7738, 7900, 8076,
+		7098, 7163, 7251, 7213, 7246, 9323, 8666, 8492, 8362, 8130, 8122, 7973, 8119,
+		7062, 7152, 7257, 7199, 7229, 9323, 8835, 8670, 8510, 8292, 8380, 8114, 8130,
+		7113, 7200, 7278, 7253, 7275, 9362, 8837, 8877, 8699, 8486, 8530, 8545, 8214,
+	},
+	{ // 98s
+		2188, 3738, 3815, 3802, 3835, 3270, 3272, 4418, 4461, 4454, 4544, 4549, 4543,
+		3995, 2161, 3746, 3735, 3735, 3156, 3311, 4404, 4432, 4486, 4581, 4582, 4569,
+		4069, 3987, 2105, 3628, 3645, 3200, 3258, 4447, 4467, 4509, 4611, 4621, 4593,
+		4062, 3990, 3870, 1938, 3525, 3107, 3191, 4339, 4464, 4495, 4587, 4592, 4576,
+		4089, 3981, 3879, 3764, 1832, 3053, 3122, 4297, 4433, 4540, 4606, 4626, 4610,
+		3410, 3291, 3322, 3221, 3157, 1766, 5000, 6462, 6482, 6509, 6550, 6568, 6626,
+		3410, 3455, 3373, 3311, 3218, 5251, 3885, 6754, 6832, 6841, 7008, 7023, 7084,
+		4689, 4672, 4709, 4610, 4559, 6822, 7136, 4915, 6345, 6445, 6577, 6695, 6836,
+		4743, 4707, 4733, 4734, 4702, 6844, 7225, 6618, 4969, 6299, 6406, 6547, 6678,
+		4741, 4773, 4784, 4776, 4823, 6888, 7255, 6735, 6586, 5096, 6255, 6365, 6453,
+		4840, 4878, 4898, 4880, 4893, 6944, 7433, 6867, 6696, 6550, 5283, 6517, 6483,
+		4839, 4878, 4905, 4884, 4913, 6955, 7446, 6990, 6851, 6658, 6821, 5296, 6510,
+		4840, 4870, 4881, 4869, 4896, 7010, 7499, 7144, 6978, 6754, 6784, 6820, 5336,
+	},
+	{ // 97s
+		2052, 3760, 3751, 3760, 3705, 3145, 4034, 3204, 4388, 4376, 4453, 4448, 4503,
+		4020, 2098, 3691, 3691, 3640, 3164, 4036, 3240, 4356, 4389, 4463, 4458, 4517,
+		4006, 3940, 2046, 3593, 3546, 3080, 3977, 3303, 4387, 4416, 4503, 4503, 4547,
+		4019, 3947, 3837, 1944, 3464, 3132, 3912, 3206, 4412, 4429, 4510, 4507, 4555,
+		3954, 3884, 3784, 3696, 1812, 3047, 3745, 3117, 4299, 4406, 4460, 4451, 4511,
+		3279, 3302, 3205, 3255, 3156, 1646, 3538, 5000, 6188, 6220, 6259, 6271, 6354,
+		4295, 4306, 4229, 4171, 3988, 3684, 3315, 6703, 6178, 6245, 6374, 6491, 6535,
+		3333, 3373, 3428, 3328, 3218, 5247, 7082, 3847, 6649, 6676, 6859, 6884, 7098,
+		4665, 4626, 4654, 4682, 4561, 6532, 6453, 7023, 4902, 6244, 6386, 6513, 6670,
+		4659, 4674, 4698, 4708, 4683, 6580, 6537, 7074, 6530, 5020, 6189, 6304, 6460,
+		4752, 4762, 4800, 4804, 4750, 6635, 6667, 7271, 6677, 6483, 5174, 6349, 6502,
+		4733, 4752, 4794, 4796, 4736, 6635, 6788, 7294, 6812, 6593, 6652, 5193, 6532,
+		4799, 4817, 4843, 4850, 4795, 6721, 6833, 7509, 6968, 6763, 6805, 6843, 5307,
+	},
+	{ // 96s
+		1934, 3661, 3692, 3612, 3621, 3026, 3945, 3951, 3076, 4278, 4335, 4332, 4385,
+		3921, 1966, 3609, 3556, 3545, 3046, 3935, 3934, 3109, 4272, 4344, 4343, 4378,
+		3946, 3856, 1932, 3496, 3483, 3097, 3885, 4002, 3165, 4315, 4385, 4393, 4416,
+		3866, 3811, 3736, 1895, 3394, 2975, 3784, 3860, 3176, 4268, 4336, 4337, 4374,
+		3869, 3791, 3721, 3626, 1803, 3037, 3701, 3779, 3120, 4301, 4350, 4345, 4389,
+		3158, 3179, 3230, 3094, 3156, 1498, 3518, 3812, 5000, 5871, 5907, 5944, 5996,
+		4206, 4199, 4138, 4035, 3948, 3675, 3249, 5594, 6764, 6185, 6258, 6434, 6430,
+		4210, 4196, 4259, 4118, 4029, 3987, 5850, 3352, 6586, 6043, 6176, 6316, 6437,
+		3198, 3232, 3278, 3294, 3226, 5247, 7153, 6962, 3725, 6530, 6720, 6747, 6951,
+		4553, 4553, 4592, 4541, 4577, 6204, 6474, 6321, 6914, 4868, 6139, 6279, 6414,
+		4622, 4635, 4671, 4616, 4629, 6258, 6546, 6457, 7121, 6426, 5057, 6299, 6412,
+		4613, 4632, 4674, 4617, 4624, 6285, 6728, 6598, 7149, 6566, 6593, 5043, 6448,
+		4674, 4672, 4704, 4658, 4669, 6335, 6717, 6728, 7351, 6712, 6705, 6746, 5151,
+	},
+	{ // 95s
+		1814, 3579, 3591, 3536, 3508, 2925, 3832, 3857, 3862, 2952, 4166, 4148, 4183,
+		3832, 1851, 3532, 3545, 3470, 2949, 3896, 3873, 3870, 3027, 4275, 4278, 4309,
+		3838, 3771, 1834, 3429, 3363, 2973, 3790, 3909, 3876, 3075, 4279, 4280, 4305,
+		3781, 3793, 3663, 1783, 3314, 3023, 3743, 3788, 3882, 3081, 4267, 4269, 4298,
+		3751, 3711, 3596, 3541, 1777, 2877, 3617, 3683, 3781, 3142, 4228, 4230, 4264,
+		3032, 3065, 3085, 3137, 2977, 1398, 3491, 3780, 4129, 5000, 5591, 5607, 5650,
+		4090, 4161, 4039, 3997, 3860, 3644, 3244, 5487, 5665, 6734, 6187, 6331, 6323,
+		4115, 4129, 4160, 4043, 3931, 3950, 5746, 3338, 5517, 6580, 6101, 6226, 6363,
+		4119, 4122, 4126, 4130, 4031, 4321, 5932, 5773, 3355, 6447, 5973, 6132, 6263,
+		3067, 3149, 3186, 3194, 3257, 5252, 7139, 6969, 6823, 3699, 6511, 6556, 6761,
+		4446, 4557, 4554, 4537, 4503, 5910, 6476, 6381, 6251, 6909, 4942, 6259, 6389,
+		4421, 4555, 4552, 4540, 4503, 5915, 6628, 6512, 6419, 6941, 6550, 4961, 6444,
+		4463, 4593, 4583, 4573, 4537, 5957, 6612, 6658, 6547, 7160, 6679, 6747, 5068,
+	},
+	{ // 94s
+		1650, 3413, 3452, 3401, 3391, 2761, 3730, 3728, 3765, 3669, 2827, 4009, 4055,
+		3656, 1701, 3397, 3356, 3329, 2785, 3758, 3731, 3761, 3732, 2904, 4109, 4142,
+		3695, 3631, 1657, 3296, 3295, 2835, 3707, 3776, 3782, 3782, 2957, 4152, 4192,
+		3644, 3597, 3528, 1640, 3190, 2876, 3615, 3647, 3755, 3723, 2952, 4105, 4129,
+		3629, 3562, 3524, 3416, 1619, 2895, 3500, 3568, 3687, 3759, 3001, 4114, 4149,
+		2856, 2883, 2931, 2979, 2998, 1225, 3450, 3741, 4093, 4409, 5000, 5277, 5341,
+		3985, 4019, 3956, 3867, 3737, 3603, 3169, 5341, 5519, 5628, 6720, 6220, 6215,
+		3982, 3987, 4031, 3903, 3812, 3913, 5589, 3261, 5390, 5533, 6604, 6107, 6265,
+		4018, 4014, 4029, 4002, 3929, 4283, 5778, 5638, 3270, 5466, 6495, 6035, 6179,
+		3924, 3993, 4040, 3977, 4017, 4633, 5905, 5798, 5727, 3378, 6317, 5864, 5998,
+		2932, 3015, 3063, 3053, 3103, 5257, 7122, 6989, 6870, 6697, 3636, 6577, 6779,
+		4274, 4382, 4422, 4375, 4381, 5556, 6511, 6387, 6314, 6136, 6969, 4831, 6422,
+		4327, 4421, 4467, 4401, 4415, 5622, 6502, 6555, 6458, 6280, 7175, 6723, 4924,
+	},
+	{ // 93s
+		1625, 3437, 3455, 3411, 3350, 2742, 3701, 3740, 3775, 3684, 3711, 2828, 4067,
+		3685, 1684, 3414, 3363, 3342, 2786, 3733, 3760, 3753, 3773, 3801, 2905, 4171,
+		3703, 3655, 1643, 3306, 3247, 2837, 3687, 3802, 3776, 3800, 3811, 2945, 4189,
+		3653, 3606, 3542, 1630, 3182, 2853, 3591, 3658, 3751, 3751, 3756, 2953, 4140,
+		3585, 3578, 3477, 3408, 1615, 2863, 3470, 3571, 3660, 3778, 3768, 2999, 4143,
+		2839, 2889, 2943, 2958, 2966, 1217, 3432, 3729, 4056, 4393, 4723, 5000, 5320,
+		3956, 3997, 3939, 3845, 3708, 3590, 3130, 5276, 5455, 5561, 5675, 6875, 6194,
+		3993, 4019, 4059, 3914, 3818, 3906, 5527, 3242, 5364, 5507, 5658, 6740, 6267,
+		4034, 4009, 4027, 4002, 3905, 4252, 5714, 5614, 3267, 5439, 5575, 6628, 6163,
+		3943, 4037, 4063, 4009, 4036, 4627, 5837, 5773, 5699, 3367, 5454, 6463, 6006,
+		3978, 4073, 4082, 4020, 4032, 4991, 5953, 5931, 5841, 5727, 3463, 6530, 6082,
+		2930, 3018, 3049, 3058, 3101, 5253, 7287, 7141, 7020, 6843, 6926, 3673, 6791,
+		4339, 4451, 4466, 4411, 4408, 5606, 6484, 6559, 6446, 6294, 6372, 7196, 4924,
+	},
+	{ // 92s
+		1597, 3378, 3393, 3339, 3329, 2641, 3667, 3671, 3724, 3640, 3661, 3644, 2817,
+		3619, 1636, 3342, 3307, 3291, 2690, 3720, 3692, 3733, 3716, 3736, 3747, 2880,
+		3632, 3572, 1627, 3210, 3209, 2734, 3630, 3735, 3738, 3734, 3762, 3767, 2916,
+		3576, 3547, 3439, 1585, 3112, 2768, 3561, 3612, 3727, 3710, 3713, 3725, 2922,
+		3564, 3527, 3437, 3332, 1565, 2782, 3455, 3523, 3637, 3726, 3716, 3721, 2968,
+		2734, 2791, 2834, 2866, 2891, 1178, 3374, 3646, 4004, 4350, 4659, 4680, 5000,
+		3920, 3982, 3876, 3810, 3697, 3534, 3121, 5285, 5466, 5575, 5693, 5839, 6896,
+		3921, 3942, 3986, 3863, 3768, 3817, 5533, 3191, 5268, 5418, 5568, 5696, 6879,
+		3982, 3985, 3987, 3978, 3887, 4200, 5724, 5515, 3243, 5371, 5506, 5648, 6765,
+		3899, 3979, 3994, 3967, 3988, 4582, 5849, 5680, 5627, 3352, 5408, 5513, 6581,
+		3928, 4005, 4027, 3973, 3979, 4924, 5969, 5834, 5770, 5677, 3459, 5602, 6643,
+		3899, 4013, 4027, 3984, 3982, 4934, 6122, 5966, 5919, 5781, 5878, 3503, 6655,
+		2923, 2992, 3019, 3024, 3067, 5251, 7303, 7282, 7158, 6976, 7044, 7061, 3675,
+	},
+	{ // A8o
+		672, 2506, 2559, 2578, 2711, 2935, 4754, 6319, 6244, 6145, 6133, 6170, 6239,
+		2624, 2767, 5428, 5423, 5400, 5551, 7095, 6005, 5913, 5935, 5961, 5983, 6008,
+		2678, 5763, 2843, 5335, 5355, 5539, 6989, 6039, 5961, 5966, 5983, 6021, 6055,
+		2696, 5766, 5662, 2801, 5267, 5419, 6864, 5923, 5985, 5971, 5953, 6010, 6008,
+		2836, 5732, 5681, 5580, 2791, 5282, 6694, 5823, 5840, 5981, 5932, 6036, 6029,
+		3073, 5903, 5889, 5751, 5614, 2796, 6590, 5705, 5794, 5910, 6015, 6044, 6080,
+		5000, 7524, 7397, 7270, 7077, 6973, 2957, 6582, 6706, 6840, 6992, 7182, 7226,
+		6698, 6386, 6419, 6297, 6184, 6066, 6981, 4297, 5865, 5998, 6124, 6283, 6458,
+		6621, 6285, 6326, 6350, 6203, 6157, 7115, 6221, 4379, 5814, 5963, 6108, 6268,
+		6523, 6324, 6356, 6350, 6368, 6288, 7271, 6376, 6180, 4448, 5806, 5979, 6117,
+		6512, 6344, 6368, 6325, 6310, 6400, 7422, 6503, 6331, 6179, 4584, 6036, 6144,
+		6541, 6379, 6408, 6393, 6419, 6428, 7626, 6667, 6490, 6357, 6415, 4671, 6215,
+		6619, 6400, 6447, 6388, 6403, 6464, 7668, 6857, 6655, 6512, 6525, 6613, 4723,
+	},
+	{ // K8o
+		1184, 2369, 3406, 3343, 3309, 3450, 2373, 3780, 3812, 3796, 3829, 3871, 3849,
+		2451, 635, 2515, 2569, 2681, 2881, 4748, 6298, 6238, 6248, 6257, 6284, 6342,
+		3583, 2623, 2818, 5330, 5334, 5515, 6969, 6065, 6005, 6002, 6034, 6024, 6060,
+		3515, 2686, 5657, 2773, 5249, 5417, 6833, 5930, 6026, 5946, 5968, 6000, 5997,
+		3478, 2802, 5657, 5562, 2788, 5250, 6645, 5804, 5864, 5953, 5938, 5987, 6010,
+		3627, 3017, 5865, 5748, 5579, 2821, 6545, 5694, 5801, 5839, 5981, 6003, 6018,
+		2476, 5000, 7378, 7239, 7028, 6928, 3023, 6545, 6681, 6795, 6964, 7111, 7162,
+		3979, 6684, 6437, 6301, 6164, 6052, 6940, 4336, 5868, 6009, 6117, 6256, 6421,
+		4022, 6610, 6367, 6392, 6224, 6162, 7091, 6224, 4424, 5844, 5987, 6134, 6260,
+		4007, 6637, 6381, 6321, 6338, 6212, 7226, 6379, 6204, 4511, 5809, 5969, 6120,
+		4043, 6647, 6417, 6342, 6314, 6367, 7395, 6490, 6353, 6175, 4604, 6033, 6149,
+		4089, 6680, 6409, 6387, 6369, 6389, 7567, 6644, 6519, 6350, 6417, 4708, 6179,
+		4070, 6732, 6450, 6376, 6380, 6401, 7601, 6817, 6644, 6507, 6531, 6580, 4768,
+	},
+	{ // Q8o
+		1372, 3187, 2489, 3409, 3404, 3526, 2490, 3909, 3903, 3875, 3915, 3943, 3943,
+		3347, 1318, 2353, 3341, 3319, 3417, 2505, 3882, 3868, 3893, 3923, 3967, 3963,
+		2580, 2428, 823, 2576, 2686, 2891, 4754, 6354, 6299, 6312, 6303, 6332, 6398,
+		3585, 3510, 2683, 2796, 5314, 5471, 6921, 5980, 6096, 6020, 6031, 6049, 6092,
+		3585, 3489, 2805, 5628, 2763, 5365, 6778, 5902, 5983, 6072, 6049, 6082, 6116,
+		3703, 3589, 3018, 5805, 5698, 2802, 6627, 5771, 5862, 5961, 6044, 6061, 6124,
+		2603, 2622, 5000, 7324, 7166, 7014, 3096, 6662, 6647, 6789, 6921, 7058, 7120,
+		4123, 4098, 6738, 6350, 6268, 6133, 7069, 4466, 5871, 6022, 6146, 6279, 6436,
+		4123, 4081, 6668, 6464, 6350, 6220, 7053, 6230, 4476, 5845, 6004, 6141, 6274,
+		4094, 4113, 6700, 6395, 6463, 6339, 7219, 6398, 6203, 4525, 5815, 5974, 6143,
+		4142, 4148, 6692, 6405, 6428, 6427, 7352, 6527, 6373, 6182, 4661, 6021, 6125,
+		4172, 4201, 6721, 6431, 6469, 6443, 7500, 6662, 6521, 6344, 6397, 4768, 6181,
+		4172, 4197, 6791, 6475, 6492, 6510, 7560, 6832, 6657, 6529, 6507, 6576, 4805,
+	},
+	{ // J8o
+		1523, 3297, 3271, 2558, 3494, 3614, 2606, 3945, 4000, 3947, 4002, 4024, 4025,
+		3462, 1463, 3200, 2452, 3417, 3520, 2635, 3940, 3988, 3981, 4029, 4068, 4064,
+		3430, 3343, 1379, 2498, 3309, 3435, 2567, 3965, 3996, 3990, 4043, 4074, 4064,
+		2657, 2541, 2579, 932, 2677, 2854, 4748, 6311, 6306, 6305, 6300, 6347, 6400,
+		3676, 3591, 3477, 2791, 2849, 5420, 6833, 5979, 6029, 6123, 6106, 6139, 6212,
+		3797, 3699, 3606, 2976, 5755, 2874, 6689, 5829, 5965, 6003, 6133, 6155, 6190,
+		2730, 2761, 2676, 5000, 7227, 7077, 3216, 6574, 6739, 6738, 6867, 7051, 7090,
+		4159, 4156, 4175, 6694, 6352, 6192, 6978, 4501, 5963, 6064, 6162, 6324, 6455,
+		4226, 4211, 4209, 6686, 6398, 6332, 7160, 6328, 4580, 5886, 6015, 6157, 6294,
+		4170, 4207, 4209, 6699, 6513, 6385, 7169, 6437, 6248, 4614, 5824, 6006, 6141,
+		4237, 4263, 4270, 6688, 6490, 6523, 7293, 6544, 6390, 6191, 4772, 6072, 6153,
+		4256, 4303, 4304, 6742, 6525, 6543, 7496, 6707, 6546, 6379, 6453, 4844, 6217,
+		4260, 4304, 4292, 6794, 6591, 6576, 7526, 6848, 6677, 6524, 6534, 6613, 4899,
+	},
+	{ // T8o
+		1722, 3464, 3439, 3395, 2742, 3811, 2786, 4101, 4158, 4158, 4164, 4217, 4192,
+		3640, 1674, 3338, 3295, 2622, 3694, 2832, 4074, 4124, 4183, 4191, 4250, 4222,
+		3611, 3496, 1568, 3185, 2641, 3604, 2714, 4100, 4128, 4195, 4200, 4255, 4222,
+		3558, 3454, 3332, 1430, 2574, 3471, 2661, 3984, 4118, 4156, 4163, 4212, 4194,
+		2847, 2718, 2733, 2654, 1134, 2895, 4751, 6333, 6315, 6361, 6335, 6389, 6444,
+		4000, 3876, 3778, 3640, 3012, 2924, 6782, 6012, 6052, 6140, 6263, 6292, 6303,
+		2923, 2972, 2834, 2773, 5000, 7175, 3408, 6649, 6682, 6851, 6819, 7007, 7047,
+		4317, 4292, 4308, 4190, 6710, 6373, 7051, 4588, 6035, 6179, 6273, 6440, 6592,
+		4391, 4352, 4346, 4333, 6692, 6421, 7093, 6395, 4720, 5993, 6085, 6261, 6384,
+		4398, 4427, 4430, 4385, 6758, 6525, 7293, 6555, 6365, 4822, 5895, 6085, 6214,
+		4404, 4432, 4435, 4394, 6728, 6654, 7247, 6654, 6461, 6266, 4901, 6091, 6191,
+		4455, 4499, 4492, 4449, 6782, 6680, 7450, 6823, 6650, 6460, 6468, 4978, 6278,
+		4439, 4475, 4467, 4433, 6831, 6688, 7481, 6985, 6771, 6603, 6571, 6676, 5031,
+	},
+	{ // 98o
+		1796, 3445, 3524, 3519, 3549, 2880, 2883, 4151, 4190, 4179, 4276, 4274, 4275,
+		3621, 1770, 3450, 3451, 3448, 2761, 2928, 4136, 4161, 4212, 4310, 4309, 4299,
+		3699, 3610, 1705, 3340, 3358, 2802, 2860, 4176, 4194, 4233, 4336, 4348, 4323,
+		3697, 3621, 3498, 1535, 3235, 2712, 2801, 4075, 4201, 4231, 4324, 4330, 4316,
+		3718, 3605, 3506, 3384, 1413, 2653, 2723, 4031, 4166, 4275, 4343, 4362, 4351,
+		3002, 2874, 2908, 2805, 2735, 1274, 4749, 6316, 6325, 6356, 6397, 6410, 6466,
+		3027, 3072, 2986, 2923, 2825, 5000, 3525, 6650, 6724, 6735, 6908, 6921, 6978,
+		4369, 4349, 4384, 4290, 4234, 6688, 7053, 4660, 6107, 6211, 6347, 6468, 6613,
+		4421, 4384, 4409, 4415, 4379, 6699, 7138, 6466, 4718, 6057, 6165, 6310, 6440,
+		4417, 4449, 4459, 4459, 4503, 6744, 7165, 6588, 6427, 4846, 6000, 6111, 6210,
+		4526, 4561, 4579, 4568, 4578, 6793, 7349, 6726, 6539, 6375, 5049, 6274, 6237,
+		4520, 4559, 4588, 4574, 4599, 6805, 7362, 6852, 6699, 6485, 6662, 5065, 6265,
+		4529, 4557, 4567, 4562, 4587, 6858, 7411, 7013, 6828, 6592, 6623, 6660, 5114,
+	},
+	{ // 88
+		1985, 5294, 5333, 5316, 5295, 5499, 6643, 6792, 6816, 6645, 6656, 6690, 6713,
+		5569, 1940, 5146, 5161, 5155, 5292, 6572, 6703, 6708, 6651, 6675, 6725, 6744,
+		5605, 5403, 1928, 5134, 5137, 5335, 6512, 6828, 6808, 6750, 6785, 6834, 6834,
+		5591, 5426, 5394, 1868, 5083, 5235, 6403, 6748, 6838, 6766, 6777, 6822, 6837,
+		5562, 5412, 5395, 5332, 1860, 5124, 6226, 6698, 6765, 6769, 6776, 6813, 6842,
+		5782, 5567, 5621, 5504, 5396, 1814, 6115, 6685, 6751, 6756, 6831, 6870, 6879,
+		7043, 6977, 6904, 6784, 6592, 6475, 5000, 8145, 8365, 8443, 8592, 8774, 8793,
+		7148, 7060, 7186, 7104, 7051, 7042, 8624, 8184, 8171, 8153, 8183, 8268, 8484,
+		7179, 7060, 7161, 7193, 7114, 7106, 8861, 8577, 8109, 7975, 8043, 8111, 8313,
+		6994, 7011, 7112, 7124, 7130, 7113, 8952, 8568, 8379, 8106, 7822, 7923, 8094,
+		7014, 7037, 7148, 7130, 7138, 7201, 9118, 8596, 8444, 8218, 8099, 7973, 8083,
+		7038, 7091, 7202, 7188, 7178, 7237, 9313, 8682, 8524, 8318, 8381, 8142, 8170,
+		7067, 7108, 7203, 7200, 7192, 7243, 9322, 8904, 8722, 8510, 8489, 8584, 8182,
+	},
+	{ // 87s
+		2303, 3896, 3898, 3875, 3874, 3944, 3280, 3383, 4475, 4458, 4519, 4610, 4600,
+		4159, 2253, 3742, 3815, 3826, 3881, 3304, 3384, 4437, 4459, 4532, 4611, 4608,
+		4153, 3996, 2212, 3720, 3732, 3799, 3208, 3422, 4450, 4476, 4551, 4623, 4610,
+		4133, 4079, 3970, 2142, 3648, 3705, 3295, 3334, 4470, 4479, 4548, 4625, 4626,
+		4123, 4079, 3979, 3890, 2006, 3578, 3233, 3305, 4441, 4531, 4574, 4650, 4655,
+		4201, 4142, 4048, 3952, 3815, 1871, 3246, 3297, 4406, 4513, 4659, 4724, 4715,
+		3418, 3455, 3338, 3426, 3351, 3350, 1855, 5000, 6178, 6213, 6244, 6307, 6347,
+		3521, 3530, 3559, 3467, 3426, 3402, 5245, 3969, 6637, 6694, 6739, 6930, 6976,
+		4753, 4716, 4723, 4741, 4712, 4675, 6522, 7012, 4956, 6299, 6424, 6561, 6672,
+		4738, 4748, 4760, 4758, 4815, 4794, 6572, 7089, 6582, 5020, 6216, 6371, 6477,
+		4809, 4828, 4841, 4837, 4864, 4952, 6606, 7139, 6714, 6506, 5188, 6389, 6491,
+		4902, 4918, 4922, 4924, 4947, 5019, 6672, 7348, 6859, 6660, 6689, 5334, 6607,
+		4893, 4914, 4910, 4925, 4947, 5012, 6711, 7383, 6969, 6782, 6791, 6927, 5362,
+	},
+	{ // 86s
+		2077, 3802, 3811, 3731, 3788, 3796, 3161, 4071, 3225, 4376, 4441, 4517, 4515,
+		4065, 2057, 3710, 3665, 3708, 3713, 3181, 4026, 3222, 4345, 4414, 4487, 4478,
+		4072, 3960, 2072, 3599, 3644, 3683, 3222, 4090, 3285, 4387, 4448, 4527, 4513,
+		3985, 3918, 3843, 2027, 3537, 3537, 3139, 3931, 3283, 4311, 4391, 4456, 4459,
+		4043, 3958, 3889, 3775, 1942, 3500, 3197, 3918, 3278, 4412, 4457, 4526, 4533,
+		4050, 3961, 3929, 3774, 3738, 1803, 3168, 3822, 3236, 4335, 4481, 4545, 4534,
+		3294, 3319, 3353, 3261, 3318, 3276, 1635, 3822, 5000, 5863, 5901, 5978, 6021,
+		4340, 4290, 4353, 4187, 4176, 4077, 3985, 3318, 6571, 6088, 6215, 6352, 6468,
+		3356, 3351, 3409, 3407, 3401, 3343, 5244, 6944, 3844, 6486, 6553, 6749, 6793,
+		4661, 4627, 4667, 4583, 4698, 4608, 6193, 6363, 6866, 4897, 6155, 6326, 6428,
+		4733, 4703, 4734, 4671, 4743, 4764, 6235, 6498, 6940, 6441, 5069, 6336, 6394,
+		4811, 4784, 4815, 4745, 4816, 4832, 6315, 6632, 7154, 6611, 6631, 5184, 6434,
+		4809, 4775, 4806, 4747, 4822, 4821, 6352, 6759, 7184, 6725, 6685, 6736, 5232,
+	},
+	{ // 85s
+		2005, 3709, 3706, 3657, 3661, 3696, 3046, 3980, 3978, 3100, 4254, 4316, 4312,
+		3966, 1993, 3634, 3641, 3618, 3660, 3080, 3939, 3929, 3139, 4315, 4384, 4369,
+		3959, 3882, 2012, 3557, 3555, 3598, 3096, 3980, 3951, 3197, 4328, 4398, 4380,
+		3906, 3897, 3799, 1963, 3477, 3520, 3142, 3843, 3937, 3182, 4298, 4359, 4351,
+		3907, 3866, 3802, 3715, 1942, 3423, 3044, 3798, 3893, 3285, 4315, 4385, 4381,
+		3942, 3908, 3841, 3759, 3655, 1846, 3159, 3755, 3815, 3266, 4372, 4439, 4425,
+		3160, 3205, 3211, 3262, 3149, 3265, 1557, 3787, 4137, 5000, 5572, 5667, 5696,
+		4236, 4199, 4236, 4102, 4050, 4001, 3953, 3303, 5537, 6585, 6150, 6272, 6393,
+		4240, 4192, 4210, 4195, 4151, 4068, 4333, 5789, 3390, 6375, 5979, 6132, 6240,
+		3227, 3269, 3320, 3307, 3421, 3382, 5254, 6974, 6747, 3779, 6339, 6556, 6583,
+		4530, 4599, 4605, 4574, 4590, 4649, 5875, 6430, 6253, 6716, 4953, 6270, 6333,
+		4595, 4672, 4681, 4642, 4666, 4718, 5981, 6552, 6416, 6944, 6555, 5068, 6363,
+		4593, 4658, 4663, 4632, 4658, 4704, 6002, 6684, 6521, 6980, 6616, 6660, 5116,
+	},
+	{ // 84s
+		1836, 3578, 3602, 3560, 3555, 3594, 2897, 3879, 3893, 3810, 3011, 4219, 4213,
+		3829, 1836, 3518, 3512, 3501, 3543, 2921, 3840, 3833, 3838, 3047, 4265, 4251,
+		3853, 3763, 1827, 3451, 3456, 3499, 2973, 3875, 3864, 3883, 3098, 4308, 4297,
+		3811, 3762, 3692, 1792, 3375, 3412, 3016, 3777, 3870, 3848, 3091, 4279, 4270,
+		3801, 3746, 3701, 3614, 1787, 3308, 3067, 3685, 3785, 3872, 3163, 4276, 4273,
+		3845, 3794, 3745, 3653, 3545, 1749, 2992, 3626, 3742, 3813, 3280, 4325, 4307,
+		3008, 3036, 3079, 3133, 3181, 3092, 1408, 3756, 4099, 4428, 5000, 5356, 5396,
+		4138, 4098, 4134, 4036, 3938, 3871, 3922, 3250, 5410, 5589, 6610, 6158, 6308,
+		4153, 4092, 4116, 4123, 4039, 3991, 4289, 5658, 3325, 5454, 6440, 6049, 6163,
+		4076, 4106, 4145, 4109, 4143, 4070, 4658, 5854, 5711, 3387, 6269, 5902, 6011,
+		3134, 3172, 3221, 3213, 3292, 3410, 5253, 7000, 6814, 6646, 3760, 6572, 6601,
+		4496, 4550, 4590, 4563, 4558, 4606, 5647, 6438, 6327, 6177, 6971, 5000, 6359,
+		4496, 4541, 4583, 4554, 4553, 4592, 5683, 6599, 6443, 6297, 6996, 6661, 5012,
+	},
+	{ // 83s
+		1655, 3451, 3459, 3409, 3374, 3436, 2721, 3750, 3742, 3676, 3718, 2877, 4071,
+		3693, 1654, 3417, 3393, 3371, 3372, 2788, 3732, 3690, 3729, 3778, 2914, 4141,
+		3704, 3656, 1658, 3355, 3322, 3365, 2840, 3775, 3733, 3768, 3809, 2966, 4174,
+		3652, 3637, 3594, 1616, 3228, 3246, 2847, 3650, 3724, 3717, 3742, 2957, 4136,
+		3608, 3606, 3559, 3458, 1620, 3158, 2897, 3566, 3623, 3743, 3754, 3031, 4132,
+		3677, 3615, 3609, 3481, 3385, 1589, 2977, 3509, 3566, 3669, 3780, 3125, 4161,
+		2818, 2889, 2942, 2949, 2993, 3079, 1226, 3693, 4022, 4333, 4644, 5000, 5288,
+		4006, 3987, 4032, 3908, 3815, 3755, 3865, 3164, 5286, 5463, 5594, 6608, 6183,
+		3999, 3945, 3985, 3980, 3872, 3814, 4220, 5532, 3243, 5318, 5471, 6442, 6023,
+		3936, 3989, 4031, 3977, 4008, 3927, 4562, 5725, 5572, 3293, 5330, 6312, 5891,
+		3983, 4041, 4078, 4008, 4017, 4049, 4896, 5863, 5733, 5591, 3427, 6358, 5944,
+		2985, 3028, 3076, 3068, 3144, 3239, 5250, 7001, 6822, 6685, 6742, 3658, 6598,
+		4348, 4423, 4457, 4416, 4401, 4439, 5571, 6474, 6303, 6180, 6229, 6996, 4905,
+	},
+	{ // 82s
+		1671, 3428, 3422, 3397, 3388, 3393, 2677, 3691, 3730, 3667, 3682, 3720, 2859,
+		3675, 1645, 3370, 3379, 3352, 3371, 2733, 3679, 3704, 3711, 3737, 3789, 2894,
+		3662, 3607, 1682, 3287, 3290, 3307, 2782, 3707, 3719, 3728, 3759, 3803, 2931,
+		3640, 3624, 3518, 1617, 3203, 3220, 2806, 3617, 3732, 3709, 3731, 3773, 2942,
+		3626, 3593, 3527, 3433, 1596, 3144, 2849, 3539, 3649, 3738, 3738, 3785, 3015,
+		3635, 3615, 3542, 3447, 3374, 1585, 2916, 3465, 3570, 3677, 3785, 3806, 3104,
+		2774, 2838, 2880, 2910, 2953, 3022, 1207, 3653, 3979, 4304, 4604, 4712, 5000,
+		3942, 3933, 3958, 3872, 3786, 3706, 3822, 3097, 5204, 5383, 5510, 5652, 6763,
+		3987, 3961, 3968, 3987, 3905, 3820, 4177, 5449, 3215, 5262, 5414, 5570, 6585,
+		3928, 3976, 3983, 3967, 4004, 3933, 4534, 5641, 5512, 3270, 5286, 5461, 6434,
+		3947, 4007, 4022, 3996, 4003, 4051, 4856, 5776, 5674, 5546, 3410, 5516, 6455,
+		3981, 4064, 4067, 4041, 4050, 4069, 4970, 5919, 5838, 5726, 5785, 3492, 6514,
+		2971, 3013, 3043, 3054, 3130, 3219, 5249, 7162, 6965, 6828, 6845, 6912, 3671,
+	},
+	{ // A7o
+		664, 2495, 2535, 2612, 2718, 2972, 3155, 4756, 5952, 5815, 5813, 5813, 5913,
+		2617, 2813, 5440, 5433, 5407, 5552, 5667, 7081, 5947, 5957, 5964, 5977, 6029,
+		2656, 5773, 2809, 5253, 5273, 5450, 5545, 7112, 5972, 5943, 5999, 5999, 6048,
+		2733, 5776, 5572, 2813, 5205, 5337, 5507, 6927, 5959, 5936, 5939, 5982, 6019,
+		2842, 5736, 5591, 5515, 2794, 5223, 5366, 6790, 5848, 5977, 5922, 5991, 6042,
+		3113, 5902, 5789, 5663, 5554, 2812, 5311, 6667, 5790, 5885, 6018, 6007, 6079,
+		3302, 6021, 5877, 5841, 5683, 5631, 2852, 6479, 5660, 5764, 5862, 5994, 6058,
+		5000, 7500, 7525, 7335, 7179, 7053, 6844, 2947, 6575, 6708, 6882, 7072, 7278,
+		6309, 6317, 6336, 6323, 6214, 6150, 6007, 6969, 4273, 5814, 5963, 6132, 6290,
+		6172, 6345, 6329, 6313, 6360, 6259, 6132, 7126, 6177, 4382, 5807, 5970, 6121,
+		6169, 6347, 6384, 6313, 6301, 6403, 6229, 7306, 6335, 6183, 4493, 6029, 6184,
+		6164, 6369, 6382, 6365, 6371, 6383, 6366, 7509, 6512, 6346, 6411, 4560, 6247,
+		6271, 6419, 6431, 6400, 6415, 6461, 6426, 7720, 6672, 6509, 6568, 6644, 4709,
+	},
+	{ // K7o
+		1232, 2342, 3419, 3388, 3352, 3504, 3441, 2397, 3817, 3786, 3804, 3838, 3907,
+		2425, 673, 2520, 2613, 2696, 2915, 3163, 4750, 5953, 5957, 5950, 5949, 6048,
+		3599, 2639, 2803, 5264, 5274, 5449, 5566, 7126, 6019, 6022, 6053, 6025, 6094,
+		3564, 2738, 5584, 2799, 5208, 5368, 5506, 6931, 6024, 5970, 5969, 5994, 6041,
+		3525, 2817, 5589, 5520, 2820, 5218, 5389, 6766, 5899, 6000, 5963, 5974, 6064,
+		3684, 3052, 5788, 5696, 5544, 2851, 5328, 6627, 5804, 5871, 6013, 5981, 6058,
+		3614, 3316, 5902, 5844, 5708, 5651, 2940, 6470, 5710, 5801, 5902, 6013, 6067,
+		2500, 5000, 7537, 7344, 7158, 7012, 6838, 3059, 6526, 6673, 6833, 6989, 7210,
+		4029, 6308, 6384, 6391, 6265, 6160, 6065, 6921, 4409, 5841, 6003, 6139, 6305,
+		3997, 6327, 6405, 6349, 6381, 6245, 6171, 7091, 6209, 4465, 5835, 5996, 6168,
+		4024, 6320, 6436, 6345, 6338, 6396, 6275, 7256, 6372, 6209, 4589, 6024, 6197,
+		4052, 6322, 6407, 6382, 6355, 6359, 6400, 7429, 6521, 6379, 6409, 4666, 6225,
+		4130, 6414, 6480, 6422, 6437, 6440, 6437, 7652, 6686, 6557, 6581, 6625, 4791,
+	},
+	{ // Q7o
+		1222, 3073, 2339, 3322, 3291, 3447, 3413, 2373, 3772, 3729, 3767, 3806, 3861,
+		3225, 1190, 2367, 3229, 3192, 3308, 3384, 2357, 3725, 3724, 3758, 3796, 3862,
+		2419, 2449, 652, 2553, 2670, 2906, 3119, 4757, 5937, 5937, 5947, 5938, 6024,
+		3493, 3402, 2668, 2740, 5156, 5305, 5491, 6842, 5958, 5900, 5934, 5934, 5986,
+		3462, 3358, 2792, 5465, 2693, 5203, 5370, 6740, 5865, 5988, 5969, 5961, 6020,
+		3623, 3484, 3042, 5632, 5535, 2747, 5291, 6572, 5741, 5840, 5969, 5941, 6014,
+		3581, 3563, 3262, 5825, 5692, 5616, 2814, 6441, 5647, 5764, 5866, 5968, 6042,
+		2475, 2463, 5000, 7243, 7130, 6954, 6806, 2999, 6469, 6633, 6801, 6929, 7138,
+		3981, 3933, 6282, 6321, 6233, 6096, 5996, 6862, 4345, 5786, 5982, 6112, 6222,
+		3941, 3942, 6302, 6272, 6375, 6216, 6133, 7050, 6150, 4380, 5800, 5931, 6117,
+		3981, 3977, 6311, 6308, 6348, 6351, 6235, 7224, 6355, 6174, 4475, 5975, 6151,
+		4018, 4022, 6300, 6312, 6341, 6316, 6343, 7360, 6489, 6304, 6356, 4597, 6171,
+		4082, 4091, 6383, 6362, 6389, 6393, 6411, 7573, 6598, 6500, 6533, 6565, 4760,
+	},
+	{ // J7o
+		1406, 3204, 3200, 2446, 3426, 3595, 3529, 2552, 3946, 3879, 3904, 3944, 3986,
+		3357, 1357, 3140, 2494, 3355, 3459, 3521, 2546, 3914, 3882, 3918, 3959, 4012,
+		3356, 3287, 1320, 2384, 3248, 3423, 3479, 2639, 3949, 3918, 3964, 4001, 4039,
+		2531, 2583, 2464, 824, 2691, 2904, 3160, 4752, 6000, 5998, 5996, 5994, 6088,
+		3596, 3520, 3411, 2804, 2837, 5294, 5487, 6838, 5964, 6073, 6064, 6062, 6164,
+		3772, 3628, 3593, 3029, 5621, 2862, 5390, 6672, 5882, 5957, 6097, 6086, 6137,
+		3703, 3699, 3650, 3306, 5810, 5710, 2896, 6533, 5813, 5898, 5964, 6092, 6128,
+		2665, 2656, 2757, 5000, 7234, 7059, 6904, 3208, 6592, 6601, 6746, 6924, 7132,
+		4165, 4126, 4166, 6354, 6331, 6242, 6175, 6999, 4549, 5856, 6007, 6153, 6317,
+		4091, 4098, 4139, 6365, 6459, 6333, 6268, 7019, 6223, 4547, 5839, 5976, 6151,
+		4129, 4143, 4193, 6364, 6446, 6483, 6337, 7171, 6382, 6213, 4675, 6020, 6197,
+		4161, 4181, 4230, 6364, 6445, 6464, 6468, 7358, 6536, 6351, 6403, 4750, 6252,
+		4214, 4244, 4274, 6454, 6541, 6517, 6497, 7569, 6698, 6536, 6576, 6647, 4888,
+	},
+	{ // T7o
+		1600, 3369, 3336, 3294, 2595, 3703, 3640, 2696, 4040, 4025, 4023, 4063, 4097,
+		3539, 1559, 3263, 3243, 2626, 3601, 3656, 2715, 4019, 4060, 4052, 4098, 4138,
+		3502, 3419, 1532, 3107, 2480, 3516, 3564, 2746, 4013, 4049, 4055, 4100, 4130,
+		3451, 3395, 3248, 1418, 2559, 3400, 3483, 2655, 4026, 4052, 4039, 4081, 4125,
+		2690, 2723, 2568, 2642, 1014, 2909, 3153, 4752, 5997, 6048, 6028, 6041, 6140,
+		3887, 3778, 3688, 3560, 3035, 2867, 5441, 6782, 5971, 6069, 6188, 6182, 6232,
+		3816, 3836, 3732, 3648, 3290, 5766, 2949, 6574, 5824, 5950, 6062, 6185, 6214,
+		2821, 2842, 2870, 2766, 5000, 7174, 6946, 3331, 6512, 6700, 6684, 6876, 7083,
+		4263, 4234, 4225, 4230, 6352, 6332, 6176, 6907, 4586, 5962, 6065, 6228, 6394,
+		4255, 4293, 4281, 4272, 6420, 6451, 6318, 7126, 6332, 4688, 5851, 6024, 6188,
+		4259, 4289, 4291, 4262, 6398, 6579, 6436, 7103, 6442, 6226, 4780, 6030, 6207,
+		4290, 4336, 4334, 4308, 6409, 6563, 6565, 7303, 6609, 6397, 6413, 4837, 6286,
+		4337, 4382, 4374, 4359, 6502, 6615, 6581, 7520, 6776, 6575, 6586, 6683, 4958,
+	},
+	{ // 97o
+		1662, 3467, 3457, 3469, 3417, 2752, 3751, 2812, 4114, 4099, 4181, 4168, 4231,
+		3648, 1711, 3402, 3401, 3357, 2776, 3759, 2853, 4087, 4117, 4195, 4188, 4251,
+		3632, 3569, 1655, 3305, 3260, 2679, 3693, 2912, 4116, 4143, 4233, 4229, 4279,
+		3645, 3572, 3466, 1544, 3173, 2732, 3631, 2814, 4142, 4160, 4244, 4237, 4289,
+		3580, 3512, 3409, 3313, 1397, 2648, 3468, 2720, 4030, 4141, 4199, 4187, 4252,
+		2865, 2891, 2784, 2832, 2733, 1148, 3178, 4753, 6013, 6050, 6087, 6094, 6183,
+		3934, 3948, 3867, 3808, 3627, 3312, 2958, 6598, 5923, 5999, 6129, 6245, 6294,
+		2947, 2988, 3046, 2941, 2826, 5000, 6971, 3490, 6530, 6561, 6748, 6775, 6995,
+		4338, 4302, 4330, 4356, 4233, 6363, 6278, 6922, 4644, 6005, 6147, 6274, 6435,
+		4331, 4351, 4375, 4384, 4361, 6414, 6373, 6973, 6375, 4765, 5937, 6058, 6220,
+		4432, 4445, 4484, 4487, 4435, 6464, 6504, 7177, 6522, 6312, 4943, 6103, 6259,
+		4406, 4432, 4474, 4476, 4418, 6462, 6627, 7200, 6659, 6428, 6489, 4961, 6295,
+		4481, 4504, 4531, 4536, 4484, 6553, 6672, 7425, 6817, 6603, 6647, 6689, 5084,
+	},
+	{ // 87o
+		1929, 3615, 3619, 3599, 3597, 3668, 2900, 3009, 4213, 4189, 4259, 4344, 4340,
+		3802, 1875, 3456, 3538, 3550, 3606, 2933, 3012, 4180, 4196, 4276, 4353, 4352,
+		3798, 3627, 1837, 3442, 3458, 3523, 2822, 3048, 4190, 4210, 4291, 4361, 4352,
+		3777, 3720, 3609, 1755, 3374, 3428, 2914, 2961, 4215, 4219, 4295, 4370, 4374,
+		3765, 3717, 3620, 3528, 1608, 3299, 2857, 2933, 4185, 4275, 4324, 4396, 4405,
+		3841, 3780, 3686, 3585, 3445, 1466, 2864, 2918, 4150, 4254, 4411, 4473, 4467,
+		3019, 3060, 2931, 3022, 2949, 2947, 1376, 4755, 6015, 6047, 6078, 6135, 6178,
+		3156, 3162, 3194, 3096, 3054, 3029, 5000, 3627, 6527, 6586, 6636, 6831, 6871,
+		4441, 4405, 4411, 4431, 4400, 4364, 6364, 6921, 4708, 6062, 6197, 6331, 6444,
+		4418, 4433, 4443, 4443, 4502, 4478, 6411, 6996, 6428, 4771, 5967, 6125, 6245,
+		4503, 4523, 4534, 4533, 4560, 4649, 6442, 7048, 6572, 6337, 4956, 6151, 6255,
+		4595, 4614, 4618, 4624, 4645, 4719, 6506, 7264, 6715, 6496, 6533, 5116, 6377,
+		4592, 4615, 4611, 4628, 4650, 4717, 6547, 7296, 6826, 6631, 6638, 6786, 5151,
+	},
+	{ // 77
+		1945, 5300, 5278, 5254, 5273, 5429, 5422, 6657, 6825, 6658, 6674, 6670, 6746,
+		5574, 1955, 5153, 5146, 5150, 5294, 5388, 6548, 6708, 6673, 6679, 6709, 6773,
+		5548, 5399, 1918, 4984, 5022, 5175, 5271, 6612, 6749, 6709, 6725, 6751, 6788,
+		5526, 5403, 5220, 1922, 4955, 5068, 5236, 6411, 6729, 6669, 6680, 6692, 6764,
+		5538, 5401, 5263, 5196, 1871, 5023, 5158, 6301, 6708, 6734, 6727, 6744, 6820,
+		5706, 5563, 5434, 5323, 5286, 1863, 5085, 6153, 6648, 6662, 6739, 6758, 6809,
+		5703, 5664, 5534, 5499, 5412, 5340, 1816, 6031, 6682, 6697, 6750, 6836, 6903,
+		7053, 6941, 7001, 6792, 6669, 6510, 6373, 5000, 8185, 8285, 8441, 8624, 8817,
+		7192, 7056, 7091, 7070, 7062, 6997, 7033, 8663, 8131, 8127, 8169, 8209, 8323,
+		7018, 7030, 7061, 7022, 7090, 7015, 7054, 8777, 8535, 8120, 7926, 8013, 8115,
+		7031, 7039, 7083, 7032, 7081, 7102, 7109, 8950, 8580, 8336, 8132, 8057, 8089,
+		7028, 7070, 7107, 7055, 7105, 7116, 7196, 9149, 8626, 8417, 8469, 8142, 8177,
+		7111, 7137, 7148, 7122, 7172, 7166, 7259, 9343, 8735, 8527, 8493, 8600, 8197,
+	},
+	{ // 76s
+		2255, 3918, 3894, 3853, 3874, 3919, 3883, 3285, 3309, 4428, 4479, 4530, 4639,
+		4184, 2248, 3856, 3834, 3829, 3872, 3878, 3330, 3354, 4443, 4499, 4552, 4635,
+		4156, 4110, 2191, 3682, 3778, 3869, 3881, 3389, 3395, 4460, 4518, 4570, 4659,
+		4111, 4092, 3928, 2181, 3679, 3755, 3798, 3274, 3432, 4436, 4492, 4535, 4626,
+		4126, 4077, 4027, 3920, 2099, 3680, 3728, 3356, 3398, 4494, 4521, 4560, 4661,
+		4175, 4125, 4123, 3998, 3923, 1967, 3655, 3351, 3414, 4483, 4610, 4636, 4732,
+		4135, 4132, 4129, 4037, 3965, 3893, 1829, 3363, 3429, 4463, 4590, 4714, 4796,
+		3425, 3474, 3531, 3408, 3488, 3470, 3473, 1815, 5000, 5857, 5909, 5964, 6079,
+		3448, 3493, 3531, 3566, 3527, 3530, 3541, 5242, 3948, 6402, 6479, 6555, 6752,
+		4711, 4725, 4741, 4710, 4772, 4758, 4742, 6176, 6773, 4968, 6160, 6285, 6411,
+		4772, 4789, 4805, 4772, 4803, 4895, 4870, 6238, 6854, 6443, 5089, 6297, 6420,
+		4821, 4850, 4862, 4824, 4850, 4923, 5003, 6295, 6937, 6566, 6585, 5173, 6431,
+		4943, 4939, 4959, 4922, 4950, 5027, 5088, 6413, 7138, 6706, 6705, 6728, 5375,
+	},
+	{ // 75s
+		2121, 3803, 3795, 3784, 3728, 3815, 3761, 3171, 4076, 3182, 4297, 4345, 4441,
+		4061, 2144, 3743, 3749, 3694, 3753, 3740, 3201, 4045, 3238, 4353, 4405, 4502,
+		4052, 3995, 2104, 3674, 3631, 3754, 3734, 3242, 4050, 3284, 4363, 4422, 4506,
+		4036, 4007, 3920, 2128, 3592, 3686, 3694, 3271, 4078, 3336, 4361, 4424, 4513,
+		3977, 3944, 3880, 3833, 2097, 3547, 3585, 3183, 3974, 3390, 4336, 4389, 4494,
+		4068, 4007, 4006, 3931, 3789, 1989, 3555, 3324, 3957, 3420, 4467, 4493, 4582,
+		4002, 3991, 3978, 3936, 3821, 3789, 1847, 3306, 3912, 3415, 4411, 4537, 4617,
+		3292, 3327, 3367, 3399, 3300, 3439, 3414, 1715, 4143, 5000, 5568, 5659, 5734,
+		4338, 4302, 4306, 4333, 4231, 4209, 4167, 4329, 3373, 6300, 5969, 6124, 6217,
+		3318, 3378, 3424, 3472, 3531, 3550, 3536, 5251, 6662, 3882, 6233, 6342, 6531,
+		4573, 4637, 4642, 4637, 4612, 4748, 4685, 5866, 6237, 6606, 4949, 6215, 6350,
+		4620, 4688, 4705, 4703, 4668, 4772, 4815, 5965, 6394, 6711, 6497, 5043, 6360,
+		4728, 4795, 4797, 4800, 4775, 4872, 4896, 6041, 6491, 6921, 6627, 6649, 5249,
+	},
+	{ // 74s
+		1945, 3694, 3653, 3650, 3645, 3673, 3636, 3007, 3971, 3838, 3064, 4211, 4314,
+		3952, 1988, 3638, 3647, 3613, 3630, 3635, 3047, 3934, 3912, 3137, 4293, 4401,
+		3904, 3889, 1935, 3535, 3560, 3601, 3614, 3088, 3913, 3899, 3165, 4287, 4383,
+		3902, 3904, 3779, 1975, 3499, 3548, 3599, 3132, 3962, 3920, 3222, 4309, 4396,
+		3891, 3860, 3806, 3738, 1919, 3449, 3496, 3194, 3878, 3949, 3253, 4295, 4401,
+		3929, 3886, 3856, 3796, 3694, 1913, 3423, 3141, 3824, 3899, 3396, 4342, 4432,
+		3876, 3883, 3854, 3838, 3727, 3653, 1817, 3261, 3785, 3850, 3390, 4406, 4490,
+		3118, 3167, 3199, 3254, 3316, 3252, 3364, 1559, 4091, 4432, 5000, 5343, 5493,
+		4233, 4195, 4167, 4220, 4130, 4079, 4030, 4272, 3311, 5379, 6354, 6015, 6142,
+		4104, 4184, 4166, 4184, 4218, 4163, 4112, 4654, 5633, 3364, 6167, 5848, 5995,
+		3195, 3277, 3300, 3356, 3390, 3541, 3513, 5251, 6720, 6539, 3813, 6342, 6551,
+		4485, 4581, 4569, 4591, 4576, 4626, 4685, 5626, 6284, 6113, 6721, 4937, 6348,
+		4599, 4696, 4671, 4685, 4683, 4724, 4770, 5781, 6414, 6276, 6941, 6643, 5098,
+	},
+	{ // 73s
+		1796, 3542, 3522, 3484, 3461, 3531, 3477, 2835, 3800, 3706, 3733, 2948, 4158,
+		3790, 1813, 3517, 3497, 3472, 3496, 3500, 2906, 3793, 3795, 3830, 3014, 4278,
+		3767, 3764, 1759, 3436, 3425, 3492, 3480, 2968, 3787, 3807, 3854, 3048, 4270,
+		3727, 3746, 3677, 1796, 3359, 3404, 3438, 2971, 3808, 3808, 3825, 3100, 4252,
+		3700, 3713, 3668, 3594, 1764, 3297, 3330, 3018, 3714, 3824, 3811, 3131, 4257,
+		3775, 3742, 3742, 3644, 3535, 1742, 3305, 3116, 3684, 3774, 3893, 3260, 4304,
+		3717, 3744, 3721, 3676, 3560, 3532, 1732, 3070, 3648, 3728, 3842, 3392, 4348,
+		2928, 3011, 3071, 3076, 3124, 3225, 3169, 1376, 4036, 4341, 4657, 5000, 5384,
+		4060, 4053, 4046, 4063, 3968, 3936, 3897, 4228, 3215, 5247, 5431, 6369, 5998,
+		3966, 4059, 4074, 4066, 4086, 4034, 3983, 4563, 5496, 3269, 5272, 6202, 5857,
+		3998, 4102, 4127, 4090, 4081, 4168, 4105, 4906, 5690, 5532, 3368, 6254, 5944,
+		3055, 3139, 3168, 3219, 3254, 3383, 3517, 5252, 6739, 6568, 6630, 3716, 6531,
+		4437, 4566, 4558, 4534, 4530, 4586, 4625, 5667, 6271, 6138, 6223, 6927, 4996,
+	},
+	{ // 72s
+		1594, 3418, 3372, 3353, 3316, 3357, 3313, 2631, 3671, 3585, 3575, 3624, 2814,
+		3664, 1617, 3354, 3346, 3297, 3335, 3340, 2692, 3665, 3652, 3655, 3715, 2883,
+		3615, 3595, 1575, 3270, 3275, 3331, 3330, 2762, 3695, 3667, 3682, 3740, 2908,
+		3591, 3593, 3509, 1588, 3210, 3271, 3310, 2767, 3695, 3677, 3665, 3716, 2964,
+		3549, 3532, 3515, 3441, 1554, 3154, 3185, 2815, 3595, 3693, 3653, 3699, 2997,
+		3597, 3575, 3575, 3506, 3388, 1546, 3164, 2902, 3563, 3637, 3735, 3733, 3121,
+		3542, 3579, 3564, 3545, 3408, 3387, 1516, 3024, 3532, 3607, 3692, 3817, 3237,
+		2722, 2790, 2862, 2868, 2917, 3005, 3129, 1183, 3921, 4266, 4507, 4616, 5000,
+		3926, 3919, 3948, 3950, 3842, 3811, 3776, 4113, 3134, 5101, 5281, 5435, 6371,
+		3840, 3915, 3931, 3935, 3951, 3895, 3859, 4492, 5343, 3198, 5143, 5288, 6212,
+		3834, 3921, 3948, 3930, 3913, 4001, 3951, 4752, 5530, 5396, 3298, 5335, 6263,
+		3875, 3982, 4006, 3981, 3957, 3992, 4077, 4865, 5688, 5544, 5596, 3377, 6302,
+		2923, 3002, 3027, 3079, 3108, 3238, 3350, 5244, 6738, 6588, 6638, 6688, 3639,
+	},
+	{ // A6o
+		627, 2516, 2584, 2627, 2758, 2960, 3220, 3513, 4754, 5476, 5473, 5472, 5579,
+		2641, 2776, 5419, 5370, 5340, 5500, 5625, 5625, 7074, 5961, 5976, 5965, 6010,
+		2708, 5754, 2802, 5245, 5260, 5440, 5547, 5676, 7105, 5963, 6008, 6022, 6047,
+		2750, 5710, 5563, 2755, 5110, 5273, 5444, 5494, 7051, 5920, 5924, 5948, 5971,
+		2889, 5669, 5580, 5410, 2771, 5147, 5295, 5412, 6902, 5935, 5902, 5946, 5996,
+		3104, 5850, 5780, 5592, 5469, 2793, 5257, 5335, 6802, 5881, 5982, 5966, 6018,
+		3379, 5978, 5877, 5774, 5609, 5579, 2821, 5247, 6644, 5760, 5847, 6001, 6013,
+		3691, 5971, 6019, 5835, 5737, 5662, 5559, 2808, 6552, 5662, 5767, 5940, 6074,
+		5000, 7495, 7516, 7457, 7303, 7198, 7027, 6927, 2964, 6564, 6758, 6924, 7143,
+		5802, 6350, 6347, 6292, 6314, 6256, 6128, 6020, 6968, 4336, 5819, 5972, 6149,
+		5802, 6360, 6394, 6292, 6283, 6366, 6213, 6133, 7172, 6192, 4429, 6036, 6180,
+		5790, 6355, 6401, 6326, 6321, 6341, 6372, 6310, 7352, 6345, 6419, 4523, 6199,
+		5909, 6400, 6429, 6341, 6361, 6391, 6378, 6447, 7575, 6536, 6562, 6586, 4638,
+	},
+	{ // K6o
+		1203, 2380, 3488, 3415, 3401, 3538, 3528, 3502, 2400, 3833, 3844, 3882, 3917,
+		2471, 656, 2563, 2619, 2733, 2921, 3226, 3513, 4754, 5630, 5623, 5642, 5686,
+		3676, 2686, 2838, 5260, 5265, 5448, 5582, 5731, 7121, 6061, 6080, 6074, 6090,
+		3596, 2746, 5581, 2778, 5117, 5308, 5456, 5535, 7066, 5966, 5966, 6000, 6001,
+		3583, 2867, 5583, 5419, 2831, 5156, 5331, 5440, 6891, 5972, 5948, 5979, 6009,
+		3726, 3065, 5789, 5628, 5476, 2868, 5293, 5374, 6768, 5878, 5986, 5991, 6015,
+		3715, 3390, 5919, 5789, 5648, 5616, 2940, 5284, 6649, 5808, 5908, 6055, 6039,
+		3683, 3692, 6067, 5874, 5766, 5698, 5595, 2944, 6507, 5698, 5805, 5947, 6081,
+		2505, 5000, 7531, 7473, 7292, 7163, 7037, 6878, 3106, 6546, 6742, 6884, 7093,
+		4044, 5968, 6441, 6338, 6355, 6250, 6177, 6054, 6951, 4451, 5874, 6019, 6185,
+		4066, 5968, 6468, 6337, 6330, 6371, 6282, 6165, 7155, 6246, 4566, 6079, 6217,
+		4104, 5985, 6454, 6380, 6358, 6369, 6442, 6316, 7315, 6399, 6466, 4642, 6201,
+		4143, 6027, 6475, 6372, 6378, 6391, 6408, 6453, 7522, 6575, 6600, 6590, 4773,
+	},
+	{ // Q6o
+		1249, 3130, 2398, 3362, 3355, 3492, 3492, 3484, 2380, 3796, 3816, 3836, 3887,
+		3284, 1220, 2407, 3255, 3243, 3354, 3448, 3437, 2366, 3761, 3785, 3821, 3865,
+		2488, 2495, 677, 2606, 2719, 2909, 3177, 3538, 4759, 5627, 5643, 5656, 5686,
+		3537, 3427, 2723, 2730, 5092, 5268, 5465, 5504, 6997, 5914, 5966, 5968, 5978,
+		3532, 3416, 2849, 5390, 2722, 5170, 5336, 5448, 6886, 5987, 5977, 5983, 6005,
+		3675, 3532, 3048, 5589, 5493, 2785, 5267, 5346, 6722, 5874, 5971, 5973, 6013,
+		3674, 3633, 3332, 5791, 5654, 5591, 2839, 5277, 6591, 5790, 5884, 6015, 6032,
+		3664, 3616, 3718, 5834, 5775, 5670, 5589, 2909, 6469, 5694, 5833, 5954, 6052,
+		2484, 2469, 5000, 7397, 7285, 7111, 6972, 6837, 3044, 6486, 6678, 6799, 6994,
+		4008, 3974, 5965, 6280, 6371, 6250, 6157, 6052, 6882, 4427, 5837, 5966, 6144,
+		4031, 3999, 5982, 6335, 6358, 6352, 6251, 6196, 7088, 6209, 4494, 6032, 6151,
+		4052, 4042, 5989, 6340, 6360, 6347, 6388, 6317, 7220, 6334, 6413, 4596, 6162,
+		4109, 4092, 6023, 6345, 6371, 6388, 6400, 6417, 7418, 6529, 6529, 6545, 4762,
+	},
+	{ // J6o
+		1259, 3133, 3130, 2364, 3361, 3482, 3471, 3499, 2431, 3823, 3858, 3879, 3922,
+		3291, 1237, 3035, 2391, 3255, 3335, 3429, 3434, 2420, 3776, 3817, 3847, 3881,
+		3291, 3181, 1203, 2423, 3165, 3296, 3367, 3501, 2495, 3814, 3852, 3886, 3920,
+		2452, 2480, 2507, 665, 2672, 2868, 3161, 3470, 4755, 5608, 5613, 5633, 5676,
+		3539, 3429, 3334, 2798, 2767, 5150, 5351, 5442, 6831, 5965, 5972, 5971, 6039,
+		3667, 3511, 3468, 3003, 5470, 2781, 5266, 5318, 6706, 5870, 5998, 5998, 6022,
+		3650, 3608, 3536, 3314, 5667, 5585, 2807, 5259, 6593, 5805, 5877, 6020, 6013,
+		3677, 3609, 3679, 3646, 5770, 5644, 5569, 2930, 6434, 5667, 5780, 5937, 6050,
+		2543, 2527, 2603, 5000, 7229, 7095, 6978, 6804, 3124, 6439, 6607, 6769, 6970,
+		4039, 3987, 4033, 5940, 6346, 6243, 6170, 6021, 6842, 4462, 5830, 5961, 6140,
+		4083, 4039, 4071, 5950, 6350, 6383, 6243, 6141, 7016, 6198, 4581, 6023, 6148,
+		4099, 4067, 4107, 5969, 6349, 6376, 6392, 6300, 7194, 6333, 6403, 4651, 6180,
+		4151, 4111, 4147, 6011, 6409, 6396, 6378, 6415, 7394, 6523, 6526, 6564, 4787,
+	},
+	{ // T6o
+		1443, 3275, 3227, 3197, 2497, 3614, 3608, 3603, 2571, 3968, 3954, 3991, 4014,
+		3448, 1416, 3130, 3118, 2508, 3489, 3586, 3555, 2585, 3949, 3937, 3979, 4004,
+		3393, 3287, 1394, 3023, 2529, 3409, 3473, 3589, 2600, 3945, 3937, 3987, 4002,
+		3358, 3277, 3169, 1343, 2422, 3324, 3431, 3496, 2654, 3943, 3935, 3973, 4011,
+		2592, 2605, 2624, 2502, 835, 2883, 3159, 3477, 4752, 5680, 5661, 5692, 5738,
+		3806, 3674, 3588, 3491, 3020, 2820, 5298, 5439, 6774, 5969, 6071, 6095, 6113,
+		3797, 3776, 3650, 3602, 3308, 5621, 2886, 5288, 6599, 5849, 5961, 6128, 6095,
+		3786, 3735, 3767, 3669, 3648, 5767, 5600, 2938, 6473, 5769, 5870, 6032, 6158,
+		2697, 2708, 2715, 2771, 5000, 7170, 6980, 6846, 3254, 6543, 6553, 6740, 6935,
+		4192, 4178, 4170, 4163, 6023, 6347, 6217, 6127, 6958, 4604, 5825, 6012, 6165,
+		4182, 4166, 4161, 4152, 6006, 6464, 6330, 6235, 6965, 6197, 4662, 6033, 6174,
+		4213, 4211, 4210, 4194, 6031, 6473, 6504, 6394, 7161, 6380, 6411, 4717, 6230,
+		4251, 4247, 4237, 4241, 6071, 6491, 6460, 6528, 7359, 6549, 6552, 6618, 4837,
+	},
+	{ // 96o
+		1538, 3369, 3403, 3322, 3332, 2632, 3660, 3668, 2676, 4002, 4063, 4054, 4119,
+		3547, 1567, 3319, 3267, 3258, 2654, 3653, 3655, 2712, 3999, 4074, 4073, 4111,
+		3577, 3486, 1538, 3203, 3194, 2706, 3601, 3719, 2767, 4042, 4114, 4120, 4150,
+		3492, 3437, 3361, 1500, 3101, 2572, 3498, 3578, 2784, 3997, 4068, 4066, 4110,
+		3494, 3417, 3346, 3243, 1390, 2637, 3415, 3498, 2720, 4032, 4083, 4077, 4126,
+		2743, 2766, 2820, 2666, 2734, 989, 3156, 3468, 4753, 5679, 5717, 5748, 5800,
+		3843, 3838, 3780, 3668, 3579, 3301, 2894, 5325, 6657, 5932, 6009, 6186, 6180,
+		3850, 3840, 3904, 3758, 3668, 3637, 5636, 3003, 6470, 5791, 5921, 6064, 6189,
+		2802, 2837, 2889, 2905, 2830, 5000, 7041, 6839, 3354, 6404, 6600, 6625, 6837,
+		4222, 4225, 4267, 4212, 4249, 6015, 6302, 6147, 6806, 4605, 5894, 6033, 6177,
+		4299, 4314, 4353, 4295, 4307, 6069, 6379, 6282, 7018, 6266, 4816, 6055, 6170,
+		4285, 4309, 4354, 4295, 4302, 6094, 6564, 6428, 7043, 6403, 6435, 4802, 6205,
+		4357, 4355, 4391, 4344, 4353, 6144, 6546, 6559, 7253, 6556, 6547, 6587, 4926,
+	},
+	{ // 86o
+		1681, 3515, 3527, 3445, 3506, 3513, 2771, 3800, 2835, 4104, 4177, 4252, 4253,
+		3698, 1655, 3418, 3372, 3422, 3428, 2794, 3749, 2831, 4073, 4144, 4220, 4212,
+		3711, 3587, 1677, 3305, 3357, 3398, 2834, 3813, 2896, 4113, 4180, 4260, 4246,
+		3617, 3540, 3465, 1636, 3245, 3247, 2740, 3649, 2894, 4036, 4121, 4185, 4191,
+		3678, 3588, 3519, 3396, 1543, 3216, 2808, 3645, 2895, 4146, 4194, 4265, 4273,
+		3682, 3591, 3560, 3395, 3364, 1389, 2775, 3547, 2847, 4068, 4222, 4286, 4276,
+		2885, 2909, 2947, 2840, 2907, 2862, 1139, 3478, 4756, 5667, 5711, 5780, 5823,
+		3993, 3935, 4004, 3825, 3824, 3722, 3636, 2967, 6459, 5833, 5970, 6103, 6224,
+		2973, 2963, 3028, 3022, 3020, 2959, 5000, 6825, 3487, 6359, 6432, 6630, 6674,
+		4333, 4297, 4340, 4250, 4374, 4283, 6001, 6186, 6755, 4633, 5909, 6077, 6190,
+		4418, 4381, 4418, 4347, 4425, 4450, 6049, 6333, 6833, 6277, 4819, 6097, 6156,
+		4500, 4467, 4505, 4426, 4504, 4523, 6122, 6464, 7051, 6444, 6475, 4951, 6190,
+		4502, 4461, 4496, 4430, 4512, 4514, 6160, 6593, 7080, 6569, 6531, 6578, 5008,
+	},
+	{ // 76o
+		1879, 3638, 3615, 3571, 3594, 3645, 3609, 2910, 2929, 4165, 4222, 4267, 4384,
+		3828, 1866, 3576, 3551, 3550, 3600, 3604, 2958, 2978, 4180, 4241, 4293, 4379,
+		3801, 3754, 1810, 3395, 3496, 3594, 3602, 3016, 3019, 4195, 4259, 4306, 4399,
+		3753, 3730, 3561, 1802, 3395, 3476, 3518, 2891, 3055, 4171, 4234, 4271, 4367,
+		3767, 3718, 3664, 3552, 1712, 3402, 3453, 2985, 3024, 4234, 4266, 4302, 4406,
+		3821, 3772, 3769, 3635, 3560, 1572, 3382, 2977, 3038, 4227, 4362, 4386, 4485,
+		3779, 3776, 3770, 3672, 3605, 3534, 1423, 2988, 3056, 4211, 4342, 4468, 4551,
+		3031, 3079, 3138, 3001, 3093, 3078, 3079, 1337, 4758, 5671, 5728, 5772, 5887,
+		3073, 3122, 3163, 3196, 3154, 3161, 3175, 5000, 3602, 6279, 6358, 6425, 6628,
+		4394, 4409, 4424, 4391, 4456, 4447, 4437, 5995, 6664, 4713, 5918, 6042, 6175,
+		4466, 4482, 4497, 4462, 4495, 4595, 4570, 6058, 6747, 6282, 4849, 6057, 6185,
+		4513, 4546, 4555, 4515, 4543, 4625, 4709, 6107, 6821, 6404, 6427, 4949, 6190,
+		4647, 4642, 4661, 4620, 4652, 4736, 4799, 6226, 7028, 6549, 6553, 6571, 5163,
+	},
+	{ // 66
+		1967, 5263, 5290, 5203, 5213, 5418, 5356, 5458, 6641, 6635, 6662, 6661, 6733,
+		5530, 1971, 5134, 5076, 5076, 5232, 5305, 5329, 6505, 6634, 6651, 6668, 6707,
+		5559, 5386, 1978, 4999, 5021, 5183, 5268, 5392, 6567, 6686, 6725, 6740, 6754,
+		5458, 5327, 5235, 1940, 4833, 5018, 5167, 5193, 6493, 6618, 6626, 6637, 6675,
+		5467, 5319, 5265, 5050, 1937, 4914, 5041, 5159, 6374, 6642, 6645, 6655, 6707,
+		5698, 5499, 5449, 5260, 5156, 1888, 5031, 5098, 6275, 6645, 6730, 6733, 6757,
+		5621, 5576, 5524, 5420, 5280, 5282, 1891, 5044, 6156, 6610, 6675, 6757, 6785,
+		5727, 5591, 5655, 5451, 5414, 5356, 5292, 1869, 6052, 6627, 6689, 6785, 6866,
+		7036, 6894, 6956, 6876, 6746, 6646, 6513, 6398, 5000, 8120, 8309, 8478, 8669,
+		6984, 6992, 7037, 6955, 6993, 7002, 6962, 6974, 8603, 8122, 8069, 8124, 8192,
+		7010, 7002, 7078, 6962, 6994, 7092, 7024, 7040, 8801, 8486, 8138, 8148, 8197,
+		6998, 7022, 7096, 6983, 7010, 7091, 7110, 7137, 8987, 8529, 8564, 8155, 8243,
+		7088, 7068, 7109, 7014, 7044, 7114, 7127, 7217, 9185, 8607, 8600, 8661, 8174,
+	},
+	{ // 65s
+		2267, 3949, 3981, 3913, 3870, 3928, 3926, 3932, 3302, 3352, 4399, 4430, 4526,
+		4216, 2302, 3885, 3862, 3796, 3862, 3896, 3911, 3322, 3395, 4458, 4498, 4565,
+		4250, 4142, 2267, 3848, 3794, 3888, 3899, 3966, 3378, 3479, 4514, 4569, 4629,
+		4176, 4121, 4102, 2218, 3643, 3799, 3861, 3896, 3426, 3485, 4484, 4526, 4596,
+		4127, 4049, 4049, 3888, 2194, 3676, 3761, 3795, 3330, 3550, 4457, 4493, 4576,
+		4188, 4115, 4146, 4049, 3923, 2164, 3701, 3756, 3470, 3553, 4534, 4561, 4629,
+		4186, 4156, 4155, 4114, 4007, 3943, 2025, 3701, 3514, 3625, 4546, 4682, 4738,
+		4186, 4159, 4214, 4144, 4038, 3995, 3938, 1873, 3598, 3700, 4621, 4753, 4899,
+		3436, 3454, 3514, 3561, 3457, 3596, 3641, 3721, 1880, 5000, 5575, 5668, 5722,
+		3503, 3546, 3635, 3636, 3703, 3691, 3767, 3835, 5250, 4035, 6120, 6213, 6286,
+		4681, 4741, 4801, 4760, 4733, 4815, 4826, 4903, 5864, 6478, 5032, 6175, 6268,
+		4711, 4781, 4857, 4807, 4775, 4838, 4967, 5038, 5971, 6563, 6446, 5094, 6263,
+		4818, 4858, 4924, 4882, 4860, 4916, 5026, 5192, 6023, 6649, 6535, 6540, 5270,
+	},
+	{ // 64s
+		2097, 3799, 3804, 3758, 3762, 3799, 3782, 3790, 3117, 3960, 3199, 4270, 4375,
+		4066, 2099, 3757, 3719, 3710, 3757, 3756, 3749, 3131, 4017, 3243, 4354, 4427,
+		4065, 4012, 2090, 3657, 3682, 3736, 3748, 3776, 3203, 4053, 3313, 4389, 4463,
+		4023, 3984, 3907, 2062, 3600, 3659, 3734, 3741, 3261, 4026, 3336, 4376, 4450,
+		4019, 3965, 3935, 3851, 2067, 3598, 3673, 3693, 3315, 4094, 3410, 4394, 4474,
+		4061, 4017, 3993, 3915, 3849, 2037, 3594, 3614, 3280, 4027, 3505, 4425, 4494,
+		4037, 4013, 3996, 3985, 3915, 3835, 1957, 3576, 3447, 4021, 3560, 4529, 4586,
+		4037, 3997, 4018, 3993, 3935, 3853, 3803, 1831, 3521, 4031, 3646, 4569, 4719,
+		3242, 3258, 3322, 3393, 3447, 3400, 3568, 3642, 1691, 4425, 5000, 5325, 5463,
+		4221, 4290, 4322, 4294, 4364, 4286, 4284, 4293, 4646, 3411, 5999, 5750, 5880,
+		3345, 3394, 3463, 3484, 3561, 3659, 3699, 3783, 5251, 6350, 3928, 6167, 6249,
+		4546, 4644, 4667, 4665, 4678, 4707, 4811, 4851, 5606, 6005, 6527, 4957, 6209,
+		4659, 4723, 4754, 4741, 4760, 4783, 4870, 5006, 5749, 6148, 6606, 6485, 5120,
+	},
+	{ // 63s
+		1929, 3681, 3669, 3636, 3628, 3677, 3637, 3620, 2961, 3831, 3842, 3093, 4253,
+		3943, 1956, 3624, 3588, 3563, 3599, 3612, 3612, 3001, 3891, 3900, 3127, 4304,
+		3929, 3875, 1938, 3574, 3553, 3611, 3613, 3647, 3086, 3934, 3954, 3199, 4335,
+		3890, 3844, 3819, 1913, 3486, 3555, 3593, 3601, 3115, 3917, 3932, 3225, 4327,
+		3882, 3813, 3803, 3727, 1925, 3467, 3501, 3530, 3143, 3942, 3934, 3288, 4324,
+		3937, 3850, 3863, 3804, 3717, 1907, 3453, 3487, 3253, 3868, 3965, 3372, 4352,
+		3892, 3866, 3859, 3843, 3739, 3690, 1889, 3439, 3251, 3868, 3951, 3558, 4430,
+		3868, 3861, 3888, 3847, 3772, 3726, 3669, 1791, 3445, 3876, 3985, 3631, 4565,
+		3076, 3116, 3201, 3231, 3260, 3375, 3370, 3575, 1522, 4332, 4675, 5000, 5369,
+		4096, 4159, 4204, 4179, 4213, 4128, 4129, 4139, 4549, 3311, 5149, 6036, 5744,
+		4114, 4176, 4225, 4199, 4206, 4236, 4217, 4257, 4918, 5391, 3391, 6089, 5812,
+		3224, 3267, 3334, 3360, 3427, 3507, 3704, 3775, 5250, 6379, 6451, 3823, 6233,
+		4539, 4597, 4624, 4611, 4603, 4636, 4714, 4852, 5652, 6011, 6076, 6593, 5000,
+	},
+	{ // 62s
+		1757, 3527, 3520, 3474, 3446, 3487, 3491, 3471, 2756, 3678, 3678, 3712, 2957,
+		3779, 1749, 3493, 3445, 3420, 3451, 3494, 3455, 2803, 3762, 3760, 3826, 2999,
+		3769, 3738, 1754, 3419, 3408, 3437, 3487, 3537, 2904, 3811, 3830, 3880, 3069,
+		3718, 3696, 3659, 1710, 3339, 3398, 3463, 3440, 2923, 3762, 3792, 3821, 3088,
+		3689, 3666, 3651, 3577, 1723, 3313, 3379, 3372, 2952, 3805, 3779, 3820, 3158,
+		3737, 3697, 3685, 3643, 3559, 1719, 3322, 3330, 3049, 3737, 3821, 3837, 3235,
+		3732, 3740, 3726, 3706, 3616, 3560, 1687, 3328, 3207, 3760, 3837, 3977, 3415,
+		3710, 3695, 3778, 3683, 3606, 3565, 3556, 1677, 3248, 3783, 3858, 4002, 3629,
+		2857, 2907, 3006, 3030, 3065, 3163, 3326, 3372, 1331, 4278, 4537, 4631, 5000,
+		3934, 4026, 4078, 4023, 4073, 3996, 4020, 4040, 4498, 3239, 5019, 5171, 6048,
+		3937, 4027, 4098, 4054, 4046, 4090, 4098, 4122, 4775, 5259, 3331, 5232, 6089,
+		3970, 4102, 4151, 4093, 4090, 4104, 4250, 4270, 4878, 5413, 5479, 3399, 6120,
+		3079, 3131, 3200, 3216, 3285, 3362, 3550, 3774, 5244, 6404, 6446, 6476, 3744,
+	},
+	{ // A5o
+		795, 2626, 2685, 2775, 2847, 3125, 3317, 3645, 3986, 4747, 5289, 5291, 5341,
+		2749, 2928, 5497, 5433, 5380, 5521, 5636, 5653, 5610, 7103, 6109, 6085, 6133,
+		2809, 5833, 2961, 5307, 5290, 5474, 5567, 5712, 5651, 7128, 6146, 6119, 6157,
+		2906, 5777, 5632, 2947, 5182, 5320, 5493, 5562, 5626, 7054, 6060, 6053, 6073,
+		2980, 5710, 5614, 5487, 2906, 5135, 5287, 5418, 5473, 7050, 5994, 6029, 6067,
+		3278, 5870, 5819, 5644, 5458, 2964, 5259, 5341, 5447, 6933, 6076, 6057, 6101,
+		3477, 5993, 5906, 5830, 5602, 5583, 3006, 5262, 5339, 6773, 5924, 6064, 6072,
+		3828, 6003, 6059, 5909, 5745, 5669, 5582, 2982, 5289, 6682, 5896, 6034, 6160,
+		4198, 5956, 5992, 5961, 5808, 5778, 5667, 5606, 3016, 6497, 5779, 5904, 6066,
+		5000, 7536, 7559, 7476, 7472, 7348, 7171, 7073, 6872, 3207, 6712, 6898, 7100,
+		5599, 6500, 6539, 6438, 6376, 6465, 6295, 6264, 6140, 7131, 4513, 6162, 6292,
+		5594, 6482, 6504, 6437, 6405, 6434, 6441, 6407, 6275, 7322, 6551, 4616, 6351,
+		5647, 6530, 6548, 6455, 6439, 6481, 6444, 6543, 6436, 7538, 6676, 6750, 4753,
+	},
+	{ // K5o
+		1203, 2335, 3424, 3414, 3339, 3540, 3495, 3480, 3473, 2360, 3717, 3738, 3779,
+		2413, 670, 2520, 2645, 2700, 2952, 3203, 3496, 3834, 4745, 5294, 5304, 5349,
+		3606, 2635, 2840, 5289, 5246, 5442, 5546, 5718, 5685, 7124, 6121, 6069, 6131,
+		3593, 2769, 5613, 2828, 5134, 5298, 5455, 5559, 5673, 7029, 6034, 5998, 6036,
+		3516, 2823, 5565, 5440, 2829, 5081, 5257, 5382, 5490, 6995, 5953, 5947, 6010,
+		3729, 3097, 5786, 5621, 5400, 2904, 5227, 5326, 5447, 6851, 6007, 5963, 6021,
+		3676, 3363, 5887, 5793, 5573, 5551, 2989, 5252, 5373, 6731, 5894, 6011, 6024,
+		3655, 3673, 6058, 5902, 5707, 5649, 5567, 2970, 5275, 6622, 5816, 5941, 6085,
+		3650, 4032, 6026, 6013, 5822, 5775, 5703, 5591, 3008, 6454, 5710, 5841, 5974,
+		2464, 5000, 7551, 7452, 7415, 7265, 7134, 7006, 6832, 3159, 6551, 6705, 6928,
+		3919, 5607, 6510, 6410, 6331, 6395, 6267, 6175, 6068, 6962, 4505, 6065, 6214,
+		3942, 5622, 6454, 6384, 6326, 6343, 6400, 6314, 6212, 7131, 6453, 4611, 6231,
+		3991, 5662, 6522, 6415, 6380, 6403, 6395, 6464, 6344, 7359, 6595, 6631, 4720,
+	},
+	{ // Q5o
+		1256, 3089, 2369, 3387, 3329, 3505, 3474, 3496, 3478, 2340, 3704, 3725, 3775,
+		3239, 1248, 2376, 3274, 3217, 3359, 3437, 3417, 3386, 2342, 3726, 3764, 3808,
+		2445, 2454, 701, 2624, 2683, 2926, 3154, 3525, 3841, 4749, 5304, 5310, 5348,
+		3559, 3447, 2738, 2788, 5110, 5259, 5456, 5522, 5631, 6950, 5989, 5963, 6007,
+		3501, 3384, 2802, 5415, 2721, 5109, 5255, 5394, 5495, 6985, 5968, 5943, 6011,
+		3683, 3539, 3065, 5584, 5433, 2806, 5216, 5302, 5408, 6814, 5960, 5937, 6006,
+		3644, 3619, 3300, 5791, 5570, 5541, 2888, 5240, 5333, 6680, 5855, 5969, 6017,
+		3671, 3595, 3698, 5861, 5719, 5625, 5557, 2939, 5259, 6576, 5834, 5926, 6069,
+		3653, 3559, 4035, 5967, 5830, 5733, 5660, 5576, 2963, 6365, 5678, 5796, 5922,
+		2441, 2449, 5000, 7365, 7406, 7225, 7077, 6961, 6731, 3065, 6471, 6605, 6828,
+		3902, 3931, 5612, 6365, 6346, 6343, 6224, 6201, 6039, 6879, 4434, 6005, 6160,
+		3929, 3979, 5619, 6346, 6321, 6312, 6347, 6295, 6161, 7016, 6390, 4562, 6174,
+		3984, 4024, 5656, 6385, 6379, 6386, 6387, 6443, 6287, 7255, 6541, 6571, 4678,
+	},
+	{ // J5o
+		1321, 3161, 3165, 2381, 3339, 3507, 3476, 3514, 3523, 2411, 3757, 3774, 3818,
+		3316, 1294, 3088, 2425, 3297, 3447, 3497, 3478, 3485, 2437, 3833, 3878, 3911,
+		3319, 3229, 1259, 2440, 3182, 3388, 3434, 3553, 3538, 2521, 3879, 3914, 3946,
+		2460, 2507, 2519, 730, 2681, 2923, 3152, 3462, 3861, 4746, 5304, 5317, 5357,
+		3510, 3464, 3345, 2796, 2786, 5094, 5300, 5400, 5504, 6948, 5980, 5968, 6055,
+		3687, 3625, 3565, 3059, 5412, 2851, 5224, 5292, 5459, 6806, 6023, 5991, 6033,
+		3650, 3679, 3605, 3301, 5615, 5541, 2876, 5242, 5417, 6693, 5891, 6023, 6033,
+		3687, 3651, 3728, 3635, 5728, 5616, 5557, 2978, 5290, 6528, 5816, 5934, 6065,
+		3708, 3662, 3720, 4060, 5837, 5788, 5750, 5609, 3045, 6364, 5706, 5821, 5977,
+		2524, 2548, 2635, 5000, 7371, 7218, 7091, 6911, 6738, 3192, 6402, 6571, 6776,
+		3969, 4046, 4093, 5613, 6357, 6411, 6258, 6179, 6068, 6808, 4551, 6024, 6172,
+		3985, 4091, 4130, 5632, 6348, 6373, 6402, 6303, 6196, 6984, 6407, 4648, 6193,
+		4037, 4132, 4166, 5666, 6430, 6413, 6402, 6440, 6346, 7201, 6553, 6587, 4769,
+	},
+	{ // T5o
+		1335, 3158, 3127, 3086, 2352, 3507, 3457, 3467, 3505, 2414, 3747, 3768, 3806,
+		3319, 1317, 3050, 3048, 2384, 3421, 3482, 3444, 3470, 2466, 3820, 3858, 3891,
+		3280, 3196, 1292, 2922, 2389, 3304, 3372, 3457, 3458, 2483, 3806, 3852, 3869,
+		3231, 3194, 3052, 1253, 2425, 3226, 3325, 3377, 3485, 2519, 3816, 3840, 3883,
+		2428, 2465, 2469, 2501, 728, 2837, 3097, 3412, 3778, 4747, 5264, 5289, 5335,
+		3687, 3600, 3474, 3381, 2970, 2799, 5177, 5317, 5423, 6743, 5983, 5964, 6012,
+		3632, 3662, 3537, 3487, 3242, 5497, 2870, 5185, 5302, 6579, 5857, 5992, 5996,
+		3640, 3619, 3625, 3541, 3580, 5639, 5498, 2910, 5228, 6469, 5782, 5914, 6049,
+		3686, 3645, 3629, 3654, 3977, 5751, 5626, 5544, 3007, 6297, 5636, 5787, 5927,
+		2528, 2585, 2594, 2629, 5000, 7153, 6969, 6849, 6669, 3163, 6301, 6509, 6722,
+		3958, 4037, 4015, 4019, 5578, 6373, 6223, 6145, 5996, 6706, 4539, 5955, 6120,
+		3979, 4078, 4066, 4050, 5601, 6341, 6370, 6278, 6156, 6917, 6335, 4606, 6195,
+		4028, 4116, 4089, 4095, 5641, 6389, 6359, 6423, 6289, 7143, 6494, 6589, 4700,
+	},
+	{ // 95o
+		1394, 3277, 3287, 3231, 3202, 2502, 3535, 3563, 3568, 2532, 3884, 3858, 3903,
+		3446, 1439, 3228, 3239, 3166, 2536, 3602, 3577, 3578, 2614, 3991, 3992, 4029,
+		3448, 3381, 1422, 3124, 3054, 2556, 3492, 3610, 3580, 2657, 3992, 3992, 4023,
+		3387, 3399, 3269, 1369, 3006, 2607, 3444, 3492, 3590, 2666, 3983, 3984, 4017,
+		3356, 3317, 3195, 3140, 1357, 2444, 3319, 3385, 3486, 2728, 3945, 3944, 3985,
+		2594, 2631, 2651, 2699, 2522, 874, 3112, 3420, 3796, 4748, 5367, 5373, 5418,
+		3712, 3788, 3661, 3615, 3475, 3256, 2887, 5206, 5392, 6618, 5930, 6073, 6067,
+		3741, 3755, 3784, 3667, 3549, 3586, 5522, 2985, 5242, 6450, 5837, 5966, 6105,
+		3744, 3750, 3750, 3757, 3653, 3985, 5717, 5553, 2998, 6309, 5714, 5872, 6004,
+		2652, 2735, 2775, 2782, 2847, 5000, 7016, 6831, 6679, 3310, 6371, 6411, 6632,
+		4105, 4217, 4212, 4193, 4161, 5689, 6302, 6199, 6072, 6782, 4684, 6010, 6142,
+		4077, 4216, 4212, 4198, 4162, 5688, 6452, 6334, 6240, 6813, 6391, 4710, 6201,
+		4128, 4259, 4248, 4236, 4202, 5731, 6437, 6481, 6367, 7046, 6520, 6591, 4826,
+	},
+	{ // 85o
+		1606, 3411, 3408, 3364, 3363, 3397, 2633, 3691, 3688, 2696, 3971, 4033, 4032,
+		3586, 1579, 3333, 3343, 3317, 3357, 2670, 3650, 3641, 2731, 4033, 4107, 4093,
+		3579, 3498, 1603, 3260, 3259, 3296, 2682, 3690, 3663, 2790, 4047, 4120, 4104,
+		3528, 3512, 3415, 1555, 3182, 3221, 2731, 3556, 3653, 2780, 4020, 4086, 4078,
+		3526, 3479, 3420, 3332, 1538, 3123, 2622, 3513, 3606, 2891, 4037, 4111, 4109,
+		3558, 3519, 3453, 3370, 3264, 1426, 2745, 3463, 3526, 2861, 4095, 4163, 4151,
+		2729, 2774, 2781, 2831, 2707, 2835, 1048, 3428, 3807, 4746, 5342, 5438, 5466,
+		3868, 3829, 3867, 3732, 3682, 3627, 3589, 2946, 5258, 6464, 5888, 6017, 6141,
+		3872, 3823, 3843, 3830, 3783, 3698, 3999, 5563, 3038, 6233, 5716, 5871, 5980,
+		2829, 2866, 2923, 2909, 3031, 2984, 5000, 6844, 6599, 3399, 6188, 6414, 6448,
+		4192, 4260, 4269, 4238, 4255, 4315, 5652, 6250, 6069, 6577, 4693, 6018, 6082,
+		4262, 4342, 4354, 4317, 4341, 4392, 5758, 6377, 6235, 6819, 6389, 4823, 6118,
+		4261, 4329, 4336, 4306, 4334, 4379, 5779, 6513, 6339, 6858, 6450, 6502, 4874,
+	},
+	{ // 75o
+		1725, 3514, 3504, 3495, 3434, 3525, 3469, 2771, 3796, 2787, 4022, 4067, 4170,
+		3691, 1748, 3453, 3461, 3404, 3464, 3454, 2804, 3768, 2848, 4082, 4135, 4235,
+		3680, 3624, 1710, 3380, 3337, 3464, 3442, 2843, 3769, 2891, 4089, 4147, 4236,
+		3663, 3635, 3543, 1734, 3301, 3397, 3408, 2875, 3803, 2947, 4092, 4158, 4248,
+		3601, 3571, 3502, 3457, 1701, 3255, 3299, 2778, 3697, 3004, 4065, 4118, 4229,
+		3695, 3635, 3635, 3556, 3409, 1585, 3265, 2926, 3679, 3031, 4202, 4227, 4320,
+		3624, 3621, 3602, 3563, 3445, 3412, 1432, 2911, 3637, 3026, 4146, 4275, 4359,
+		2874, 2909, 2950, 2981, 2874, 3027, 3004, 1223, 3824, 4749, 5346, 5437, 5508,
+		3980, 3946, 3948, 3979, 3873, 3853, 3814, 4005, 3026, 6165, 5707, 5861, 5960,
+		2927, 2994, 3039, 3089, 3151, 3169, 3156, 5000, 6519, 3517, 6086, 6193, 6395,
+		4241, 4309, 4313, 4310, 4285, 4426, 4364, 5649, 6051, 6471, 4692, 5965, 6105,
+		4292, 4367, 4381, 4384, 4347, 4456, 4502, 5749, 6208, 6579, 6333, 4799, 6120,
+		4407, 4480, 4481, 4485, 4462, 4563, 4589, 5820, 6307, 6798, 6467, 6494, 5017,
+	},
+	{ // 65o
+		1888, 3662, 3702, 3631, 3581, 3643, 3641, 3651, 2914, 2974, 4132, 4162, 4264,
+		3856, 1915, 3600, 3575, 3507, 3577, 3616, 3624, 2935, 3014, 4187, 4230, 4300,
+		3898, 3784, 1892, 3564, 3508, 3604, 3620, 3682, 2998, 3108, 4249, 4306, 4369,
+		3817, 3756, 3742, 1831, 3351, 3517, 3582, 3613, 3044, 3110, 4217, 4261, 4333,
+		3759, 3680, 3685, 3510, 1806, 3386, 3474, 3511, 2937, 3175, 4187, 4226, 4314,
+		3824, 3753, 3786, 3685, 3548, 1767, 3414, 3470, 3086, 3177, 4273, 4301, 4373,
+		3820, 3796, 3797, 3752, 3635, 3573, 1621, 3418, 3134, 3253, 4289, 4428, 4488,
+		3823, 3791, 3850, 3777, 3668, 3625, 3572, 1465, 3227, 3338, 4367, 4504, 4657,
+		3032, 3049, 3118, 3158, 3042, 3194, 3245, 3336, 1397, 4750, 5354, 5451, 5502,
+		3128, 3168, 3269, 3262, 3331, 3321, 3401, 3481, 5000, 3682, 5976, 6058, 6134,
+		4358, 4417, 4486, 4438, 4407, 4499, 4515, 4598, 5651, 6344, 4774, 5923, 6017,
+		4393, 4464, 4548, 4493, 4456, 4527, 4664, 4743, 5760, 6422, 6278, 4852, 6010,
+		4508, 4546, 4621, 4573, 4549, 4612, 4730, 4904, 5808, 6510, 6366, 6368, 5043,
+	},
+	{ // 55
+		1935, 5223, 5233, 5154, 5135, 5304, 5279, 5342, 5383, 6396, 6649, 6673, 6692,
+		5499, 1954, 5122, 5007, 4994, 5111, 5212, 5264, 5277, 6434, 6598, 6626, 6658,
+		5508, 5376, 1944, 4936, 4950, 5116, 5207, 5350, 5308, 6530, 6704, 6728, 6735,
+		5416, 5268, 5178, 1962, 4804, 4930, 5126, 5185, 5275, 6413, 6592, 6604, 6638,
+		5398, 5246, 5196, 5035, 1900, 4788, 4935, 5057, 5136, 6440, 6582, 6606, 6642,
+		5582, 5378, 5387, 5178, 5034, 1927, 4904, 4980, 5132, 6301, 6622, 6633, 6648,
+		5552, 5489, 5475, 5386, 5178, 5154, 1894, 4980, 5103, 6221, 6613, 6707, 6730,
+		5618, 5535, 5620, 5453, 5312, 5235, 5229, 1880, 5032, 6118, 6636, 6731, 6802,
+		5664, 5549, 5573, 5538, 5396, 5395, 5367, 5287, 1878, 5965, 6589, 6689, 6761,
+		6793, 6841, 6935, 6808, 6837, 6690, 6601, 6483, 6318, 5000, 8036, 8243, 8417,
+		7022, 6969, 7066, 6941, 6940, 6987, 6972, 6996, 6947, 8527, 8128, 8257, 8300,
+		7035, 6994, 7084, 6960, 6962, 6987, 7069, 7090, 7047, 8739, 8691, 8125, 8328,
+		7060, 7033, 7100, 6993, 6997, 7007, 7088, 7168, 7122, 8932, 8725, 8764, 8163,
+	},
+	{ // 54s
+		2180, 3920, 3936, 3919, 3900, 3927, 3936, 3941, 3934, 3163, 3262, 4432, 4493,
+		4184, 2319, 3890, 3877, 3847, 3861, 3923, 3910, 3878, 3305, 3414, 4473, 4539,
+		4195, 4149, 2304, 3860, 3846, 3883, 3928, 3949, 3918, 3390, 3469, 4519, 4579,
+		4183, 4143, 4115, 2302, 3808, 3841, 3912, 3905, 3924, 3449, 3525, 4520, 4581,
+		4160, 4111, 4107, 4064, 2272, 3667, 3847, 3891, 3925, 3544, 3572, 4532, 4600,
+		4192, 4126, 4147, 4102, 3928, 2262, 3745, 3811, 3861, 3489, 3683, 4546, 4592,
+		4194, 4191, 4185, 4176, 4105, 4000, 2178, 3784, 3845, 3661, 3731, 4670, 4714,
+		4193, 4165, 4200, 4161, 4149, 4063, 4033, 2074, 3840, 3767, 3833, 4728, 4857,
+		4181, 4126, 4163, 4170, 4175, 4106, 4091, 4082, 1931, 3880, 4001, 4851, 4981,
+		3288, 3449, 3529, 3598, 3699, 3629, 3812, 3914, 4024, 1964, 5000, 5336, 5472,
+		3391, 3562, 3617, 3677, 3725, 3843, 3880, 3979, 4150, 5259, 4096, 5931, 6021,
+		4703, 4754, 4798, 4805, 4817, 4832, 4959, 5012, 5141, 5607, 6263, 5067, 6076,
+		4777, 4829, 4867, 4869, 4886, 4885, 5005, 5151, 5280, 5759, 6349, 6332, 5209,
+	},
+	{ // 53s
+		1989, 3802, 3814, 3791, 3738, 3811, 3767, 3783, 3782, 2988, 3983, 3099, 4353,
+		4052, 2125, 3789, 3776, 3714, 3767, 3773, 3759, 3732, 3165, 4058, 3269, 4425,
+		4072, 4043, 2116, 3761, 3711, 3774, 3768, 3819, 3782, 3257, 4104, 3318, 4462,
+		4046, 4035, 4013, 2130, 3655, 3732, 3737, 3774, 3792, 3289, 4085, 3381, 4460,
+		3992, 3966, 3969, 3903, 2081, 3637, 3664, 3727, 3737, 3350, 4073, 3406, 4438,
+		4069, 4023, 4037, 3988, 3893, 2100, 3635, 3696, 3721, 3444, 4136, 3537, 4487,
+		4021, 4031, 4026, 3994, 3915, 3889, 2077, 3629, 3674, 3444, 4098, 3688, 4539,
+		4030, 4004, 4069, 4024, 3976, 3942, 3875, 1987, 3715, 3658, 4152, 3798, 4712,
+		4028, 3981, 4034, 4039, 3988, 3967, 3923, 3958, 1876, 3787, 4250, 3964, 4829,
+		3102, 3295, 3395, 3429, 3491, 3589, 3586, 3807, 3942, 1757, 4664, 5000, 5354,
+		4245, 4327, 4376, 4354, 4345, 4417, 4370, 4425, 4530, 4912, 3444, 5809, 5599,
+		3215, 3406, 3459, 3524, 3551, 3686, 3844, 3950, 4128, 5253, 6140, 3976, 5939,
+		4632, 4710, 4749, 4743, 4717, 4777, 4827, 4999, 5129, 5640, 5839, 6273, 5083,
+	},
+	{ // 52s
+		1794, 3641, 3651, 3656, 3584, 3652, 3644, 3641, 3616, 2798, 3839, 3859, 2990,
+		3888, 1936, 3591, 3613, 3532, 3596, 3631, 3592, 3580, 2954, 3893, 3928, 3148,
+		3898, 3836, 1947, 3573, 3514, 3596, 3617, 3641, 3619, 3051, 3923, 3963, 3182,
+		3901, 3864, 3817, 1939, 3497, 3587, 3616, 3607, 3623, 3102, 3938, 3971, 3261,
+		3825, 3779, 3761, 3734, 1900, 3471, 3548, 3571, 3592, 3146, 3901, 3923, 3285,
+		3900, 3845, 3846, 3832, 3720, 1924, 3547, 3540, 3586, 3239, 4002, 3994, 3419,
+		3883, 3880, 3857, 3859, 3786, 3790, 1906, 3523, 3572, 3417, 3989, 4109, 3566,
+		3879, 3832, 3883, 3849, 3812, 3780, 3755, 1885, 3589, 3469, 4005, 4143, 3788,
+		3851, 3815, 3856, 3860, 3835, 3823, 3810, 3825, 1808, 3714, 4120, 4256, 3952,
+		2900, 3072, 3172, 3224, 3278, 3368, 3552, 3605, 3866, 1583, 4528, 4646, 5000,
+		4100, 4156, 4188, 4199, 4165, 4272, 4253, 4267, 4389, 4769, 3377, 4974, 5840,
+		4111, 4193, 4227, 4235, 4188, 4258, 4372, 4405, 4536, 4885, 5196, 3440, 5858,
+		3099, 3273, 3310, 3388, 3409, 3553, 3699, 3938, 4111, 5253, 6160, 6189, 3891,
+	},
+	{ // A4o
+		759, 2551, 2625, 2742, 2874, 3079, 3324, 3644, 3988, 4176, 4745, 4996, 5078,
+		2671, 2888, 5487, 5425, 5386, 5497, 5605, 5632, 5592, 5717, 7125, 6079, 6138,
+		2741, 5825, 2922, 5306, 5294, 5431, 5527, 5674, 5634, 5738, 7147, 6110, 6158,
+		2872, 5768, 5631, 2910, 5175, 5276, 5434, 5531, 5586, 5683, 7062, 6050, 6092,
+		3008, 5716, 5616, 5481, 2906, 5136, 5285, 5420, 5483, 5689, 7029, 6025, 6082,
+		3227, 5847, 5770, 5599, 5459, 2902, 5160, 5248, 5378, 5554, 7068, 6022, 6072,
+		3488, 5957, 5858, 5763, 5596, 5474, 2986, 5191, 5267, 5470, 6866, 6017, 6053,
+		3831, 5976, 6019, 5871, 5741, 5568, 5497, 2969, 5228, 5427, 6805, 6002, 6166,
+		4198, 5934, 5969, 5917, 5818, 5701, 5582, 5534, 2990, 5319, 6655, 5886, 6063,
+		4401, 6081, 6098, 6031, 6042, 5895, 5808, 5759, 5642, 2978, 6609, 5755, 5900,
+		5000, 7559, 7579, 7481, 7452, 7494, 7271, 7203, 7037, 7005, 3250, 6940, 7138,
+		5276, 6474, 6495, 6436, 6403, 6400, 6389, 6375, 6251, 6119, 7372, 4559, 6380,
+		5363, 6533, 6548, 6473, 6454, 6451, 6421, 6544, 6428, 6274, 7570, 6778, 4666,
+	},
+	{ // K4o
+		1170, 2263, 3360, 3361, 3340, 3467, 3473, 3472, 3461, 3331, 2337, 3661, 3719,
+		2340, 637, 2475, 2586, 2708, 2900, 3192, 3498, 3835, 4157, 4742, 5005, 5082,
+		3533, 2582, 2804, 5288, 5246, 5395, 5514, 5685, 5666, 5714, 7142, 6062, 6136,
+		3539, 2708, 5614, 2788, 5143, 5256, 5402, 5518, 5628, 5606, 7043, 5995, 6056,
+		3515, 2832, 5565, 5451, 2824, 5088, 5252, 5390, 5501, 5609, 6982, 5950, 6011,
+		3651, 3039, 5736, 5578, 5407, 2837, 5122, 5238, 5365, 5443, 6985, 5927, 5995,
+		3656, 3353, 5852, 5737, 5568, 5439, 2963, 5172, 5297, 5401, 6828, 5959, 5993,
+		3653, 3680, 6023, 5857, 5711, 5555, 5477, 2961, 5211, 5363, 6723, 5898, 6079,
+		3640, 4032, 6001, 5961, 5834, 5686, 5619, 5518, 2998, 5259, 6606, 5824, 5973,
+		3500, 4393, 6069, 5954, 5963, 5783, 5740, 5691, 5583, 3031, 6438, 5673, 5844,
+		2441, 5000, 7575, 7466, 7402, 7413, 7238, 7116, 6987, 6826, 3183, 6735, 6952,
+		3861, 5298, 6447, 6381, 6328, 6307, 6347, 6269, 6187, 6041, 7164, 4548, 6234,
+		3930, 5372, 6525, 6437, 6381, 6376, 6364, 6456, 6338, 6216, 7380, 6631, 4633,
+	},
+	{ // Q4o
+		1214, 2997, 2286, 3305, 3312, 3429, 3456, 3444, 3437, 3299, 2321, 3643, 3706,
+		3137, 1208, 2301, 3191, 3198, 3290, 3403, 3388, 3366, 3319, 2323, 3680, 3735,
+		2357, 2373, 652, 2560, 2695, 2885, 3158, 3511, 3829, 4160, 4747, 5011, 5078,
+		3471, 3355, 2670, 2729, 5106, 5206, 5401, 5476, 5599, 5566, 6974, 5954, 6016,
+		3480, 3363, 2816, 5411, 2708, 5088, 5248, 5388, 5504, 5631, 6979, 5953, 6023,
+		3605, 3464, 3021, 5529, 5410, 2749, 5102, 5200, 5329, 5446, 6937, 5918, 5973,
+		3632, 3583, 3308, 5730, 5565, 5421, 2852, 5159, 5266, 5395, 6779, 5922, 5978,
+		3616, 3564, 3689, 5807, 5709, 5516, 5466, 2917, 5195, 5358, 6700, 5873, 6052,
+		3606, 3532, 4018, 5929, 5839, 5647, 5582, 5503, 2922, 5199, 6537, 5775, 5902,
+		3461, 3490, 4388, 5907, 5985, 5788, 5731, 5687, 5514, 2934, 6383, 5624, 5812,
+		2421, 2425, 5000, 7389, 7398, 7359, 7181, 7092, 6917, 6764, 3100, 6648, 6857,
+		3835, 3883, 5297, 6332, 6329, 6292, 6295, 6241, 6133, 5978, 7066, 4503, 6188,
+		3909, 3943, 5365, 6394, 6393, 6350, 6345, 6423, 6260, 6178, 7279, 6580, 4611,
+	},
+	{ // J4o
+		1257, 3107, 3099, 2315, 3371, 3479, 3494, 3510, 3521, 3384, 2403, 3740, 3787,
+		3262, 1233, 3012, 2327, 3271, 3366, 3476, 3476, 3481, 3409, 2421, 3809, 3833,
+		3249, 3148, 1198, 2359, 3172, 3321, 3421, 3514, 3490, 3456, 2496, 3845, 3870,
+		2393, 2407, 2432, 688, 2688, 2878, 3156, 3459, 3852, 4155, 4744, 5022, 5089,
+		3546, 3442, 3336, 2808, 2779, 5099, 5291, 5413, 5513, 5624, 6953, 5979, 6080,
+		3659, 3541, 3492, 3012, 5418, 2787, 5120, 5196, 5384, 5463, 6947, 5980, 6027,
+		3675, 3658, 3595, 3312, 5606, 5432, 2870, 5163, 5329, 5426, 6787, 5992, 6004,
+		3687, 3655, 3692, 3636, 5738, 5513, 5467, 2968, 5228, 5363, 6644, 5910, 6070,
+		3708, 3663, 3665, 4050, 5848, 5705, 5653, 5538, 3038, 5240, 6516, 5801, 5946,
+		3562, 3590, 3635, 4387, 5981, 5807, 5762, 5690, 5562, 3059, 6323, 5646, 5801,
+		2519, 2534, 2611, 5000, 7370, 7373, 7187, 7034, 6900, 6704, 3250, 6621, 6821,
+		3946, 4022, 4056, 5313, 6359, 6362, 6368, 6281, 6170, 6006, 7041, 4611, 6243,
+		4002, 4052, 4085, 5379, 6456, 6407, 6373, 6441, 6313, 6171, 7243, 6638, 4699,
+	},
+	{ // T4o
+		1309, 3147, 3112, 3089, 2346, 3512, 3511, 3526, 3539, 3448, 2431, 3785, 3829,
+		3303, 1299, 3030, 2998, 2360, 3392, 3503, 3484, 3495, 3481, 2477, 3841, 3889,
+		3260, 3173, 1279, 2904, 2389, 3322, 3401, 3481, 3476, 3477, 2486, 3840, 3866,
+		3235, 3143, 3036, 1256, 2418, 3195, 3340, 3386, 3481, 3466, 2515, 3826, 3862,
+		2422, 2439, 2465, 2495, 726, 2856, 3123, 3430, 3798, 4189, 4743, 5025, 5099,
+		3690, 3567, 3492, 3354, 2992, 2754, 5107, 5250, 5371, 5497, 6897, 5968, 6021,
+		3690, 3686, 3572, 3510, 3272, 5422, 2862, 5136, 5257, 5410, 6708, 5983, 5997,
+		3699, 3662, 3652, 3554, 3602, 5565, 5440, 2919, 5197, 5388, 6610, 5919, 6087,
+		3717, 3670, 3642, 3650, 3994, 5693, 5575, 5505, 3006, 5267, 6439, 5794, 5954,
+		3624, 3669, 3654, 3643, 4422, 5839, 5745, 5715, 5593, 3060, 6275, 5655, 5835,
+		2548, 2598, 2602, 2630, 5000, 7323, 7110, 6998, 6817, 6656, 3262, 6529, 6737,
+		3992, 4061, 4052, 4041, 5315, 6347, 6360, 6284, 6157, 6012, 6945, 4638, 6225,
+		4048, 4113, 4085, 4078, 5386, 6400, 6360, 6458, 6314, 6201, 7154, 6621, 4715,
+	},
+	{ // 94o
+		1227, 3103, 3143, 3092, 3081, 2324, 3426, 3425, 3463, 3365, 2395, 3711, 3765,
+		3256, 1274, 3087, 3046, 3016, 2348, 3454, 3430, 3461, 3428, 2475, 3815, 3853,
+		3297, 3231, 1231, 2987, 2984, 2398, 3402, 3473, 3482, 3480, 2528, 3860, 3903,
+		3242, 3193, 3127, 1214, 2875, 2443, 3308, 3345, 3454, 3421, 2521, 3813, 3842,
+		3224, 3152, 3119, 3002, 1186, 2461, 3195, 3263, 3381, 3456, 2571, 3819, 3860,
+		2404, 2429, 2479, 2527, 2545, 677, 3056, 3365, 3742, 4090, 4743, 5009, 5076,
+		3600, 3633, 3573, 3477, 3346, 3207, 2799, 5048, 5236, 5351, 6590, 5951, 5949,
+		3597, 3604, 3649, 3517, 3421, 3536, 5351, 2898, 5105, 5252, 6459, 5832, 5999,
+		3634, 3629, 3648, 3617, 3536, 3931, 5550, 5405, 2908, 5185, 6341, 5764, 5910,
+		3535, 3605, 3657, 3589, 3627, 4311, 5685, 5574, 5501, 3013, 6157, 5583, 5728,
+		2506, 2587, 2641, 2627, 2677, 5000, 6988, 6839, 6712, 6528, 3238, 6431, 6644,
+		3914, 4027, 4073, 4021, 4024, 5295, 6325, 6195, 6123, 5932, 6842, 4564, 6174,
+		3975, 4071, 4121, 4051, 4063, 5363, 6315, 6367, 6266, 6083, 7057, 6562, 4664,
+	},
+	{ // 84o
+		1428, 3282, 3308, 3272, 3262, 3295, 2482, 3592, 3605, 3521, 2600, 3939, 3939,
+		3448, 1423, 3215, 3215, 3202, 3241, 2507, 3548, 3540, 3548, 2634, 3983, 3971,
+		3476, 3372, 1417, 3153, 3161, 3198, 2556, 3586, 3574, 3593, 2690, 4030, 4019,
+		3436, 3376, 3306, 1386, 3081, 3112, 2609, 3486, 3585, 3564, 2689, 4004, 3996,
+		3421, 3357, 3316, 3229, 1374, 3011, 2658, 3397, 3499, 3589, 2762, 4003, 4000,
+		3460, 3405, 3357, 3262, 3154, 1334, 2567, 3333, 3454, 3524, 2878, 4047, 4031,
+		2578, 2605, 2648, 2707, 2753, 2651, 882, 3394, 3765, 4125, 4747, 5104, 5144,
+		3771, 3725, 3765, 3663, 3564, 3496, 3558, 2891, 5130, 5315, 6487, 5895, 6049,
+		3787, 3718, 3749, 3757, 3670, 3621, 3951, 5430, 2976, 5174, 6301, 5783, 5902,
+		3705, 3733, 3776, 3742, 3777, 3698, 4348, 5636, 5485, 3028, 6120, 5630, 5747,
+		2729, 2762, 2819, 2813, 2890, 3012, 5000, 6868, 6668, 6486, 3381, 6437, 6465,
+		4165, 4214, 4261, 4237, 4230, 4276, 5403, 6256, 6141, 5982, 6853, 4752, 6111,
+		4169, 4206, 4254, 4226, 4226, 4262, 5439, 6419, 6259, 6109, 6874, 6501, 4769,
+	},
+	{ // 74o
+		1543, 3402, 3358, 3356, 3350, 3379, 3343, 2599, 3683, 3553, 2660, 3927, 4037,
+		3580, 1582, 3346, 3356, 3322, 3339, 3347, 2642, 3652, 3633, 2740, 4020, 4133,
+		3526, 3515, 1530, 3238, 3266, 3308, 3317, 2679, 3628, 3613, 2766, 4009, 4106,
+		3524, 3529, 3396, 1572, 3203, 3253, 3303, 2728, 3681, 3640, 2826, 4032, 4125,
+		3512, 3483, 3426, 3353, 1519, 3159, 3204, 2798, 3595, 3668, 2864, 4020, 4131,
+		3552, 3512, 3480, 3414, 3316, 1508, 3133, 2729, 3543, 3619, 3011, 4069, 4166,
+		3497, 3510, 3473, 3456, 3346, 3274, 1404, 2861, 3502, 3570, 3000, 4137, 4224,
+		2694, 2744, 2776, 2829, 2897, 2823, 2952, 1050, 3762, 4134, 4749, 5094, 5248,
+		3867, 3835, 3804, 3859, 3765, 3718, 3667, 3942, 2960, 5097, 6217, 5743, 5878,
+		3736, 3825, 3799, 3821, 3855, 3801, 3750, 4351, 5402, 3004, 6021, 5575, 5733,
+		2797, 2884, 2908, 2966, 3002, 3161, 3132, 5000, 6574, 6381, 3447, 6198, 6416,
+		4146, 4253, 4236, 4259, 4245, 4300, 4361, 5383, 6089, 5916, 6591, 4682, 6102,
+		4272, 4377, 4344, 4363, 4360, 4407, 4455, 5541, 6222, 6086, 6818, 6482, 4857,
+	},
+	{ // 64o
+		1704, 3514, 3517, 3469, 3473, 3509, 3495, 3503, 2719, 3681, 2813, 3994, 4107,
+		3705, 1704, 3466, 3429, 3420, 3471, 3470, 3463, 2735, 3739, 2859, 4085, 4160,
+		3701, 3644, 1697, 3361, 3388, 3445, 3457, 3484, 2806, 3769, 2930, 4115, 4190,
+		3654, 3614, 3531, 1672, 3305, 3366, 3442, 3452, 2872, 3745, 2951, 4103, 4179,
+		3647, 3593, 3560, 3470, 1671, 3309, 3383, 3404, 2924, 3815, 3029, 4122, 4205,
+		3692, 3653, 3625, 3539, 3473, 1638, 3304, 3323, 2879, 3749, 3130, 4159, 4230,
+		3669, 3647, 3627, 3610, 3539, 3461, 1556, 3286, 3060, 3747, 3186, 4267, 4326,
+		3665, 3628, 3645, 3618, 3558, 3478, 3428, 1420, 3146, 3763, 3280, 4310, 4470,
+		2828, 2845, 2912, 2984, 3035, 2982, 3167, 3253, 1199, 4136, 4749, 5082, 5225,
+		3860, 3932, 3961, 3932, 4004, 3928, 3931, 3949, 4349, 3053, 5850, 5470, 5611,
+		2963, 3013, 3083, 3100, 3183, 3288, 3332, 3426, 5000, 6190, 3574, 6014, 6094,
+		4217, 4324, 4345, 4340, 4351, 4390, 4499, 4544, 5368, 5797, 6389, 4707, 5948,
+		4341, 4407, 4434, 4421, 4439, 4470, 4562, 4710, 5515, 5945, 6465, 6304, 4883,
+	},
+	{ // 54o
+		1774, 3629, 3644, 3630, 3605, 3632, 3642, 3649, 3647, 2759, 2859, 4153, 4219,
+		3814, 1922, 3598, 3586, 3551, 3566, 3634, 3617, 3591, 2910, 3022, 4200, 4268,
+		3824, 3779, 1912, 3568, 3553, 3589, 3637, 3655, 3630, 2995, 3081, 4246, 4308,
+		3813, 3773, 3746, 1912, 3516, 3548, 3623, 3613, 3641, 3062, 3141, 4250, 4311,
+		3784, 3733, 3734, 3690, 1878, 3365, 3557, 3600, 3639, 3157, 3184, 4259, 4331,
+		3818, 3755, 3778, 3729, 3539, 1870, 3450, 3517, 3574, 3091, 3303, 4273, 4323,
+		3821, 3825, 3818, 3809, 3734, 3625, 1782, 3494, 3559, 3284, 3354, 4409, 4454,
+		3817, 3791, 3826, 3787, 3774, 3688, 3663, 1664, 3557, 3394, 3461, 4468, 4604,
+		3808, 3754, 3791, 3802, 3803, 3734, 3723, 3718, 1514, 3522, 3650, 4609, 4741,
+		2869, 3038, 3121, 3192, 3294, 3218, 3423, 3529, 3656, 1473, 4741, 5088, 5231,
+		2995, 3174, 3236, 3296, 3344, 3472, 3514, 3619, 3810, 5000, 3744, 5758, 5840,
+		4371, 4425, 4472, 4480, 4490, 4507, 4649, 4703, 4853, 5367, 6105, 4807, 5801,
+		4452, 4507, 4548, 4548, 4566, 4568, 4698, 4849, 4997, 5524, 6184, 6132, 4966,
+	},
+	{ // 44
+		1886, 5195, 5205, 5080, 5057, 5199, 5150, 5247, 5299, 5210, 6359, 6621, 6639,
+		5462, 1891, 5081, 4980, 4950, 5032, 5122, 5157, 5172, 5218, 6416, 6596, 6614,
+		5478, 5340, 1897, 4914, 4893, 4997, 5082, 5262, 5244, 5290, 6493, 6674, 6670,
+		5333, 5233, 5155, 1919, 4761, 4836, 4979, 5075, 5171, 5189, 6364, 6538, 6570,
+		5307, 5196, 5132, 4983, 1925, 4716, 4866, 4981, 5083, 5191, 6349, 6526, 6553,
+		5468, 5299, 5253, 5071, 4951, 1878, 4717, 4826, 4943, 5058, 6364, 6537, 6541,
+		5416, 5396, 5339, 5228, 5099, 4951, 1901, 4812, 4931, 5047, 6240, 6573, 6590,
+		5507, 5411, 5525, 5325, 5220, 5057, 5044, 1868, 4911, 5051, 6187, 6632, 6702,
+		5571, 5434, 5506, 5419, 5338, 5184, 5181, 5151, 1862, 4968, 6072, 6609, 6669,
+		5487, 5495, 5566, 5449, 5461, 5316, 5307, 5308, 5226, 1872, 5904, 6556, 6623,
+		6750, 6817, 6900, 6750, 6738, 6762, 6619, 6553, 6426, 6256, 5000, 8252, 8393,
+		6977, 6958, 7038, 6885, 6873, 6888, 6927, 6982, 6966, 6910, 8753, 8089, 8294,
+		6997, 6978, 7033, 6917, 6896, 6897, 6936, 7053, 7023, 6984, 8904, 8725, 8145,
+	},
+	{ // 43s
+		1921, 3716, 3744, 3724, 3729, 3736, 3712, 3727, 3723, 3596, 2947, 3017, 4274,
+		3969, 2072, 3724, 3697, 3694, 3694, 3711, 3725, 3675, 3689, 3135, 3178, 4356,
+		3996, 3974, 2041, 3679, 3693, 3694, 3729, 3779, 3730, 3752, 3217, 3259, 4406,
+		3975, 3954, 3924, 2065, 3647, 3649, 3676, 3731, 3736, 3731, 3244, 3316, 4382,
+		3983, 3947, 3947, 3894, 2065, 3623, 3662, 3721, 3724, 3794, 3330, 3408, 4431,
+		3991, 3951, 3949, 3902, 3880, 2027, 3483, 3651, 3701, 3741, 3423, 3470, 4398,
+		3964, 3967, 3979, 3928, 3909, 3726, 2027, 3611, 3664, 3730, 3428, 3642, 4484,
+		3971, 3976, 4025, 3980, 3970, 3897, 3849, 1943, 3703, 3785, 3658, 3746, 4665,
+		3964, 3921, 3968, 3977, 3967, 3945, 3903, 3943, 1852, 3825, 3833, 3911, 4768,
+		3838, 3935, 3995, 3976, 4045, 3990, 3982, 4035, 4077, 1743, 4069, 4191, 5026,
+		3060, 3265, 3352, 3379, 3471, 3569, 3563, 3802, 3986, 4242, 1748, 5000, 5351,
+		3123, 3312, 3384, 3455, 3551, 3611, 3789, 3894, 4059, 4361, 5257, 3884, 5444,
+		4550, 4645, 4686, 4665, 4711, 4684, 4766, 4953, 5061, 5352, 5632, 5731, 4961,
+	},
+	{ // 42s
+		1774, 3579, 3606, 3590, 3579, 3600, 3609, 3576, 3583, 3468, 2757, 3754, 2914,
+		3830, 1927, 3551, 3523, 3548, 3540, 3597, 3559, 3543, 3539, 2926, 3836, 3064,
+		3855, 3798, 1912, 3512, 3530, 3559, 3628, 3606, 3611, 3593, 3020, 3902, 3137,
+		3836, 3773, 3756, 1918, 3480, 3522, 3598, 3556, 3614, 3587, 3054, 3879, 3195,
+		3830, 3801, 3783, 3724, 1916, 3490, 3563, 3548, 3583, 3625, 3128, 3903, 3286,
+		3853, 3794, 3812, 3768, 3744, 1881, 3517, 3498, 3588, 3611, 3221, 3918, 3357,
+		3856, 3851, 3875, 3847, 3809, 3763, 1917, 3509, 3606, 3667, 3399, 4056, 3545,
+		3816, 3803, 3849, 3803, 3793, 3741, 3745, 1911, 3580, 3650, 3449, 4056, 3737,
+		3820, 3783, 3849, 3852, 3826, 3830, 3844, 3815, 1803, 3732, 3751, 4188, 3911,
+		3708, 3786, 3840, 3828, 3880, 3858, 3918, 3895, 3983, 1700, 3979, 4401, 4160,
+		2862, 3048, 3143, 3179, 3263, 3356, 3535, 3584, 3906, 4160, 1607, 4649, 5000,
+		4009, 4105, 4165, 4147, 4173, 4183, 4325, 4323, 4463, 4696, 4896, 3369, 5295,
+		3021, 3189, 3259, 3324, 3416, 3489, 3680, 3886, 4062, 4345, 5253, 5577, 3780,
+	},
+	{ // A3o
+		753, 2554, 2635, 2707, 2824, 3108, 3291, 3647, 3990, 4176, 4470, 4751, 5067,
+		2674, 2884, 5472, 5416, 5363, 5487, 5556, 5595, 5552, 5694, 5779, 7122, 6139,
+		2761, 5811, 2907, 5295, 5285, 5447, 5497, 5637, 5614, 5714, 5803, 7158, 6161,
+		2838, 5764, 5621, 2910, 5155, 5270, 5411, 5493, 5567, 5665, 5704, 7073, 6088,
+		2962, 5696, 5610, 5464, 2906, 5139, 5233, 5386, 5454, 5670, 5655, 7061, 6083,
+		3264, 5845, 5794, 5597, 5468, 2938, 5161, 5267, 5387, 5579, 5726, 7070, 6101,
+		3459, 5911, 5828, 5744, 5545, 5480, 2962, 5098, 5189, 5405, 5504, 7015, 6019,
+		3836, 5948, 5982, 5839, 5710, 5594, 5405, 2972, 5179, 5380, 5515, 6945, 6125,
+		4210, 5896, 5948, 5901, 5787, 5715, 5500, 5487, 3002, 5289, 5454, 6776, 6030,
+		4406, 6058, 6071, 6015, 6021, 5923, 5738, 5708, 5607, 2965, 5297, 6785, 5889,
+		4724, 6139, 6165, 6054, 6008, 6086, 5835, 5854, 5783, 5629, 3023, 6877, 5991,
+		5000, 7561, 7585, 7499, 7481, 7494, 7423, 7353, 7168, 7182, 7282, 3332, 7186,
+		5355, 6540, 6556, 6474, 6461, 6491, 6393, 6509, 6401, 6268, 6366, 7630, 4648,
+	},
+	{ // K3o
+		1119, 2235, 3404, 3351, 3317, 3509, 3444, 3458, 3467, 3353, 3356, 2339, 3716,
+		2306, 591, 2481, 2541, 2668, 2908, 3161, 3497, 3812, 4143, 4444, 4744, 5057,
+		3578, 2594, 2764, 5267, 5221, 5393, 5465, 5643, 5624, 5672, 5758, 7139, 6106,
+		3521, 2660, 5591, 2754, 5107, 5251, 5362, 5478, 5599, 5562, 5630, 7051, 6022,
+		3488, 2791, 5541, 5414, 2803, 5057, 5189, 5344, 5461, 5572, 5590, 6998, 6008,
+		3693, 3053, 5736, 5575, 5375, 2848, 5122, 5248, 5368, 5445, 5618, 6982, 5987,
+		3621, 3320, 5799, 5697, 5501, 5441, 2909, 5082, 5216, 5328, 5450, 6972, 5936,
+		3631, 3678, 5978, 5819, 5664, 5568, 5386, 2930, 5150, 5312, 5419, 6861, 6018,
+		3645, 4015, 5958, 5933, 5789, 5691, 5533, 5454, 2978, 5219, 5356, 6733, 5898,
+		3518, 4378, 6021, 5909, 5922, 5784, 5658, 5633, 5536, 3006, 5246, 6594, 5807,
+		3526, 4702, 6117, 5978, 5939, 5973, 5786, 5747, 5676, 5575, 3042, 6688, 5895,
+		2439, 5000, 7562, 7478, 7416, 7404, 7391, 7264, 7125, 6989, 7086, 3249, 6970,
+		3923, 5348, 6497, 6401, 6382, 6368, 6307, 6396, 6264, 6180, 6266, 7407, 4649,
+	},
+	{ // Q3o
+		1155, 3042, 2248, 3291, 3270, 3455, 3414, 3441, 3421, 3323, 3334, 2316, 3691,
+		3185, 1144, 2304, 3204, 3192, 3329, 3409, 3411, 3369, 3369, 3380, 2331, 3760,
+		2322, 2376, 602, 2544, 2644, 2888, 3128, 3519, 3813, 4149, 4448, 4751, 5054,
+		3460, 3370, 2656, 2695, 5074, 5198, 5366, 5435, 5562, 5529, 5599, 6985, 5986,
+		3440, 3356, 2766, 5381, 2682, 5079, 5194, 5343, 5457, 5584, 5603, 6995, 5992,
+		3637, 3511, 3029, 5522, 5402, 2743, 5095, 5206, 5326, 5448, 5578, 6951, 5973,
+		3592, 3591, 3279, 5696, 5508, 5412, 2798, 5078, 5185, 5319, 5410, 6924, 5933,
+		3618, 3593, 3700, 5770, 5666, 5526, 5382, 2893, 5138, 5295, 5431, 6832, 5994,
+		3599, 3546, 4011, 5893, 5790, 5646, 5495, 5445, 2904, 5143, 5333, 6666, 5849,
+		3496, 3546, 4381, 5870, 5934, 5788, 5646, 5619, 5452, 2916, 5202, 6541, 5773,
+		3505, 3553, 4703, 5944, 5948, 5927, 5739, 5764, 5655, 5528, 2962, 6616, 5835,
+		2415, 2438, 5000, 7401, 7415, 7366, 7334, 7232, 7055, 6922, 7010, 3189, 6880,
+		3895, 3973, 5341, 6365, 6364, 6353, 6302, 6366, 6209, 6139, 6201, 7309, 4637,
+	},
+	{ // J3o
+		1224, 3082, 3075, 2265, 3318, 3490, 3430, 3460, 3490, 3389, 3386, 2394, 3748,
+		3229, 1207, 3006, 2312, 3238, 3366, 3437, 3444, 3441, 3440, 3439, 2414, 3813,
+		3223, 3139, 1175, 2388, 3154, 3337, 3395, 3509, 3480, 3474, 3485, 2488, 3852,
+		2339, 2386, 2465, 649, 2649, 2865, 3106, 3459, 3830, 4137, 4432, 4746, 5059,
+		3489, 3402, 3315, 2767, 2763, 5071, 5239, 5366, 5474, 5598, 5610, 6958, 6052,
+		3667, 3540, 3512, 3000, 5391, 2801, 5116, 5204, 5383, 5460, 5625, 6942, 6016,
+		3607, 3613, 3569, 3258, 5551, 5426, 2812, 5076, 5255, 5358, 5437, 6932, 5959,
+		3635, 3618, 3688, 3636, 5692, 5524, 5376, 2945, 5176, 5297, 5409, 6781, 6019,
+		3674, 3620, 3660, 4031, 5806, 5705, 5574, 5485, 3017, 5193, 5335, 6640, 5907,
+		3563, 3616, 3654, 4368, 5950, 5802, 5683, 5616, 5507, 3040, 5195, 6476, 5765,
+		3564, 3619, 3668, 4687, 5959, 5979, 5763, 5741, 5660, 5520, 3115, 6545, 5853,
+		2501, 2522, 2599, 5000, 7377, 7363, 7341, 7182, 7035, 6861, 6937, 3288, 6841,
+		3958, 4027, 4067, 5344, 6427, 6395, 6327, 6390, 6271, 6131, 6221, 7272, 4688,
+	},
+	{ // T3o
+		1290, 3119, 3074, 3055, 2272, 3471, 3409, 3456, 3496, 3414, 3412, 2409, 3754,
+		3274, 1275, 3031, 2998, 2333, 3408, 3451, 3466, 3461, 3484, 3484, 2465, 3844,
+		3222, 3171, 1249, 2911, 2366, 3313, 3364, 3488, 3467, 3497, 3488, 2477, 3848,
+		3200, 3142, 3039, 1215, 2388, 3219, 3310, 3386, 3480, 3473, 3462, 2511, 3850,
+		2348, 2410, 2445, 2463, 691, 2836, 3072, 3416, 3767, 4163, 4427, 4745, 5061,
+		3649, 3590, 3485, 3379, 2975, 2771, 5087, 5264, 5376, 5497, 5619, 6899, 6018,
+		3581, 3631, 3531, 3475, 3218, 5401, 2822, 5053, 5184, 5334, 5442, 6856, 5950,
+		3629, 3645, 3659, 3555, 3591, 5582, 5355, 2895, 5150, 5332, 5424, 6746, 6043,
+		3679, 3642, 3640, 3651, 3969, 5698, 5496, 5457, 2990, 5225, 5322, 6573, 5910,
+		3595, 3674, 3679, 3652, 4399, 5838, 5659, 5653, 5544, 3038, 5183, 6449, 5812,
+		3597, 3672, 3671, 3641, 4685, 5976, 5770, 5755, 5649, 5510, 3127, 6449, 5827,
+		2519, 2584, 2585, 2623, 5000, 7319, 7267, 7141, 6964, 6833, 6840, 3276, 6778,
+		3968, 4065, 4060, 4061, 5345, 6399, 6313, 6414, 6272, 6181, 6191, 7207, 4655,
+	},
+	{ // 93o
+		1206, 3133, 3152, 3105, 3039, 2309, 3397, 3443, 3480, 3386, 3414, 2398, 3778,
+		3291, 1260, 3110, 3058, 3036, 2353, 3431, 3464, 3459, 3475, 3505, 2482, 3886,
+		3313, 3262, 1227, 3000, 2941, 2413, 3384, 3506, 3480, 3504, 3516, 2522, 3905,
+		3256, 3209, 3146, 1211, 2871, 2427, 3287, 3362, 3456, 3453, 3460, 2528, 3855,
+		3185, 3177, 3080, 3003, 1185, 2435, 3168, 3273, 3364, 3482, 3472, 2573, 3860,
+		2389, 2436, 2502, 2512, 2520, 677, 3045, 3365, 3715, 4085, 4444, 4747, 5066,
+		3572, 3611, 3557, 3457, 3320, 3195, 2763, 4981, 5168, 5282, 5394, 6761, 5931,
+		3617, 3641, 3684, 3536, 3437, 3538, 5281, 2884, 5077, 5228, 5374, 6617, 6008,
+		3659, 3631, 3653, 3624, 3527, 3906, 5477, 5375, 2909, 5162, 5293, 6493, 5896,
+		3566, 3657, 3688, 3627, 3659, 4312, 5608, 5544, 5473, 3013, 5168, 6314, 5742,
+		3600, 3693, 3708, 3638, 3653, 4705, 5724, 5700, 5610, 5493, 3112, 6389, 5817,
+		2506, 2596, 2634, 2637, 2681, 5000, 7168, 7009, 6877, 6686, 6775, 3282, 6664,
+		3992, 4107, 4125, 4066, 4064, 5353, 6299, 6380, 6255, 6103, 6186, 7082, 4666,
+	},
+	{ // 83o
+		1245, 3145, 3158, 3113, 3071, 3129, 2293, 3459, 3448, 3379, 3426, 2461, 3787,
+		3300, 1232, 3106, 3087, 3060, 3060, 2354, 3430, 3388, 3425, 3475, 2492, 3847,
+		3319, 3254, 1240, 3052, 3018, 3059, 2415, 3480, 3439, 3470, 3516, 2548, 3891,
+		3267, 3239, 3199, 1198, 2926, 2943, 2421, 3360, 3436, 3423, 3451, 2543, 3856,
+		3216, 3201, 3164, 3061, 1196, 2853, 2471, 3272, 3330, 3449, 3461, 2618, 3849,
+		3281, 3209, 3211, 3082, 2984, 1165, 2554, 3212, 3272, 3372, 3489, 2713, 3878,
+		2374, 2433, 2500, 2504, 2550, 2638, 687, 3328, 3685, 4019, 4353, 4750, 5030,
+		3634, 3600, 3657, 3532, 3435, 3373, 3494, 2804, 4997, 5185, 5315, 6483, 5923,
+		3628, 3558, 3612, 3608, 3496, 3436, 3878, 5291, 2890, 5033, 5189, 6296, 5750,
+		3559, 3600, 3653, 3598, 3630, 3548, 4242, 5498, 5336, 2931, 5041, 6156, 5628,
+		3611, 3653, 3705, 3632, 3640, 3675, 4597, 5639, 5501, 5351, 3073, 6211, 5675,
+		2577, 2609, 2666, 2659, 2733, 2832, 5000, 6862, 6667, 6521, 6580, 3271, 6464,
+		4007, 4069, 4116, 4075, 4058, 4097, 5316, 6291, 6104, 5989, 6033, 6875, 4643,
+	},
+	{ // 73o
+		1389, 3241, 3226, 3187, 3165, 3233, 3180, 2411, 3511, 3416, 3443, 2528, 3879,
+		3402, 1408, 3221, 3199, 3175, 3197, 3200, 2485, 3508, 3505, 3544, 2604, 3999,
+		3386, 3380, 1354, 3138, 3132, 3197, 3181, 2552, 3506, 3519, 3569, 2637, 3995,
+		3343, 3359, 3290, 1391, 3062, 3110, 3143, 2556, 3527, 3522, 3539, 2692, 3978,
+		3318, 3327, 3286, 3206, 1353, 3002, 3036, 2608, 3434, 3540, 3529, 2729, 3986,
+		3391, 3355, 3359, 3260, 3149, 1330, 3010, 2706, 3402, 3488, 3613, 2859, 4034,
+		3333, 3356, 3338, 3293, 3177, 3148, 1318, 2652, 3368, 3448, 3562, 2999, 4081,
+		2491, 2571, 2640, 2642, 2697, 2800, 2736, 851, 3705, 4035, 4374, 4748, 5135,
+		3690, 3684, 3683, 3700, 3606, 3572, 3536, 3893, 2863, 4962, 5149, 6225, 5730,
+		3593, 3686, 3705, 3697, 3722, 3666, 3623, 4251, 5257, 2910, 4988, 6050, 5595,
+		3625, 3731, 3759, 3719, 3716, 3805, 3744, 4617, 5456, 5297, 3018, 6106, 5677,
+		2647, 2736, 2768, 2818, 2859, 2991, 3138, 5000, 6585, 6409, 6470, 3342, 6397,
+		4101, 4229, 4227, 4203, 4202, 4260, 4305, 5420, 6073, 5946, 6029, 6807, 4744,
+	},
+	{ // 63o
+		1525, 3386, 3374, 3336, 3330, 3379, 3337, 3325, 2549, 3542, 3559, 2693, 3980,
+		3566, 1546, 3329, 3288, 3265, 3300, 3311, 3317, 2589, 3601, 3619, 2732, 4030,
+		3555, 3498, 1530, 3272, 3258, 3313, 3313, 3351, 2679, 3646, 3674, 2803, 4063,
+		3507, 3458, 3434, 1505, 3182, 3256, 3290, 3305, 2708, 3623, 3646, 2827, 4051,
+		3500, 3429, 3423, 3334, 1511, 3169, 3199, 3238, 2739, 3655, 3654, 2894, 4053,
+		3556, 3468, 3483, 3417, 3329, 1490, 3149, 3188, 2851, 3581, 3686, 2980, 4081,
+		3510, 3481, 3479, 3454, 3350, 3301, 1476, 3141, 2846, 3584, 3673, 3178, 4162,
+		3488, 3479, 3511, 3464, 3391, 3341, 3285, 1374, 3063, 3606, 3716, 3261, 4312,
+		2648, 2685, 2780, 2806, 2839, 2957, 2949, 3179, 1013, 4029, 4394, 4750, 5122,
+		3725, 3788, 3839, 3804, 3844, 3760, 3765, 3792, 4240, 2953, 4859, 5872, 5464,
+		3749, 3813, 3867, 3830, 3843, 3877, 3859, 3911, 4632, 5147, 3034, 5941, 5537,
+		2832, 2875, 2945, 2965, 3036, 3123, 3333, 3415, 5000, 6205, 6290, 3465, 6074,
+		4209, 4268, 4300, 4280, 4276, 4311, 4394, 4548, 5407, 5795, 5870, 6448, 4755,
+	},
+	{ // 53o
+		1585, 3507, 3526, 3502, 3446, 3520, 3471, 3492, 3495, 2583, 3701, 2696, 4077,
+		3674, 1722, 3495, 3481, 3414, 3469, 3474, 3461, 3439, 2761, 3774, 2872, 4145,
+		3703, 3669, 1725, 3468, 3419, 3487, 3476, 3527, 3496, 2865, 3828, 2931, 4192,
+		3674, 3657, 3641, 1735, 3360, 3441, 3444, 3483, 3504, 2897, 3806, 2991, 4188,
+		3617, 3583, 3596, 3525, 1684, 3341, 3371, 3437, 3452, 2961, 3795, 3019, 4168,
+		3698, 3646, 3672, 3615, 3514, 1708, 3342, 3407, 3434, 3059, 3864, 3157, 4219,
+		3643, 3650, 3656, 3621, 3540, 3515, 1682, 3340, 3389, 3056, 3823, 3315, 4274,
+		3654, 3621, 3696, 3649, 3603, 3572, 3504, 1583, 3434, 3289, 3887, 3432, 4456,
+		3655, 3601, 3666, 3667, 3620, 3597, 3556, 3596, 1471, 3437, 3995, 3621, 4587,
+		2678, 2869, 2984, 3016, 3083, 3187, 3181, 3421, 3578, 1261, 4393, 4747, 5115,
+		3881, 3959, 4022, 3994, 3988, 4068, 4018, 4084, 4203, 4633, 3090, 5639, 5304,
+		2818, 3011, 3078, 3139, 3167, 3314, 3479, 3591, 3795, 5000, 5957, 3617, 5761,
+		4302, 4374, 4428, 4417, 4394, 4458, 4512, 4694, 4845, 5402, 5606, 6108, 4829,
+	},
+	{ // 43o
+		1510, 3418, 3450, 3427, 3433, 3435, 3414, 3430, 3428, 3299, 2533, 2600, 3994,
+		3590, 1664, 3422, 3396, 3389, 3387, 3407, 3423, 3374, 3391, 2725, 2771, 4073,
+		3620, 3592, 1633, 3380, 3395, 3393, 3430, 3480, 3434, 3456, 2817, 2853, 4128,
+		3595, 3570, 3543, 1661, 3348, 3351, 3376, 3433, 3441, 3440, 2844, 2917, 4105,
+		3604, 3559, 3567, 3512, 1661, 3325, 3366, 3423, 3432, 3503, 2934, 3012, 4156,
+		3608, 3564, 3569, 3521, 3499, 1620, 3179, 3348, 3407, 3450, 3031, 3074, 4122,
+		3585, 3583, 3603, 3547, 3532, 3338, 1619, 3311, 3369, 3445, 3029, 3258, 4215,
+		3589, 3591, 3644, 3597, 3587, 3511, 3467, 1531, 3415, 3503, 3279, 3370, 4404,
+		3581, 3534, 3587, 3597, 3589, 3565, 3525, 3573, 1436, 3554, 3473, 3549, 4521,
+		3449, 3547, 3610, 3593, 3665, 3609, 3611, 3667, 3722, 1309, 3737, 3860, 4804,
+		2628, 2836, 2934, 2959, 3055, 3158, 3147, 3409, 3611, 3895, 1247, 4743, 5104,
+		2718, 2914, 2990, 3063, 3160, 3225, 3420, 3530, 3710, 4043, 5000, 3518, 5213,
+		4215, 4307, 4353, 4332, 4381, 4352, 4446, 4641, 4767, 5089, 5387, 5514, 4700,
+	},
+	{ // 33
+		1843, 5111, 5123, 5028, 5026, 5141, 5074, 5183, 5217, 5121, 5171, 6290, 6578,
+		5376, 1877, 4981, 4926, 4884, 4978, 5024, 5076, 5107, 5116, 5182, 6351, 6535,
+		5382, 5227, 1895, 4827, 4822, 4955, 4983, 5155, 5156, 5177, 5223, 6417, 6583,
+		5283, 5179, 5062, 1883, 4678, 4795, 4910, 5001, 5102, 5093, 5130, 6324, 6510,
+		5281, 5129, 5063, 4903, 1864, 4680, 4787, 4924, 5032, 5129, 5098, 6334, 6517,
+		5404, 5238, 5206, 5032, 4916, 1886, 4704, 4807, 4957, 5039, 5169, 6327, 6497,
+		5329, 5292, 5232, 5156, 5022, 4935, 1858, 4666, 4816, 4932, 5000, 6342, 6508,
+		5440, 5334, 5403, 5250, 5163, 5039, 4884, 1858, 4827, 4957, 5063, 6284, 6623,
+		5477, 5358, 5404, 5349, 5283, 5198, 5049, 5051, 1845, 4906, 5043, 6177, 6601,
+		5384, 5389, 5438, 5352, 5394, 5290, 5177, 5201, 5148, 1875, 4933, 6024, 6560,
+		5441, 5452, 5497, 5389, 5362, 5436, 5248, 5318, 5293, 5193, 1911, 6116, 6631,
+		6668, 6751, 6811, 6712, 6724, 6718, 6729, 6658, 6535, 6383, 6482, 5000, 8393,
+		6934, 6898, 6943, 6859, 6869, 6849, 6851, 6976, 6947, 6920, 6990, 8907, 8078,
+	},
+	{ // 32s
+		1720, 3554, 3579, 3549, 3511, 3575, 3543, 3516, 3559, 3413, 3381, 2723, 2867,
+		3802, 1850, 3562, 3508, 3478, 3539, 3569, 3534, 3553, 3523, 3518, 2913, 3052,
+		3824, 3807, 1838, 3517, 3498, 3548, 3577, 3592, 3595, 3586, 3570, 3004, 3127,
+		3794, 3759, 3757, 1848, 3457, 3508, 3539, 3507, 3576, 3564, 3513, 3038, 3156,
+		3753, 3723, 3739, 3695, 1851, 3449, 3487, 3481, 3531, 3561, 3530, 3098, 3224,
+		3823, 3793, 3797, 3752, 3698, 1870, 3490, 3468, 3552, 3556, 3578, 3209, 3345,
+		3785, 3821, 3819, 3783, 3722, 3735, 1830, 3393, 3566, 3637, 3641, 3402, 3486,
+		3753, 3775, 3829, 3748, 3714, 3705, 3623, 1823, 3569, 3640, 3652, 3469, 3698,
+		3801, 3799, 3838, 3820, 3770, 3795, 3810, 3810, 1757, 3737, 3791, 3767, 3880,
+		3649, 3769, 3826, 3807, 3805, 3799, 3882, 3880, 3990, 1672, 3924, 4061, 4142,
+		3620, 3766, 3812, 3757, 3775, 3826, 3889, 3898, 4052, 4199, 1706, 4556, 4705,
+		2814, 3030, 3120, 3159, 3222, 3336, 3536, 3603, 3926, 4239, 4787, 1607, 5000,
+		2969, 3174, 3249, 3279, 3340, 3476, 3619, 3842, 4043, 4326, 4939, 5254, 3732,
+	},
+	{ // A2o
+		685, 2460, 2538, 2622, 2731, 2975, 3221, 3546, 3882, 4124, 4386, 4400, 4751,
+		2577, 2818, 5471, 5411, 5376, 5477, 5582, 5527, 5520, 5649, 5717, 5711, 7152,
+		2653, 5808, 2850, 5286, 5291, 5416, 5501, 5580, 5564, 5661, 5737, 5750, 7169,
+		2743, 5756, 5609, 2846, 5166, 5268, 5412, 5449, 5524, 5616, 5654, 5688, 7092,
+		2859, 5704, 5609, 5469, 2834, 5123, 5255, 5345, 5423, 5628, 5610, 5688, 7092,
+		3121, 5826, 5756, 5590, 5442, 2887, 5160, 5201, 5326, 5537, 5673, 5661, 7077,
+		3381, 5930, 5828, 5740, 5561, 5471, 2933, 5107, 5191, 5407, 5504, 5652, 7029,
+		3729, 5870, 5918, 5786, 5663, 5519, 5408, 2889, 5057, 5272, 5401, 5563, 7077,
+		4091, 5857, 5891, 5849, 5749, 5643, 5498, 5353, 2912, 5182, 5341, 5461, 6921,
+		4353, 6009, 6016, 5963, 5972, 5872, 5739, 5593, 5492, 2940, 5223, 5368, 6901,
+		4637, 6070, 6091, 5998, 5952, 6025, 5831, 5728, 5659, 5548, 3003, 5450, 6979,
+		4645, 6077, 6105, 6042, 6032, 6008, 5993, 5899, 5791, 5698, 5785, 3066, 7031,
+		5000, 7590, 7600, 7515, 7501, 7500, 7435, 7491, 7321, 7312, 7384, 7448, 3345,
+	},
+	{ // K2o
+		1067, 2154, 3316, 3309, 3264, 3421, 3421, 3406, 3421, 3299, 3291, 3289, 2308,
+		2226, 542, 2383, 2464, 2574, 2799, 3105, 3404, 3771, 4100, 4369, 4390, 4747,
+		3485, 2490, 2718, 5257, 5223, 5377, 5470, 5578, 5578, 5626, 5701, 5673, 7159,
+		3479, 2582, 5579, 2705, 5115, 5250, 5366, 5423, 5559, 5525, 5602, 5619, 7070,
+		3433, 2697, 5539, 5418, 2740, 5064, 5216, 5302, 5428, 5539, 5542, 5586, 7034,
+		3598, 2939, 5717, 5571, 5378, 2800, 5130, 5183, 5328, 5407, 5579, 5549, 7008,
+		3600, 3268, 5803, 5696, 5525, 5443, 2892, 5086, 5225, 5342, 5459, 5577, 6987,
+		3581, 3586, 5909, 5756, 5618, 5496, 5385, 2863, 5061, 5205, 5304, 5434, 6998,
+		3600, 3973, 5908, 5889, 5753, 5645, 5539, 5358, 2932, 5142, 5277, 5403, 6869,
+		3470, 4338, 5976, 5868, 5884, 5741, 5671, 5520, 5454, 2967, 5171, 5290, 6727,
+		3467, 4628, 6057, 5948, 5887, 5929, 5794, 5623, 5593, 5493, 3022, 5355, 6811,
+		3460, 4652, 6027, 5973, 5935, 5893, 5931, 5771, 5732, 5626, 5693, 3102, 6826,
+		2410, 5000, 7590, 7494, 7445, 7430, 7396, 7410, 7268, 7134, 7215, 7242, 3272,
+	},
+	{ // Q2o
+		1106, 2935, 2168, 3248, 3243, 3378, 3380, 3389, 3391, 3281, 3274, 3277, 2301,
+		3071, 1088, 2205, 3148, 3146, 3246, 3373, 3343, 3352, 3305, 3300, 3325, 2308,
+		2239, 2273, 573, 2449, 2568, 2778, 3061, 3435, 3780, 4112, 4381, 4405, 4751,
+		3412, 3307, 2556, 2671, 5083, 5213, 5382, 5400, 5528, 5500, 5572, 5588, 7013,
+		3414, 3308, 2689, 5384, 2648, 5086, 5224, 5309, 5437, 5566, 5570, 5594, 7033,
+		3553, 3416, 2911, 5534, 5405, 2722, 5119, 5157, 5296, 5417, 5533, 5534, 6981,
+		3553, 3550, 3209, 5708, 5533, 5433, 2797, 5090, 5194, 5337, 5417, 5543, 6957,
+		3569, 3520, 3617, 5726, 5626, 5469, 5389, 2852, 5041, 5203, 5329, 5442, 6973,
+		3571, 3525, 3977, 5853, 5763, 5609, 5504, 5339, 2891, 5076, 5246, 5376, 6800,
+		3452, 3478, 4344, 5834, 5911, 5752, 5664, 5519, 5379, 2900, 5133, 5251, 6690,
+		3452, 3475, 4635, 5915, 5915, 5879, 5746, 5656, 5566, 5452, 2967, 5314, 6741,
+		3444, 3503, 4659, 5933, 5940, 5875, 5884, 5773, 5700, 5572, 5647, 3057, 6751,
+		2400, 2410, 5000, 7432, 7442, 7401, 7362, 7379, 7194, 7085, 7140, 7159, 3236,
+	},
+	{ // J2o
+		1164, 3054, 3038, 2187, 3266, 3420, 3433, 3423, 3470, 3365, 3344, 3352, 2377,
+		3204, 1127, 2955, 2219, 3181, 3317, 3444, 3402, 3445, 3402, 3381, 3417, 2398,
+		3182, 3085, 1123, 2271, 3104, 3257, 3358, 3459, 3476, 3433, 3426, 3455, 2460,
+		2259, 2295, 2345, 602, 2556, 2762, 3055, 3370, 3788, 4102, 4367, 4398, 4748,
+		3433, 3345, 3262, 2671, 2700, 5082, 5258, 5321, 5433, 5556, 5578, 5592, 7012,
+		3595, 3488, 3423, 2892, 5397, 2747, 5131, 5150, 5342, 5427, 5599, 5589, 6976,
+		3612, 3624, 3525, 3206, 5567, 5438, 2800, 5075, 5253, 5368, 5446, 5584, 6946,
+		3600, 3578, 3638, 3546, 5641, 5464, 5372, 2878, 5078, 5200, 5315, 5466, 6921,
+		3659, 3628, 3655, 3989, 5759, 5656, 5570, 5380, 2986, 5118, 5259, 5389, 6784,
+		3545, 3585, 3615, 4334, 5905, 5764, 5694, 5515, 5427, 3007, 5131, 5257, 6612,
+		3527, 3563, 3606, 4621, 5922, 5949, 5774, 5637, 5579, 5452, 3083, 5335, 6676,
+		3526, 3599, 3635, 4656, 5939, 5934, 5925, 5797, 5720, 5583, 5668, 3141, 6721,
+		2485, 2506, 2568, 5000, 7422, 7396, 7352, 7326, 7181, 7011, 7072, 7130, 3317,
+	},
+	{ // T2o
+		1221, 3056, 3048, 2969, 2191, 3437, 3416, 3407, 3449, 3377, 3355, 3364, 2381,
+		3211, 1202, 2979, 2898, 2234, 3340, 3435, 3387, 3438, 3429, 3426, 3429, 2434,
+		3200, 3119, 1191, 2839, 2281, 3268, 3337, 3433, 3453, 3436, 3420, 3458, 2443,
+		3110, 3039, 2967, 1170, 2291, 3118, 3242, 3291, 3415, 3393, 3364, 3392, 2466,
+		2266, 2312, 2359, 2364, 639, 2742, 3019, 3325, 3725, 4123, 4356, 4400, 4751,
+		3621, 3521, 3440, 3275, 2878, 2725, 5104, 5205, 5331, 5463, 5585, 5592, 6933,
+		3597, 3620, 3508, 3409, 3169, 5413, 2808, 5053, 5178, 5342, 5447, 5599, 6870,
+		3585, 3563, 3611, 3459, 3498, 5516, 5350, 2828, 5050, 5225, 5317, 5470, 6892,
+		3639, 3622, 3629, 3591, 3929, 5647, 5488, 5348, 2956, 5140, 5240, 5397, 6715,
+		3561, 3620, 3621, 3570, 4359, 5798, 5666, 5538, 5451, 3003, 5114, 5283, 6591,
+		3546, 3619, 3607, 3544, 4614, 5937, 5774, 5640, 5561, 5434, 3104, 5289, 6584,
+		3539, 3618, 3636, 3573, 4655, 5936, 5942, 5798, 5724, 5606, 5619, 3131, 6660,
+		2499, 2555, 2558, 2578, 5000, 7351, 7269, 7296, 7106, 6988, 6978, 7068, 3299,
+	},
+	{ // 92o
+		1173, 3065, 3083, 3029, 3015, 2209, 3361, 3366, 3428, 3339, 3360, 3333, 2390,
+		3218, 1212, 3029, 2996, 2978, 2261, 3418, 3388, 3435, 3414, 3435, 3446, 2458,
+		3235, 3169, 1203, 2900, 2898, 2308, 3324, 3430, 3440, 3433, 3462, 3464, 2490,
+		3178, 3145, 3038, 1157, 2799, 2340, 3256, 3312, 3432, 3411, 3414, 3426, 2497,
+		3161, 3122, 3035, 2924, 1138, 2359, 3152, 3219, 3338, 3429, 3417, 3421, 2544,
+		2288, 2343, 2392, 2421, 2446, 638, 2990, 3279, 3665, 4043, 4378, 4394, 4749,
+		3536, 3599, 3490, 3424, 3312, 3142, 2757, 4988, 5179, 5296, 5408, 5561, 6781,
+		3539, 3560, 3607, 3483, 3385, 3447, 5283, 2834, 4973, 5128, 5276, 5414, 6762,
+		3609, 3609, 3612, 3604, 3509, 3856, 5486, 5264, 2886, 5084, 5217, 5364, 6638,
+		3519, 3597, 3614, 3587, 3611, 4269, 5621, 5437, 5388, 2993, 5115, 5223, 6447,
+		3549, 3624, 3650, 3593, 3600, 4637, 5738, 5593, 5530, 5432, 3103, 5316, 6511,
+		3509, 3632, 3647, 3605, 3601, 4647, 5903, 5740, 5689, 5542, 5648, 3151, 6524,
+		2500, 2570, 2599, 2604, 2649, 5000, 7183, 7160, 7024, 6831, 6904, 6922, 3288,
+	},
+	{ // 82o
+		1257, 3128, 3123, 3101, 3089, 3091, 2252, 3399, 3439, 3375, 3391, 3424, 2445,
+		3290, 1230, 3067, 3077, 3052, 3067, 2312, 3388, 3413, 3421, 3445, 3498, 2482,
+		3279, 3216, 1268, 2985, 2993, 3006, 2359, 3414, 3428, 3437, 3468, 3511, 2519,
+		3258, 3235, 3128, 1203, 2904, 2919, 2389, 3328, 3445, 3421, 3442, 3484, 2532,
+		3243, 3205, 3142, 3043, 1181, 2845, 2435, 3250, 3361, 3454, 3453, 3500, 2612,
+		3245, 3223, 3152, 3055, 2983, 1163, 2501, 3167, 3283, 3388, 3498, 3516, 2697,
+		2332, 2399, 2440, 2474, 2519, 2589, 678, 3289, 3648, 3998, 4317, 4429, 4751,
+		3574, 3563, 3589, 3503, 3419, 3328, 3453, 2741, 4912, 5104, 5230, 5375, 6650,
+		3622, 3592, 3600, 3622, 3540, 3454, 3840, 5201, 2873, 4974, 5130, 5286, 6450,
+		3556, 3605, 3613, 3598, 3641, 3563, 4221, 5411, 5270, 2912, 4995, 5173, 6301,
+		3579, 3636, 3655, 3627, 3640, 3685, 4561, 5545, 5438, 5302, 3064, 5234, 6320,
+		3607, 3693, 3698, 3673, 3687, 3701, 4684, 5695, 5606, 5488, 5554, 3149, 6381,
+		2565, 2604, 2638, 2648, 2731, 2817, 5000, 7041, 6824, 6685, 6697, 6769, 3288,
+	},
+	{ // 72o
+		1172, 3110, 3071, 3047, 3008, 3048, 3004, 2205, 3376, 3283, 3276, 3320, 2396,
+		3268, 1191, 3050, 3045, 2990, 3027, 3035, 2268, 3373, 3354, 3359, 3419, 2469,
+		3226, 3200, 1153, 2969, 2973, 3028, 3022, 2344, 3406, 3372, 3390, 3446, 2497,
+		3197, 3200, 3118, 1169, 2908, 2967, 3007, 2350, 3408, 3383, 3374, 3426, 2554,
+		3154, 3133, 3124, 3046, 1132, 2849, 2883, 2400, 3302, 3397, 3361, 3407, 2591,
+		3199, 3176, 3183, 3109, 2989, 1123, 2856, 2491, 3272, 3342, 3445, 3441, 2718,
+		3143, 3183, 3168, 3152, 3015, 2987, 1096, 2617, 3241, 3316, 3401, 3526, 2838,
+		2280, 2348, 2427, 2431, 2480, 2575, 2704, 657, 3587, 3959, 4219, 4333, 4756,
+		3553, 3547, 3583, 3585, 3472, 3441, 3407, 3774, 2783, 4808, 4994, 5148, 6226,
+		3457, 3536, 3557, 3560, 3577, 3519, 3487, 4180, 5096, 2832, 4849, 5001, 6062,
+		3456, 3544, 3577, 3559, 3542, 3633, 3581, 4459, 5290, 5151, 2947, 5047, 6114,
+		3491, 3604, 3634, 3610, 3586, 3620, 3709, 4580, 5452, 5306, 5359, 3024, 6158,
+		2509, 2590, 2621, 2674, 2704, 2840, 2959, 5000, 6583, 6427, 6476, 6534, 3260,
+	},
+	{ // 62o
+		1341, 3231, 3226, 3178, 3149, 3192, 3190, 3173, 2340, 3389, 3392, 3420, 2553,
+		3399, 1337, 3195, 3147, 3123, 3154, 3196, 3158, 2391, 3472, 3473, 3538, 2600,
+		3392, 3355, 1344, 3118, 3112, 3141, 3186, 3240, 2495, 3523, 3547, 3594, 2673,
+		3338, 3311, 3273, 1301, 3039, 3105, 3166, 3143, 2517, 3472, 3505, 3534, 2690,
+		3305, 3280, 3269, 3187, 1310, 3020, 3081, 3076, 2549, 3523, 3499, 3538, 2764,
+		3357, 3315, 3305, 3262, 3176, 1301, 3022, 3032, 2649, 3453, 3542, 3554, 2842,
+		3345, 3356, 3343, 3323, 3229, 3172, 1278, 3031, 2816, 3479, 3557, 3697, 3035,
+		3328, 3314, 3402, 3302, 3224, 3183, 3174, 1265, 2862, 3509, 3586, 3729, 3262,
+		2425, 2478, 2582, 2606, 2641, 2747, 2920, 2972, 815, 3977, 4251, 4348, 4756,
+		3564, 3656, 3713, 3654, 3711, 3633, 3661, 3693, 4192, 2878, 4720, 4871, 5889,
+		3572, 3662, 3740, 3687, 3686, 3734, 3741, 3778, 4485, 5003, 2977, 4939, 5938,
+		3599, 3736, 3791, 3729, 3728, 3745, 3896, 3927, 4593, 5155, 5233, 3053, 5957,
+		2679, 2732, 2806, 2819, 2894, 2976, 3176, 3417, 5000, 6233, 6282, 6302, 3376,
+	},
+	{ // 52o
+		1375, 3338, 3350, 3352, 3276, 3349, 3333, 3335, 3314, 2377, 3543, 3557, 2573,
+		3501, 1522, 3289, 3308, 3223, 3293, 3326, 3285, 3275, 2540, 3600, 3633, 2739,
+		3513, 3450, 1536, 3273, 3212, 3294, 3312, 3338, 3317, 2640, 3634, 3672, 2779,
+		3514, 3476, 3435, 1531, 3189, 3286, 3314, 3304, 3322, 2694, 3646, 3681, 2856,
+		3434, 3384, 3375, 3341, 1490, 3166, 3242, 3267, 3291, 2743, 3611, 3632, 2880,
+		3517, 3461, 3465, 3449, 3331, 1514, 3246, 3237, 3288, 2840, 3720, 3706, 3024,
+		3488, 3493, 3471, 3476, 3397, 3408, 1490, 3218, 3275, 3020, 3703, 3820, 3172,
+		3491, 3443, 3500, 3464, 3425, 3397, 3369, 1473, 3294, 3079, 3724, 3862, 3412,
+		3464, 3425, 3471, 3477, 3451, 3444, 3431, 3451, 1393, 3351, 3852, 3989, 3596,
+		2462, 2641, 2745, 2799, 2857, 2954, 3142, 3202, 3490, 1068, 4241, 4360, 4747,
+		3726, 3784, 3822, 3829, 3799, 3917, 3891, 3914, 4055, 4476, 3016, 4648, 5655,
+		3732, 3820, 3861, 3869, 3819, 3897, 4011, 4054, 4205, 4598, 4911, 3080, 5674,
+		2688, 2866, 2915, 2989, 3012, 3169, 3315, 3573, 3767, 5000, 5965, 5996, 3519,
+	},
+	{ // 42o
+		1364, 3287, 3314, 3295, 3288, 3303, 3313, 3277, 3290, 3175, 2342, 3459, 2502,
+		3453, 1516, 3254, 3224, 3253, 3240, 3297, 3258, 3247, 3247, 2516, 3545, 2655,
+		3478, 3415, 1506, 3216, 3234, 3262, 3327, 3306, 3319, 3301, 2615, 3614, 2730,
+		3456, 3388, 3375, 1520, 3186, 3224, 3300, 3260, 3321, 3296, 2651, 3590, 2793,
+		3451, 3419, 3399, 3341, 1513, 3195, 3269, 3254, 3293, 3340, 2731, 3619, 2887,
+		3469, 3409, 3431, 3385, 3361, 1470, 3216, 3195, 3295, 3321, 2825, 3628, 2956,
+		3475, 3469, 3493, 3466, 3429, 3377, 1511, 3209, 3315, 3384, 3004, 3771, 3155,
+		3432, 3419, 3467, 3424, 3414, 3353, 3362, 1507, 3295, 3373, 3059, 3777, 3362,
+		3438, 3400, 3471, 3474, 3448, 3453, 3469, 3447, 1400, 3465, 3394, 3924, 3554,
+		3324, 3405, 3459, 3447, 3506, 3480, 3550, 3533, 3634, 1275, 3651, 4161, 3840,
+		2430, 2620, 2721, 2757, 2846, 2943, 3126, 3182, 3535, 3816, 1096, 4368, 4747,
+		3634, 3734, 3799, 3779, 3809, 3814, 3967, 3971, 4130, 4394, 4613, 3010, 5061,
+		2616, 2785, 2860, 2928, 3022, 3096, 3303, 3524, 3718, 4035, 5000, 5330, 3404,
+	},
+	{ // 32o
+		1299, 3245, 3274, 3246, 3200, 3266, 3231, 3205, 3259, 3105, 3073, 2292, 2442,
+		3408, 1429, 3255, 3200, 3164, 3229, 3254, 3220, 3251, 3214, 3211, 2493, 2633,
+		3434, 3415, 1421, 3209, 3186, 3240, 3265, 3282, 3296, 3277, 3267, 2590, 2713,
+		3404, 3365, 3364, 1426, 3146, 3202, 3229, 3199, 3276, 3261, 3209, 2624, 2742,
+		3355, 3321, 3340, 3297, 1430, 3142, 3175, 3170, 3229, 3257, 3224, 2687, 2807,
+		3429, 3398, 3406, 3361, 3304, 1455, 3180, 3157, 3254, 3253, 3277, 2804, 2939,
+		3387, 3420, 3424, 3387, 3324, 3340, 1416, 3073, 3264, 3340, 3339, 3004, 3088,
+		3356, 3375, 3435, 3353, 3317, 3311, 3214, 1400, 3272, 3351, 3357, 3073, 3312,
+		3414, 3410, 3455, 3436, 3382, 3413, 3422, 3429, 1339, 3460, 3515, 3407, 3524,
+		3250, 3369, 3429, 3413, 3411, 3409, 3498, 3506, 3632, 1236, 3668, 3727, 3811,
+		3222, 3369, 3420, 3362, 3379, 3438, 3499, 3518, 3696, 3868, 1275, 4269, 4423,
+		2370, 2593, 2691, 2728, 2793, 2918, 3125, 3193, 3552, 3892, 4486, 1093, 4746,
+		2552, 2758, 2841, 2870, 2932, 3078, 3231, 3466, 3698, 4004, 4670, 5000, 3348,
+	},
+	{ // 22
+		1759, 5026, 5044, 4932, 4941, 5092, 5027, 5042, 5107, 4987, 5075, 5089, 6270,
+		5286, 1789, 4922, 4849, 4832, 4919, 4981, 4959, 4980, 5016, 5102, 5083, 6333,
+		5299, 5170, 1812, 4752, 4744, 4898, 4951, 5002, 5003, 5064, 5128, 5098, 6371,
+		5182, 5097, 4983, 1807, 4660, 4747, 4864, 4871, 4980, 4985, 5047, 5056, 6297,
+		5182, 5068, 4969, 4876, 1790, 4625, 4751, 4813, 4928, 5046, 5031, 5086, 6323,
+		5351, 5177, 5146, 4980, 4849, 1786, 4664, 4693, 4849, 4932, 5076, 5076, 6325,
+		5277, 5232, 5195, 5101, 4969, 4886, 1818, 4638, 4768, 4884, 4988, 5095, 6329,
+		5291, 5209, 5240, 5112, 5042, 4916, 4849, 1803, 4625, 4751, 4902, 5004, 6361,
+		5362, 5227, 5238, 5213, 5163, 5074, 4992, 4837, 1826, 4730, 4880, 5000, 6256,
+		5247, 5280, 5322, 5231, 5300, 5174, 5126, 4983, 4957, 1837, 4791, 4917, 6109,
+		5334, 5367, 5389, 5301, 5285, 5336, 5231, 5143, 5117, 5034, 1855, 5039, 6220,
+		5352, 5351, 5363, 5312, 5345, 5334, 5357, 5256, 5245, 5171, 5300, 1922, 6268,
+		6655, 6728, 6764, 6683, 6701, 6712, 6712, 6740, 6624, 6481, 6596, 6652, 5000,
+	},
+}
@@ -0,0 +1,120 @@
+// Command gen builds the preflop equity table of the pushfold package with the poker evaluator.
+// Every sampled board is scored against all 1326 starting hands at once, so each board adds
+// a showdown to every class matchup whose combos do not collide with it.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+
+	"temperature-converter/poker"
+	"temperature-converter/pushfold"
+)
+
+func main() {
+	boards := flag.Int("boards", 10000, "number of random boards to sample")
+	seed := flag.Int64("seed", 1, "random seed")
+	output := flag.String("o", "equity_table.go", "output file")
+	flag.Parse()
+
+	deck := poker.GetDeck()
+	type combo struct {
+		cards []poker.Card
+		mask  uint64
+		class int
+	}
+	var combos []combo
+	for i := 0; i < len(deck); i++ {
+		for j := i + 1; j < len(deck); j++ {
+			combos = append(combos, combo{
+				cards: []poker.Card{deck[i], deck[j]},
+				mask:  1<<uint(i) | 1<<uint(j),
+				class: pushfold.ClassOf(deck[i], deck[j]),
+			})
+		}
+	}
+
+	var wins, games [pushfold.NumClasses][pushfold.NumClasses]float64
+	ranks := make([]int, len(combos))
+	r := rand.New(rand.NewSource(*seed))
+	for b := 0; b < *boards; b++ {
+		board := make([]poker.Card, 5)
+		var boardMask uint64
+		for i, k := range r.Perm(len(deck))[:5] {
+			board[i] = deck[k]
+			boardMask |= 1 << uint(k)
+		}
+
+		var live []int
+		for i, c := range combos {
+			if c.mask&boardMask != 0 {
+				continue
+			}
+			hole := []poker.Card{c.cards[0], c.cards[1]}
+			ranks[i] = poker.EvaluateBestHand(hole, board).Rank
+			live = append(live, i)
+		}
+
+		for x, i := range live {
+			for _, j := range live[x+1:] {
+				if combos[i].mask&combos[j].mask != 0 {
+					continue
+				}
+				ci, cj := combos[i].class, combos[j].class
+				switch {
+				case ranks[i] < ranks[j]:
+					wins[ci][cj]++
+				case ranks[i] > ranks[j]:
+					wins[cj][ci]++
+				default:
+					wins[ci][cj] += 0.5
+					wins[cj][ci] += 0.5
+				}
+				games[ci][cj]++
+				games[cj][ci]++
+			}
+		}
+		if (b+1)%1000 == 0 {
+			log.Printf("%d/%d boards", b+1, *boards)
+		}
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "// Code generated by go run ./gen -boards %d -seed %d; DO NOT EDIT.\n\n", *boards, *seed)
+	fmt.Fprintln(w, "package pushfold")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// preflopEquity[i][j] is the all-in equity of class i against class j in hundredths of a percent")
+	fmt.Fprintln(w, "var preflopEquity = [NumClasses][NumClasses]uint16{")
+	for i := 0; i < pushfold.NumClasses; i++ {
+		fmt.Fprintf(w, "\t{ // %s\n", pushfold.ClassName(i))
+		for j := 0; j < pushfold.NumClasses; j++ {
+			if j%13 == 0 {
+				fmt.Fprint(w, "\t\t")
+			}
+			equity := 5000.0
+			if games[i][j] > 0 {
+				equity = 10000 * wins[i][j] / games[i][j]
+			}
+			fmt.Fprintf(w, "%d,", int(equity+0.5))
+			if j%13 == 12 {
+				fmt.Fprintln(w)
+			} else {
+				fmt.Fprint(w, " ")
+			}
+		}
+		fmt.Fprintln(w, "\t},")
+	}
+	fmt.Fprintln(w, "}")
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,191 @@
+// Package pushfold solves heads-up push/fold play: the small blind either moves all-in or folds,
+// and the big blind either calls the all-in or folds. Strategies are over the 169 starting-hand
+// classes and use a preflop equity table built with the poker evaluator (see gen).
+package pushfold
+
+import (
+	"fmt"
+	"sync"
+)
+
+//go:generate go run ./gen -boards 20000 -o equity_table.go
+
+// DefaultIterations is the number of fictitious play iterations used by Solve
+const DefaultIterations = 2000
+
+// Structure is a heads-up blind structure, with every amount in big blinds
+type Structure struct {
+	Stack      float64 // Effective stack before posting blinds and antes
+	SmallBlind float64 // Small blind (usually 0.5)
+	Ante       float64 // Ante posted by each player
+}
+
+// Solution is an approximate Nash equilibrium of the push/fold game
+type Solution struct {
+	Push           [NumClasses]float64 // Frequency the small blind moves all-in with each class
+	Call           [NumClasses]float64 // Frequency the big blind calls the all-in with each class
+	PushPercent    float64             // Share of starting hands pushed
+	CallPercent    float64             // Share of starting hands called
+	SmallBlindEV   float64             // Small blind's expected result in big blinds (the big blind's is the negative)
+	Exploitability float64             // Big blinds per hand a best response would gain against the solution
+	Iterations     int
+}
+
+// Equity returns the all-in preflop equity of class1 against class2
+func Equity(class1, class2 int) float64 {
+	return float64(preflopEquity[class1][class2]) / 10000
+}
+
+var (
+	matchupOnce    sync.Once
+	matchupWeights [NumClasses][NumClasses]float64
+)
+
+// weights returns the number of non-colliding combo pairs for every pair of classes
+func weights() *[NumClasses][NumClasses]float64 {
+	matchupOnce.Do(func() {
+		for i := 0; i < NumClasses; i++ {
+			for j := i; j < NumClasses; j++ {
+				count := float64(matchupCombos(i, j))
+				matchupWeights[i][j] = count
+				matchupWeights[j][i] = count
+			}
+		}
+	})
+	return &matchupWeights
+}
+
+// Solve approximates the push/fold equilibrium for the structure with fictitious play:
+// each player repeatedly best-responds to the other's average strategy, and the averages converge.
+func Solve(structure Structure, iterations int) (Solution, error) {
+	if structure.SmallBlind <= 0 || structure.SmallBlind > 1 {
+		return Solution{}, fmt.Errorf("small blind must be greater than 0 and at most 1 big blind")
+	}
+	if structure.Ante < 0 {
+		return Solution{}, fmt.Errorf("ante must not be negative")
+	}
+	if structure.Stack < 1+structure.Ante {
+		return Solution{}, fmt.Errorf("stack must cover the big blind and ante")
+	}
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+
+	g := newGame(structure)
+	var solution Solution
+	for i := range solution.Push {
+		solution.Push[i] = 1
+		solution.Call[i] = 1
+	}
+	for t := 1; t <= iterations; t++ {
+		call := g.bestCall(&solution.Push)
+		for i := range solution.Call {
+			solution.Call[i] += (call[i] - solution.Call[i]) / float64(t+1)
+		}
+		push := g.bestPush(&solution.Call)
+		for i := range solution.Push {
+			solution.Push[i] += (push[i] - solution.Push[i]) / float64(t+1)
+		}
+	}
+
+	solution.Iterations = iterations
+	solution.PushPercent = rangePercent(&solution.Push)
+	solution.CallPercent = rangePercent(&solution.Call)
+	solution.SmallBlindEV = g.value(&solution.Push, &solution.Call)
+	bestPush := g.bestPush(&solution.Call)
+	bestCall := g.bestCall(&solution.Push)
+	solution.Exploitability = (g.value(&bestPush, &solution.Call) - g.value(&solution.Push, &bestCall)) / 2
+	return solution, nil
+}
+
+// game holds the payoffs of a structure
+type game struct {
+	structure Structure
+	weights   *[NumClasses][NumClasses]float64
+}
+
+func newGame(structure Structure) game {
+	return game{structure: structure, weights: weights()}
+}
+
+// showdown returns the small blind's result when class i is called by class j
+func (g game) showdown(i, j int) float64 {
+	return Equity(i, j)*2*g.structure.Stack - g.structure.Stack
+}
+
+// steal returns the small blind's result when the big blind folds
+func (g game) steal() float64 {
+	return 1 + g.structure.Ante
+}
+
+// foldLoss returns the small blind's result when folding
+func (g game) foldLoss() float64 {
+	return -(g.structure.SmallBlind + g.structure.Ante)
+}
+
+// bestPush returns the small blind's best response to a calling strategy
+func (g game) bestPush(call *[NumClasses]float64) [NumClasses]float64 {
+	var push [NumClasses]float64
+	for i := 0; i < NumClasses; i++ {
+		ev, total := 0.0, 0.0
+		for j := 0; j < NumClasses; j++ {
+			w := g.weights[i][j]
+			ev += w * (call[j]*g.showdown(i, j) + (1-call[j])*g.steal())
+			total += w
+		}
+		if ev/total >= g.foldLoss() {
+			push[i] = 1
+		}
+	}
+	return push
+}
+
+// bestCall returns the big blind's best response to a pushing strategy
+func (g game) bestCall(push *[NumClasses]float64) [NumClasses]float64 {
+	var call [NumClasses]float64
+	for j := 0; j < NumClasses; j++ {
+		// The big blind's result is the negative of the small blind's
+		ev, total := 0.0, 0.0
+		for i := 0; i < NumClasses; i++ {
+			w := g.weights[i][j] * push[i]
+			ev -= w * g.showdown(i, j)
+			total += w
+		}
+		if total == 0 || ev/total >= -g.steal() {
+			call[j] = 1
+		}
+	}
+	return call
+}
+
+// value returns the small blind's expected result in big blinds
+func (g game) value(push, call *[NumClasses]float64) float64 {
+	ev, total := 0.0, 0.0
+	for i := 0; i < NumClasses; i++ {
+		for j := 0; j < NumClasses; j++ {
+			w := g.weights[i][j]
+			pushed := call[j]*g.showdown(i, j) + (1-call[j])*g.steal()
+			ev += w * (push[i]*pushed + (1-push[i])*g.foldLoss())
+			total += w
+		}
+	}
+	return ev / total
+}
+
+// rangePercent returns the share of all 1326 combos played by a strategy
+func rangePercent(strategy *[NumClasses]float64) float64 {
+	combos := 0.0
+	for class, frequency := range strategy {
+		combos += frequency * float64(comboCount(class))
+	}
+	return combos / 1326
+}
+
+// Chart arranges a strategy as a 13x13 grid, Aces first, with suited hands above the diagonal
+func Chart(strategy [NumClasses]float64) [13][13]float64 {
+	var chart [13][13]float64
+	for class, frequency := range strategy {
+		chart[class/13][class%13] = frequency
+	}
+	return chart
+}
@@ -0,0 +1,161 @@
+package pushfold
+
+import (
+	"math"
+	"testing"
+
+	"temperature-converter/poker"
+)
+
+func TestClasses(t *testing.T) {
+	testCases := []struct {
+		cards []string
+		name  string
+	}{
+		{[]string{"HA", "SA"}, "AA"},
+		{[]string{"HA", "HK"}, "AKs"},
+		{[]string{"HK", "SA"}, "AKo"},
+		{[]string{"C7", "D2"}, "72o"},
+		{[]string{"S3", "S2"}, "32s"},
+		{[]string{"D2", "C2"}, "22"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cards, err := poker.ParseCards(tc.cards)
+			if err != nil {
+				t.Fatalf("Failed to parse cards: %v", err)
+			}
+			class := ClassOf(cards[0], cards[1])
+			if name := ClassName(class); name != tc.name {
+				t.Errorf("Expected %s, got %s", tc.name, name)
+			}
+			found := false
+			for _, combo := range ClassCombos(class) {
+				if ClassOf(combo[0], combo[1]) != class {
+					t.Errorf("Combo %v is not in class %s", combo, tc.name)
+				}
+				if (combo[0] == cards[0] && combo[1] == cards[1]) || (combo[0] == cards[1] && combo[1] == cards[0]) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Expected %v among the combos of %s", tc.cards, tc.name)
+			}
+		})
+	}
+
+	total := 0
+	for class := 0; class < NumClasses; class++ {
+		if len(ClassCombos(class)) != comboCount(class) {
+			t.Errorf("Expected %d combos for %s, got %d", comboCount(class), ClassName(class), len(ClassCombos(class)))
+		}
+		total += comboCount(class)
+	}
+	if total != 1326 {
+		t.Errorf("Expected 1326 combos, got %d", total)
+	}
+}
+
+func TestEquity(t *testing.T) {
+	class := func(name string) int {
+		for i := 0; i < NumClasses; i++ {
+			if ClassName(i) == name {
+				return i
+			}
+		}
+		t.Fatalf("Unknown class %s", name)
+		return 0
+	}
+
+	testCases := []struct {
+		hand     string
+		opponent string
+		expected float64
+	}{
+		{"AA", "KK", 0.82},
+		{"AKo", "22", 0.47},
+		{"AKs", "QJs", 0.62},
+		{"72o", "AA", 0.12},
+	}
+	for _, tc := range testCases {
+		equity := Equity(class(tc.hand), class(tc.opponent))
+		if math.Abs(equity-tc.expected) > 0.015 {
+			t.Errorf("Expected %s vs %s equity near %.2f, got %.4f", tc.hand, tc.opponent, tc.expected, equity)
+		}
+	}
+
+	for i := 0; i < NumClasses; i++ {
+		for j := 0; j < NumClasses; j++ {
+			if sum := Equity(i, j) + Equity(j, i); math.Abs(sum-1) > 0.0002 {
+				t.Fatalf("Expected %s and %s equities to sum to 1, got %.4f", ClassName(i), ClassName(j), sum)
+			}
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	short, err := Solve(Structure{Stack: 2, SmallBlind: 0.5}, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	deep, err := Solve(Structure{Stack: 15, SmallBlind: 0.5}, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if short.PushPercent < 0.9 {
+		t.Errorf("Expected nearly every hand pushed at 2bb, got %.3f", short.PushPercent)
+	}
+	if deep.PushPercent >= short.PushPercent || deep.CallPercent >= short.CallPercent {
+		t.Errorf("Expected tighter ranges at 15bb, got push %.3f/%.3f and call %.3f/%.3f",
+			deep.PushPercent, short.PushPercent, deep.CallPercent, short.CallPercent)
+	}
+	if deep.PushPercent < 0.35 || deep.PushPercent > 0.6 {
+		t.Errorf("Expected about 45%% pushed at 15bb, got %.3f", deep.PushPercent)
+	}
+
+	aces, sevenTwo := 0, 12*13+7
+	if deep.Push[aces] != 1 || deep.Call[aces] != 1 {
+		t.Errorf("Expected AA always pushed and called, got %.3f and %.3f", deep.Push[aces], deep.Call[aces])
+	}
+	if ClassName(sevenTwo) != "72o" || deep.Call[sevenTwo] > 0.01 {
+		t.Errorf("Expected 72o folded by the big blind at 15bb, got %.3f", deep.Call[sevenTwo])
+	}
+	if deep.Exploitability > 0.01 {
+		t.Errorf("Expected exploitability below 0.01bb, got %.4f", deep.Exploitability)
+	}
+
+	// Antes make stealing more attractive
+	ante, err := Solve(Structure{Stack: 15, SmallBlind: 0.5, Ante: 0.125}, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ante.PushPercent <= deep.PushPercent {
+		t.Errorf("Expected wider pushing with antes, got %.3f vs %.3f", ante.PushPercent, deep.PushPercent)
+	}
+
+	invalid := []Structure{
+		{Stack: 10, SmallBlind: 0},
+		{Stack: 10, SmallBlind: 0.5, Ante: -1},
+		{Stack: 0.5, SmallBlind: 0.5},
+	}
+	for _, structure := range invalid {
+		if _, err := Solve(structure, 0); err == nil {
+			t.Errorf("Expected error for %+v", structure)
+		}
+	}
+}
+
+func TestChart(t *testing.T) {
+	var strategy [NumClasses]float64
+	strategy[1] = 1  // AKs
+	strategy[13] = 1 // AKo
+	chart := Chart(strategy)
+	if chart[0][1] != 1 || chart[1][0] != 1 || chart[0][0] != 0 {
+		t.Errorf("Expected AKs and AKo set in the chart, got %v", chart[0][:2])
+	}
+	if ClassName(1) != "AKs" || ClassName(13) != "AKo" {
+		t.Errorf("Expected AKs above the diagonal and AKo below, got %s and %s", ClassName(1), ClassName(13))
+	}
+}
@@ -0,0 +1,159 @@
+// Package resultcache is an in-process least-recently-used cache for computed results, with a size limit,
+// a time-to-live and hit/miss counters.
+package resultcache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Stats counts how a cache has been used
+type Stats struct {
+	Entries     int           // Entries held now
+	Capacity    int           // Most entries held at once
+	TTL         time.Duration // How long an entry is served after it is stored; 0 for no limit
+	Hits        uint64        // Lookups that found a live entry
+	Misses      uint64        // Lookups that found nothing, or an expired entry
+	Evictions   uint64        // Entries dropped to make room for new ones
+	Expirations uint64        // Entries dropped because they outlived the TTL
+}
+
+// HitRate returns the share of lookups that were hits, or 0 before any lookup
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Cache maps string keys to values, dropping the least recently used entry when it is full. It is safe
+// for concurrent use.
+type Cache[V any] struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	entries  map[string]*list.Element
+	order    *list.List // Most recently used first
+	stats    Stats
+}
+
+// entry is one cached value
+type entry[V any] struct {
+	key     string
+	value   V
+	expires time.Time // Zero when the cache has no TTL
+}
+
+// New creates a cache holding up to capacity entries, each for at most ttl (0 for no limit)
+func New[V any](capacity int, ttl time.Duration) *Cache[V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &Cache[V]{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value stored under key, if there is one that has not expired
+func (c *Cache[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	element := c.live(key)
+	if element == nil {
+		c.stats.Misses++
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	c.stats.Hits++
+	return element.Value.(*entry[V]).value, true
+}
+
+// Put stores value under key, replacing any earlier value and restarting its TTL
+func (c *Cache[V]) Put(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.store(key, value)
+}
+
+// Update stores the value update returns for the one stored under key, given ok when there is one that
+// has not expired, and returns it. No other change to key can come between reading and storing, so
+// concurrent callers can merge their results into one entry. It does not count as a lookup.
+func (c *Cache[V]) Update(key string, update func(value V, ok bool) V) V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var current V
+	element := c.live(key)
+	if element != nil {
+		current = element.Value.(*entry[V]).value
+	}
+	value := update(current, element != nil)
+	c.store(key, value)
+	return value
+}
+
+// live returns the entry stored under key, dropping it if it has expired
+func (c *Cache[V]) live(key string) *list.Element {
+	element, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if expires := element.Value.(*entry[V]).expires; !expires.IsZero() && !c.now().Before(expires) {
+		c.remove(element)
+		c.stats.Expirations++
+		return nil
+	}
+	return element
+}
+
+// store puts value under key, evicting the least recently used entries beyond the capacity
+func (c *Cache[V]) store(key string, value V) {
+	var expires time.Time
+	if c.ttl > 0 {
+		expires = c.now().Add(c.ttl)
+	}
+	if element, ok := c.entries[key]; ok {
+		e := element.Value.(*entry[V])
+		e.value, e.expires = value, expires
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry[V]{key: key, value: value, expires: expires})
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+		c.stats.Evictions++
+	}
+}
+
+// Len returns the number of entries held, including expired ones not yet dropped
+func (c *Cache[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Stats returns the cache's counters
+func (c *Cache[V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = c.order.Len()
+	stats.Capacity = c.capacity
+	stats.TTL = c.ttl
+	return stats
+}
+
+// remove drops an entry
+func (c *Cache[V]) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*entry[V]).key)
+}
@@ -0,0 +1,142 @@
+package resultcache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCacheLRU(t *testing.T) {
+	cache := New[int](2, 0)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	// Reading a makes b the least recently used, so c pushes b out
+	if value, ok := cache.Get("a"); !ok || value != 1 {
+		t.Errorf("Expected 1 for a, got %d (%v)", value, ok)
+	}
+	cache.Put("c", 3)
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected b to be evicted")
+	}
+	if value, ok := cache.Get("c"); !ok || value != 3 {
+		t.Errorf("Expected 3 for c, got %d (%v)", value, ok)
+	}
+
+	// Storing a key again replaces its value without evicting anything
+	cache.Put("a", 10)
+	if value, _ := cache.Get("a"); value != 10 || cache.Len() != 2 {
+		t.Errorf("Expected a replaced by 10 with 2 entries, got %d with %d", value, cache.Len())
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 3 || stats.Misses != 1 || stats.Evictions != 1 || stats.Entries != 2 || stats.Capacity != 2 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+	if rate := stats.HitRate(); rate != 0.75 {
+		t.Errorf("Expected a hit rate of 0.75, got %v", rate)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache := New[string](10, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("spot", "result")
+	now = now.Add(59 * time.Second)
+	if _, ok := cache.Get("spot"); !ok {
+		t.Error("Expected the entry before the TTL")
+	}
+
+	// Storing again restarts the TTL
+	cache.Put("spot", "newer")
+	now = now.Add(59 * time.Second)
+	if value, ok := cache.Get("spot"); !ok || value != "newer" {
+		t.Errorf("Expected the newer entry, got %q (%v)", value, ok)
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := cache.Get("spot"); ok {
+		t.Error("Expected the entry to expire")
+	}
+	stats := cache.Stats()
+	if stats.Expirations != 1 || stats.Misses != 1 || stats.Entries != 0 || stats.TTL != time.Minute {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+	if (Stats{}).HitRate() != 0 {
+		t.Error("Expected no hit rate before any lookup")
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	cache := New[int](50, time.Hour)
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := fmt.Sprint(i % 100)
+				if _, ok := cache.Get(key); !ok {
+					cache.Put(key, i)
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	stats := cache.Stats()
+	if stats.Hits+stats.Misses != 8000 || stats.Entries != 50 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+func TestCacheUpdate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache := New[int](10, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	add := func(n int) func(int, bool) int {
+		return func(value int, _ bool) int { return value + n }
+	}
+	if value := cache.Update("count", add(5)); value != 5 {
+		t.Errorf("Expected 5 from an empty entry, got %d", value)
+	}
+	if value := cache.Update("count", add(3)); value != 8 {
+		t.Errorf("Expected 8, got %d", value)
+	}
+
+	// An expired entry is updated as if there were none
+	now = now.Add(time.Minute)
+	cache.Update("count", func(value int, ok bool) int {
+		if ok || value != 0 {
+			t.Errorf("Expected no live entry after the TTL, got %d (%v)", value, ok)
+		}
+		return 1
+	})
+	stats := cache.Stats()
+	if stats.Hits+stats.Misses != 0 || stats.Expirations != 1 || stats.Entries != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+func TestCacheUpdateConcurrent(t *testing.T) {
+	cache := New[int](10, 0)
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				cache.Update("count", func(value int, _ bool) int { return value + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+
+	if value, _ := cache.Get("count"); value != 8000 {
+		t.Errorf("Expected every update to be kept, got %d of 8000", value)
+	}
+}
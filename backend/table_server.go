@@ -0,0 +1,734 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/proto"
+
+	"temperature-converter/fairshuffle"
+	"temperature-converter/game"
+	"temperature-converter/handhistory"
+	pb "temperature-converter/pb"
+)
+
+const (
+	defaultTableID         = "main"
+	defaultTableSeats      = 6
+	defaultTableSmallBlind = 1
+	defaultTableBigBlind   = 2
+	defaultBuyInBigBlinds  = 100
+	defaultTurnSeconds     = 30
+	defaultHandDelay       = 3 * time.Second
+	tableUpdateBuffer      = 256 // Updates queued for a player before their stream is dropped
+	maxClientSeedLength    = 256
+)
+
+// tableServer implements the TableService, hosting any number of named tables
+type tableServer struct {
+	pb.UnimplementedTableServiceServer
+	mu        sync.Mutex
+	tables    map[string]*liveTable
+	handDelay time.Duration      // Pause between hands
+	hands     *handhistory.Store // Where finished hands are recorded
+}
+
+// newTableServer creates a table server with no tables that records finished hands in hands
+func newTableServer(hands *handhistory.Store) *tableServer {
+	return &tableServer{
+		tables:    make(map[string]*liveTable),
+		handDelay: defaultHandDelay,
+		hands:     hands,
+	}
+}
+
+// liveTable is a game table with the players connected to it
+type liveTable struct {
+	id          string
+	mu          sync.Mutex
+	table       *game.Table
+	turnTimeout time.Duration
+	handDelay   time.Duration
+	clients     map[int]*tableClient // Connected players by seat
+	leaving     map[int]bool         // Seats to free when the hand ends
+	sent        int                  // Events of the current hand already sent
+	turn        int                  // Counts turns so that a stale timer does nothing
+	prompted    [2]int               // Hand number and event count of the last turn prompt
+	timer       *time.Timer
+	nextHand    *time.Timer // Deals the next hand while it is scheduled
+	hands       *handhistory.Store
+	created     time.Time
+	before      []game.PlayerState // Seats before the current hand started, for its history
+	started     time.Time
+	recorded    int    // Number of the last hand recorded
+	onEmpty     func() // Called once the last seat is freed
+
+	// Provably fair shuffles: the hash of the next hand's seed is published before players can change their
+	// client seeds for it, and each seed is revealed when its hand ends
+	clientSeeds map[int]string // By seat
+	nextSeed    fairshuffle.ServerSeed
+	seed        fairshuffle.ServerSeed // Seed of the current or last hand
+	fairness    *pb.HandFairness       // Shuffle of the current or last hand, without its seed
+	revealed    int                    // Number of the last hand whose seed was revealed
+}
+
+// tableClient is one player's stream of updates
+type tableClient struct {
+	seat    int
+	updates chan *pb.TableUpdate
+	closed  bool
+}
+
+// PlayHand seats the player from the first command, then relays their actions and the table's updates
+func (s *tableServer) PlayHand(stream pb.TableService_PlayHandServer) error {
+	command, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	join := command.GetJoin()
+	if join == nil {
+		return fmt.Errorf("the first command must join a table")
+	}
+	live, client, err := s.join(join)
+	if err != nil {
+		return err
+	}
+	defer s.leave(live, client)
+
+	// Commands are read in the background so that updates keep flowing while waiting for the player
+	commands := make(chan *pb.TableCommand)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			command, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case commands <- command:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case update, ok := <-client.updates:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		case command := <-commands:
+			switch {
+			case command.GetAction() != nil:
+				live.act(client, command.GetAction())
+			case command.GetLeave() != nil:
+				s.leave(live, client)
+			case command.GetClientSeed() != nil:
+				live.setClientSeed(client, command.GetClientSeed().Seed)
+			default:
+				live.reject(client, fmt.Errorf("already seated in seat %d", client.seat))
+			}
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// join finds or creates the table and seats the player
+func (s *tableServer) join(join *pb.JoinTable) (*liveTable, *tableClient, error) {
+	id := join.TableId
+	if id == "" {
+		id = defaultTableID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	live, ok := s.tables[id]
+	if !ok {
+		config, turnTimeout, err := tableConfig(join.Config)
+		if err != nil {
+			return nil, nil, err
+		}
+		table, err := game.NewTable(config, time.Now().UnixNano())
+		if err != nil {
+			return nil, nil, err
+		}
+		live = &liveTable{
+			id:          id,
+			table:       table,
+			turnTimeout: turnTimeout,
+			handDelay:   s.handDelay,
+			clients:     make(map[int]*tableClient),
+			leaving:     make(map[int]bool),
+			hands:       s.hands,
+			created:     time.Now(),
+			clientSeeds: make(map[int]string),
+			nextSeed:    fairshuffle.NewServerSeed(),
+		}
+		live.onEmpty = func() { s.remove(live) }
+	}
+
+	client, err := live.join(join)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.tables[id] = live
+	return live, client, nil
+}
+
+// leave takes the player away from the table and closes it once nobody is seated
+func (s *tableServer) leave(live *liveTable, client *tableClient) {
+	if live.leave(client) {
+		s.remove(live)
+	}
+}
+
+// remove closes the table if nobody is seated at it
+func (s *tableServer) remove(live *liveTable) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	live.mu.Lock()
+	defer live.mu.Unlock()
+	if len(live.table.State().Players) == 0 && s.tables[live.id] == live {
+		delete(s.tables, live.id)
+		live.stopTimer()
+		if live.nextHand != nil {
+			live.nextHand.Stop()
+			live.nextHand = nil
+		}
+	}
+}
+
+// tableConfig converts table settings, filling in defaults
+func tableConfig(config *pb.TableConfig) (game.Config, time.Duration, error) {
+	result := game.Config{
+		Seats:      defaultTableSeats,
+		SmallBlind: defaultTableSmallBlind,
+		BigBlind:   defaultTableBigBlind,
+	}
+	turnSeconds := int32(defaultTurnSeconds)
+	if config != nil {
+		if config.Seats != 0 {
+			result.Seats = int(config.Seats)
+		}
+		if config.BigBlind != 0 {
+			result.SmallBlind = config.SmallBlind
+			result.BigBlind = config.BigBlind
+		}
+		result.Ante = config.Ante
+		if config.Structure != "" {
+			structure, err := game.ParseBettingStructure(config.Structure)
+			if err != nil {
+				return game.Config{}, 0, err
+			}
+			result.Structure = structure
+		}
+		if config.TurnSeconds < 0 {
+			return game.Config{}, 0, fmt.Errorf("turn seconds must not be negative")
+		}
+		if config.TurnSeconds != 0 {
+			turnSeconds = config.TurnSeconds
+		}
+	}
+	return result, time.Duration(turnSeconds) * time.Second, nil
+}
+
+// join sits the player down and announces them to the table
+func (lt *liveTable) join(join *pb.JoinTable) (*tableClient, error) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	config := lt.table.Config()
+	seat := -1
+	if join.Seat != nil {
+		seat = int(*join.Seat)
+	} else {
+		occupied := make(map[int]bool)
+		for _, p := range lt.table.State().Players {
+			occupied[p.Seat] = true
+		}
+		for number := 0; number < config.Seats; number++ {
+			if !occupied[number] {
+				seat = number
+				break
+			}
+		}
+		if seat < 0 {
+			return nil, fmt.Errorf("table %s is full", lt.id)
+		}
+	}
+	buyIn := join.BuyIn
+	if buyIn == 0 {
+		buyIn = defaultBuyInBigBlinds * config.BigBlind
+	}
+	if len(join.ClientSeed) > maxClientSeedLength {
+		return nil, fmt.Errorf("client seed must be at most %d characters", maxClientSeedLength)
+	}
+	if err := lt.table.Sit(seat, join.Name, buyIn); err != nil {
+		return nil, err
+	}
+
+	client := &tableClient{seat: seat, updates: make(chan *pb.TableUpdate, tableUpdateBuffer)}
+	lt.clients[seat] = client
+	lt.clientSeeds[seat] = join.ClientSeed
+	lt.broadcast(&pb.TableUpdate{
+		Type:     "joined",
+		Seat:     int32(seat),
+		Amount:   buyIn,
+		Text:     fmt.Sprintf("%s sits in seat %d with %d", lt.name(seat, join.Name), seat, buyIn),
+		Fairness: &pb.HandFairness{NextServerSeedHash: lt.nextSeed.Hash()},
+	})
+	lt.advance()
+	return client, nil
+}
+
+// leave frees the player's seat, or folds their hand and frees it when the hand ends.
+// It reports whether the player was still at the table.
+func (lt *liveTable) leave(client *tableClient) bool {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	if lt.clients[client.seat] != client {
+		return false
+	}
+
+	state := lt.table.State()
+	update := &pb.TableUpdate{Type: "left", Seat: int32(client.seat), Text: fmt.Sprintf("seat %d leaves the table", client.seat)}
+	lt.broadcast(update)
+	lt.drop(client)
+	if state.ToAct == client.seat {
+		lt.advance()
+	}
+	return true
+}
+
+// drop disconnects the player and frees their seat, or marks it to be freed when the hand ends
+func (lt *liveTable) drop(client *tableClient) {
+	if lt.clients[client.seat] != client {
+		return
+	}
+	lt.close(client)
+	delete(lt.clients, client.seat)
+
+	if lt.table.InHand() {
+		// The seat is freed when the hand ends; the player folds when their turn comes
+		lt.leaving[client.seat] = true
+	} else {
+		lt.table.Leave(client.seat)
+		delete(lt.clientSeeds, client.seat)
+	}
+}
+
+// act applies the player's action, or tells them why it was rejected
+func (lt *liveTable) act(client *tableClient, action *pb.TableAction) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	actionType, err := game.ParseActionType(action.Type)
+	if err != nil {
+		lt.reject(client, err)
+		return
+	}
+	if err := lt.table.Act(client.seat, game.Action{Type: actionType, Amount: action.Amount}); err != nil {
+		lt.reject(client, err)
+		return
+	}
+	lt.turn++
+	lt.advance()
+}
+
+// setClientSeed changes the player's client seed, which takes effect from the next hand
+func (lt *liveTable) setClientSeed(client *tableClient, seed string) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	if len(seed) > maxClientSeedLength {
+		lt.reject(client, fmt.Errorf("client seed must be at most %d characters", maxClientSeedLength))
+		return
+	}
+	lt.clientSeeds[client.seat] = seed
+	lt.broadcast(&pb.TableUpdate{
+		Type: "client_seed",
+		Seat: int32(client.seat),
+		Text: fmt.Sprintf("seat %d changes their client seed for the next hand", client.seat),
+	})
+}
+
+// reject sends an error to one player
+func (lt *liveTable) reject(client *tableClient, err error) {
+	lt.send(client, &pb.TableUpdate{Type: "error", Seat: int32(client.seat), Error: err.Error()})
+}
+
+// advance sends new events, folds for players who left, and prompts the next player or schedules the next hand
+func (lt *liveTable) advance() {
+	for {
+		lt.flush()
+		if !lt.table.InHand() {
+			lt.handOver()
+			return
+		}
+		seat := lt.table.ToAct()
+		if _, connected := lt.clients[seat]; connected {
+			lt.prompt(seat)
+			return
+		}
+		// Players who left fold when their turn comes
+		lt.turn++
+		if err := lt.table.Act(seat, game.Action{Type: game.Fold}); err != nil {
+			log.Printf("table %s: failed to fold for seat %d: %v", lt.id, seat, err)
+			return
+		}
+	}
+}
+
+// flush sends the events of the hand that were not sent yet; hole cards go only to their owner
+func (lt *liveTable) flush() {
+	events := lt.table.Events()
+	for _, event := range events[lt.sent:] {
+		update := &pb.TableUpdate{
+			Type:   string(event.Type),
+			Seat:   int32(event.Seat),
+			Amount: event.Amount,
+			Cards:  cardsToStrings(event.Cards),
+			Text:   event.Text,
+		}
+		if event.Type == game.EventAction {
+			update.Action = event.Action.String()
+		}
+		if event.Private {
+			if client, ok := lt.clients[event.Seat]; ok {
+				lt.send(client, update)
+			}
+			continue
+		}
+		lt.broadcast(update)
+	}
+	lt.sent = len(events)
+}
+
+// prompt announces whose turn it is, with the legal actions for that player, and starts their timer
+func (lt *liveTable) prompt(seat int) {
+	// Only a new decision restarts the clock
+	key := [2]int{lt.table.State().HandNumber, len(lt.table.Events())}
+	if lt.prompted == key {
+		return
+	}
+	lt.prompted = key
+
+	legal, err := lt.table.LegalActions()
+	if err != nil {
+		return
+	}
+	deadline := time.Now().Add(lt.turnTimeout)
+	lt.stopTimer()
+	turn := lt.turn
+	lt.timer = time.AfterFunc(lt.turnTimeout, func() { lt.timeout(turn) })
+
+	for _, client := range lt.clients {
+		update := &pb.TableUpdate{
+			Type:           "turn",
+			Seat:           int32(seat),
+			Text:           fmt.Sprintf("seat %d to act", seat),
+			DeadlineUnixMs: deadline.UnixMilli(),
+		}
+		if client.seat == seat {
+			update.Legal = &pb.LegalActions{
+				CanCheck:   legal.CanCheck,
+				CallAmount: legal.CallAmount,
+				CanRaise:   legal.CanRaise,
+				MinRaise:   legal.MinRaise,
+				MaxRaise:   legal.MaxRaise,
+			}
+		}
+		lt.send(client, update)
+	}
+}
+
+// timeout checks or folds for a player who ran out of time
+func (lt *liveTable) timeout(turn int) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	if turn != lt.turn || !lt.table.InHand() {
+		return
+	}
+
+	seat := lt.table.ToAct()
+	legal, err := lt.table.LegalActions()
+	if err != nil {
+		return
+	}
+	action := game.Action{Type: game.Fold}
+	if legal.CanCheck {
+		action.Type = game.Check
+	}
+	lt.broadcast(&pb.TableUpdate{
+		Type:   "timeout",
+		Seat:   int32(seat),
+		Action: action.Type.String(),
+		Text:   fmt.Sprintf("seat %d ran out of time", seat),
+	})
+	if err := lt.table.Act(seat, action); err != nil {
+		log.Printf("table %s: failed to act for seat %d: %v", lt.id, seat, err)
+		return
+	}
+	lt.turn++
+	lt.advance()
+}
+
+// handOver frees the seats of players who left during the hand and schedules the next hand
+func (lt *liveTable) handOver() {
+	lt.stopTimer()
+	lt.record()
+	lt.reveal()
+	for seat := range lt.leaving {
+		lt.table.Leave(seat)
+		delete(lt.leaving, seat)
+		delete(lt.clientSeeds, seat)
+	}
+	if len(lt.table.State().Players) == 0 && lt.onEmpty != nil {
+		// The server locks itself before the table, so the table is closed once this lock is released
+		go lt.onEmpty()
+		return
+	}
+	if lt.nextHand != nil {
+		return
+	}
+
+	withChips := 0
+	for _, p := range lt.table.State().Players {
+		if p.Stack > 0 {
+			withChips++
+		}
+	}
+	if withChips < 2 {
+		return
+	}
+	lt.nextHand = time.AfterFunc(lt.handDelay, lt.startHand)
+}
+
+// startHand deals the next hand if enough players are still seated
+func (lt *liveTable) startHand() {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	lt.nextHand = nil
+	if lt.table.InHand() {
+		return
+	}
+	state := lt.table.State()
+
+	// The deck comes from the committed seed and the client seeds of the players dealt in
+	var clientSeeds []string
+	for _, p := range state.Players {
+		if p.Stack > 0 {
+			clientSeeds = append(clientSeeds, lt.clientSeeds[p.Seat])
+		}
+	}
+	seed, nonce := lt.nextSeed, int64(state.HandNumber+1)
+	if err := lt.table.StartHandWithDeck(fairshuffle.Deck(seed, clientSeeds, nonce)); err != nil {
+		return
+	}
+	lt.seed, lt.nextSeed = seed, fairshuffle.NewServerSeed()
+	lt.fairness = &pb.HandFairness{
+		HandNumber:         int32(nonce),
+		ServerSeedHash:     seed.Hash(),
+		ClientSeeds:        clientSeeds,
+		Nonce:              nonce,
+		NextServerSeedHash: lt.nextSeed.Hash(),
+	}
+	lt.before = state.Players
+	lt.started = time.Now().UTC().Truncate(time.Second)
+	lt.sent = 0
+	lt.broadcast(&pb.TableUpdate{
+		Type:     "shuffle",
+		Seat:     -1,
+		Text:     fmt.Sprintf("hand %d is dealt from the server seed with hash %s", nonce, seed.Hash()),
+		Fairness: lt.fairness,
+	})
+	lt.advance()
+}
+
+// reveal publishes the server seed of the hand that just ended, so players can verify its shuffle
+func (lt *liveTable) reveal() {
+	result := lt.table.Result()
+	if lt.fairness == nil || result == nil || result.HandNumber == lt.revealed {
+		return
+	}
+	lt.revealed = result.HandNumber
+	fairness := proto.Clone(lt.fairness).(*pb.HandFairness)
+	fairness.ServerSeed = lt.seed.String()
+	lt.broadcast(&pb.TableUpdate{
+		Type:     "seed_revealed",
+		Seat:     -1,
+		Text:     fmt.Sprintf("hand %d server seed: %s", result.HandNumber, fairness.ServerSeed),
+		Fairness: fairness,
+	})
+}
+
+// record stores the history of the hand that just ended
+func (lt *liveTable) record() {
+	result := lt.table.Result()
+	if lt.hands == nil || result == nil || result.HandNumber == lt.recorded {
+		return
+	}
+	lt.recorded = result.HandNumber
+	id := fmt.Sprintf("%s-%d-%d", lt.id, lt.created.Unix(), result.HandNumber)
+	hand, err := handhistory.FromGame(id, lt.id, lt.table.Config(), lt.before, lt.table.Events())
+	if err != nil {
+		log.Printf("table %s: failed to record hand %d: %v", lt.id, result.HandNumber, err)
+		return
+	}
+	hand.Time = lt.started
+	lt.hands.Add(hand)
+}
+
+// stopTimer cancels the turn timer
+func (lt *liveTable) stopTimer() {
+	if lt.timer != nil {
+		lt.timer.Stop()
+		lt.timer = nil
+	}
+}
+
+// broadcast sends an update to every connected player
+func (lt *liveTable) broadcast(update *pb.TableUpdate) {
+	for _, client := range lt.clients {
+		lt.send(client, proto.Clone(update).(*pb.TableUpdate))
+	}
+}
+
+// send queues an update for one player with the table as they see it. A player too far behind is disconnected.
+func (lt *liveTable) send(client *tableClient, update *pb.TableUpdate) {
+	if client.closed {
+		return
+	}
+	update.State = lt.state(client.seat)
+	select {
+	case client.updates <- update:
+	default:
+		log.Printf("table %s: seat %d is not reading updates, disconnecting", lt.id, client.seat)
+		lt.drop(client)
+		if len(lt.table.State().Players) == 0 && lt.onEmpty != nil {
+			go lt.onEmpty()
+		}
+	}
+}
+
+// close ends a player's stream of updates
+func (lt *liveTable) close(client *tableClient) {
+	if !client.closed {
+		client.closed = true
+		close(client.updates)
+	}
+}
+
+// state converts the table's public state, adding the hole cards of the player in seat
+func (lt *liveTable) state(seat int) *pb.TableState {
+	state := lt.table.State()
+	result := &pb.TableState{
+		TableId:    lt.id,
+		HandNumber: int32(state.HandNumber),
+		Street:     state.Street.String(),
+		Button:     int32(state.Button),
+		Board:      cardsToStrings(state.Board),
+		Pot:        state.Pot,
+		CurrentBet: state.CurrentBet,
+		ToAct:      int32(state.ToAct),
+		YourSeat:   int32(seat),
+		YourCards:  cardsToStrings(lt.table.HoleCards(seat)),
+		InHand:     lt.table.InHand(),
+	}
+	for _, p := range state.Players {
+		_, connected := lt.clients[p.Seat]
+		result.Seats = append(result.Seats, &pb.TableSeat{
+			Seat:      int32(p.Seat),
+			Name:      p.Name,
+			Stack:     p.Stack,
+			Bet:       p.Bet,
+			Committed: p.Committed,
+			InHand:    p.InHand,
+			Folded:    p.Folded,
+			AllIn:     p.AllIn,
+			Connected: connected,
+		})
+	}
+	return result
+}
+
+// name returns a player's name, or their seat
+func (lt *liveTable) name(seat int, name string) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("seat %d", seat)
+}
+
+// tableWebSocketHandler bridges WebSocket clients, such as the Flutter web app, to the PlayHand stream.
+// Every text frame carries one JSON command from the client or one update from the server.
+func tableWebSocketHandler(grpcClient pb.TableServiceClient) http.Handler {
+	// websocket.Server skips the origin check, so the web client may be served from another host
+	return websocket.Server{Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+		ctx, cancel := context.WithCancel(ws.Request().Context())
+		defer cancel()
+
+		stream, err := grpcClient.PlayHand(ctx)
+		if err != nil {
+			sendTableUpdate(ws, &pb.TableUpdate{Type: "error", Seat: -1, Error: err.Error()})
+			return
+		}
+
+		// Forward commands until the socket closes
+		go func() {
+			defer stream.CloseSend()
+			for {
+				var message string
+				if err := websocket.Message.Receive(ws, &message); err != nil {
+					return
+				}
+				command := &pb.TableCommand{}
+				if err := restUnmarshalOptions.Unmarshal([]byte(message), command); err != nil {
+					sendTableUpdate(ws, &pb.TableUpdate{Type: "error", Seat: -1, Error: "Invalid command"})
+					continue
+				}
+				if err := stream.Send(command); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			update, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				sendTableUpdate(ws, &pb.TableUpdate{Type: "error", Seat: -1, Error: err.Error()})
+				return
+			}
+			if err := sendTableUpdate(ws, update); err != nil {
+				return
+			}
+		}
+	}}
+}
+
+// sendTableUpdate writes a table update to the WebSocket client as JSON
+func sendTableUpdate(ws *websocket.Conn, update *pb.TableUpdate) error {
+	data, err := marshalJSON(update)
+	if err != nil {
+		return err
+	}
+	return websocket.Message.Send(ws, string(data))
+}
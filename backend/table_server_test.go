@@ -0,0 +1,472 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"temperature-converter/fairshuffle"
+	"temperature-converter/handhistory"
+	pb "temperature-converter/pb"
+)
+
+// testTimeout bounds how long a test waits for the table
+const testTimeout = 10 * time.Second
+
+// dialTestServer serves the services register adds over an in-memory connection and returns a connection to them
+func dialTestServer(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	register(grpcServer)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// startTableServer serves a table server over an in-memory connection and returns a client for it
+func startTableServer(t *testing.T) (pb.TableServiceClient, *tableServer) {
+	t.Helper()
+	server := newTableServer(handhistory.NewStore())
+	server.handDelay = 10 * time.Millisecond
+	conn := dialTestServer(t, func(s *grpc.Server) { pb.RegisterTableServiceServer(s, server) })
+	return pb.NewTableServiceClient(conn), server
+}
+
+// testContext returns a context that ends with the test. Cancelling it disconnects the players using it.
+func testContext(t *testing.T) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	t.Cleanup(cancel)
+	return ctx, cancel
+}
+
+// openTable opens a PlayHand stream and sends the join command
+func openTable(t *testing.T, ctx context.Context, client pb.TableServiceClient, join *pb.JoinTable) pb.TableService_PlayHandClient {
+	t.Helper()
+	stream, err := client.PlayHand(ctx)
+	if err != nil {
+		t.Fatalf("Failed to open stream: %v", err)
+	}
+	if err := stream.Send(&pb.TableCommand{Command: &pb.TableCommand_Join{Join: join}}); err != nil {
+		t.Fatalf("Failed to join: %v", err)
+	}
+	return stream
+}
+
+// joinTable seats a player and returns their stream with the update announcing them, which comes first
+func joinTable(t *testing.T, ctx context.Context, client pb.TableServiceClient, join *pb.JoinTable) (pb.TableService_PlayHandClient, *pb.TableUpdate) {
+	t.Helper()
+	stream := openTable(t, ctx, client, join)
+	joined, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Failed to join: %v", err)
+	}
+	if joined.Type != "joined" {
+		t.Fatalf("Expected to be announced first, got %q", joined.Type)
+	}
+	return stream, joined
+}
+
+// nextUpdate skips updates until one of the given type arrives
+func nextUpdate(t *testing.T, stream pb.TableService_PlayHandClient, updateType string) *pb.TableUpdate {
+	t.Helper()
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Stream ended waiting for %q: %v", updateType, err)
+		}
+		if update.Type == updateType {
+			return update
+		}
+		if update.Type == "error" {
+			t.Fatalf("Unexpected error waiting for %q: %s", updateType, update.Error)
+		}
+	}
+}
+
+// sendAction sends a player's action
+func sendAction(t *testing.T, stream pb.TableService_PlayHandClient, actionType string, amount int64) {
+	t.Helper()
+	command := &pb.TableCommand{Command: &pb.TableCommand_Action{Action: &pb.TableAction{Type: actionType, Amount: amount}}}
+	if err := stream.Send(command); err != nil {
+		t.Fatalf("Failed to send %s: %v", actionType, err)
+	}
+}
+
+// eventually polls condition until it holds or the test times out
+func eventually(t *testing.T, message string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal(message)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// tableCount returns the number of open tables
+func tableCount(server *tableServer) int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return len(server.tables)
+}
+
+// seatsTaken returns the number of players seated at a table
+func seatsTaken(server *tableServer, id string) int {
+	server.mu.Lock()
+	live := server.tables[id]
+	server.mu.Unlock()
+	live.mu.Lock()
+	defer live.mu.Unlock()
+	return len(live.table.State().Players)
+}
+
+func TestPlayHand(t *testing.T) {
+	client, _ := startTableServer(t)
+	ctx, _ := testContext(t)
+	config := &pb.TableConfig{Seats: 2, SmallBlind: 5, BigBlind: 10}
+	alice, joined := joinTable(t, ctx, client, &pb.JoinTable{TableId: "play", Name: "Alice", Config: config})
+	if joined.Seat != 0 || joined.Amount != 1000 || joined.State.YourSeat != 0 {
+		t.Errorf("Expected Alice in seat 0 with 1000, got seat %d with %d", joined.Seat, joined.Amount)
+	}
+	bob, _ := joinTable(t, ctx, client, &pb.JoinTable{TableId: "play", Name: "Bob", BuyIn: 500})
+	if joined := nextUpdate(t, alice, "joined"); joined.Seat != 1 || joined.Amount != 500 {
+		t.Errorf("Expected Bob in seat 1 with 500, got seat %d with %d", joined.Seat, joined.Amount)
+	}
+
+	// Each player sees only their own hole cards
+	streams := []pb.TableService_PlayHandClient{alice, bob}
+	for seat, stream := range streams {
+		cards := nextUpdate(t, stream, "hole_cards")
+		if int(cards.Seat) != seat || len(cards.Cards) != 2 || len(cards.State.YourCards) != 2 {
+			t.Errorf("Expected 2 hole cards for seat %d, got %v for seat %d", seat, cards.Cards, cards.Seat)
+		}
+	}
+
+	turn := nextUpdate(t, alice, "turn")
+	nextUpdate(t, bob, "turn")
+	toAct, waiting := streams[turn.Seat], streams[1-turn.Seat]
+	if (turn.Legal != nil) != (turn.Seat == 0) {
+		t.Errorf("Expected legal actions only for the player to act")
+	}
+
+	// Acting out of turn is rejected without ending the stream
+	sendAction(t, waiting, "check", 0)
+	if rejected := nextUpdate(t, waiting, "error"); rejected.Error == "" {
+		t.Errorf("Expected a reason for the rejected action")
+	}
+
+	sendAction(t, toAct, "fold", 0)
+	action := nextUpdate(t, waiting, "action")
+	if action.Seat != turn.Seat || action.Action != "fold" {
+		t.Errorf("Expected seat %d to fold, got %s from seat %d", turn.Seat, action.Action, action.Seat)
+	}
+	awarded := nextUpdate(t, waiting, "pot_awarded")
+	if awarded.Seat != 1-turn.Seat || awarded.Amount != 15 {
+		t.Errorf("Expected seat %d to win 15, got %d for seat %d", 1-turn.Seat, awarded.Amount, awarded.Seat)
+	}
+	nextUpdate(t, waiting, "hand_ended")
+
+	// The next hand is dealt by itself
+	if shuffle := nextUpdate(t, waiting, "shuffle"); shuffle.Fairness.HandNumber != 2 {
+		t.Errorf("Expected hand 2 to be dealt, got hand %d", shuffle.Fairness.HandNumber)
+	}
+}
+
+func TestPlayHandRejectsJoinFirst(t *testing.T) {
+	client, _ := startTableServer(t)
+	stream, err := client.PlayHand(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to open stream: %v", err)
+	}
+	sendAction(t, stream, "check", 0)
+	if _, err := stream.Recv(); err == nil || !strings.Contains(err.Error(), "must join a table") {
+		t.Errorf("Expected the stream to fail without a join, got %v", err)
+	}
+
+	ctx, _ := testContext(t)
+	joinTable(t, ctx, client, &pb.JoinTable{TableId: "full", Config: &pb.TableConfig{Seats: 2}, Seat: int32Ptr(1)})
+	taken := openTable(t, ctx, client, &pb.JoinTable{TableId: "full", Seat: int32Ptr(1)})
+	if _, err := taken.Recv(); err == nil {
+		t.Errorf("Expected an error when joining a taken seat")
+	}
+}
+
+func TestTurnTimeout(t *testing.T) {
+	client, _ := startTableServer(t)
+	ctx, _ := testContext(t)
+	config := &pb.TableConfig{Seats: 2, TurnSeconds: 1}
+	alice, _ := joinTable(t, ctx, client, &pb.JoinTable{TableId: "clock", Config: config})
+	joinTable(t, ctx, client, &pb.JoinTable{TableId: "clock"})
+
+	turn := nextUpdate(t, alice, "turn")
+	wait := time.UnixMilli(turn.DeadlineUnixMs).Sub(time.Now())
+	if wait <= 0 || wait > time.Second {
+		t.Errorf("Expected a deadline within a second, got %v", wait)
+	}
+
+	// Preflop the first player faces the big blind, so running out of time folds
+	timeout := nextUpdate(t, alice, "timeout")
+	if timeout.Seat != turn.Seat || timeout.Action != "fold" {
+		t.Errorf("Expected seat %d to fold on timeout, got %s from seat %d", turn.Seat, timeout.Action, timeout.Seat)
+	}
+	if ended := nextUpdate(t, alice, "hand_ended"); ended.State.InHand {
+		t.Errorf("Expected the hand to be over")
+	}
+}
+
+func TestLeaveDuringHand(t *testing.T) {
+	client, server := startTableServer(t)
+	ctx, _ := testContext(t)
+	config := &pb.TableConfig{Seats: 2}
+	alice, _ := joinTable(t, ctx, client, &pb.JoinTable{TableId: "leave", Config: config})
+	bob, _ := joinTable(t, ctx, client, &pb.JoinTable{TableId: "leave"})
+	streams := []pb.TableService_PlayHandClient{alice, bob}
+
+	turn := nextUpdate(t, alice, "turn")
+	nextUpdate(t, bob, "turn")
+	toAct, leaver := int(turn.Seat), 1-int(turn.Seat)
+
+	// The seat stays taken until the hand is over, and the player folds when their turn comes
+	if err := streams[leaver].Send(&pb.TableCommand{Command: &pb.TableCommand_Leave{Leave: &pb.LeaveTable{}}}); err != nil {
+		t.Fatalf("Failed to leave: %v", err)
+	}
+	left := nextUpdate(t, streams[toAct], "left")
+	if int(left.Seat) != leaver || len(left.State.Seats) != 2 {
+		t.Errorf("Expected seat %d to leave with 2 seats still taken, got seat %d with %d", leaver, left.Seat, len(left.State.Seats))
+	}
+	sendAction(t, streams[toAct], "call", 0)
+	fold := nextUpdate(t, streams[toAct], "action")
+	for fold.Action != "fold" {
+		fold = nextUpdate(t, streams[toAct], "action")
+	}
+	if int(fold.Seat) != leaver {
+		t.Errorf("Expected seat %d to fold, got seat %d", leaver, fold.Seat)
+	}
+	nextUpdate(t, streams[toAct], "hand_ended")
+	eventually(t, "Expected the seat to be freed after the hand", func() bool { return seatsTaken(server, "leave") == 1 })
+	if tableCount(server) != 1 {
+		t.Errorf("Expected the table to stay open while a player is seated")
+	}
+}
+
+func TestDisconnectDuringHandClosesTable(t *testing.T) {
+	client, server := startTableServer(t)
+	var disconnects []context.CancelFunc
+	var alice pb.TableService_PlayHandClient
+	for seat := 0; seat < 3; seat++ {
+		ctx, disconnect := testContext(t)
+		stream, _ := joinTable(t, ctx, client, &pb.JoinTable{TableId: "gone", Config: &pb.TableConfig{Seats: 3}})
+		if seat == 0 {
+			alice = stream
+		}
+		disconnects = append(disconnects, disconnect)
+	}
+
+	// The player to act disconnects last, after the others are waiting to be folded
+	turn := nextUpdate(t, alice, "turn")
+	for seat, disconnect := range disconnects {
+		if seat != int(turn.Seat) {
+			disconnect()
+		}
+	}
+	disconnects[turn.Seat]()
+
+	eventually(t, "Expected the table to close once everyone disconnected", func() bool { return tableCount(server) == 0 })
+}
+
+func TestSlowPlayerIsDropped(t *testing.T) {
+	server := newTableServer(nil)
+	live, slow, err := server.join(&pb.JoinTable{TableId: "slow"})
+	if err != nil {
+		t.Fatalf("Failed to join: %v", err)
+	}
+
+	// Nobody reads the player's updates, so the one after a full buffer disconnects them and frees the seat
+	live.mu.Lock()
+	for i := 0; i <= tableUpdateBuffer; i++ {
+		live.send(slow, &pb.TableUpdate{Type: "test"})
+	}
+	_, connected := live.clients[slow.seat]
+	live.mu.Unlock()
+	if connected || !slow.closed {
+		t.Errorf("Expected the slow player to be disconnected")
+	}
+	eventually(t, "Expected the table to close once the slow player was dropped", func() bool { return tableCount(server) == 0 })
+}
+
+func TestRemoveStopsNextHand(t *testing.T) {
+	server := newTableServer(nil)
+	server.handDelay = time.Hour
+	var clients []*tableClient
+	var live *liveTable
+	for seat := 0; seat < 2; seat++ {
+		table, client, err := server.join(&pb.JoinTable{TableId: "idle"})
+		if err != nil {
+			t.Fatalf("Failed to join: %v", err)
+		}
+		live = table
+		clients = append(clients, client)
+	}
+
+	// Two players can start a hand, so it is scheduled until they both leave
+	live.mu.Lock()
+	scheduled := live.nextHand != nil
+	live.mu.Unlock()
+	if !scheduled {
+		t.Fatalf("Expected the next hand to be scheduled")
+	}
+	for _, client := range clients {
+		server.leave(live, client)
+	}
+	if tableCount(server) != 0 {
+		t.Errorf("Expected the table to close")
+	}
+	live.mu.Lock()
+	defer live.mu.Unlock()
+	if live.nextHand != nil {
+		t.Errorf("Expected the next hand to be cancelled when the table closed")
+	}
+}
+
+func TestFairShuffle(t *testing.T) {
+	client, _ := startTableServer(t)
+	ctx, _ := testContext(t)
+	config := &pb.TableConfig{Seats: 2}
+	alice, joined := joinTable(t, ctx, client, &pb.JoinTable{TableId: "fair", Config: config, ClientSeed: "alice"})
+	commitment := joined.Fairness.NextServerSeedHash
+	if len(commitment) != 64 {
+		t.Fatalf("Expected a SHA-256 commitment when joining, got %q", commitment)
+	}
+	bob, _ := joinTable(t, ctx, client, &pb.JoinTable{TableId: "fair", ClientSeed: "bob"})
+
+	shuffle := nextUpdate(t, alice, "shuffle").Fairness
+	if shuffle.ServerSeedHash != commitment || shuffle.ServerSeed != "" {
+		t.Errorf("Expected the committed hash %s and no seed, got %s", commitment, shuffle.ServerSeedHash)
+	}
+	if shuffle.Nonce != 1 || strings.Join(shuffle.ClientSeeds, ",") != "alice,bob" {
+		t.Errorf("Expected nonce 1 with seeds alice,bob, got %d with %v", shuffle.Nonce, shuffle.ClientSeeds)
+	}
+	cards := nextUpdate(t, alice, "hole_cards").Cards
+	turn := nextUpdate(t, alice, "turn")
+
+	// A client seed changed during the hand is used from the next one
+	if err := bob.Send(&pb.TableCommand{Command: &pb.TableCommand_ClientSeed{ClientSeed: &pb.SetClientSeed{Seed: "bob 2"}}}); err != nil {
+		t.Fatalf("Failed to set the client seed: %v", err)
+	}
+	nextUpdate(t, alice, "client_seed")
+	sendAction(t, []pb.TableService_PlayHandClient{alice, bob}[turn.Seat], "fold", 0)
+
+	revealed := nextUpdate(t, alice, "seed_revealed").Fairness
+	deck, err := fairshuffle.Verify(revealed.ServerSeed, commitment, revealed.ClientSeeds, revealed.Nonce)
+	if err != nil {
+		t.Fatalf("Revealed seed failed verification: %v", err)
+	}
+	dealt := strings.Join(cardsToStrings(deck), ",")
+	for _, card := range cards {
+		if !strings.Contains(dealt, card) {
+			t.Errorf("Expected hole card %s in the verified deck", card)
+		}
+	}
+	if revealed.NextServerSeedHash == commitment {
+		t.Errorf("Expected a new commitment for the next hand")
+	}
+
+	next := nextUpdate(t, alice, "shuffle").Fairness
+	if next.ServerSeedHash != revealed.NextServerSeedHash || strings.Join(next.ClientSeeds, ",") != "alice,bob 2" {
+		t.Errorf("Expected hand 2 from hash %s with seeds alice,bob 2, got %s with %v", revealed.NextServerSeedHash, next.ServerSeedHash, next.ClientSeeds)
+	}
+}
+
+func TestTableWebSocket(t *testing.T) {
+	client, _ := startTableServer(t)
+	httpServer := httptest.NewServer(tableWebSocketHandler(client))
+	defer httpServer.Close()
+
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http"), "", httpServer.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer ws.Close()
+	ws.SetDeadline(time.Now().Add(testTimeout))
+
+	receive := func(updateType string) (*pb.TableUpdate, map[string]any) {
+		t.Helper()
+		for {
+			var message string
+			if err := websocket.Message.Receive(ws, &message); err != nil {
+				t.Fatalf("Socket closed waiting for %q: %v", updateType, err)
+			}
+			update := &pb.TableUpdate{}
+			var raw map[string]any
+			if err := restUnmarshalOptions.Unmarshal([]byte(message), update); err != nil {
+				t.Fatalf("Invalid update %s: %v", message, err)
+			}
+			json.Unmarshal([]byte(message), &raw)
+			if update.Type == updateType {
+				return update, raw
+			}
+		}
+	}
+
+	websocket.Message.Send(ws, "not json")
+	if rejected, _ := receive("error"); rejected.Error != "Invalid command" {
+		t.Errorf("Expected Invalid command, got %q", rejected.Error)
+	}
+
+	websocket.Message.Send(ws, `{"join": {"table_id": "web", "name": "Alice", "config": {"seats": 2, "big_blind": 10, "small_blind": 5}}}`)
+	joined, raw := receive("joined")
+	if joined.State == nil || joined.State.YourSeat != 0 || joined.Amount != 1000 || joined.State.Seats[0].Name != "Alice" {
+		t.Fatalf("Expected Alice in seat 0 with 1000, got %+v", joined)
+	}
+	if joined.Fairness == nil || joined.Fairness.NextServerSeedHash == "" {
+		t.Errorf("Expected the next server seed hash when joining")
+	}
+	if board, ok := raw["state"].(map[string]any)["board"].([]any); !ok || len(board) != 0 {
+		t.Errorf("Expected an empty board array, got %v", raw["state"])
+	}
+	if amount, ok := raw["amount"].(float64); !ok || amount != 1000 {
+		t.Errorf("Expected the buy-in as a JSON number, got %v", raw["amount"])
+	}
+
+	// A gRPC player at the same table starts the hand
+	ctx, _ := testContext(t)
+	joinTable(t, ctx, client, &pb.JoinTable{TableId: "web"})
+	if cards, _ := receive("hole_cards"); len(cards.Cards) != 2 || len(cards.State.YourCards) != 2 {
+		t.Errorf("Expected 2 hole cards, got %v", cards.Cards)
+	}
+	turn, _ := receive("turn")
+	if turn.Seat == 0 && (turn.Legal == nil || turn.Legal.CallAmount != 5) {
+		t.Errorf("Expected to call 5 from the small blind, got %+v", turn.Legal)
+	}
+
+	websocket.Message.Send(ws, `{"leave": {}}`)
+	if left, _ := receive("left"); left.Seat != 0 {
+		t.Errorf("Expected seat 0 to leave, got seat %d", left.Seat)
+	}
+}
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}